@@ -80,6 +80,7 @@ import (
 	"github.com/sideprotocol/side/app/keepers"
 	v1 "github.com/sideprotocol/side/app/upgrades/v1"
 	v2 "github.com/sideprotocol/side/app/upgrades/v2"
+	v3 "github.com/sideprotocol/side/app/upgrades/v3"
 	// packet forward module
 	// bitcoincdc "github.com/sideprotocol/side/bitcoin/codec"
 )
@@ -89,7 +90,7 @@ const (
 	Name = "side"
 )
 
-var Upgrades = []upgrades.Upgrade{v1.Upgrade, v2.Upgrade}
+var Upgrades = []upgrades.Upgrade{v1.Upgrade, v2.Upgrade, v3.Upgrade}
 
 // this line is used by starport scaffolding # stargate/wasm/app/enabledProposals
 
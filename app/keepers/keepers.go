@@ -397,13 +397,17 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		appKeepers.keys[btcbridgetypes.StoreKey],
 		appKeepers.keys[btcbridgetypes.StoreKey],
 		appKeepers.BankKeeper,
+		appKeepers.DistrKeeper,
+		appKeepers.TransferKeeper,
+		gmmmodulekeeper.NewMsgServerImpl(appKeepers.GmmKeeper),
+		govAuthor,
 	)
 
 	// The last arguments can contain custom message handlers, and custom query handlers,
 	// if we want to allow any custom callbacks
 	supportedFeatures := "iterator,staking,stargate,side,cosmwasm_1_1,cosmwasm_1_2,cosmwasm_1_4"
 
-	wasmOpts = append(swasm.RegisterCustomPlugins(&appKeepers.BankKeeper, &appKeepers.GmmKeeper), wasmOpts...)
+	wasmOpts = append(swasm.RegisterCustomPlugins(&appKeepers.BankKeeper, &appKeepers.GmmKeeper, &appKeepers.BtcBridgeKeeper), wasmOpts...)
 
 	// Create Wasmd Keepers
 	// this line is used by starport scaffolding # stargate/app/scopedKeeper
@@ -490,6 +494,12 @@ func (appKeepers *AppKeepers) SetupHooks() {
 			appKeepers.SlashingKeeper.Hooks(),
 		),
 	)
+
+	appKeepers.BtcBridgeKeeper.SetHooks(
+		btcbridgetypes.NewMultiBtcBridgeHooks(
+		// insert btcbridge hooks receivers here
+		),
+	)
 }
 
 // TODO: We need to automate this, by bundling with a module struct...
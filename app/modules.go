@@ -142,7 +142,7 @@ func appModules(
 		icaModule,
 		gmmModule,
 		yieldModule,
-		btcbridge.NewAppModule(appCodec, app.BtcBridgeKeeper),
+		btcbridge.NewAppModule(appCodec, app.BtcBridgeKeeper, app.AccountKeeper, app.BankKeeper),
 		// this line is used by starport scaffolding # stargate/app/appModule
 
 		crisis.NewAppModule(app.CrisisKeeper, skipGenesisInvariants, app.GetSubspace(crisistypes.ModuleName)), // always be last to make sure that it checks for all invariants and not only part of them
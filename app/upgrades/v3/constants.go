@@ -0,0 +1,19 @@
+package v03
+
+import (
+	store "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/sideprotocol/side/app/upgrades"
+)
+
+const (
+	UpgradeName = "v03"
+)
+
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          UpgradeName,
+	CreateUpgradeHandler: CreateUpgradeHandler,
+	StoreUpgrades: store.StoreUpgrades{
+		Added:   []string{},
+		Deleted: []string{},
+	},
+}
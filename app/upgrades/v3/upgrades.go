@@ -0,0 +1,22 @@
+package v03
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	keepers "github.com/sideprotocol/side/app/keepers"
+)
+
+// CreateUpgradeHandler creates an SDK upgrade handler for v03
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	keepers *keepers.AppKeepers,
+) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		logger := ctx.Logger().With("upgrade", UpgradeName)
+		logger.Debug("running module migrations ...")
+		_ = keepers
+		return mm.RunMigrations(ctx, configurator, vm)
+	}
+}
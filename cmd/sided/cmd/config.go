@@ -19,6 +19,8 @@ func initSDKConfig() {
 	config.SetBech32PrefixForAccount(bech32Prefix, accountPubKeyPrefix)
 	config.SetBech32PrefixForValidator(validatorAddressPrefix, validatorPubKeyPrefix)
 	config.SetBech32PrefixForConsensusNode(consNodeAddressPrefix, consNodePubKeyPrefix)
+	// the bitcoin network of the sdk config only determines the encoding of the bitcoin addresses as account addresses;
+	// btcbridge resolves the bitcoin network from its params
 	config.SetBtcChainCfg(&chaincfg.SigNetParams)
 
 	config.Seal()
@@ -42,6 +42,7 @@ import (
 	// this line is used by starport scaffolding # root/moduleImport
 	"github.com/sideprotocol/side/app"
 	appparams "github.com/sideprotocol/side/app/params"
+	btcbridgecli "github.com/sideprotocol/side/x/btcbridge/client/cli"
 )
 
 // NewRootCmd creates a new root command for a Cosmos SDK application
@@ -129,6 +130,7 @@ func initRootCmd(
 		debug.Cmd(),
 		config.Cmd(),
 		pruning.PruningCmd(a.newApp),
+		btcbridgecli.GetDaemonCmd(),
 		// this line is used by starport scaffolding # root/commands
 	)
 
@@ -0,0 +1,221 @@
+package bitcoin
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// Chain is an in-memory bitcoin chain with regtest difficulty.
+// It implements the bitcoin rpc methods used by the bridge tooling.
+type Chain struct {
+	Params *chaincfg.Params
+
+	// the proof of work hash of the network, nil for the block hash
+	powHash func(header *wire.BlockHeader) chainhash.Hash
+
+	blocks []*wire.MsgBlock
+	txs    map[chainhash.Hash]*wire.MsgTx
+}
+
+// NewChain creates a new chain starting from the regtest genesis block
+func NewChain() *Chain {
+	params := chaincfg.RegressionNetParams
+
+	return NewChainWithParams(&params, nil)
+}
+
+// NewChainWithParams creates a new chain starting from the genesis block of the given network with regtest difficulty.
+// The blocks are solved against the given proof of work hash, e.g. scrypt for litecoin, or the block hash if nil.
+func NewChainWithParams(params *chaincfg.Params, powHash func(header *wire.BlockHeader) chainhash.Hash) *Chain {
+	c := &Chain{
+		Params:  params,
+		powHash: powHash,
+		txs:     make(map[chainhash.Hash]*wire.MsgTx),
+	}
+
+	c.addBlock(params.GenesisBlock)
+
+	return c
+}
+
+// Tip returns the last block of the chain
+func (c *Chain) Tip() *wire.MsgBlock {
+	return c.blocks[len(c.blocks)-1]
+}
+
+// Height returns the height of the chain tip
+func (c *Chain) Height() int64 {
+	return int64(len(c.blocks) - 1)
+}
+
+// BlockAt returns the block at the given height
+func (c *Chain) BlockAt(height int64) *wire.MsgBlock {
+	return c.blocks[height]
+}
+
+// MineBlock mines a new block containing a coinbase tx and the given txs on top of the chain tip
+func (c *Chain) MineBlock(txs ...*wire.MsgTx) *wire.MsgBlock {
+	block := NewBlock(c.Tip(), c.Height()+1, txs...)
+	if c.powHash != nil {
+		SolveWithPowHash(&block.Header, c.powHash)
+	}
+
+	c.addBlock(block)
+
+	return block
+}
+
+// MineCoinbaseBlock mines a new block whose coinbase tx pays to the given outputs on top of the chain tip
+func (c *Chain) MineCoinbaseBlock(outs []*wire.TxOut, txs ...*wire.MsgTx) *wire.MsgBlock {
+	coinbase := NewCoinbaseTx(c.Height()+1, nil)
+	coinbase.TxOut = outs
+
+	block := NewBlockWithCoinbase(c.Tip(), coinbase, txs...)
+	if c.powHash != nil {
+		SolveWithPowHash(&block.Header, c.powHash)
+	}
+
+	c.addBlock(block)
+
+	return block
+}
+
+// Reorg removes the blocks above the given height, so that new blocks can be mined on a fork
+func (c *Chain) Reorg(height int64) {
+	c.blocks = c.blocks[:height+1]
+}
+
+func (c *Chain) addBlock(block *wire.MsgBlock) {
+	c.blocks = append(c.blocks, block)
+
+	for _, tx := range block.Transactions {
+		c.txs[tx.TxHash()] = tx
+	}
+}
+
+// AddTx makes the given tx available via GetRawTransaction without including it in a block
+func (c *Chain) AddTx(tx *wire.MsgTx) {
+	c.txs[tx.TxHash()] = tx
+}
+
+// GetBlockCount returns the height of the chain tip
+func (c *Chain) GetBlockCount() (int64, error) {
+	return c.Height(), nil
+}
+
+// GetBlockHash returns the hash of the block at the given height
+func (c *Chain) GetBlockHash(height int64) (*chainhash.Hash, error) {
+	if height < 0 || height > c.Height() {
+		return nil, fmt.Errorf("block height out of range: %d", height)
+	}
+
+	hash := c.blocks[height].BlockHash()
+
+	return &hash, nil
+}
+
+// GetBlock returns the block of the given hash
+func (c *Chain) GetBlock(hash *chainhash.Hash) (*wire.MsgBlock, error) {
+	for _, block := range c.blocks {
+		if block.BlockHash() == *hash {
+			return block, nil
+		}
+	}
+
+	return nil, fmt.Errorf("block not found: %s", hash)
+}
+
+// GetRawTransaction returns the tx of the given hash
+func (c *Chain) GetRawTransaction(hash *chainhash.Hash) (*btcutil.Tx, error) {
+	tx, ok := c.txs[*hash]
+	if !ok {
+		return nil, fmt.Errorf("transaction not found: %s", hash)
+	}
+
+	return btcutil.NewTx(tx), nil
+}
+
+// NewBlock creates a block with a valid merkle root and regtest proof of work on top of the given block
+func NewBlock(prev *wire.MsgBlock, height int64, txs ...*wire.MsgTx) *wire.MsgBlock {
+	return NewBlockWithCoinbase(prev, NewCoinbaseTx(height, nil), txs...)
+}
+
+// NewBlockWithCoinbase creates a block with the given coinbase tx on top of the given block
+func NewBlockWithCoinbase(prev *wire.MsgBlock, coinbase *wire.MsgTx, txs ...*wire.MsgTx) *wire.MsgBlock {
+	block := &wire.MsgBlock{
+		Header: wire.BlockHeader{
+			Version:   0x20000000,
+			PrevBlock: prev.BlockHash(),
+			Timestamp: prev.Header.Timestamp.Add(10 * time.Minute),
+			Bits:      chaincfg.RegressionNetParams.PowLimitBits,
+		},
+		Transactions: append([]*wire.MsgTx{coinbase}, txs...),
+	}
+
+	utxs := make([]*btcutil.Tx, 0, len(block.Transactions))
+	for _, tx := range block.Transactions {
+		utxs = append(utxs, btcutil.NewTx(tx))
+	}
+
+	merkles := blockchain.BuildMerkleTreeStore(utxs, false)
+	block.Header.MerkleRoot = *merkles[len(merkles)-1]
+
+	Solve(&block.Header)
+
+	return block
+}
+
+// Solve finds the nonce which makes the header satisfy its target
+func Solve(header *wire.BlockHeader) {
+	SolveWithPowHash(header, func(header *wire.BlockHeader) chainhash.Hash {
+		return header.BlockHash()
+	})
+}
+
+// SolveWithPowHash finds the nonce which makes the proof of work hash of the header satisfy its target
+func SolveWithPowHash(header *wire.BlockHeader, powHash func(header *wire.BlockHeader) chainhash.Hash) {
+	target := blockchain.CompactToBig(header.Bits)
+
+	for {
+		hash := powHash(header)
+		if blockchain.HashToBig(&hash).Cmp(target) <= 0 {
+			return
+		}
+
+		header.Nonce++
+	}
+}
+
+// NewCoinbaseTx creates a coinbase tx at the given height paying to the given script
+func NewCoinbaseTx(height int64, pkScript []byte) *wire.MsgTx {
+	sigScript, _ := txscript.NewScriptBuilder().AddInt64(height).AddOp(txscript.OP_0).Script()
+	if len(pkScript) == 0 {
+		pkScript = []byte{txscript.OP_TRUE}
+	}
+
+	tx := wire.NewMsgTx(wire.TxVersion)
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{}, wire.MaxPrevOutIndex), sigScript, nil))
+	tx.AddTxOut(wire.NewTxOut(50*btcutil.SatoshiPerBitcoin, pkScript))
+
+	return tx
+}
+
+// NewTx creates a tx spending the given outpoint to the given outputs.
+// The witness of the input is filled with the given items.
+func NewTx(prevOut *wire.OutPoint, witness wire.TxWitness, outs ...*wire.TxOut) *wire.MsgTx {
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(wire.NewTxIn(prevOut, nil, witness))
+
+	for _, out := range outs {
+		tx.AddTxOut(out)
+	}
+
+	return tx
+}
@@ -11,9 +11,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/store"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/sideprotocol/side/app"
 	"github.com/sideprotocol/side/x/btcbridge/keeper"
 	"github.com/sideprotocol/side/x/btcbridge/types"
+	gmmkeeper "github.com/sideprotocol/side/x/gmm/keeper"
 	"github.com/stretchr/testify/require"
 )
 
@@ -21,12 +24,10 @@ func BtcLightClientKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 	app := app.InitSideTestApp(false)
 
 	storeKey := sdk.NewKVStoreKey(types.StoreKey)
-	memStoreKey := storetypes.NewMemoryStoreKey(types.ModuleName)
 
 	db := tmdb.NewMemDB()
 	stateStore := store.NewCommitMultiStore(db)
 	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
-	stateStore.MountStoreWithDB(memStoreKey, storetypes.StoreTypeMemory, nil)
 	require.NoError(t, stateStore.LoadLatestVersion())
 
 	registry := codectypes.NewInterfaceRegistry()
@@ -35,8 +36,12 @@ func BtcLightClientKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 	k := keeper.NewKeeper(
 		cdc,
 		storeKey,
-		memStoreKey,
+		storeKey,
 		app.BankKeeper,
+		app.DistrKeeper,
+		app.TransferKeeper,
+		gmmkeeper.NewMsgServerImpl(app.GmmKeeper),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
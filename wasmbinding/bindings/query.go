@@ -4,6 +4,8 @@ package bindings
 type SideQuery struct {
 	// Pool   *Pool   `json:"pool,omitempty"`
 	Params *Params `json:"params,omitempty"`
+
+	VerifyTxInclusion *VerifyTxInclusion `json:"verify_tx_inclusion,omitempty"`
 }
 
 // type Pool struct {
@@ -23,3 +25,29 @@ type ParamsResponse struct {
 type ParamsRes struct {
 	PoolCreationFee uint64 `json:"pool_creation_fee"`
 }
+
+// VerifyTxInclusion verifies that the bitcoin tx is included in a confirmed block of the btcbridge light client
+type VerifyTxInclusion struct {
+	// the serialized tx, base64 encoded in json
+	TxBytes          []byte   `json:"tx_bytes"`
+	BlockHash        string   `json:"block_hash"`
+	Proof            []string `json:"proof"`
+	MinConfirmations uint64   `json:"min_confirmations"`
+	// the bridged chain, empty for bitcoin
+	ChainID string `json:"chain_id,omitempty"`
+}
+
+type VerifyTxInclusionResponse struct {
+	Txid          string     `json:"txid"`
+	BlockHash     string     `json:"block_hash"`
+	Height        uint64     `json:"height"`
+	Confirmations uint64     `json:"confirmations"`
+	Outputs       []TxOutput `json:"outputs"`
+}
+
+type TxOutput struct {
+	Vout     uint32 `json:"vout"`
+	Value    int64  `json:"value"`
+	PkScript []byte `json:"pk_script"`
+	Address  string `json:"address"`
+}
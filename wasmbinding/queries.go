@@ -4,17 +4,20 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/sideprotocol/side/wasmbinding/bindings"
+	btcbridgekeeper "github.com/sideprotocol/side/x/btcbridge/keeper"
 	gmmkeeper "github.com/sideprotocol/side/x/gmm/keeper"
 )
 
 type QueryPlugin struct {
-	gmmkeeper *gmmkeeper.Keeper
+	gmmkeeper       *gmmkeeper.Keeper
+	btcbridgekeeper *btcbridgekeeper.Keeper
 }
 
 // NewQueryPlugin returns a reference to a new QueryPlugin.
-func NewQueryPlugin(tfk *gmmkeeper.Keeper) *QueryPlugin {
+func NewQueryPlugin(tfk *gmmkeeper.Keeper, bbk *btcbridgekeeper.Keeper) *QueryPlugin {
 	return &QueryPlugin{
-		gmmkeeper: tfk,
+		gmmkeeper:       tfk,
+		btcbridgekeeper: bbk,
 	}
 }
 
@@ -24,3 +27,34 @@ func (qp QueryPlugin) GetParams(ctx sdk.Context) (*bindings.ParamsResponse, erro
 
 	return &bindings.ParamsResponse{Params: (*bindings.ParamsRes)(&params)}, nil
 }
+
+// VerifyTxInclusion is a query to verify the inclusion of a bitcoin tx in a confirmed block.
+func (qp QueryPlugin) VerifyTxInclusion(ctx sdk.Context, req *bindings.VerifyTxInclusion) (*bindings.VerifyTxInclusionResponse, error) {
+	k, err := qp.btcbridgekeeper.ChainKeeper(ctx, req.ChainID)
+	if err != nil {
+		return nil, err
+	}
+
+	inclusion, err := k.VerifyTxInclusion(ctx, req.TxBytes, req.BlockHash, req.Proof, req.MinConfirmations)
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := make([]bindings.TxOutput, 0, len(inclusion.Outputs))
+	for _, out := range inclusion.Outputs {
+		outputs = append(outputs, bindings.TxOutput{
+			Vout:     out.Vout,
+			Value:    out.Value,
+			PkScript: out.PkScript,
+			Address:  out.Address,
+		})
+	}
+
+	return &bindings.VerifyTxInclusionResponse{
+		Txid:          inclusion.Txid,
+		BlockHash:     inclusion.Blockhash,
+		Height:        inclusion.Height,
+		Confirmations: inclusion.Confirmations,
+		Outputs:       outputs,
+	}, nil
+}
@@ -33,6 +33,19 @@ func CustomQuerier(qp *QueryPlugin) func(ctx sdk.Context, request json.RawMessag
 
 			return bz, nil
 
+		case contractQuery.VerifyTxInclusion != nil:
+			res, err := qp.VerifyTxInclusion(ctx, contractQuery.VerifyTxInclusion)
+			if err != nil {
+				return nil, errorsmod.Wrap(err, "side verify tx inclusion query")
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, errorsmod.Wrap(err, "side verify tx inclusion query response")
+			}
+
+			return bz, nil
+
 		default:
 			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown side query variant"}
 		}
@@ -5,14 +5,16 @@ import (
 
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 
+	btcbridgekeeper "github.com/sideprotocol/side/x/btcbridge/keeper"
 	gmmkeeper "github.com/sideprotocol/side/x/gmm/keeper"
 )
 
 func RegisterCustomPlugins(
 	bank *bankkeeper.Keeper,
 	gmm *gmmkeeper.Keeper,
+	btcbridge *btcbridgekeeper.Keeper,
 ) []wasmkeeper.Option {
-	wasmQueryPlugin := NewQueryPlugin(gmm)
+	wasmQueryPlugin := NewQueryPlugin(gmm, btcbridge)
 
 	queryPluginOpt := wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
 		Custom: CustomQuerier(wasmQueryPlugin),
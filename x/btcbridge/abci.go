@@ -0,0 +1,34 @@
+package btclightclient
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sideprotocol/side/x/btcbridge/keeper"
+)
+
+// EndBlocker is called at the end of every block
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	params := k.GetParams(ctx)
+
+	for _, chainID := range params.ChainIDs() {
+		ck := k.WithChain(chainID)
+
+		ck.PruneExpiredAttestations(ctx)
+		ck.MintPendingDeposits(ctx)
+		ck.CheckHeaderLiveness(ctx)
+	}
+
+	k.RefundExpiredHTLCs(ctx)
+
+	// distribute the fees collected on each chain in the epoch, which are kept for the next epoch on failure
+	if params.FeeEpoch > 0 && ctx.BlockHeight()%params.FeeEpoch == 0 {
+		for _, chainID := range params.ChainIDs() {
+			cacheCtx, write := ctx.CacheContext()
+			if err := k.WithChain(chainID).DistributeFees(cacheCtx); err != nil {
+				k.Logger(ctx).Error("failed to distribute the bridge fees", "chain", chainID, "error", err)
+				continue
+			}
+
+			write()
+		}
+	}
+}
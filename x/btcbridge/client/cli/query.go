@@ -12,7 +12,6 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
-	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // GetQueryCmd returns the cli query commands for this module
@@ -31,6 +30,20 @@ func GetQueryCmd(_ string) *cobra.Command {
 	cmd.AddCommand(CmdQueryBlock())
 	cmd.AddCommand(CmdQueryUTXOs())
 	cmd.AddCommand(CmdQuerySigningRequest())
+	cmd.AddCommand(CmdQueryReserves())
+	cmd.AddCommand(CmdQueryAddressLink())
+	cmd.AddCommand(CmdQueryFeeQuote())
+	cmd.AddCommand(CmdQueryFeePool())
+	cmd.AddCommand(CmdQueryVerifyTxInclusion())
+	cmd.AddCommand(CmdQueryRequiredConfirmations())
+	cmd.AddCommand(CmdQueryHTLC())
+	cmd.AddCommand(CmdQueryHTLCs())
+	cmd.AddCommand(CmdQueryBlocklist())
+	cmd.AddCommand(CmdQueryQuarantinedDeposits())
+	cmd.AddCommand(CmdQueryPendingDeposit())
+	cmd.AddCommand(CmdQueryPendingDeposits())
+	cmd.AddCommand(CmdQueryBridgeStatus())
+	cmd.AddCommand(CmdQueryTaggedUTXOs())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -75,8 +88,9 @@ func CmdBestBlock() *cobra.Command {
 			}
 
 			queryClient := types.NewQueryClient(clientCtx)
+			chainID, _ := cmd.Flags().GetString(FlagBridgedChain)
 
-			res, err := queryClient.QueryChainTip(cmd.Context(), &types.QueryChainTipRequest{})
+			res, err := queryClient.QueryChainTip(cmd.Context(), &types.QueryChainTipRequest{ChainId: chainID})
 			if err != nil {
 				return err
 			}
@@ -85,6 +99,7 @@ func CmdBestBlock() *cobra.Command {
 		},
 	}
 
+	addBridgedChainFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
@@ -103,11 +118,12 @@ func CmdQueryBlock() *cobra.Command {
 			}
 
 			queryClient := types.NewQueryClient(clientCtx)
+			chainID, _ := cmd.Flags().GetString(FlagBridgedChain)
 
 			height, err := strconv.ParseUint(args[0], 10, 64)
 
 			if err != nil {
-				res, err := queryClient.QueryBlockHeaderByHash(cmd.Context(), &types.QueryBlockHeaderByHashRequest{Hash: args[0]})
+				res, err := queryClient.QueryBlockHeaderByHash(cmd.Context(), &types.QueryBlockHeaderByHashRequest{Hash: args[0], ChainId: chainID})
 				if err != nil {
 					return err
 				}
@@ -115,7 +131,7 @@ func CmdQueryBlock() *cobra.Command {
 				return clientCtx.PrintProto(res)
 			}
 
-			res, err := queryClient.QueryBlockHeaderByHeight(cmd.Context(), &types.QueryBlockHeaderByHeightRequest{Height: height})
+			res, err := queryClient.QueryBlockHeaderByHeight(cmd.Context(), &types.QueryBlockHeaderByHeightRequest{Height: height, ChainId: chainID})
 			if err != nil {
 				return err
 			}
@@ -124,6 +140,7 @@ func CmdQueryBlock() *cobra.Command {
 		},
 	}
 
+	addBridgedChainFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
@@ -142,13 +159,14 @@ func CmdQuerySigningRequest() *cobra.Command {
 			}
 
 			queryClient := types.NewQueryClient(clientCtx)
+			chainID, _ := cmd.Flags().GetString(FlagBridgedChain)
 
 			status, err := strconv.ParseInt(args[0], 10, 32)
 			if err != nil {
 				return err
 			}
 
-			res, err := queryClient.QuerySigningRequest(cmd.Context(), &types.QuerySigningRequestRequest{Status: types.SigningStatus(status)})
+			res, err := queryClient.QuerySigningRequest(cmd.Context(), &types.QuerySigningRequestRequest{Status: types.SigningStatus(status), ChainId: chainID})
 			if err != nil {
 				return err
 			}
@@ -157,6 +175,501 @@ func CmdQuerySigningRequest() *cobra.Command {
 		},
 	}
 
+	addBridgedChainFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryReserves returns the command to query the reserves of the vaults
+func CmdQueryReserves() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reserves",
+		Short: "Query the reserves of the vaults and the supply of the voucher token",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			chainID, _ := cmd.Flags().GetString(FlagBridgedChain)
+
+			res, err := queryClient.QueryReserves(cmd.Context(), &types.QueryReservesRequest{ChainId: chainID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addBridgedChainFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryAddressLink returns the command to query the address link
+func CmdQueryAddressLink() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "address-link [address]",
+		Short: "Query the address link by the side address or the bitcoin address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			chainID, _ := cmd.Flags().GetString(FlagBridgedChain)
+
+			res, err := queryClient.QueryAddressLink(cmd.Context(), &types.QueryAddressLinkRequest{Address: args[0], ChainId: chainID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addBridgedChainFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryFeeQuote returns the command to query the bridge fee of a deposit or withdrawal
+func CmdQueryFeeQuote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-quote [deposit|withdraw] [amount]",
+		Short: "Query the bridge fee of a deposit or withdrawal of the given amount in sat",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var operation types.BridgeOperation
+			switch args[0] {
+			case "deposit":
+				operation = types.BridgeOperation_BRIDGE_OPERATION_DEPOSIT
+			case "withdraw":
+				operation = types.BridgeOperation_BRIDGE_OPERATION_WITHDRAW
+			default:
+				return fmt.Errorf("invalid operation %s, expected deposit or withdraw", args[0])
+			}
+
+			amount, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			chainID, _ := cmd.Flags().GetString(FlagBridgedChain)
+
+			res, err := queryClient.QueryFeeQuote(cmd.Context(), &types.QueryFeeQuoteRequest{Operation: operation, Amount: amount, ChainId: chainID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addBridgedChainFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryFeePool returns the command to query the fees collected in the current fee epoch
+func CmdQueryFeePool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-pool",
+		Short: "Query the fees collected and the contributions of the relayers and signers in the current fee epoch",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			chainID, _ := cmd.Flags().GetString(FlagBridgedChain)
+
+			res, err := queryClient.QueryFeePool(cmd.Context(), &types.QueryFeePoolRequest{ChainId: chainID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addBridgedChainFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryVerifyTxInclusion returns the command to verify the inclusion of a bitcoin tx in a confirmed block
+func CmdQueryVerifyTxInclusion() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-tx-inclusion [base64-tx] [blockhash] [min-confirmations] [proof]...",
+		Short: "Verify that the bitcoin tx is included in the block by the merkle proof with enough confirmations",
+		Args:  cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			minConfirmations, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			chainID, _ := cmd.Flags().GetString(FlagBridgedChain)
+
+			res, err := queryClient.QueryVerifyTxInclusion(cmd.Context(), &types.QueryVerifyTxInclusionRequest{
+				TxBytes:          args[0],
+				Blockhash:        args[1],
+				Proof:            args[3:],
+				MinConfirmations: minConfirmations,
+				ChainId:          chainID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addBridgedChainFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryRequiredConfirmations returns the command to query the confirmations required by a deposit or withdrawal
+func CmdQueryRequiredConfirmations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "required-confirmations [amount]",
+		Short: "Query the confirmations required by a deposit or withdrawal of the given amount in sat",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			chainID, _ := cmd.Flags().GetString(FlagBridgedChain)
+
+			res, err := queryClient.QueryRequiredConfirmations(cmd.Context(), &types.QueryRequiredConfirmationsRequest{Amount: amount, ChainId: chainID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addBridgedChainFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryHTLC returns the command to query the HTLC by id
+func CmdQueryHTLC() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "htlc [id]",
+		Short: "Query the HTLC by id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryHTLC(cmd.Context(), &types.QueryHTLCRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryHTLCs returns the command to query the HTLCs with an optional status
+func CmdQueryHTLCs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "htlcs [open|claimed|refunded]",
+		Short: "Query the HTLCs with an optional status",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			status := types.HTLCStatus_HTLC_STATUS_UNSPECIFIED
+			if len(args) > 0 {
+				switch args[0] {
+				case "open":
+					status = types.HTLCStatus_HTLC_STATUS_OPEN
+				case "claimed":
+					status = types.HTLCStatus_HTLC_STATUS_CLAIMED
+				case "refunded":
+					status = types.HTLCStatus_HTLC_STATUS_REFUNDED
+				default:
+					return fmt.Errorf("invalid status %s, expected open, claimed or refunded", args[0])
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryHTLCs(cmd.Context(), &types.QueryHTLCsRequest{Status: status})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryBlocklist returns the command to query the blocked bitcoin addresses and side accounts
+func CmdQueryBlocklist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "blocklist",
+		Short: "Query the blocked bitcoin addresses and side accounts",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryBlocklist(cmd.Context(), &types.QueryBlocklistRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryQuarantinedDeposits returns the command to query the quarantined deposits with an optional status
+func CmdQueryQuarantinedDeposits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "quarantined-deposits [held|released|returned]",
+		Short: "Query the quarantined deposits with an optional status",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			status := types.QuarantineStatus_QUARANTINE_STATUS_UNSPECIFIED
+			if len(args) > 0 {
+				switch args[0] {
+				case "held":
+					status = types.QuarantineStatus_QUARANTINE_STATUS_HELD
+				case "released":
+					status = types.QuarantineStatus_QUARANTINE_STATUS_RELEASED
+				case "returned":
+					status = types.QuarantineStatus_QUARANTINE_STATUS_RETURNED
+				default:
+					return fmt.Errorf("invalid status %s, expected held, released or returned", args[0])
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryQuarantinedDeposits(cmd.Context(), &types.QueryQuarantinedDepositsRequest{Status: status})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryPendingDeposit returns the command to query the pending deposit and its confirmations
+func CmdQueryPendingDeposit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-deposit [txid]",
+		Short: "Query the pending deposit by the given txid and its confirmations",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			chainID, _ := cmd.Flags().GetString(FlagBridgedChain)
+
+			res, err := queryClient.QueryPendingDeposit(cmd.Context(), &types.QueryPendingDepositRequest{Txid: args[0], ChainId: chainID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addBridgedChainFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryPendingDeposits returns the command to query the pending deposits with an optional state
+func CmdQueryPendingDeposits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-deposits [confirming|maturing]",
+		Short: "Query the pending deposits with an optional state",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			state := types.PendingDepositState_PENDING_DEPOSIT_STATE_UNSPECIFIED
+			if len(args) > 0 {
+				switch args[0] {
+				case "confirming":
+					state = types.PendingDepositState_PENDING_DEPOSIT_STATE_CONFIRMING
+				case "maturing":
+					state = types.PendingDepositState_PENDING_DEPOSIT_STATE_MATURING
+				default:
+					return fmt.Errorf("invalid state %s, expected confirming or maturing", args[0])
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			chainID, _ := cmd.Flags().GetString(FlagBridgedChain)
+
+			res, err := queryClient.QueryPendingDeposits(cmd.Context(), &types.QueryPendingDepositsRequest{ChainId: chainID, State: state})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addBridgedChainFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryBridgeStatus returns the command to query the liveness of the light client and whether the bridge is degraded
+func CmdQueryBridgeStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bridge-status",
+		Short: "Query the lag of the best block header and whether the bridge is degraded",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			chainID, _ := cmd.Flags().GetString(FlagBridgedChain)
+
+			res, err := queryClient.QueryBridgeStatus(cmd.Context(), &types.QueryBridgeStatusRequest{ChainId: chainID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addBridgedChainFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryTaggedUTXOs returns the command to query the utxos carrying inscriptions or runes
+func CmdQueryTaggedUTXOs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tagged-utxos [vault-address]",
+		Short: "Query the utxos carrying inscriptions or runes, optionally of the given vault",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			address := ""
+			if len(args) > 0 {
+				address = args[0]
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			chainID, _ := cmd.Flags().GetString(FlagBridgedChain)
+
+			res, err := queryClient.QueryTaggedUTXOs(cmd.Context(), &types.QueryTaggedUTXOsRequest{Address: address, ChainId: chainID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addBridgedChainFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
@@ -173,23 +686,26 @@ func CmdQueryUTXOs() *cobra.Command {
 				return err
 			}
 
+			chainID, _ := cmd.Flags().GetString(FlagBridgedChain)
+
 			if len(args) == 0 {
-				return queryUTXOs(&clientCtx, cmd.Context())
+				return queryUTXOs(&clientCtx, cmd.Context(), chainID)
 			}
 
-			return queryUTXOsByAddr(&clientCtx, cmd.Context(), args[0])
+			return queryUTXOsByAddr(&clientCtx, cmd.Context(), chainID, args[0])
 		},
 	}
 
+	addBridgedChainFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
 }
 
-func queryUTXOs(clientCtx *client.Context, cmdCtx context.Context) error {
+func queryUTXOs(clientCtx *client.Context, cmdCtx context.Context, chainID string) error {
 	queryClient := types.NewQueryClient(clientCtx)
 
-	res, err := queryClient.QueryUTXOs(cmdCtx, &types.QueryUTXOsRequest{})
+	res, err := queryClient.QueryUTXOs(cmdCtx, &types.QueryUTXOsRequest{ChainId: chainID})
 	if err != nil {
 		return err
 	}
@@ -197,16 +713,13 @@ func queryUTXOs(clientCtx *client.Context, cmdCtx context.Context) error {
 	return clientCtx.PrintProto(res)
 }
 
-func queryUTXOsByAddr(clientCtx *client.Context, cmdCtx context.Context, addr string) error {
+// queryUTXOsByAddr queries the utxos of the given address, which is validated against the network of the chain by the node
+func queryUTXOsByAddr(clientCtx *client.Context, cmdCtx context.Context, chainID string, addr string) error {
 	queryClient := types.NewQueryClient(clientCtx)
 
-	_, err := sdk.AccAddressFromBech32(addr)
-	if err != nil {
-		return err
-	}
-
 	res, err := queryClient.QueryUTXOsByAddress(cmdCtx, &types.QueryUTXOsByAddressRequest{
 		Address: addr,
+		ChainId: chainID,
 	})
 	if err != nil {
 		return err
@@ -0,0 +1,140 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/btcsuite/btcd/rpcclient"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"github.com/sideprotocol/side/x/btcbridge/client/relayer"
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+const (
+	FlagBtcRPC        = "btc-rpc"
+	FlagBtcRPCUser    = "btc-rpc-user"
+	FlagBtcRPCPass    = "btc-rpc-pass"
+	FlagBtcNetwork    = "btc-network"
+	FlagBatchSize     = "batch-size"
+	FlagInterval      = "interval"
+	FlagStartHeight   = "start-height"
+	FlagStateFile     = "state-file"
+	FlagBridgedChain  = "bridged-chain"
+	defaultBtcRPCHost = "127.0.0.1:8332"
+)
+
+// GetDaemonCmd returns the daemon commands for this module
+func GetDaemonCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Bitcoin bridge daemons",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdRelayer())
+	cmd.AddCommand(CmdSigner())
+
+	return cmd
+}
+
+// CmdRelayer runs the relayer which syncs bitcoin headers and submits deposit and withdrawal transactions
+func CmdRelayer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relayer",
+		Short: "Run the relayer which syncs bitcoin headers and submits deposit and withdrawal transactions with proofs",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			btcClient, err := newBitcoindClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer btcClient.Shutdown()
+
+			network, _ := cmd.Flags().GetString(FlagBtcNetwork)
+			chainParams, err := types.BitcoinNetworkParams(network)
+			if err != nil {
+				return err
+			}
+
+			chainID, _ := cmd.Flags().GetString(FlagBridgedChain)
+			batchSize, _ := cmd.Flags().GetInt(FlagBatchSize)
+			interval, _ := cmd.Flags().GetDuration(FlagInterval)
+			startHeight, _ := cmd.Flags().GetInt64(FlagStartHeight)
+
+			statePath, _ := cmd.Flags().GetString(FlagStateFile)
+			if len(statePath) == 0 {
+				statePath = filepath.Join(clientCtx.HomeDir, types.ModuleName, "relayer.json")
+			}
+
+			r, err := relayer.NewRelayer(
+				btcClient,
+				types.NewQueryClient(clientCtx),
+				relayer.NewTxSubmitter(clientCtx, cmd.Flags()),
+				relayer.Config{
+					Sender:      clientCtx.GetFromAddress().String(),
+					ChainID:     chainID,
+					ChainParams: chainParams,
+					BatchSize:   batchSize,
+					StartHeight: startHeight,
+					Interval:    interval,
+					StatePath:   statePath,
+				},
+				log.NewTMLogger(log.NewSyncWriter(os.Stdout)).With("module", "relayer"),
+			)
+			if err != nil {
+				return err
+			}
+
+			return r.Run(cmd.Context())
+		},
+	}
+
+	addBitcoindFlags(cmd)
+	addBtcNetworkFlag(cmd)
+	cmd.Flags().Int(FlagBatchSize, relayer.DefaultBatchSize, "Number of headers submitted in one message")
+	cmd.Flags().Duration(FlagInterval, relayer.DefaultInterval, "Interval between two relaying rounds")
+	cmd.Flags().Int64(FlagStartHeight, 0, "Bitcoin block height from which to scan transactions if there is no saved state, default to the latest confirmed block")
+	cmd.Flags().String(FlagStateFile, "", "File path of the relayer state, default to <home>/btcbridge/relayer.json")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// addBitcoindFlags adds the flags for connecting to bitcoind
+func addBitcoindFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagBtcRPC, defaultBtcRPCHost, "Bitcoind json-rpc host:port")
+	cmd.Flags().String(FlagBtcRPCUser, "", "Bitcoind json-rpc user")
+	cmd.Flags().String(FlagBtcRPCPass, "", "Bitcoind json-rpc password")
+}
+
+// addBtcNetworkFlag adds the flags for the bitcoin network and the bridged chain
+func addBtcNetworkFlag(cmd *cobra.Command) {
+	cmd.Flags().String(FlagBtcNetwork, "signet", "Bitcoin network: mainnet, testnet3, signet, regtest, litecoin, litecoin-testnet4 or litecoin-regtest")
+	addBridgedChainFlag(cmd)
+}
+
+// addBridgedChainFlag adds the flag for the bridged bitcoin-family chain
+func addBridgedChainFlag(cmd *cobra.Command) {
+	cmd.Flags().String(FlagBridgedChain, "", "Id of the bridged bitcoin-family chain, empty for bitcoin")
+}
+
+// newBitcoindClient creates the bitcoind client from the flags
+func newBitcoindClient(cmd *cobra.Command) (*rpcclient.Client, error) {
+	host, _ := cmd.Flags().GetString(FlagBtcRPC)
+	user, _ := cmd.Flags().GetString(FlagBtcRPCUser)
+	pass, _ := cmd.Flags().GetString(FlagBtcRPCPass)
+
+	return relayer.NewBitcoindClient(host, user, pass)
+}
@@ -0,0 +1,153 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto"
+
+	"github.com/sideprotocol/side/x/btcbridge/client/relayer"
+	"github.com/sideprotocol/side/x/btcbridge/client/signer"
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+const (
+	FlagSigningKey = "signing-key"
+	FlagKeyFile    = "key-file"
+	FlagMaxAmount  = "max-amount"
+	FlagMaxFee     = "max-fee"
+	FlagMaxFeeRate = "max-fee-rate"
+	FlagAllowList  = "allow-list"
+	FlagDenyList   = "deny-list"
+)
+
+// CmdSigner runs the signer which signs the withdrawal transactions of the vault controlled by the signing key
+func CmdSigner() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signer",
+		Short: "Run the signer which validates and signs the withdrawal transactions of the vault controlled by the signing key",
+		Long: `Run the signer which validates and signs the withdrawal transactions of the vault controlled by the signing key.
+
+The vault key is loaded from the keyring by --signing-key or from a file containing the hex or WIF encoded private key by --key-file.
+Each signing request is re-validated against the bridge state and the operator policies before signing.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			key, err := loadSigningKey(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+
+			network, _ := cmd.Flags().GetString(FlagBtcNetwork)
+			chainParams, err := types.BitcoinNetworkParams(network)
+			if err != nil {
+				return err
+			}
+
+			chainID, _ := cmd.Flags().GetString(FlagBridgedChain)
+			maxFee, _ := cmd.Flags().GetInt64(FlagMaxFee)
+			maxFeeRate, _ := cmd.Flags().GetInt64(FlagMaxFeeRate)
+			interval, _ := cmd.Flags().GetDuration(FlagInterval)
+
+			s := signer.NewSigner(
+				types.NewQueryClient(clientCtx),
+				relayer.NewTxSubmitter(clientCtx, cmd.Flags()),
+				key,
+				signingPolicies(cmd),
+				signer.Config{
+					Sender:      clientCtx.GetFromAddress().String(),
+					ChainID:     chainID,
+					ChainParams: chainParams,
+					MaxFee:      maxFee,
+					MaxFeeRate:  maxFeeRate,
+					Interval:    interval,
+				},
+				log.NewTMLogger(log.NewSyncWriter(os.Stdout)).With("module", "signer"),
+			)
+
+			return s.Run(cmd.Context())
+		},
+	}
+
+	cmd.Flags().String(FlagSigningKey, "", "Name of the vault key in the keyring, default to the --from key")
+	cmd.Flags().String(FlagKeyFile, "", "File containing the hex or WIF encoded vault private key, instead of the keyring")
+	cmd.Flags().Int64(FlagMaxAmount, 0, "Maximum amount in satoshis of a withdrawal, no limit if zero")
+	cmd.Flags().Int64(FlagMaxFee, 0, "Maximum fee in satoshis of a withdrawal transaction, no limit if zero")
+	cmd.Flags().Int64(FlagMaxFeeRate, 0, "Maximum fee rate in sat/vbyte of a withdrawal transaction, no limit if zero")
+	cmd.Flags().StringSlice(FlagAllowList, nil, "Bitcoin addresses which withdrawals are only allowed to")
+	cmd.Flags().StringSlice(FlagDenyList, nil, "Bitcoin addresses which withdrawals are not allowed to")
+	cmd.Flags().Duration(FlagInterval, signer.DefaultInterval, "Interval between two signing rounds")
+	addBtcNetworkFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// loadSigningKey loads the vault private key from the key file or the keyring
+func loadSigningKey(cmd *cobra.Command, clientCtx client.Context) (*btcec.PrivateKey, error) {
+	keyFile, _ := cmd.Flags().GetString(FlagKeyFile)
+	if len(keyFile) != 0 {
+		bz, err := os.ReadFile(keyFile)
+		if err != nil {
+			return nil, err
+		}
+
+		return signer.DecodePrivateKey(strings.TrimSpace(string(bz)))
+	}
+
+	name, _ := cmd.Flags().GetString(FlagSigningKey)
+	if len(name) == 0 {
+		name = clientCtx.GetFromName()
+	}
+
+	if len(name) == 0 {
+		return nil, fmt.Errorf("either --%s, --%s or --%s is required", FlagKeyFile, FlagSigningKey, flags.FlagFrom)
+	}
+
+	// the armor is only used in memory, so the passphrase does not matter
+	passphrase := "btcbridge-signer"
+
+	armor, err := clientCtx.Keyring.ExportPrivKeyArmor(name, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	privKey, _, err := crypto.UnarmorDecryptPrivKey(armor, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	key, _ := btcec.PrivKeyFromBytes(privKey.Bytes())
+
+	return key, nil
+}
+
+// signingPolicies returns the signing policies from the flags
+func signingPolicies(cmd *cobra.Command) []signer.Policy {
+	policies := make([]signer.Policy, 0)
+
+	if maxAmount, _ := cmd.Flags().GetInt64(FlagMaxAmount); maxAmount > 0 {
+		policies = append(policies, signer.MaxAmountPolicy(maxAmount))
+	}
+
+	if allowList, _ := cmd.Flags().GetStringSlice(FlagAllowList); len(allowList) > 0 {
+		policies = append(policies, signer.AllowListPolicy(allowList))
+	}
+
+	if denyList, _ := cmd.Flags().GetStringSlice(FlagDenyList); len(denyList) > 0 {
+		policies = append(policies, signer.DenyListPolicy(denyList))
+	}
+
+	return policies
+}
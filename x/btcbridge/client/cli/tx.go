@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"bytes"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -9,6 +11,8 @@ import (
 	"time"
 
 	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -17,9 +21,15 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	// "github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/sideprotocol/side/x/btcbridge/client/relayer"
 	"github.com/sideprotocol/side/x/btcbridge/types"
 )
 
+const (
+	FlagPrevTx     = "prev-tx"
+	FlagBtcAddress = "btc-address"
+)
+
 var DefaultRelativePacketTimeoutTimestamp = uint64((time.Duration(10) * time.Minute).Nanoseconds())
 
 // const (
@@ -40,7 +50,15 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdSubmitBlocks())
 	cmd.AddCommand(CmdUpdateSenders())
 	cmd.AddCommand(CmdWithdrawBitcoin())
+	cmd.AddCommand(CmdWithdrawBitcoinToRecipients())
 	cmd.AddCommand(CmdSubmitWithdrawSignatures())
+	cmd.AddCommand(CmdSubmitDepositTransaction())
+	cmd.AddCommand(CmdSubmitWithdrawTransaction())
+	cmd.AddCommand(CmdLinkBitcoinAddress())
+	cmd.AddCommand(CmdCreateHTLC())
+	cmd.AddCommand(CmdClaimHTLC())
+	cmd.AddCommand(CmdReleaseQuarantinedDeposit())
+	cmd.AddCommand(CmdReturnQuarantinedDeposit())
 
 	return cmd
 }
@@ -67,6 +85,9 @@ func CmdSubmitBlocks() *cobra.Command {
 				clientCtx.GetFromAddress().String(),
 				blockHeaders,
 			)
+
+			msg.ChainId, _ = cmd.Flags().GetString(FlagBridgedChain)
+
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -74,6 +95,7 @@ func CmdSubmitBlocks() *cobra.Command {
 		},
 	}
 
+	addBridgedChainFlag(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -83,7 +105,7 @@ func CmdSubmitBlocks() *cobra.Command {
 func CmdUpdateSenders() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "update-senders [senders]",
-		Short: "Update authorized senders",
+		Short: "Update authorized senders, which must be submitted by the gov authority",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -117,7 +139,7 @@ func CmdUpdateSenders() *cobra.Command {
 func CmdWithdrawBitcoin() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "withdraw [amount] [fee-rate]",
-		Short: "Withdraw bitcoin to the given sender",
+		Short: "Withdraw bitcoin to the given bitcoin address, the linked bitcoin address or the sender by default",
 		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -141,6 +163,264 @@ func CmdWithdrawBitcoin() *cobra.Command {
 				feeRate,
 			)
 
+			msg.BtcAddress, _ = cmd.Flags().GetString(FlagBtcAddress)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagBtcAddress, "", "Bitcoin address receiving the withdrawal")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdWithdrawBitcoinToRecipients returns the command to withdraw bitcoin to several recipients in a single tx
+func CmdWithdrawBitcoinToRecipients() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "withdraw-multi [fee-rate] [btc-address=amount]...",
+		Short:   "Withdraw bitcoin to several bitcoin addresses in a single tx",
+		Example: "withdraw-multi 10 bc1q...=100000sat bc1p...=50000sat",
+		Args:    cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			feeRate, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid fee rate")
+			}
+
+			recipients := make([]*types.WithdrawRecipient, 0)
+			for _, arg := range args[1:] {
+				address, amount, found := strings.Cut(arg, "=")
+				if !found {
+					return fmt.Errorf("invalid recipient %s, expected btc-address=amount", arg)
+				}
+
+				recipients = append(recipients, &types.WithdrawRecipient{BtcAddress: address, Amount: amount})
+			}
+
+			msg := types.NewMsgWithdrawBitcoinToRecipientsRequest(
+				clientCtx.GetFromAddress().String(),
+				recipients,
+				feeRate,
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdLinkBitcoinAddress returns the command to link the bitcoin address to the sender
+func CmdLinkBitcoinAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "link-btc-address [btc-address] [signature]",
+		Short: "Link the bitcoin address to the sender",
+		Long: `Link the bitcoin address to the sender by proving the ownership of the bitcoin address.
+The signature is the base64 encoded BIP-322 simple signature or legacy signmessage signature of the bitcoin address
+over the link message "side-btcbridge-link/<chain-id>/<sender>", where chain-id is the id of the side chain.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgLinkBitcoinAddressRequest(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+			)
+
+			msg.ChainId, _ = cmd.Flags().GetString(FlagBridgedChain)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addBridgedChainFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdCreateHTLC returns the command to lock the tokens in an HTLC against a bitcoin payment
+func CmdCreateHTLC() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-htlc [taker] [amount] [hash-lock] [timeout-height] [pk-script] [btc-amount]",
+		Short: "Lock the tokens for the taker until the taker proves the payment of the btc amount to the pk script",
+		Long: `Lock the tokens in a hashed timelock contract for the taker.
+The taker claims the tokens by the preimage of the hex encoded sha256 hash lock and the proof of a bitcoin tx
+paying at least the btc amount in sat to the hex encoded pk script, before the side block height of the timeout.
+The tokens are refunded to the sender on timeout.`,
+		Args: cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			timeoutHeight, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid timeout height")
+			}
+
+			btcAmount, err := strconv.ParseUint(args[5], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid btc amount")
+			}
+
+			msg := types.NewMsgCreateHTLCRequest(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				args[2],
+				timeoutHeight,
+				args[4],
+				btcAmount,
+			)
+
+			msg.ChainId, _ = cmd.Flags().GetString(FlagBridgedChain)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addBridgedChainFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdClaimHTLC returns the command to claim the HTLC for the taker
+func CmdClaimHTLC() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-htlc [id] [preimage] [blockhash] [base64-tx] [proof]...",
+		Short: "Claim the HTLC for the taker by the hex encoded preimage and the proof of the bitcoin payment",
+		Args:  cobra.MinimumNArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid id")
+			}
+
+			msg := types.NewMsgClaimHTLCRequest(
+				clientCtx.GetFromAddress().String(),
+				id,
+				args[1],
+				args[2],
+				args[3],
+				args[4:],
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdReleaseQuarantinedDeposit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "release-quarantined-deposit [id]",
+		Short: "Credit the quarantined deposit to the recipient by the screening admin",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid id")
+			}
+
+			msg := types.NewMsgReleaseQuarantinedDepositRequest(
+				clientCtx.GetFromAddress().String(),
+				id,
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdReturnQuarantinedDeposit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "return-quarantined-deposit [id] [fee-rate] [btc-address]",
+		Short: "Return the quarantined deposit to the given bitcoin address, the sender of the deposit by default, by the screening admin",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid id")
+			}
+
+			feeRate, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid fee rate")
+			}
+
+			btcAddress := ""
+			if len(args) > 2 {
+				btcAddress = args[2]
+			}
+
+			msg := types.NewMsgReturnQuarantinedDepositRequest(
+				clientCtx.GetFromAddress().String(),
+				id,
+				btcAddress,
+				feeRate,
+			)
+
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -181,6 +461,104 @@ func CmdSubmitWithdrawSignatures() *cobra.Command {
 				args[0],
 			)
 
+			msg.ChainId, _ = cmd.Flags().GetString(FlagBridgedChain)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addBridgedChainFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// Submit the deposit transaction with the proof built from the raw block
+func CmdSubmitDepositTransaction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-deposit [block] [txid]",
+		Short: "Submit the deposit transaction of the given raw block",
+		Long: `Submit the deposit transaction of the given raw block.
+
+The block is given in hex or as a file path containing the hex or binary serialized block.
+The previous transaction spent by the first input is given by --prev-tx, or fetched from bitcoind otherwise.
+The previous transactions spent by the other inputs are fetched from bitcoind.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			block, index, err := readBlockAndTx(args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			prevTx, err := getPrevTx(cmd, block.Transactions[index])
+			if err != nil {
+				return err
+			}
+
+			inputPrevTxs, err := getInputPrevTxs(cmd, block.Transactions[index])
+			if err != nil {
+				return err
+			}
+
+			msg, err := relayer.BuildDepositMsg(clientCtx.GetFromAddress().String(), block, index, prevTx, inputPrevTxs...)
+			if err != nil {
+				return err
+			}
+
+			msg.ChainId, _ = cmd.Flags().GetString(FlagBridgedChain)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagPrevTx, "", "Previous transaction in hex or the file path containing it")
+	addBitcoindFlags(cmd)
+	addBridgedChainFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// Submit the withdrawal transaction with the proof built from the raw block
+func CmdSubmitWithdrawTransaction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-withdraw [block] [txid]",
+		Short: "Submit the withdrawal transaction of the given raw block",
+		Long: `Submit the withdrawal transaction of the given raw block.
+
+The block is given in hex or as a file path containing the hex or binary serialized block.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			block, index, err := readBlockAndTx(args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			msg, err := relayer.BuildWithdrawalMsg(clientCtx.GetFromAddress().String(), block, index)
+			if err != nil {
+				return err
+			}
+
+			msg.ChainId, _ = cmd.Flags().GetString(FlagBridgedChain)
+
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -189,11 +567,117 @@ func CmdSubmitWithdrawSignatures() *cobra.Command {
 		},
 	}
 
+	addBridgedChainFlag(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
 
+// readBlockAndTx reads the raw block and returns the index of the given tx in the block
+func readBlockAndTx(blockArg string, txid string) (*wire.MsgBlock, int, error) {
+	txHash, err := chainhash.NewHashFromStr(txid)
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid txid: %s", txid)
+	}
+
+	blockBytes, err := readHexOrFile(blockArg)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var block wire.MsgBlock
+	if err := block.Deserialize(bytes.NewReader(blockBytes)); err != nil {
+		return nil, 0, fmt.Errorf("invalid block: %w", err)
+	}
+
+	index := relayer.FindTx(&block, txHash)
+	if index < 0 {
+		return nil, 0, fmt.Errorf("transaction %s not found in block %s", txid, block.BlockHash())
+	}
+
+	return &block, index, nil
+}
+
+// getPrevTx gets the tx spent by the first input of the given tx from the flag or bitcoind
+func getPrevTx(cmd *cobra.Command, tx *wire.MsgTx) (*wire.MsgTx, error) {
+	prevTxArg, _ := cmd.Flags().GetString(FlagPrevTx)
+	if len(prevTxArg) != 0 {
+		prevTxBytes, err := readHexOrFile(prevTxArg)
+		if err != nil {
+			return nil, err
+		}
+
+		var prevTx wire.MsgTx
+		if err := prevTx.Deserialize(bytes.NewReader(prevTxBytes)); err != nil {
+			return nil, fmt.Errorf("invalid previous transaction: %w", err)
+		}
+
+		if prevTx.TxHash() != tx.TxIn[0].PreviousOutPoint.Hash {
+			return nil, fmt.Errorf("previous transaction mismatch: expected %s, got %s", tx.TxIn[0].PreviousOutPoint.Hash, prevTx.TxHash())
+		}
+
+		return &prevTx, nil
+	}
+
+	btcClient, err := newBitcoindClient(cmd)
+	if err != nil {
+		return nil, err
+	}
+	defer btcClient.Shutdown()
+
+	prevTx, err := btcClient.GetRawTransaction(&tx.TxIn[0].PreviousOutPoint.Hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return prevTx.MsgTx(), nil
+}
+
+// getInputPrevTxs gets the txs spent by the inputs of the given tx other than the first one from bitcoind
+func getInputPrevTxs(cmd *cobra.Command, tx *wire.MsgTx) ([]*wire.MsgTx, error) {
+	hashes := relayer.InputPrevTxHashes(tx)
+	if len(hashes) == 0 {
+		return nil, nil
+	}
+
+	btcClient, err := newBitcoindClient(cmd)
+	if err != nil {
+		return nil, err
+	}
+	defer btcClient.Shutdown()
+
+	prevTxs := make([]*wire.MsgTx, 0, len(hashes))
+	for _, hash := range hashes {
+		prevTx, err := btcClient.GetRawTransaction(&hash)
+		if err != nil {
+			return nil, err
+		}
+
+		prevTxs = append(prevTxs, prevTx.MsgTx())
+	}
+
+	return prevTxs, nil
+}
+
+// readHexOrFile decodes the given hex string, or reads the file of the given path
+// whose content is either hex or binary
+func readHexOrFile(arg string) ([]byte, error) {
+	if bz, err := hex.DecodeString(arg); err == nil {
+		return bz, nil
+	}
+
+	bz, err := os.ReadFile(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	if decoded, err := hex.DecodeString(strings.TrimSpace(string(bz))); err == nil {
+		return decoded, nil
+	}
+
+	return bz, nil
+}
+
 // readBlockHeadersFromFile reads the block headers from the file
 func readBlockHeadersFromFile(filePath string) ([]*types.BlockHeader, error) {
 	// read the file
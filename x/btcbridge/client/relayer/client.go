@@ -0,0 +1,30 @@
+package relayer
+
+import (
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/rpcclient"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// BitcoinClient defines the bitcoin rpc methods required by the relayer.
+// It is satisfied by the bitcoind json-rpc client and can be replaced for testing.
+type BitcoinClient interface {
+	GetBlockCount() (int64, error)
+	GetBlockHash(height int64) (*chainhash.Hash, error)
+	GetBlock(hash *chainhash.Hash) (*wire.MsgBlock, error)
+	GetRawTransaction(hash *chainhash.Hash) (*btcutil.Tx, error)
+}
+
+var _ BitcoinClient = (*rpcclient.Client)(nil)
+
+// NewBitcoindClient creates a new bitcoind json-rpc client
+func NewBitcoindClient(host string, user string, pass string) (*rpcclient.Client, error) {
+	return rpcclient.New(&rpcclient.ConnConfig{
+		Host:         host,
+		User:         user,
+		Pass:         pass,
+		HTTPPostMode: true,
+		DisableTLS:   true,
+	}, nil)
+}
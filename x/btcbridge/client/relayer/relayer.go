@@ -0,0 +1,562 @@
+package relayer
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/cometbft/cometbft/libs/log"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+const (
+	// default number of headers submitted in one message
+	DefaultBatchSize = 100
+
+	// default interval between two relaying rounds
+	DefaultInterval = 30 * time.Second
+
+	// maximum number of blocks to walk back for finding the common ancestor
+	MaxReorgDepth = 100
+)
+
+// Config defines the relayer config
+type Config struct {
+	// the relayer address on the side chain
+	Sender string
+	// the bridged chain, empty for bitcoin
+	ChainID string
+	// the bitcoin network params
+	ChainParams *chaincfg.Params
+	// the number of headers submitted in one message
+	BatchSize int
+	// the bitcoin block height from which to scan transactions if there is no saved state
+	StartHeight int64
+	// the interval between two relaying rounds
+	Interval time.Duration
+	// the file path of the relayer state
+	StatePath string
+}
+
+// Relayer syncs bitcoin headers to the side chain and submits the deposit and withdrawal transactions with proofs
+type Relayer struct {
+	btc       BitcoinClient
+	query     types.QueryClient
+	submitter Submitter
+
+	config Config
+	state  *State
+	logger log.Logger
+}
+
+// NewRelayer creates a new relayer and loads its state from disk
+func NewRelayer(btc BitcoinClient, query types.QueryClient, submitter Submitter, config Config, logger log.Logger) (*Relayer, error) {
+	if config.BatchSize <= 0 {
+		config.BatchSize = DefaultBatchSize
+	}
+
+	if config.Interval <= 0 {
+		config.Interval = DefaultInterval
+	}
+
+	state, err := LoadState(config.StatePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Relayer{
+		btc:       btc,
+		query:     query,
+		submitter: submitter,
+		config:    config,
+		state:     state,
+		logger:    logger,
+	}, nil
+}
+
+// Run relays periodically until the context is done
+func (r *Relayer) Run(ctx context.Context) error {
+	for {
+		if err := r.RelayOnce(ctx); err != nil {
+			r.logger.Error("failed to relay", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(r.config.Interval):
+		}
+	}
+}
+
+// RelayOnce submits the missing headers and then the transactions of the newly confirmed blocks
+func (r *Relayer) RelayOnce(ctx context.Context) error {
+	if err := r.SyncHeaders(ctx); err != nil {
+		return err
+	}
+
+	return r.ScanTransactions(ctx)
+}
+
+// SyncHeaders submits the headers which the side chain is missing in batches
+func (r *Relayer) SyncHeaders(ctx context.Context) error {
+	tip, err := r.query.QueryChainTip(ctx, &types.QueryChainTipRequest{ChainId: r.config.ChainID})
+	if err != nil {
+		return err
+	}
+
+	btcHeight, err := r.btc.GetBlockCount()
+	if err != nil {
+		return err
+	}
+
+	ancestor, err := r.findCommonAncestor(ctx, int64(tip.Height), tip.Hash, btcHeight)
+	if err != nil {
+		return err
+	}
+
+	// rescan the blocks replaced by the reorg, the deposits in them are dropped by the side chain
+	if ancestor < r.state.LastScannedHeight {
+		r.logger.Info("rewinding the scanned height on reorg", "from", r.state.LastScannedHeight, "to", ancestor)
+
+		r.state.LastScannedHeight = ancestor
+		if err := r.state.Save(r.config.StatePath); err != nil {
+			return err
+		}
+	}
+
+	for start := ancestor + 1; start <= btcHeight; start += int64(r.config.BatchSize) {
+		end := start + int64(r.config.BatchSize) - 1
+		if end > btcHeight {
+			end = btcHeight
+		}
+
+		headers := make([]*types.BlockHeader, 0, end-start+1)
+		for height := start; height <= end; height++ {
+			header, err := r.getBlockHeader(height)
+			if err != nil {
+				return err
+			}
+
+			headers = append(headers, header)
+		}
+
+		r.logger.Info("submitting block headers", "from", start, "to", end)
+
+		msg := types.NewMsgSubmitBlockHeaderRequest(r.config.Sender, headers)
+		msg.ChainId = r.config.ChainID
+
+		if err := r.submitter.Submit(ctx, msg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// findCommonAncestor returns the height of the last block which the side chain and the bitcoin node agree on
+func (r *Relayer) findCommonAncestor(ctx context.Context, tipHeight int64, tipHash string, btcHeight int64) (int64, error) {
+	height := tipHeight
+	if height > btcHeight {
+		height = btcHeight
+	}
+
+	for ; height >= 0 && tipHeight-height <= MaxReorgDepth; height-- {
+		hash := tipHash
+		if height != tipHeight {
+			res, err := r.query.QueryBlockHeaderByHeight(ctx, &types.QueryBlockHeaderByHeightRequest{Height: uint64(height), ChainId: r.config.ChainID})
+			if err != nil {
+				return 0, err
+			}
+
+			hash = res.BlockHeader.Hash
+		}
+
+		btcHash, err := r.btc.GetBlockHash(height)
+		if err != nil {
+			return 0, err
+		}
+
+		if btcHash.String() == hash {
+			return height, nil
+		}
+	}
+
+	return 0, fmt.Errorf("no common ancestor found within %d blocks from height %d", MaxReorgDepth, tipHeight)
+}
+
+// getBlockHeader returns the header of the bitcoin block at the given height
+func (r *Relayer) getBlockHeader(height int64) (*types.BlockHeader, error) {
+	hash, err := r.btc.GetBlockHash(height)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := r.btc.GetBlock(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewBlockHeader(&block.Header, uint64(height), uint64(len(block.Transactions))), nil
+}
+
+// ScanTransactions scans the blocks which have not been scanned yet
+// and submits the deposit and withdrawal transactions found
+func (r *Relayer) ScanTransactions(ctx context.Context) error {
+	paramsRes, err := r.query.QueryParams(ctx, &types.QueryParamsRequest{})
+	if err != nil {
+		return err
+	}
+
+	params := paramsRes.Params.ForChain(r.config.ChainID)
+
+	tip, err := r.query.QueryChainTip(ctx, &types.QueryChainTipRequest{ChainId: r.config.ChainID})
+	if err != nil {
+		return err
+	}
+
+	// the deposits are registered as pending as soon as their blocks are known,
+	// the side chain mints them once they are deep enough
+	best := int64(tip.Height)
+
+	start := r.state.LastScannedHeight + 1
+	if r.state.LastScannedHeight == 0 {
+		start = r.config.StartHeight
+		if start == 0 {
+			start = best
+		}
+	}
+
+	for height := start; height <= best; height++ {
+		hash, err := r.btc.GetBlockHash(height)
+		if err != nil {
+			return err
+		}
+
+		block, err := r.btc.GetBlock(hash)
+		if err != nil {
+			return err
+		}
+
+		// wait until the block is deep enough for the withdrawals in it
+		required, err := r.requiredConfirmations(block, params)
+		if err != nil {
+			return err
+		}
+
+		if best-height < int64(required) {
+			return nil
+		}
+
+		msgs, err := r.BuildTxMsgs(block, params)
+		if err != nil {
+			return err
+		}
+
+		for _, msg := range msgs {
+			if err := r.submitter.Submit(ctx, msg); err != nil {
+				var txErr *TxError
+				if !errors.As(err, &txErr) {
+					return err
+				}
+
+				// the tx is rejected by the side chain, e.g. already minted
+				r.logger.Error("transaction rejected", "height", height, "error", err)
+			}
+		}
+
+		r.state.LastScannedHeight = height
+		if err := r.state.Save(r.config.StatePath); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// requiredConfirmations returns the confirmations required by the withdrawal transactions of the given block,
+// zero if there are none. Withdrawals require the most confirmations of any tier, as the amount leaving the vaults
+// is only known by the side chain. The deposits need not wait, they are registered as pending by the side chain.
+func (r *Relayer) requiredConfirmations(block *wire.MsgBlock, params types.Params) (int32, error) {
+	// skip the coinbase tx
+	for i := 1; i < len(block.Transactions); i++ {
+		withdrawal, err := r.isWithdrawalTx(block.Transactions[i], params.Vaults)
+		if err != nil {
+			return 0, err
+		}
+
+		if withdrawal {
+			return params.MaxConfirmations(), nil
+		}
+	}
+
+	return 0, nil
+}
+
+// BuildTxMsgs builds the messages for the deposit and withdrawal transactions of the given block.
+// The params are expected to be scoped to the bridged chain of the relayer.
+func (r *Relayer) BuildTxMsgs(block *wire.MsgBlock, params types.Params) ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, 0)
+
+	// the coinbase tx paying to the vaults is a deposit spending no previous tx
+	if IsDepositTx(block.Transactions[0], params.Vaults, r.config.ChainParams) {
+		msg, err := BuildDepositMsg(r.config.Sender, block, 0, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		msg.ChainId = r.config.ChainID
+
+		msgs = append(msgs, msg)
+	}
+
+	for i := 1; i < len(block.Transactions); i++ {
+		tx := block.Transactions[i]
+
+		withdrawal, err := r.isWithdrawalTx(tx, params.Vaults)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case withdrawal:
+			msg, err := BuildWithdrawalMsg(r.config.Sender, block, i)
+			if err != nil {
+				return nil, err
+			}
+
+			msg.ChainId = r.config.ChainID
+
+			msgs = append(msgs, msg)
+
+		case IsDepositTx(tx, params.Vaults, r.config.ChainParams):
+			prevTx, err := r.btc.GetRawTransaction(&tx.TxIn[0].PreviousOutPoint.Hash)
+			if err != nil {
+				return nil, err
+			}
+
+			inputPrevTxs := make([]*wire.MsgTx, 0)
+			for _, hash := range InputPrevTxHashes(tx) {
+				inputPrevTx, err := r.btc.GetRawTransaction(&hash)
+				if err != nil {
+					return nil, err
+				}
+
+				inputPrevTxs = append(inputPrevTxs, inputPrevTx.MsgTx())
+			}
+
+			msg, err := BuildDepositMsg(r.config.Sender, block, i, prevTx.MsgTx(), inputPrevTxs...)
+			if err != nil {
+				return nil, err
+			}
+
+			msg.ChainId = r.config.ChainID
+
+			msgs = append(msgs, msg)
+		}
+	}
+
+	return msgs, nil
+}
+
+// InputPrevTxHashes returns the distinct hashes of the txs spent by the inputs of the given tx other than the first one,
+// which are submitted with the deposit tx for screening the senders of all inputs
+func InputPrevTxHashes(tx *wire.MsgTx) []chainhash.Hash {
+	hashes := make([]chainhash.Hash, 0)
+	seen := map[chainhash.Hash]bool{tx.TxIn[0].PreviousOutPoint.Hash: true}
+
+	for _, in := range tx.TxIn[1:] {
+		if !seen[in.PreviousOutPoint.Hash] {
+			seen[in.PreviousOutPoint.Hash] = true
+			hashes = append(hashes, in.PreviousOutPoint.Hash)
+		}
+	}
+
+	return hashes
+}
+
+// BuildDepositMsg builds the deposit message for the tx at the given index of the block.
+// prevTx is the tx spent by the first input of the deposit tx, nil for the coinbase tx;
+// inputPrevTxs are the txs spent by the other inputs as returned by InputPrevTxHashes.
+func BuildDepositMsg(sender string, block *wire.MsgBlock, index int, prevTx *wire.MsgTx, inputPrevTxs ...*wire.MsgTx) (*types.MsgSubmitDepositTransactionRequest, error) {
+	prevTxBytes := ""
+	if prevTx != nil {
+		bz, err := serializeTx(prevTx)
+		if err != nil {
+			return nil, err
+		}
+
+		prevTxBytes = bz
+	}
+
+	inputPrevTxsBytes := make([]string, 0, len(inputPrevTxs))
+	for _, inputPrevTx := range inputPrevTxs {
+		bz, err := serializeTx(inputPrevTx)
+		if err != nil {
+			return nil, err
+		}
+
+		inputPrevTxsBytes = append(inputPrevTxsBytes, bz)
+	}
+
+	txBytes, err := serializeTx(block.Transactions[index])
+	if err != nil {
+		return nil, err
+	}
+
+	msg := types.NewMsgSubmitDepositTransactionRequest(sender, block.BlockHash().String(), prevTxBytes, txBytes, blockMerkleProof(block, index))
+	msg.InputPrevTxs = inputPrevTxsBytes
+
+	// the merkle branch of the coinbase tx alone in the block is empty, which is proved by the tx out proof instead
+	if len(block.Transactions) == 1 {
+		txHash := block.Transactions[index].TxHash()
+
+		txOutProof, err := types.BuildTxOutProof(block, &txHash)
+		if err != nil {
+			return nil, err
+		}
+
+		msg.TxOutProof = txOutProof
+	}
+
+	return msg, nil
+}
+
+// BuildWithdrawalMsg builds the withdrawal message for the tx at the given index of the block
+func BuildWithdrawalMsg(sender string, block *wire.MsgBlock, index int) (*types.MsgSubmitWithdrawTransactionRequest, error) {
+	txBytes, err := serializeTx(block.Transactions[index])
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewMsgSubmitWithdrawTransactionRequest(sender, block.BlockHash().String(), txBytes, blockMerkleProof(block, index)), nil
+}
+
+// FindTx returns the index of the tx with the given hash in the block, or -1 if not found
+func FindTx(block *wire.MsgBlock, txHash *chainhash.Hash) int {
+	for i, tx := range block.Transactions {
+		if tx.TxHash() == *txHash {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// blockMerkleProof returns the merkle proof of the tx at the given index of the block
+func blockMerkleProof(block *wire.MsgBlock, index int) []string {
+	txHashes := make([]*chainhash.Hash, 0, len(block.Transactions))
+	for _, tx := range block.Transactions {
+		hash := tx.TxHash()
+		txHashes = append(txHashes, &hash)
+	}
+
+	return types.GetMerkleProof(txHashes, index)
+}
+
+// IsWithdrawalTx returns true if the given tx is signed by one of the vaults
+func IsWithdrawalTx(tx *wire.MsgTx, vaults []*types.Vault) bool {
+	if len(tx.TxIn) == 0 || len(tx.TxIn[0].Witness) != 2 {
+		return false
+	}
+
+	return types.SelectVaultByPubKey(vaults, hex.EncodeToString(tx.TxIn[0].Witness[1])) != nil
+}
+
+// isWithdrawalTx returns true if the given tx spends one of the vaults.
+// The witness of taproot vault spends does not reveal the vault key, so the output spent by the first input is looked up.
+func (r *Relayer) isWithdrawalTx(tx *wire.MsgTx, vaults []*types.Vault) (bool, error) {
+	if IsWithdrawalTx(tx, vaults) {
+		return true, nil
+	}
+
+	if !IsTaprootSpend(tx) || !hasTaprootVault(vaults) {
+		return false, nil
+	}
+
+	prevOut := tx.TxIn[0].PreviousOutPoint
+
+	prevTx, err := r.btc.GetRawTransaction(&prevOut.Hash)
+	if err != nil {
+		return false, err
+	}
+
+	if int(prevOut.Index) >= len(prevTx.MsgTx().TxOut) {
+		return false, nil
+	}
+
+	return isVaultOutput(prevTx.MsgTx().TxOut[prevOut.Index], vaults, r.config.ChainParams), nil
+}
+
+// IsTaprootSpend returns true if the first input of the given tx looks like a taproot spend,
+// i.e. either the key path <signature> or the script path <signature> <script> <control block>
+func IsTaprootSpend(tx *wire.MsgTx) bool {
+	if len(tx.TxIn) == 0 {
+		return false
+	}
+
+	witness := tx.TxIn[0].Witness
+	if len(witness) != 1 && len(witness) != 3 {
+		return false
+	}
+
+	return len(witness[0]) == schnorr.SignatureSize || len(witness[0]) == schnorr.SignatureSize+1
+}
+
+// IsDepositTx returns true if the given tx pays to one of the vaults
+func IsDepositTx(tx *wire.MsgTx, vaults []*types.Vault, chainParams *chaincfg.Params) bool {
+	for _, out := range tx.TxOut {
+		if isVaultOutput(out, vaults, chainParams) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isVaultOutput returns true if the given output pays to one of the vaults
+func isVaultOutput(out *wire.TxOut, vaults []*types.Vault, chainParams *chaincfg.Params) bool {
+	pkScript, err := txscript.ParsePkScript(out.PkScript)
+	if err != nil {
+		return false
+	}
+
+	addr, err := pkScript.Address(chainParams)
+	if err != nil {
+		return false
+	}
+
+	return types.SelectVaultByBitcoinAddress(vaults, addr.EncodeAddress()) != nil
+}
+
+// hasTaprootVault returns true if any of the vaults is a taproot vault
+func hasTaprootVault(vaults []*types.Vault) bool {
+	for _, vault := range vaults {
+		if vault.TapDescriptor != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// serializeTx serializes the given tx in base64 format
+func serializeTx(tx *wire.MsgTx) (string, error) {
+	var buf bytes.Buffer
+	if err := tx.Serialize(&buf); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
@@ -0,0 +1,337 @@
+package relayer_test
+
+import (
+	"context"
+	"encoding/hex"
+	"path/filepath"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/cometbft/cometbft/libs/log"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/sideprotocol/side/testutil/bitcoin"
+	"github.com/sideprotocol/side/testutil/sample"
+	"github.com/sideprotocol/side/x/btcbridge/client/relayer"
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+// fakeSide is an in-memory side chain which accepts headers and records the submitted messages
+type fakeSide struct {
+	types.QueryClient
+
+	params  types.Params
+	headers []*types.BlockHeader
+	msgs    []sdk.Msg
+}
+
+func newFakeSide(genesis *wire.MsgBlock, params types.Params) *fakeSide {
+	return &fakeSide{
+		params:  params,
+		headers: []*types.BlockHeader{types.NewBlockHeader(&genesis.Header, 0, 1)},
+	}
+}
+
+func (s *fakeSide) QueryParams(_ context.Context, _ *types.QueryParamsRequest, _ ...grpc.CallOption) (*types.QueryParamsResponse, error) {
+	return &types.QueryParamsResponse{Params: s.params}, nil
+}
+
+func (s *fakeSide) QueryChainTip(_ context.Context, _ *types.QueryChainTipRequest, _ ...grpc.CallOption) (*types.QueryChainTipResponse, error) {
+	tip := s.headers[len(s.headers)-1]
+	return &types.QueryChainTipResponse{Hash: tip.Hash, Height: tip.Height}, nil
+}
+
+func (s *fakeSide) QueryBlockHeaderByHeight(_ context.Context, req *types.QueryBlockHeaderByHeightRequest, _ ...grpc.CallOption) (*types.QueryBlockHeaderByHeightResponse, error) {
+	return &types.QueryBlockHeaderByHeightResponse{BlockHeader: s.headers[req.Height]}, nil
+}
+
+func (s *fakeSide) Submit(_ context.Context, msgs ...sdk.Msg) error {
+	for _, msg := range msgs {
+		if m, ok := msg.(*types.MsgSubmitBlockHeaderRequest); ok {
+			s.headers = append(s.headers[:m.BlockHeaders[0].Height], m.BlockHeaders...)
+		}
+	}
+
+	s.msgs = append(s.msgs, msgs...)
+
+	return nil
+}
+
+func newTestRelayer(t *testing.T, chain *bitcoin.Chain, side *fakeSide, batchSize int) *relayer.Relayer {
+	r, err := relayer.NewRelayer(chain, side, side, relayer.Config{
+		Sender:      sample.AccAddress(),
+		ChainParams: chain.Params,
+		BatchSize:   batchSize,
+		StartHeight: 1,
+		StatePath:   filepath.Join(t.TempDir(), "relayer.json"),
+	}, log.NewNopLogger())
+	require.NoError(t, err)
+
+	return r
+}
+
+func TestSyncHeaders(t *testing.T) {
+	chain := bitcoin.NewChain()
+	for i := 0; i < 10; i++ {
+		chain.MineBlock()
+	}
+
+	side := newFakeSide(chain.BlockAt(0), types.DefaultParams())
+	r := newTestRelayer(t, chain, side, 3)
+
+	require.NoError(t, r.SyncHeaders(context.Background()))
+	require.Len(t, side.msgs, 4)
+	require.Len(t, side.headers, 11)
+	require.Equal(t, chain.Tip().BlockHash().String(), side.headers[10].Hash)
+
+	// reorg the last 2 blocks
+	chain.Reorg(8)
+	chain.MineBlock(bitcoin.NewTx(wire.NewOutPoint(&chainhash.Hash{1}, 0), nil, wire.NewTxOut(1000, []byte{txscript.OP_TRUE})))
+	chain.MineBlock()
+	chain.MineBlock()
+
+	require.NoError(t, r.SyncHeaders(context.Background()))
+	require.Len(t, side.headers, 12)
+	require.Equal(t, chain.Tip().BlockHash().String(), side.headers[11].Hash)
+}
+
+func TestScanTransactions(t *testing.T) {
+	chain := bitcoin.NewChain()
+
+	vaultKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	vaultAddr, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(vaultKey.PubKey().SerializeCompressed()), chain.Params)
+	require.NoError(t, err)
+	vaultPkScript, err := txscript.PayToAddrScript(vaultAddr)
+	require.NoError(t, err)
+
+	userKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	userAddr, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(userKey.PubKey().SerializeCompressed()), chain.Params)
+	require.NoError(t, err)
+	userPkScript, err := txscript.PayToAddrScript(userAddr)
+	require.NoError(t, err)
+
+	funding := chain.MineBlock().Transactions[0]
+
+	deposit := bitcoin.NewTx(wire.NewOutPoint(&chainhash.Hash{}, 0), nil, wire.NewTxOut(100000, vaultPkScript), wire.NewTxOut(1000, userPkScript))
+	deposit.TxIn[0].PreviousOutPoint.Hash = funding.TxHash()
+
+	withdrawal := bitcoin.NewTx(wire.NewOutPoint(&chainhash.Hash{2}, 0), wire.TxWitness{{0x30}, vaultKey.PubKey().SerializeCompressed()}, wire.NewTxOut(50000, userPkScript))
+
+	block := chain.MineBlock(deposit, bitcoin.NewTx(wire.NewOutPoint(&chainhash.Hash{3}, 0), nil, wire.NewTxOut(1000, userPkScript)), withdrawal)
+	chain.MineBlock()
+	chain.MineBlock()
+
+	params := types.DefaultParams()
+	params.Vaults = []*types.Vault{{
+		Address:   vaultAddr.EncodeAddress(),
+		PubKey:    hex.EncodeToString(vaultKey.PubKey().SerializeCompressed()),
+		AssetType: types.AssetType_ASSET_TYPE_BTC,
+	}}
+
+	side := newFakeSide(chain.BlockAt(0), params)
+	r := newTestRelayer(t, chain, side, 100)
+
+	require.NoError(t, r.RelayOnce(context.Background()))
+
+	var depositMsg *types.MsgSubmitDepositTransactionRequest
+	var withdrawalMsg *types.MsgSubmitWithdrawTransactionRequest
+	for _, msg := range side.msgs {
+		switch m := msg.(type) {
+		case *types.MsgSubmitDepositTransactionRequest:
+			depositMsg = m
+		case *types.MsgSubmitWithdrawTransactionRequest:
+			withdrawalMsg = m
+		}
+	}
+
+	require.NotNil(t, depositMsg)
+	require.Equal(t, block.BlockHash().String(), depositMsg.Blockhash)
+	require.NoError(t, depositMsg.ValidateBasic())
+
+	depositHash := deposit.TxHash()
+	require.True(t, types.VerifyMerkleProof(depositMsg.Proof, &depositHash, &block.Header.MerkleRoot))
+
+	require.NotNil(t, withdrawalMsg)
+	withdrawalHash := withdrawal.TxHash()
+	require.True(t, types.VerifyMerkleProof(withdrawalMsg.Proof, &withdrawalHash, &block.Header.MerkleRoot))
+
+	// blocks already scanned are not submitted again
+	submitted := len(side.msgs)
+	require.NoError(t, r.RelayOnce(context.Background()))
+	require.Len(t, side.msgs, submitted)
+}
+
+func TestScanTaprootWithdrawal(t *testing.T) {
+	chain := bitcoin.NewChain()
+
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	descriptor := &types.VaultDescriptor{
+		InternalKey:   hex.EncodeToString(schnorr.SerializePubKey(key.PubKey())),
+		RecoveryKey:   hex.EncodeToString(schnorr.SerializePubKey(key.PubKey())),
+		RecoveryDelay: 144,
+	}
+
+	vaultAddress, err := descriptor.Address(chain.Params)
+	require.NoError(t, err)
+	vaultAddr, err := btcutil.DecodeAddress(vaultAddress, chain.Params)
+	require.NoError(t, err)
+	vaultPkScript, err := txscript.PayToAddrScript(vaultAddr)
+	require.NoError(t, err)
+
+	userAddr, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(key.PubKey().SerializeCompressed()), chain.Params)
+	require.NoError(t, err)
+	userPkScript, err := txscript.PayToAddrScript(userAddr)
+	require.NoError(t, err)
+
+	funding := chain.MineBlock().Transactions[0]
+
+	deposit := bitcoin.NewTx(wire.NewOutPoint(&chainhash.Hash{}, 0), nil, wire.NewTxOut(100000, vaultPkScript))
+	deposit.TxIn[0].PreviousOutPoint.Hash = funding.TxHash()
+	chain.MineBlock(deposit)
+
+	// the key path spend with the change back to the vault does not reveal the vault key
+	depositHash := deposit.TxHash()
+	withdrawal := bitcoin.NewTx(wire.NewOutPoint(&depositHash, 0), wire.TxWitness{make([]byte, 64)}, wire.NewTxOut(50000, userPkScript), wire.NewTxOut(40000, vaultPkScript))
+	chain.MineBlock(withdrawal)
+	chain.MineBlock()
+	chain.MineBlock()
+
+	params := types.DefaultParams()
+	params.Vaults = []*types.Vault{{
+		Address:       vaultAddress,
+		PubKey:        descriptor.InternalKey,
+		AssetType:     types.AssetType_ASSET_TYPE_BTC,
+		TapDescriptor: descriptor,
+	}}
+
+	side := newFakeSide(chain.BlockAt(0), params)
+	r := newTestRelayer(t, chain, side, 100)
+
+	require.NoError(t, r.RelayOnce(context.Background()))
+
+	deposits := 0
+	withdrawals := 0
+	for _, msg := range side.msgs {
+		switch msg.(type) {
+		case *types.MsgSubmitDepositTransactionRequest:
+			deposits++
+		case *types.MsgSubmitWithdrawTransactionRequest:
+			withdrawals++
+		}
+	}
+
+	require.Equal(t, 1, deposits)
+	require.Equal(t, 1, withdrawals)
+}
+
+func TestScanTransactionsConfirmationTiers(t *testing.T) {
+	chain := bitcoin.NewChain()
+
+	vaultKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	vaultAddr, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(vaultKey.PubKey().SerializeCompressed()), chain.Params)
+	require.NoError(t, err)
+	vaultPkScript, err := txscript.PayToAddrScript(vaultAddr)
+	require.NoError(t, err)
+
+	depositTx := func(amount int64) *wire.MsgTx {
+		funding := chain.MineBlock().Transactions[0]
+
+		tx := bitcoin.NewTx(wire.NewOutPoint(&chainhash.Hash{}, 0), nil, wire.NewTxOut(amount, vaultPkScript))
+		tx.TxIn[0].PreviousOutPoint.Hash = funding.TxHash()
+
+		return tx
+	}
+
+	small, large := depositTx(100000), depositTx(2000000)
+	smallBlock := chain.MineBlock(small)
+	largeBlock := chain.MineBlock(large)
+	chain.MineBlock()
+
+	// the deposits of 0.01 btc or more require 3 confirmations instead of 1
+	params := types.DefaultParams()
+	params.Confirmations = 1
+	params.ConfirmationTiers = []*types.ConfirmationTier{{AssetType: types.AssetType_ASSET_TYPE_BTC, MinAmount: 1000000, Confirmations: 3}}
+	params.Vaults = []*types.Vault{{
+		Address:   vaultAddr.EncodeAddress(),
+		PubKey:    hex.EncodeToString(vaultKey.PubKey().SerializeCompressed()),
+		AssetType: types.AssetType_ASSET_TYPE_BTC,
+	}}
+
+	side := newFakeSide(chain.BlockAt(0), params)
+	r := newTestRelayer(t, chain, side, 100)
+
+	deposits := func() []string {
+		blocks := make([]string, 0)
+		for _, msg := range side.msgs {
+			if m, ok := msg.(*types.MsgSubmitDepositTransactionRequest); ok {
+				blocks = append(blocks, m.Blockhash)
+			}
+		}
+
+		return blocks
+	}
+
+	// both deposits are registered as soon as their blocks are known, the side chain mints them once confirmed
+	require.NoError(t, r.RelayOnce(context.Background()))
+	require.Equal(t, []string{smallBlock.BlockHash().String(), largeBlock.BlockHash().String()}, deposits())
+
+	chain.MineBlock()
+	chain.MineBlock()
+
+	// the pending deposits are not submitted again
+	require.NoError(t, r.RelayOnce(context.Background()))
+	require.Equal(t, []string{smallBlock.BlockHash().String(), largeBlock.BlockHash().String()}, deposits())
+}
+
+func TestScanCoinbaseDeposit(t *testing.T) {
+	chain := bitcoin.NewChain()
+
+	vaultKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	vaultAddr, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(vaultKey.PubKey().SerializeCompressed()), chain.Params)
+	require.NoError(t, err)
+	vaultPkScript, err := txscript.PayToAddrScript(vaultAddr)
+	require.NoError(t, err)
+
+	block := chain.MineCoinbaseBlock([]*wire.TxOut{wire.NewTxOut(100000, vaultPkScript)})
+
+	params := types.DefaultParams()
+	params.Vaults = []*types.Vault{{
+		Address:   vaultAddr.EncodeAddress(),
+		PubKey:    hex.EncodeToString(vaultKey.PubKey().SerializeCompressed()),
+		AssetType: types.AssetType_ASSET_TYPE_BTC,
+	}}
+
+	side := newFakeSide(chain.BlockAt(0), params)
+	r := newTestRelayer(t, chain, side, 100)
+
+	require.NoError(t, r.RelayOnce(context.Background()))
+
+	// the coinbase deposit is submitted without the previous tx
+	var depositMsg *types.MsgSubmitDepositTransactionRequest
+	for _, msg := range side.msgs {
+		if m, ok := msg.(*types.MsgSubmitDepositTransactionRequest); ok {
+			depositMsg = m
+		}
+	}
+
+	require.NotNil(t, depositMsg)
+	require.Equal(t, block.BlockHash().String(), depositMsg.Blockhash)
+	require.Empty(t, depositMsg.PrevTxBytes)
+	require.NoError(t, depositMsg.ValidateBasic())
+
+	coinbaseHash := block.Transactions[0].TxHash()
+	require.NoError(t, types.VerifyTxOutProof(depositMsg.TxOutProof, &coinbaseHash, block.BlockHash().String()))
+}
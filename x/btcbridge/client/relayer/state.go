@@ -0,0 +1,52 @@
+package relayer
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+)
+
+// State is the relayer state persisted on disk, so that the relayer can resume after restart
+type State struct {
+	// the height of the last bitcoin block scanned for deposit and withdrawal transactions
+	LastScannedHeight int64 `json:"last_scanned_height"`
+}
+
+// LoadState loads the state from the given file.
+// An empty state is returned if the file does not exist.
+func LoadState(path string) (*State, error) {
+	bz, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return &State{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var state State
+	if err := json.Unmarshal(bz, &state); err != nil {
+		return nil, err
+	}
+
+	return &state, nil
+}
+
+// Save writes the state to the given file atomically
+func (s *State) Save(path string) error {
+	bz, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, bz, 0o600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, path)
+}
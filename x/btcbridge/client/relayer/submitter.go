@@ -0,0 +1,124 @@
+package relayer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/spf13/pflag"
+)
+
+const (
+	// interval for polling the inclusion of the submitted tx
+	txPollInterval = 2 * time.Second
+	// maximum time to wait for the inclusion of the submitted tx
+	txPollTimeout = time.Minute
+)
+
+// TxError is returned when the submitted tx is rejected by the side chain
+type TxError struct {
+	TxHash string
+	Log    string
+}
+
+// Error implements error
+func (e *TxError) Error() string {
+	return fmt.Sprintf("tx %s failed: %s", e.TxHash, e.Log)
+}
+
+// Submitter submits messages to the side chain
+type Submitter interface {
+	Submit(ctx context.Context, msgs ...sdk.Msg) error
+}
+
+// TxSubmitter signs and broadcasts messages with the key of the client context
+// and waits until the tx is included in a block
+type TxSubmitter struct {
+	clientCtx client.Context
+	flags     *pflag.FlagSet
+}
+
+var _ Submitter = (*TxSubmitter)(nil)
+
+// NewTxSubmitter creates a new TxSubmitter
+func NewTxSubmitter(clientCtx client.Context, flags *pflag.FlagSet) *TxSubmitter {
+	return &TxSubmitter{
+		clientCtx: clientCtx.WithSkipConfirmation(true),
+		flags:     flags,
+	}
+}
+
+// Submit implements Submitter
+func (s *TxSubmitter) Submit(ctx context.Context, msgs ...sdk.Msg) error {
+	// the account sequence is queried for each tx
+	txf, err := tx.NewFactoryCLI(s.clientCtx, s.flags)
+	if err != nil {
+		return err
+	}
+
+	txf, err = txf.Prepare(s.clientCtx)
+	if err != nil {
+		return err
+	}
+
+	if txf.SimulateAndExecute() || s.clientCtx.Simulate {
+		_, adjusted, err := tx.CalculateGas(s.clientCtx, txf, msgs...)
+		if err != nil {
+			return err
+		}
+
+		txf = txf.WithGas(adjusted)
+	}
+
+	builder, err := txf.BuildUnsignedTx(msgs...)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Sign(txf, s.clientCtx.GetFromName(), builder, true); err != nil {
+		return err
+	}
+
+	txBytes, err := s.clientCtx.TxConfig.TxEncoder()(builder.GetTx())
+	if err != nil {
+		return err
+	}
+
+	res, err := s.clientCtx.BroadcastTxSync(txBytes)
+	if err != nil {
+		return err
+	}
+	if res.Code != 0 {
+		return &TxError{TxHash: res.TxHash, Log: res.RawLog}
+	}
+
+	return s.waitForTx(ctx, res.TxHash)
+}
+
+// waitForTx waits until the tx of the given hash is included in a block
+func (s *TxSubmitter) waitForTx(ctx context.Context, hash string) error {
+	timeout := time.After(txPollTimeout)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timeout:
+			return fmt.Errorf("timed out waiting for tx %s", hash)
+		case <-time.After(txPollInterval):
+			res, err := authtx.QueryTx(s.clientCtx, hash)
+			if err != nil {
+				continue
+			}
+			if res.Code != 0 {
+				return &TxError{TxHash: hash, Log: res.RawLog}
+			}
+
+			return nil
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package signer
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+// SigningContext is the signing request to be checked by the policies
+type SigningContext struct {
+	Request *types.BitcoinSigningRequest
+	Packet  *psbt.Packet
+
+	Vault         *types.Vault
+	VaultPkScript []byte
+
+	ChainParams *chaincfg.Params
+}
+
+// Withdrawals returns the outputs which do not pay back to the vault
+func (s *SigningContext) Withdrawals() []*wire.TxOut {
+	outs := make([]*wire.TxOut, 0)
+	for _, out := range s.Packet.UnsignedTx.TxOut {
+		if !bytes.Equal(out.PkScript, s.VaultPkScript) {
+			outs = append(outs, out)
+		}
+	}
+
+	return outs
+}
+
+// Policy defines the custom check of the operator on a signing request.
+// The signing request is skipped if any policy returns an error.
+type Policy interface {
+	Check(s *SigningContext) error
+}
+
+// PolicyFunc is the function adapter of Policy
+type PolicyFunc func(s *SigningContext) error
+
+// Check implements Policy
+func (f PolicyFunc) Check(s *SigningContext) error {
+	return f(s)
+}
+
+// MaxAmountPolicy rejects the requests which withdraw more than the given amount
+func MaxAmountPolicy(maxAmount int64) Policy {
+	return PolicyFunc(func(s *SigningContext) error {
+		amount := int64(0)
+		for _, out := range s.Withdrawals() {
+			amount += out.Value
+		}
+
+		if amount > maxAmount {
+			return fmt.Errorf("withdrawal amount %d exceeds the maximum amount %d", amount, maxAmount)
+		}
+
+		return nil
+	})
+}
+
+// AllowListPolicy rejects the requests which withdraw to any address not in the given list
+func AllowListPolicy(addresses []string) Policy {
+	allowed := toSet(addresses)
+
+	return PolicyFunc(func(s *SigningContext) error {
+		for _, out := range s.Withdrawals() {
+			addr, err := outputAddress(out.PkScript, s.ChainParams)
+			if err != nil {
+				return err
+			}
+
+			if !allowed[addr] {
+				return fmt.Errorf("destination %s is not allowed", addr)
+			}
+		}
+
+		return nil
+	})
+}
+
+// DenyListPolicy rejects the requests which withdraw to any address in the given list
+func DenyListPolicy(addresses []string) Policy {
+	denied := toSet(addresses)
+
+	return PolicyFunc(func(s *SigningContext) error {
+		for _, out := range s.Withdrawals() {
+			addr, err := outputAddress(out.PkScript, s.ChainParams)
+			if err != nil {
+				return err
+			}
+
+			if denied[addr] {
+				return fmt.Errorf("destination %s is denied", addr)
+			}
+		}
+
+		return nil
+	})
+}
+
+// outputAddress returns the address of the given output script
+func outputAddress(pkScript []byte, chainParams *chaincfg.Params) (string, error) {
+	script, err := txscript.ParsePkScript(pkScript)
+	if err != nil {
+		return "", err
+	}
+
+	addr, err := script.Address(chainParams)
+	if err != nil {
+		return "", err
+	}
+
+	return addr.EncodeAddress(), nil
+}
+
+func toSet(items []string) map[string]bool {
+	set := make(map[string]bool, len(items))
+	for _, item := range items {
+		set[item] = true
+	}
+
+	return set
+}
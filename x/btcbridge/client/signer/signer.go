@@ -0,0 +1,414 @@
+package signer
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/cometbft/cometbft/libs/log"
+
+	"github.com/sideprotocol/side/x/btcbridge/client/relayer"
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+const (
+	// default interval between two signing rounds
+	DefaultInterval = 30 * time.Second
+)
+
+// Config defines the signer config
+type Config struct {
+	// the signer address on the side chain
+	Sender string
+	// the bridged chain, empty for bitcoin
+	ChainID string
+	// the bitcoin network params
+	ChainParams *chaincfg.Params
+	// the maximum fee in satoshis of a withdrawal tx, no limit if zero
+	MaxFee int64
+	// the maximum fee rate in sat/vbyte of a withdrawal tx, no limit if zero
+	MaxFeeRate int64
+	// the interval between two signing rounds
+	Interval time.Duration
+}
+
+// Signer signs the withdrawal transactions of the vaults controlled by its key
+type Signer struct {
+	query     types.QueryClient
+	submitter relayer.Submitter
+
+	key      *btcec.PrivateKey
+	policies []Policy
+
+	config Config
+	logger log.Logger
+
+	// txids of the signing requests which have been handled
+	handled map[string]bool
+}
+
+// NewSigner creates a new signer
+func NewSigner(query types.QueryClient, submitter relayer.Submitter, key *btcec.PrivateKey, policies []Policy, config Config, logger log.Logger) *Signer {
+	if config.Interval <= 0 {
+		config.Interval = DefaultInterval
+	}
+
+	return &Signer{
+		query:     query,
+		submitter: submitter,
+		key:       key,
+		policies:  policies,
+		config:    config,
+		logger:    logger,
+		handled:   make(map[string]bool),
+	}
+}
+
+// Run signs periodically until the context is done
+func (s *Signer) Run(ctx context.Context) error {
+	for {
+		if err := s.SignOnce(ctx); err != nil {
+			s.logger.Error("failed to sign", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(s.config.Interval):
+		}
+	}
+}
+
+// SignOnce signs and submits the pending signing requests of the vaults controlled by the signer
+func (s *Signer) SignOnce(ctx context.Context) error {
+	paramsRes, err := s.query.QueryParams(ctx, &types.QueryParamsRequest{})
+	if err != nil {
+		return err
+	}
+
+	vault := selectVault(paramsRes.Params.ForChain(s.config.ChainID).Vaults, s.key.PubKey())
+	if vault == nil {
+		return fmt.Errorf("no vault controlled by the signing key")
+	}
+
+	requestsRes, err := s.query.QuerySigningRequest(ctx, &types.QuerySigningRequestRequest{Status: types.SigningStatus_SIGNING_STATUS_CREATED, ChainId: s.config.ChainID})
+	if err != nil {
+		return err
+	}
+
+	utxosRes, err := s.query.QueryUTXOs(ctx, &types.QueryUTXOsRequest{ChainId: s.config.ChainID})
+	if err != nil {
+		return err
+	}
+
+	utxos := make(map[wire.OutPoint]*types.UTXO, len(utxosRes.Utxos))
+	for _, utxo := range utxosRes.Utxos {
+		outPoint, err := utxoOutPoint(utxo)
+		if err != nil {
+			return err
+		}
+
+		utxos[*outPoint] = utxo
+	}
+
+	for _, req := range requestsRes.Requests {
+		// recovery requests are signed by the recovery key rather than the signer committee
+		if req.VaultAddress != vault.Address || req.Recovery || s.handled[req.Txid] {
+			continue
+		}
+
+		// requests are handled only once, rejected ones need the operator to intervene
+		s.handled[req.Txid] = true
+
+		signed, err := s.SignRequest(req, vault, utxos)
+		if err != nil {
+			s.logger.Error("signing request rejected", "txid", req.Txid, "error", err)
+			continue
+		}
+
+		s.logger.Info("submitting signatures", "txid", req.Txid)
+
+		msg := types.NewMsgSubmitWithdrawSignaturesRequest(s.config.Sender, req.Txid, signed)
+		msg.ChainId = s.config.ChainID
+
+		if err := s.submitter.Submit(ctx, msg); err != nil {
+			// retry in the next round
+			delete(s.handled, req.Txid)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SignRequest validates the given signing request against the on-chain state and the policies
+// and returns the signed psbt in base64 format
+func (s *Signer) SignRequest(req *types.BitcoinSigningRequest, vault *types.Vault, utxos map[wire.OutPoint]*types.UTXO) (string, error) {
+	packet, err := psbt.NewFromRawBytes(bytes.NewReader([]byte(req.Psbt)), true)
+	if err != nil {
+		return "", err
+	}
+
+	sc, err := s.newSigningContext(req, packet, vault)
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.validate(sc, utxos); err != nil {
+		return "", err
+	}
+
+	for _, policy := range s.policies {
+		if err := policy.Check(sc); err != nil {
+			return "", err
+		}
+	}
+
+	if err := s.sign(packet, vault); err != nil {
+		return "", err
+	}
+
+	return packet.B64Encode()
+}
+
+// newSigningContext creates the signing context of the given request
+func (s *Signer) newSigningContext(req *types.BitcoinSigningRequest, packet *psbt.Packet, vault *types.Vault) (*SigningContext, error) {
+	vaultAddr, err := btcutil.DecodeAddress(vault.Address, s.config.ChainParams)
+	if err != nil {
+		return nil, err
+	}
+
+	vaultPkScript, err := txscript.PayToAddrScript(vaultAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SigningContext{
+		Request:       req,
+		Packet:        packet,
+		Vault:         vault,
+		VaultPkScript: vaultPkScript,
+		ChainParams:   s.config.ChainParams,
+	}, nil
+}
+
+// validate checks that the psbt spends the vault utxos known by the side chain,
+// pays to the requested recipient only and does not exceed the fee ceilings
+func (s *Signer) validate(sc *SigningContext, utxos map[wire.OutPoint]*types.UTXO) error {
+	tx := sc.Packet.UnsignedTx
+
+	if tx.TxHash().String() != sc.Request.Txid {
+		return fmt.Errorf("txid mismatch: expected %s, got %s", sc.Request.Txid, tx.TxHash())
+	}
+
+	if len(tx.TxIn) == 0 || len(tx.TxIn) != len(sc.Packet.Inputs) {
+		return fmt.Errorf("invalid inputs")
+	}
+
+	spent := make([]*types.UTXO, 0, len(tx.TxIn))
+	inAmount := int64(0)
+
+	for i, txIn := range tx.TxIn {
+		prevOut := sc.Packet.Inputs[i].WitnessUtxo
+		if prevOut == nil {
+			return fmt.Errorf("missing witness utxo of input %d", i)
+		}
+
+		if !bytes.Equal(prevOut.PkScript, sc.VaultPkScript) {
+			return fmt.Errorf("input %d does not spend the vault", i)
+		}
+
+		utxo, ok := utxos[txIn.PreviousOutPoint]
+		if !ok {
+			return fmt.Errorf("input %d spends an unknown utxo %s", i, txIn.PreviousOutPoint)
+		}
+
+		if int64(utxo.Amount) != prevOut.Value {
+			return fmt.Errorf("input %d amount mismatch: expected %d, got %d", i, utxo.Amount, prevOut.Value)
+		}
+
+		if sc.Packet.Inputs[i].SighashType != txscript.SigHashAll {
+			return fmt.Errorf("input %d has unexpected sighash type %d", i, sc.Packet.Inputs[i].SighashType)
+		}
+
+		spent = append(spent, utxo)
+		inAmount += prevOut.Value
+	}
+
+	// each recipient of the request is paid by exactly one output
+	recipients := make(map[string]string)
+	for _, recipient := range sc.Request.RecipientAddresses() {
+		recipientAddr, err := btcutil.DecodeAddress(recipient, s.config.ChainParams)
+		if err != nil {
+			return err
+		}
+
+		recipientPkScript, err := txscript.PayToAddrScript(recipientAddr)
+		if err != nil {
+			return err
+		}
+
+		recipients[string(recipientPkScript)] = recipient
+	}
+
+	outAmount := int64(0)
+	withdrawals := sc.Withdrawals()
+
+	for _, out := range withdrawals {
+		if _, ok := recipients[string(out.PkScript)]; !ok {
+			return fmt.Errorf("unexpected output to %x", out.PkScript)
+		}
+
+		delete(recipients, string(out.PkScript))
+	}
+
+	if len(recipients) != 0 {
+		missing := make([]string, 0, len(recipients))
+		for _, recipient := range recipients {
+			missing = append(missing, recipient)
+		}
+
+		sort.Strings(missing)
+
+		return fmt.Errorf("no output to the recipient %s", strings.Join(missing, ", "))
+	}
+
+	for _, out := range tx.TxOut {
+		outAmount += out.Value
+	}
+
+	fee := inAmount - outAmount
+	if fee < 0 {
+		return fmt.Errorf("outputs exceed inputs")
+	}
+
+	if s.config.MaxFee > 0 && fee > s.config.MaxFee {
+		return fmt.Errorf("fee %d exceeds the maximum fee %d", fee, s.config.MaxFee)
+	}
+
+	if s.config.MaxFeeRate > 0 {
+		feeRate := fee / types.GetTxVirtualSize(tx, spent)
+		if feeRate > s.config.MaxFeeRate {
+			return fmt.Errorf("fee rate %d exceeds the maximum fee rate %d", feeRate, s.config.MaxFeeRate)
+		}
+	}
+
+	return nil
+}
+
+// sign signs all inputs of the given psbt and finalizes it.
+// The inputs of taproot vaults are signed by the key path.
+func (s *Signer) sign(packet *psbt.Packet, vault *types.Vault) error {
+	prevOutFetcher := txscript.NewMultiPrevOutFetcher(nil)
+	for i, txIn := range packet.UnsignedTx.TxIn {
+		prevOutFetcher.AddPrevOut(txIn.PreviousOutPoint, packet.Inputs[i].WitnessUtxo)
+	}
+
+	sigHashes := txscript.NewTxSigHashes(packet.UnsignedTx, prevOutFetcher)
+
+	if vault.TapDescriptor != nil {
+		return s.signTaproot(packet, vault.TapDescriptor, sigHashes)
+	}
+
+	pubKey := s.key.PubKey().SerializeCompressed()
+
+	updater, err := psbt.NewUpdater(packet)
+	if err != nil {
+		return err
+	}
+
+	for i := range packet.Inputs {
+		prevOut := packet.Inputs[i].WitnessUtxo
+
+		sig, err := txscript.RawTxInWitnessSignature(packet.UnsignedTx, sigHashes, i, prevOut.Value, prevOut.PkScript, txscript.SigHashAll, s.key)
+		if err != nil {
+			return err
+		}
+
+		if _, err := updater.Sign(i, sig, pubKey, nil, nil); err != nil {
+			return err
+		}
+	}
+
+	return psbt.MaybeFinalizeAll(packet)
+}
+
+// signTaproot signs all inputs of the given psbt by the key path of the taproot vault and finalizes it
+func (s *Signer) signTaproot(packet *psbt.Packet, descriptor *types.VaultDescriptor, sigHashes *txscript.TxSigHashes) error {
+	tree, err := descriptor.TapScriptTree()
+	if err != nil {
+		return err
+	}
+
+	rootHash := tree.RootNode.TapHash()
+
+	for i := range packet.Inputs {
+		prevOut := packet.Inputs[i].WitnessUtxo
+
+		sig, err := txscript.RawTxInTaprootSignature(packet.UnsignedTx, sigHashes, i, prevOut.Value, prevOut.PkScript, rootHash[:], txscript.SigHashAll, s.key)
+		if err != nil {
+			return err
+		}
+
+		packet.Inputs[i].TaprootKeySpendSig = sig
+	}
+
+	return psbt.MaybeFinalizeAll(packet)
+}
+
+// selectVault returns the vault controlled by the given key, either directly or as the taproot internal key
+func selectVault(vaults []*types.Vault, pubKey *btcec.PublicKey) *types.Vault {
+	if vault := types.SelectVaultByPubKey(vaults, hex.EncodeToString(pubKey.SerializeCompressed())); vault != nil {
+		return vault
+	}
+
+	internalKey := hex.EncodeToString(schnorr.SerializePubKey(pubKey))
+	for _, vault := range vaults {
+		if vault.TapDescriptor != nil && vault.TapDescriptor.InternalKey == internalKey {
+			return vault
+		}
+	}
+
+	return nil
+}
+
+// utxoOutPoint returns the outpoint of the given utxo
+func utxoOutPoint(utxo *types.UTXO) (*wire.OutPoint, error) {
+	hash, err := chainhash.NewHashFromStr(utxo.Txid)
+	if err != nil {
+		return nil, err
+	}
+
+	return wire.NewOutPoint(hash, uint32(utxo.Vout)), nil
+}
+
+// DecodePrivateKey decodes the private key in hex or WIF format
+func DecodePrivateKey(key string) (*btcec.PrivateKey, error) {
+	if keyBytes, err := hex.DecodeString(key); err == nil {
+		if len(keyBytes) != btcec.PrivKeyBytesLen {
+			return nil, fmt.Errorf("invalid private key length %d", len(keyBytes))
+		}
+
+		privKey, _ := btcec.PrivKeyFromBytes(keyBytes)
+		return privKey, nil
+	}
+
+	wif, err := btcutil.DecodeWIF(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return wif.PrivKey, nil
+}
@@ -0,0 +1,282 @@
+package signer_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/cometbft/cometbft/libs/log"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/sideprotocol/side/testutil/sample"
+	"github.com/sideprotocol/side/x/btcbridge/client/signer"
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+// fakeSide is an in-memory side chain which serves the signing requests and records the submitted messages
+type fakeSide struct {
+	types.QueryClient
+
+	params   types.Params
+	requests []*types.BitcoinSigningRequest
+	utxos    []*types.UTXO
+	msgs     []sdk.Msg
+}
+
+func (s *fakeSide) QueryParams(_ context.Context, _ *types.QueryParamsRequest, _ ...grpc.CallOption) (*types.QueryParamsResponse, error) {
+	return &types.QueryParamsResponse{Params: s.params}, nil
+}
+
+func (s *fakeSide) QuerySigningRequest(_ context.Context, _ *types.QuerySigningRequestRequest, _ ...grpc.CallOption) (*types.QuerySigningRequestResponse, error) {
+	return &types.QuerySigningRequestResponse{Requests: s.requests}, nil
+}
+
+func (s *fakeSide) QueryUTXOs(_ context.Context, _ *types.QueryUTXOsRequest, _ ...grpc.CallOption) (*types.QueryUTXOsResponse, error) {
+	return &types.QueryUTXOsResponse{Utxos: s.utxos}, nil
+}
+
+func (s *fakeSide) Submit(_ context.Context, msgs ...sdk.Msg) error {
+	s.msgs = append(s.msgs, msgs...)
+	return nil
+}
+
+type testVault struct {
+	key      *btcec.PrivateKey
+	address  string
+	pkScript []byte
+}
+
+func newTestVault(t *testing.T, chainParams *chaincfg.Params) *testVault {
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	addr, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(key.PubKey().SerializeCompressed()), chainParams)
+	require.NoError(t, err)
+
+	pkScript, err := txscript.PayToAddrScript(addr)
+	require.NoError(t, err)
+
+	return &testVault{key: key, address: addr.EncodeAddress(), pkScript: pkScript}
+}
+
+func newTestRecipient(t *testing.T, chainParams *chaincfg.Params) string {
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	addr, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(key.PubKey().SerializeCompressed()), chainParams)
+	require.NoError(t, err)
+
+	return addr.EncodeAddress()
+}
+
+// newFakeSide creates a side chain with a signing request withdrawing the given amount from the vault to the recipient
+func newFakeSide(t *testing.T, vault *testVault, recipient string, amount int64) *fakeSide {
+	utxos := []*types.UTXO{
+		{Txid: chainhash.Hash{1}.String(), Vout: 0, Address: vault.address, Amount: 100000, PubKeyScript: vault.pkScript},
+		{Txid: chainhash.Hash{2}.String(), Vout: 1, Address: vault.address, Amount: 50000, PubKeyScript: vault.pkScript},
+	}
+
+	packet, _, _, err := types.BuildPsbt(utxos, recipient, amount, 10, vault.address, &chaincfg.MainNetParams)
+	require.NoError(t, err)
+
+	psbtB64, err := packet.B64Encode()
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.Vaults = []*types.Vault{{
+		Address:   vault.address,
+		PubKey:    hex.EncodeToString(vault.key.PubKey().SerializeCompressed()),
+		AssetType: types.AssetType_ASSET_TYPE_BTC,
+	}}
+
+	return &fakeSide{
+		params: params,
+		requests: []*types.BitcoinSigningRequest{{
+			Address:      recipient,
+			Txid:         packet.UnsignedTx.TxHash().String(),
+			Psbt:         psbtB64,
+			Status:       types.SigningStatus_SIGNING_STATUS_CREATED,
+			Sequence:     1,
+			VaultAddress: vault.address,
+		}},
+		utxos: utxos,
+	}
+}
+
+func newTestSigner(side *fakeSide, key *btcec.PrivateKey, policies []signer.Policy, config signer.Config) *signer.Signer {
+	config.Sender = sample.AccAddress()
+	config.ChainParams = &chaincfg.MainNetParams
+
+	return signer.NewSigner(side, side, key, policies, config, log.NewNopLogger())
+}
+
+func TestSignOnce(t *testing.T) {
+	chainParams := &chaincfg.MainNetParams
+
+	vault := newTestVault(t, chainParams)
+	side := newFakeSide(t, vault, newTestRecipient(t, chainParams), 120000)
+
+	s := newTestSigner(side, vault.key, nil, signer.Config{})
+	require.NoError(t, s.SignOnce(context.Background()))
+	require.Len(t, side.msgs, 1)
+
+	msg := side.msgs[0].(*types.MsgSubmitWithdrawSignaturesRequest)
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, side.requests[0].Txid, msg.Txid)
+
+	packet, err := psbt.NewFromRawBytes(bytes.NewReader([]byte(msg.Psbt)), true)
+	require.NoError(t, err)
+	require.True(t, packet.IsComplete())
+	require.True(t, types.VerifyPsbtSignatures(packet))
+
+	// the request is signed only once
+	require.NoError(t, s.SignOnce(context.Background()))
+	require.Len(t, side.msgs, 1)
+}
+
+func TestSignOnceTaproot(t *testing.T) {
+	chainParams := &chaincfg.MainNetParams
+
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	recoveryKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	descriptor := &types.VaultDescriptor{
+		InternalKey:   hex.EncodeToString(schnorr.SerializePubKey(key.PubKey())),
+		RecoveryKey:   hex.EncodeToString(schnorr.SerializePubKey(recoveryKey.PubKey())),
+		RecoveryDelay: 144,
+	}
+
+	address, err := descriptor.Address(chainParams)
+	require.NoError(t, err)
+	addr, err := btcutil.DecodeAddress(address, chainParams)
+	require.NoError(t, err)
+	pkScript, err := txscript.PayToAddrScript(addr)
+	require.NoError(t, err)
+
+	vault := &testVault{key: key, address: address, pkScript: pkScript}
+	side := newFakeSide(t, vault, newTestRecipient(t, chainParams), 120000)
+	side.params.Vaults[0].PubKey = descriptor.InternalKey
+	side.params.Vaults[0].TapDescriptor = descriptor
+
+	// recovery requests are left to the recovery key
+	recovery := *side.requests[0]
+	recovery.Txid = chainhash.Hash{3}.String()
+	recovery.Recovery = true
+	side.requests = append(side.requests, &recovery)
+
+	s := newTestSigner(side, key, nil, signer.Config{})
+	require.NoError(t, s.SignOnce(context.Background()))
+	require.Len(t, side.msgs, 1)
+
+	msg := side.msgs[0].(*types.MsgSubmitWithdrawSignaturesRequest)
+	require.Equal(t, side.requests[0].Txid, msg.Txid)
+
+	// the inputs are signed by the key path
+	packet, err := psbt.NewFromRawBytes(bytes.NewReader([]byte(msg.Psbt)), true)
+	require.NoError(t, err)
+	require.True(t, packet.IsComplete())
+	require.True(t, types.VerifyPsbtSignatures(packet))
+}
+
+func TestSignRequestRejected(t *testing.T) {
+	chainParams := &chaincfg.MainNetParams
+
+	vault := newTestVault(t, chainParams)
+	recipient := newTestRecipient(t, chainParams)
+
+	testCases := []struct {
+		name     string
+		malleate func(side *fakeSide)
+		policies []signer.Policy
+		config   signer.Config
+	}{
+		{
+			name: "unknown utxo",
+			malleate: func(side *fakeSide) {
+				side.utxos = side.utxos[1:]
+			},
+		},
+		{
+			name: "amount mismatch",
+			malleate: func(side *fakeSide) {
+				side.utxos[0].Amount++
+			},
+		},
+		{
+			name: "unexpected recipient",
+			malleate: func(side *fakeSide) {
+				side.requests[0].Address = newTestRecipient(t, chainParams)
+			},
+		},
+		{
+			name: "missing recipient",
+			malleate: func(side *fakeSide) {
+				side.requests[0].Recipients = []string{recipient, newTestRecipient(t, chainParams)}
+			},
+		},
+		{
+			name:   "fee too high",
+			config: signer.Config{MaxFee: 100},
+		},
+		{
+			name:   "fee rate too high",
+			config: signer.Config{MaxFeeRate: 5},
+		},
+		{
+			name:     "amount exceeds the policy",
+			policies: []signer.Policy{signer.MaxAmountPolicy(100000)},
+		},
+		{
+			name:     "recipient not allowed",
+			policies: []signer.Policy{signer.AllowListPolicy([]string{vault.address})},
+		},
+		{
+			name:     "recipient denied",
+			policies: []signer.Policy{signer.DenyListPolicy([]string{recipient})},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			side := newFakeSide(t, vault, recipient, 120000)
+			if tc.malleate != nil {
+				tc.malleate(side)
+			}
+
+			s := newTestSigner(side, vault.key, tc.policies, tc.config)
+			require.NoError(t, s.SignOnce(context.Background()))
+			require.Empty(t, side.msgs)
+		})
+	}
+}
+
+func TestDecodePrivateKey(t *testing.T) {
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	decoded, err := signer.DecodePrivateKey(hex.EncodeToString(key.Serialize()))
+	require.NoError(t, err)
+	require.Equal(t, key.Serialize(), decoded.Serialize())
+
+	wif, err := btcutil.NewWIF(key, &chaincfg.MainNetParams, true)
+	require.NoError(t, err)
+
+	decoded, err = signer.DecodePrivateKey(wif.String())
+	require.NoError(t, err)
+	require.Equal(t, key.Serialize(), decoded.Serialize())
+
+	_, err = signer.DecodePrivateKey("invalid")
+	require.Error(t, err)
+}
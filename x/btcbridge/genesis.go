@@ -10,15 +10,62 @@ import (
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetParams(ctx, genState.Params)
-	k.SetBestBlockHeader(ctx, genState.BestBlockHeader)
-	if len(genState.BlockHeaders) > 0 {
-		k.SetBlockHeaders(ctx, genState.BlockHeaders)
+	initChainGenesis(ctx, k, genState.BestBlockHeader, genState.BlockHeaders, genState.Utxos, genState.AddressLinks, genState.PendingDeposits, genState.CollectedFees, genState.FeeContributions)
+
+	// import the other bridged chains, which start from the genesis block of the network by default
+	chains := make(map[string]*types.ChainGenesisState)
+	for _, chain := range genState.Chains {
+		chains[chain.ChainId] = chain
+	}
+
+	for _, chainParams := range genState.Params.Chains {
+		ck := k.WithChain(chainParams.ChainId)
+
+		chain, ok := chains[chainParams.ChainId]
+		if !ok {
+			chain = &types.ChainGenesisState{BestBlockHeader: types.DefaultBestBlockHeader(ck.ChainCfg(ctx))}
+		}
+
+		initChainGenesis(ctx, ck, chain.BestBlockHeader, chain.BlockHeaders, chain.Utxos, chain.AddressLinks, chain.PendingDeposits, chain.CollectedFees, chain.FeeContributions)
+	}
+
+	// import htlcs
+	for _, htlc := range genState.Htlcs {
+		k.SetHTLC(ctx, htlc)
+	}
+
+	// import the screening state
+	if err := k.UpdateBlocklist(ctx, genState.Blocklist, nil); err != nil {
+		panic(err)
+	}
+	for _, deposit := range genState.QuarantinedDeposits {
+		k.SetQuarantinedDeposit(ctx, deposit)
+	}
+}
+
+// initChainGenesis initializes the state of the bridged chain of the given keeper
+func initChainGenesis(ctx sdk.Context, k keeper.Keeper, best *types.BlockHeader, headers []*types.BlockHeader, utxos []*types.UTXO, links []*types.AddressLink, pendingDeposits []*types.PendingDeposit, fees sdk.Coins, contributions []*types.FeeContribution) {
+	k.SetBestBlockHeader(ctx, best)
+	if len(headers) > 0 {
+		k.SetBlockHeaders(ctx, headers)
 	}
 	// import utxos
-	for _, utxo := range genState.Utxos {
+	for _, utxo := range utxos {
 		k.SetUTXO(ctx, utxo)
 	}
-
+	// import address links
+	for _, link := range links {
+		k.SetAddressLink(ctx, link)
+	}
+	// import pending deposits
+	for _, deposit := range pendingDeposits {
+		k.SetPendingDeposit(ctx, deposit)
+	}
+	// import the fee epoch
+	k.SetCollectedFees(ctx, fees)
+	for _, contribution := range contributions {
+		k.SetFeeContribution(ctx, contribution)
+	}
 }
 
 // ExportGenesis returns the module's exported genesis
@@ -28,6 +75,29 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.BestBlockHeader = k.GetBestBlockHeader(ctx)
 	genesis.BlockHeaders = k.GetAllBlockHeaders(ctx)
 	genesis.Utxos = k.GetAllUTXOs(ctx)
+	genesis.AddressLinks = k.GetAllAddressLinks(ctx)
+	genesis.PendingDeposits = k.GetPendingDeposits(ctx)
+
+	for _, chain := range genesis.Params.Chains {
+		ck := k.WithChain(chain.ChainId)
+
+		genesis.Chains = append(genesis.Chains, &types.ChainGenesisState{
+			ChainId:          chain.ChainId,
+			BestBlockHeader:  ck.GetBestBlockHeader(ctx),
+			BlockHeaders:     ck.GetAllBlockHeaders(ctx),
+			Utxos:            ck.GetAllUTXOs(ctx),
+			AddressLinks:     ck.GetAllAddressLinks(ctx),
+			PendingDeposits:  ck.GetPendingDeposits(ctx),
+			CollectedFees:    ck.GetCollectedFees(ctx),
+			FeeContributions: ck.GetFeeContributions(ctx),
+		})
+	}
+
+	genesis.CollectedFees = k.GetCollectedFees(ctx)
+	genesis.FeeContributions = k.GetFeeContributions(ctx)
+	genesis.Htlcs = k.GetHTLCs(ctx, types.HTLCStatus_HTLC_STATUS_UNSPECIFIED)
+	genesis.Blocklist = k.GetBlocklist(ctx)
+	genesis.QuarantinedDeposits = k.GetQuarantinedDeposits(ctx, types.QuarantineStatus_QUARANTINE_STATUS_UNSPECIFIED)
 
 	// this line is used by starport scaffolding # genesis/module/export
 
@@ -38,6 +38,34 @@ func TestGenesis(t *testing.T) {
 	// this line is used by starport scaffolding # genesis/test/assert
 }
 
+func TestChainsGenesis(t *testing.T) {
+	genesisState := types.DefaultGenesis()
+	genesisState.Params.Chains = []*types.ChainParams{{
+		ChainId:      "ltc",
+		Network:      types.LitecoinRegressionNetParams.Name,
+		VoucherDenom: "ltcsat",
+	}}
+	require.NoError(t, genesisState.Validate())
+
+	k, ctx := keepertest.BtcLightClientKeeper(t)
+	btclightclient.InitGenesis(ctx, *k, *genesisState)
+
+	// the chain starts from the genesis block of its network by default
+	ltcKeeper := k.WithChain("ltc")
+	require.Equal(t, types.LitecoinRegressionNetParams.GenesisHash.String(), ltcKeeper.GetBestBlockHeader(ctx).Hash)
+	require.Equal(t, genesisState.BestBlockHeader.Hash, k.GetBestBlockHeader(ctx).Hash)
+
+	got := btclightclient.ExportGenesis(ctx, *k)
+	require.Len(t, got.Chains, 1)
+	require.Equal(t, "ltc", got.Chains[0].ChainId)
+	require.Equal(t, ltcKeeper.GetBestBlockHeader(ctx), got.Chains[0].BestBlockHeader)
+	require.NoError(t, got.Validate())
+
+	// the chain genesis must refer to a bridged chain
+	got.Chains[0].ChainId = "doge"
+	require.ErrorIs(t, got.Validate(), types.ErrUnknownChain)
+}
+
 // TestSubmitTx tests the SubmitTx function
 // func TestSubmitTx(t *testing.T) {
 
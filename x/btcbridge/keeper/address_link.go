@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/btcsuite/btcd/btcutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+// LinkBitcoinAddress links the given bitcoin address to the side address after verifying
+// the signature of the bitcoin address over the link message of the side address.
+// The bitcoin address is linked in the canonical encoding. The previous links of both addresses are replaced.
+func (k Keeper) LinkBitcoinAddress(ctx sdk.Context, address string, btcAddress string, signature string) error {
+	params := k.GetParams(ctx)
+
+	addr, err := btcutil.DecodeAddress(btcAddress, params.ChainCfg())
+	if err != nil || !addr.IsForNet(params.ChainCfg()) {
+		return errorsmod.Wrapf(types.ErrInvalidBtcAddress, "invalid address %s", btcAddress)
+	}
+
+	btcAddress = addr.EncodeAddress()
+	if types.SelectVaultByBitcoinAddress(params.Vaults, btcAddress) != nil {
+		return types.ErrInvalidBtcAddress
+	}
+
+	if err := types.VerifyAddressSignature(btcAddress, types.AddressLinkMessage(ctx.ChainID(), address), signature, params.ChainCfg()); err != nil {
+		return err
+	}
+
+	k.RemoveAddressLink(ctx, address, k.GetLinkedBitcoinAddress(ctx, address))
+	k.RemoveAddressLink(ctx, k.GetLinkedAccount(ctx, btcAddress), btcAddress)
+
+	k.SetAddressLink(ctx, &types.AddressLink{Address: address, BtcAddress: btcAddress})
+
+	return nil
+}
+
+// GetLinkedAccount returns the side address linked to the given bitcoin address
+func (k Keeper) GetLinkedAccount(ctx sdk.Context, btcAddress string) string {
+	store := k.store(ctx)
+	return string(store.Get(types.BtcAddressLinkKey(btcAddress)))
+}
+
+// GetLinkedBitcoinAddress returns the bitcoin address linked to the given side address
+func (k Keeper) GetLinkedBitcoinAddress(ctx sdk.Context, address string) string {
+	store := k.store(ctx)
+	return string(store.Get(types.AccountLinkKey(address)))
+}
+
+// SetAddressLink sets the link between the side address and the bitcoin address
+func (k Keeper) SetAddressLink(ctx sdk.Context, link *types.AddressLink) {
+	store := k.store(ctx)
+	store.Set(types.BtcAddressLinkKey(link.BtcAddress), []byte(link.Address))
+	store.Set(types.AccountLinkKey(link.Address), []byte(link.BtcAddress))
+}
+
+// RemoveAddressLink removes the link between the side address and the bitcoin address
+func (k Keeper) RemoveAddressLink(ctx sdk.Context, address string, btcAddress string) {
+	if len(address) == 0 || len(btcAddress) == 0 {
+		return
+	}
+
+	store := k.store(ctx)
+	store.Delete(types.BtcAddressLinkKey(btcAddress))
+	store.Delete(types.AccountLinkKey(address))
+}
+
+// GetAllAddressLinks returns all address links
+func (k Keeper) GetAllAddressLinks(ctx sdk.Context) []*types.AddressLink {
+	links := make([]*types.AddressLink, 0)
+	k.IterateAddressLinks(ctx, func(link *types.AddressLink) (stop bool) {
+		links = append(links, link)
+		return false
+	})
+	return links
+}
+
+// IterateAddressLinks iterates through all address links
+func (k Keeper) IterateAddressLinks(ctx sdk.Context, process func(link *types.AddressLink) (stop bool)) {
+	store := k.store(ctx)
+	iterator := sdk.KVStorePrefixIterator(store, types.BtcAddressLinkKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		link := &types.AddressLink{
+			Address:    string(iterator.Value()),
+			BtcAddress: string(iterator.Key()[len(types.BtcAddressLinkKeyPrefix):]),
+		}
+		if process(link) {
+			break
+		}
+	}
+}
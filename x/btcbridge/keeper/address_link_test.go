@@ -0,0 +1,129 @@
+package keeper_test
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sideprotocol/side/testutil/bitcoin"
+	"github.com/sideprotocol/side/testutil/sample"
+	"github.com/sideprotocol/side/x/btcbridge/keeper"
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+// linkBitcoinAddress links a new bitcoin address to the given account and returns the pk script of the bitcoin address
+func (env *depositTestEnv) linkBitcoinAddress(t *testing.T, account string) (btcutil.Address, []byte) {
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	addr, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(key.PubKey().SerializeCompressed()), env.chain.Params)
+	require.NoError(t, err)
+	pkScript, err := txscript.PayToAddrScript(addr)
+	require.NoError(t, err)
+
+	sig, err := ecdsa.SignCompact(key, types.SignedMessageHash(types.AddressLinkMessage(env.ctx.ChainID(), account)), true)
+	require.NoError(t, err)
+
+	msg := types.NewMsgLinkBitcoinAddressRequest(account, addr.EncodeAddress(), base64.StdEncoding.EncodeToString(sig))
+	_, err = keeper.NewMsgServerImpl(env.app.BtcBridgeKeeper).LinkBitcoinAddress(sdk.WrapSDKContext(env.ctx), msg)
+	require.NoError(t, err)
+
+	return addr, pkScript
+}
+
+func TestLinkBitcoinAddress(t *testing.T) {
+	env := newDepositTestEnv(t)
+	k := env.app.BtcBridgeKeeper
+
+	account := sample.AccAddress()
+	addr, _ := env.linkBitcoinAddress(t, account)
+
+	require.Equal(t, account, k.GetLinkedAccount(env.ctx, addr.EncodeAddress()))
+	require.Equal(t, addr.EncodeAddress(), k.GetLinkedBitcoinAddress(env.ctx, account))
+
+	for _, address := range []string{account, addr.EncodeAddress()} {
+		res, err := k.QueryAddressLink(sdk.WrapSDKContext(env.ctx), &types.QueryAddressLinkRequest{Address: address})
+		require.NoError(t, err)
+		require.Equal(t, &types.AddressLink{Address: account, BtcAddress: addr.EncodeAddress()}, res.Link)
+	}
+
+	// relinking replaces the previous link
+	newAddr, _ := env.linkBitcoinAddress(t, account)
+	require.Equal(t, newAddr.EncodeAddress(), k.GetLinkedBitcoinAddress(env.ctx, account))
+	require.Empty(t, k.GetLinkedAccount(env.ctx, addr.EncodeAddress()))
+	require.Len(t, k.GetAllAddressLinks(env.ctx), 1)
+
+	// the signature over another account is rejected
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	otherAddr, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(key.PubKey().SerializeCompressed()), env.chain.Params)
+	require.NoError(t, err)
+	sig, err := ecdsa.SignCompact(key, types.SignedMessageHash(types.AddressLinkMessage(env.ctx.ChainID(), sample.AccAddress())), true)
+	require.NoError(t, err)
+
+	err = k.LinkBitcoinAddress(env.ctx, account, otherAddr.EncodeAddress(), base64.StdEncoding.EncodeToString(sig))
+	require.ErrorIs(t, err, types.ErrInvalidAddressSignature)
+
+	// the signature over the bare account or the link message of another chain is rejected
+	for _, message := range []string{account, types.AddressLinkMessage("other-chain", account)} {
+		sig, err = ecdsa.SignCompact(key, types.SignedMessageHash(message), true)
+		require.NoError(t, err)
+
+		err = k.LinkBitcoinAddress(env.ctx, account, otherAddr.EncodeAddress(), base64.StdEncoding.EncodeToString(sig))
+		require.ErrorIs(t, err, types.ErrInvalidAddressSignature)
+	}
+
+	// the address is linked in the canonical encoding
+	sig, err = ecdsa.SignCompact(key, types.SignedMessageHash(types.AddressLinkMessage(env.ctx.ChainID(), account)), true)
+	require.NoError(t, err)
+
+	require.NoError(t, k.LinkBitcoinAddress(env.ctx, account, strings.ToUpper(otherAddr.EncodeAddress()), base64.StdEncoding.EncodeToString(sig)))
+	require.Equal(t, otherAddr.EncodeAddress(), k.GetLinkedBitcoinAddress(env.ctx, account))
+	require.Equal(t, account, k.GetLinkedAccount(env.ctx, otherAddr.EncodeAddress()))
+}
+
+func TestDepositFromLinkedAddress(t *testing.T) {
+	env := newDepositTestEnv(t)
+
+	account := sample.AccAddress()
+	_, pkScript := env.linkBitcoinAddress(t, account)
+
+	funding := bitcoin.NewTx(wire.NewOutPoint(&chainhash.Hash{}, 0), nil, wire.NewTxOut(200000, pkScript))
+
+	// the deposit without the recipient output is credited to the linked account
+	msg := env.depositMsgFrom(t, funding, wire.NewTxOut(100000, env.vaultPkScript))
+	require.NoError(t, env.app.BtcBridgeKeeper.ProcessBitcoinDepositTransaction(env.ctx, msg))
+	require.Equal(t, int64(100000), env.balance(account, "sat").Int64())
+}
+
+func TestWithdrawToLinkedAddress(t *testing.T) {
+	env := newDepositTestEnv(t)
+
+	account := sample.AccAddress()
+	addr, pkScript := env.linkBitcoinAddress(t, account)
+
+	err := env.deposit(t, wire.NewTxOut(100000, env.vaultPkScript), env.memoOut(t, &types.DepositMemo{Recipient: account}))
+	require.NoError(t, err)
+
+	msg := types.NewMsgWithdrawBitcoinRequest(account, "40000sat", 10)
+	require.NoError(t, msg.ValidateBasic())
+
+	_, err = keeper.NewMsgServerImpl(env.app.BtcBridgeKeeper).WithdrawBitcoin(sdk.WrapSDKContext(env.ctx), msg)
+	require.NoError(t, err)
+
+	requests := env.app.BtcBridgeKeeper.FilterSigningRequestsByStatus(env.ctx, &types.QuerySigningRequestRequest{Status: types.SigningStatus_SIGNING_STATUS_CREATED})
+	require.Len(t, requests, 1)
+	require.Equal(t, addr.EncodeAddress(), requests[0].Address)
+
+	tx := env.signWithdrawal(t, requests[0])
+	require.Equal(t, pkScript, tx.TxOut[0].PkScript)
+	require.Equal(t, int64(40000), tx.TxOut[0].Value)
+}
@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sideprotocol/side/testutil/sample"
+	"github.com/sideprotocol/side/x/btcbridge/keeper"
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+func TestTaggedUTXOs(t *testing.T) {
+	env := newDepositTestEnv(t)
+	vault := env.addTaprootVault(t)
+
+	holder := sample.AccAddress()
+	memo := env.memoOut(t, &types.DepositMemo{Recipient: holder})
+
+	// the runestone transfers 1000 of the rune 840000:3 to the vault output
+	runestone, err := txscript.NewScriptBuilder().AddOp(txscript.OP_RETURN).AddOp(types.RunestoneMagic).AddData([]byte{0, 0xc0, 0xa2, 0x33, 3, 0xe8, 0x07, 0}).Script()
+	require.NoError(t, err)
+
+	require.NoError(t, env.deposit(t, wire.NewTxOut(100000, vault.pkScript), memo, wire.NewTxOut(0, runestone)))
+	require.NoError(t, env.deposit(t, wire.NewTxOut(50000, vault.pkScript), memo))
+
+	// the deposit is minted as usual
+	require.Equal(t, int64(150000), env.balance(holder, "sat").Int64())
+
+	events := typedEvents[*types.EventUTXOTagged](t, env.ctx)
+	require.Len(t, events, 1)
+	require.Equal(t, vault.address, events[0].Address)
+
+	tagged := env.app.BtcBridgeKeeper.GetUTXO(env.ctx, events[0].Txid, events[0].Vout)
+	require.True(t, tagged.IsTagged())
+	require.Contains(t, tagged.Runes, &types.RuneBalance{RuneId: "840000:3", Amount: "1000"})
+
+	// the tagged utxo is excluded from the coin selection
+	utxos := env.app.BtcBridgeKeeper.GetOrderedUTXOsByAddr(env.ctx, vault.address)
+	require.Len(t, utxos, 1)
+	require.Equal(t, uint64(50000), utxos[0].Amount)
+
+	res, err := env.app.BtcBridgeKeeper.QueryTaggedUTXOs(sdk.WrapSDKContext(env.ctx), &types.QueryTaggedUTXOsRequest{Address: vault.address})
+	require.NoError(t, err)
+	require.Len(t, res.Utxos, 1)
+	require.Equal(t, tagged.Txid, res.Utxos[0].Txid)
+
+	res, err = env.app.BtcBridgeKeeper.QueryTaggedUTXOs(sdk.WrapSDKContext(env.ctx), &types.QueryTaggedUTXOsRequest{Address: sample.AccAddress()})
+	require.NoError(t, err)
+	require.Empty(t, res.Utxos)
+
+	// the recovery sweeps the tagged utxo along, whose runes are carried over to the target vault
+	target := env.app.BtcBridgeKeeper.GetParams(env.ctx).Vaults[0].Address
+
+	for i := uint32(0); i < vault.descriptor.RecoveryDelay; i++ {
+		env.chain.MineBlock()
+	}
+	env.syncHeaders(t)
+
+	request, err := env.app.BtcBridgeKeeper.NewRecoverySigningRequest(env.ctx, vault.address, target, 10)
+	require.NoError(t, err)
+
+	packet, _ := decodeRequestPsbt(t, request)
+	require.Len(t, packet.UnsignedTx.TxIn, 2)
+
+	swept := env.app.BtcBridgeKeeper.GetUTXO(env.ctx, packet.UnsignedTx.TxHash().String(), 0)
+	require.True(t, swept.IsTagged())
+	require.Equal(t, tagged.Runes, swept.Runes)
+}
+
+func TestTagUTXO(t *testing.T) {
+	env := newDepositTestEnv(t)
+
+	msgServer := keeper.NewMsgServerImpl(env.app.BtcBridgeKeeper)
+	goCtx := sdk.WrapSDKContext(env.ctx)
+	authority := env.app.BtcBridgeKeeper.GetAuthority()
+
+	// the inscription moved to the vault by the input is not detected
+	require.NoError(t, env.deposit(t, wire.NewTxOut(100000, env.vaultPkScript), env.memoOut(t, &types.DepositMemo{Recipient: sample.AccAddress()})))
+
+	utxos := env.app.BtcBridgeKeeper.GetAllUTXOs(env.ctx)
+	require.Len(t, utxos, 1)
+	require.False(t, utxos[0].IsTagged())
+
+	msg := types.NewMsgTagUTXORequest(sample.AccAddress(), utxos[0].Txid, utxos[0].Vout, 1, nil)
+	_, err := msgServer.TagUTXO(goCtx, msg)
+	require.ErrorIs(t, err, types.ErrSenderAddressNotAuthorized)
+
+	// the governance tags the utxo, which is excluded from the coin selection
+	msg.Authority = authority
+	_, err = msgServer.TagUTXO(goCtx, msg)
+	require.NoError(t, err)
+
+	require.True(t, env.app.BtcBridgeKeeper.GetUTXO(env.ctx, utxos[0].Txid, utxos[0].Vout).IsTagged())
+	require.Empty(t, env.app.BtcBridgeKeeper.GetOrderedUTXOsByAddr(env.ctx, utxos[0].Address))
+	require.Len(t, typedEvents[*types.EventUTXOTagged](t, env.ctx), 1)
+
+	// clearing the tags releases the utxo
+	_, err = msgServer.TagUTXO(goCtx, types.NewMsgTagUTXORequest(authority, utxos[0].Txid, utxos[0].Vout, 0, nil))
+	require.NoError(t, err)
+	require.Len(t, env.app.BtcBridgeKeeper.GetOrderedUTXOsByAddr(env.ctx, utxos[0].Address), 1)
+
+	_, err = msgServer.TagUTXO(goCtx, types.NewMsgTagUTXORequest(authority, utxos[0].Txid, utxos[0].Vout+1, 1, nil))
+	require.ErrorIs(t, err, types.ErrUTXODoesNotExist)
+
+	_, err = msgServer.TagUTXO(goCtx, types.NewMsgTagUTXORequest(authority, utxos[0].Txid, utxos[0].Vout, 0, []*types.RuneBalance{{RuneId: "840000:3", Amount: "-1"}}))
+	require.ErrorIs(t, err, types.ErrInvalidAmount)
+}
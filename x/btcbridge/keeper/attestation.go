@@ -0,0 +1,179 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+// Attest records the attestation of the given content by the relayer.
+// It returns true if the content has been attested by enough distinct relayers and should take effect,
+// in which case the pending attestation is removed.
+func (k Keeper) Attest(ctx sdk.Context, relayer string, attestationType types.AttestationType, subject string, content []byte) (bool, error) {
+	params := k.GetParams(ctx)
+
+	hash := types.AttestationHash(attestationType, content)
+
+	attestation := k.GetAttestation(ctx, hash)
+	if attestation == nil {
+		attestation = &types.Attestation{
+			Hash:             hash,
+			Type:             attestationType,
+			Subject:          subject,
+			ExpirationHeight: ctx.BlockHeight() + params.AttestationExpiry,
+		}
+
+		k.checkAttestationConflicts(ctx, relayer, attestation)
+	}
+
+	for _, r := range attestation.Relayers {
+		if r == relayer {
+			return false, types.ErrAlreadyAttested
+		}
+	}
+
+	attestation.Relayers = append(attestation.Relayers, relayer)
+
+	k.EmitEvent(ctx, relayer,
+		sdk.NewAttribute(types.AttributeKeyAction, types.EventValueActionAttest),
+		sdk.NewAttribute(types.AttributeKeyAttestationHash, hash),
+		sdk.NewAttribute(types.AttributeKeyAttestationSubject, subject),
+		sdk.NewAttribute(types.AttributeKeyAttestations, fmt.Sprintf("%d/%d", len(attestation.Relayers), params.RelayerQuorum)),
+	)
+
+	if uint32(len(attestation.Relayers)) >= params.RelayerQuorum {
+		k.removeAttestation(ctx, attestation)
+
+		// all relayers of the attestation contribute to the submission taking effect
+		for _, r := range attestation.Relayers {
+			k.AddFeeContribution(ctx, types.ContributorRole_CONTRIBUTOR_ROLE_RELAYER, r)
+		}
+
+		return true, nil
+	}
+
+	k.SetAttestation(ctx, attestation)
+
+	return false, nil
+}
+
+// checkAttestationConflicts emits an event for each pending attestation of the same subject with different content
+func (k Keeper) checkAttestationConflicts(ctx sdk.Context, relayer string, attestation *types.Attestation) {
+	k.IterateAttestationsBySubject(ctx, attestation.Subject, func(other *types.Attestation) (stop bool) {
+		if other.Hash != attestation.Hash {
+			k.Logger(ctx).Error("relayers disagree", "subject", attestation.Subject, "hash", attestation.Hash, "conflicting hash", other.Hash)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeAttestationConflict,
+					sdk.NewAttribute(types.AttributeKeySender, relayer),
+					sdk.NewAttribute(types.AttributeKeyAttestationSubject, attestation.Subject),
+					sdk.NewAttribute(types.AttributeKeyAttestationHash, attestation.Hash),
+					sdk.NewAttribute(types.AttributeKeyConflictingHash, other.Hash),
+				),
+			)
+		}
+
+		return false
+	})
+}
+
+// GetAttestation returns the pending attestation of the given hash, nil if not found
+func (k Keeper) GetAttestation(ctx sdk.Context, hash string) *types.Attestation {
+	store := k.store(ctx)
+
+	bz := store.Get(types.BtcAttestationKey(hash))
+	if bz == nil {
+		return nil
+	}
+
+	var attestation types.Attestation
+	k.cdc.MustUnmarshal(bz, &attestation)
+
+	return &attestation
+}
+
+// SetAttestation sets the pending attestation
+func (k Keeper) SetAttestation(ctx sdk.Context, attestation *types.Attestation) {
+	store := k.store(ctx)
+
+	bz := k.cdc.MustMarshal(attestation)
+	store.Set(types.BtcAttestationKey(attestation.Hash), bz)
+	store.Set(types.BtcAttestationSubjectKey(attestation.Subject, attestation.Hash), []byte{1})
+	store.Set(types.BtcAttestationExpiryKey(attestation.ExpirationHeight, attestation.Hash), []byte{1})
+}
+
+// removeAttestation deletes the given pending attestation
+func (k Keeper) removeAttestation(ctx sdk.Context, attestation *types.Attestation) {
+	store := k.store(ctx)
+
+	store.Delete(types.BtcAttestationKey(attestation.Hash))
+	store.Delete(types.BtcAttestationSubjectKey(attestation.Subject, attestation.Hash))
+	store.Delete(types.BtcAttestationExpiryKey(attestation.ExpirationHeight, attestation.Hash))
+}
+
+// IterateAttestations iterates through all pending attestations
+func (k Keeper) IterateAttestations(ctx sdk.Context, cb func(attestation *types.Attestation) (stop bool)) {
+	store := k.store(ctx)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.BtcAttestationKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var attestation types.Attestation
+		k.cdc.MustUnmarshal(iterator.Value(), &attestation)
+
+		if cb(&attestation) {
+			break
+		}
+	}
+}
+
+// IterateAttestationsBySubject iterates through the pending attestations of the given subject
+func (k Keeper) IterateAttestationsBySubject(ctx sdk.Context, subject string, cb func(attestation *types.Attestation) (stop bool)) {
+	store := k.store(ctx)
+
+	keyPrefix := append(types.BtcAttestationSubjectKeyPrefix, []byte(subject)...)
+	iterator := sdk.KVStorePrefixIterator(store, keyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		hash := iterator.Key()[len(keyPrefix):]
+
+		attestation := k.GetAttestation(ctx, string(hash))
+		if attestation != nil && cb(attestation) {
+			break
+		}
+	}
+}
+
+// PruneExpiredAttestations removes the pending attestations which expire at the current block height.
+// Only the attestations indexed by the expiration heights up to the current height are visited.
+func (k Keeper) PruneExpiredAttestations(ctx sdk.Context) {
+	store := k.store(ctx)
+
+	iterator := store.Iterator(types.BtcAttestationExpiryKeyPrefix, types.BtcAttestationExpiryKey(ctx.BlockHeight()+1, ""))
+	defer iterator.Close()
+
+	expired := make([]*types.Attestation, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		hash := iterator.Key()[len(types.BtcAttestationExpiryKeyPrefix)+8:]
+
+		if attestation := k.GetAttestation(ctx, string(hash)); attestation != nil {
+			expired = append(expired, attestation)
+		}
+	}
+
+	for _, attestation := range expired {
+		k.removeAttestation(ctx, attestation)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAttestationExpired,
+				sdk.NewAttribute(types.AttributeKeyAttestationSubject, attestation.Subject),
+				sdk.NewAttribute(types.AttributeKeyAttestationHash, attestation.Hash),
+			),
+		)
+	}
+}
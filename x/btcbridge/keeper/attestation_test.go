@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/sideprotocol/side/testutil/keeper"
+	"github.com/sideprotocol/side/testutil/sample"
+	"github.com/sideprotocol/side/x/btcbridge/keeper"
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+func TestAttest(t *testing.T) {
+	k, ctx := keepertest.BtcLightClientKeeper(t)
+
+	relayers := []string{sample.AccAddress(), sample.AccAddress(), sample.AccAddress()}
+
+	params := k.GetParams(ctx)
+	params.AuthorizedRelayers = relayers
+	params.RelayerQuorum = 2
+	params.AttestationExpiry = 10
+	k.SetParams(ctx, params)
+
+	subject := "headers/prev"
+	content := []byte("headers")
+
+	attested, err := k.Attest(ctx, relayers[0], types.AttestationType_ATTESTATION_TYPE_HEADERS, subject, content)
+	require.NoError(t, err)
+	require.False(t, attested)
+
+	_, err = k.Attest(ctx, relayers[0], types.AttestationType_ATTESTATION_TYPE_HEADERS, subject, content)
+	require.ErrorIs(t, err, types.ErrAlreadyAttested)
+
+	// a relayer submitting different content for the same subject is reported
+	attested, err = k.Attest(ctx, relayers[1], types.AttestationType_ATTESTATION_TYPE_HEADERS, subject, []byte("forked headers"))
+	require.NoError(t, err)
+	require.False(t, attested)
+	require.True(t, hasEvent(ctx.EventManager().Events(), types.EventTypeAttestationConflict))
+
+	attested, err = k.Attest(ctx, relayers[2], types.AttestationType_ATTESTATION_TYPE_HEADERS, subject, content)
+	require.NoError(t, err)
+	require.True(t, attested)
+
+	hash := types.AttestationHash(types.AttestationType_ATTESTATION_TYPE_HEADERS, content)
+	require.Nil(t, k.GetAttestation(ctx, hash))
+}
+
+func TestPruneExpiredAttestations(t *testing.T) {
+	k, ctx := keepertest.BtcLightClientKeeper(t)
+
+	params := k.GetParams(ctx)
+	params.RelayerQuorum = 2
+	params.AttestationExpiry = 10
+	k.SetParams(ctx, params)
+
+	relayer := sample.AccAddress()
+	content := []byte("deposit")
+	hash := types.AttestationHash(types.AttestationType_ATTESTATION_TYPE_DEPOSIT, content)
+
+	_, err := k.Attest(ctx, relayer, types.AttestationType_ATTESTATION_TYPE_DEPOSIT, "deposit/txid", content)
+	require.NoError(t, err)
+
+	// the attestation submitted later expires later
+	laterContent := []byte("later deposit")
+	laterHash := types.AttestationHash(types.AttestationType_ATTESTATION_TYPE_DEPOSIT, laterContent)
+
+	_, err = k.Attest(ctx.WithBlockHeight(5), relayer, types.AttestationType_ATTESTATION_TYPE_DEPOSIT, "deposit/later", laterContent)
+	require.NoError(t, err)
+
+	k.PruneExpiredAttestations(ctx.WithBlockHeight(9))
+	require.NotNil(t, k.GetAttestation(ctx, hash))
+
+	k.PruneExpiredAttestations(ctx.WithBlockHeight(10))
+	require.Nil(t, k.GetAttestation(ctx, hash))
+	require.NotNil(t, k.GetAttestation(ctx, laterHash))
+
+	k.PruneExpiredAttestations(ctx.WithBlockHeight(15))
+	require.Nil(t, k.GetAttestation(ctx, laterHash))
+}
+
+func TestUpdateQualifiedRelayers(t *testing.T) {
+	k, ctx := keepertest.BtcLightClientKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(*k)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	relayers := []string{sample.AccAddress(), sample.AccAddress(), sample.AccAddress()}
+
+	params := k.GetParams(ctx)
+	params.AuthorizedRelayers = relayers
+	params.RelayerQuorum = 2
+	k.SetParams(ctx, params)
+
+	// a relayer can not replace the relayer set to meet the quorum alone
+	_, err := msgServer.UpdateQualifiedRelayers(goCtx, types.NewMsgUpdateSendersRequest(relayers[0], []string{relayers[0], sample.AccAddress()}))
+	require.ErrorIs(t, err, types.ErrSenderAddressNotAuthorized)
+	require.Equal(t, relayers, k.GetParams(ctx).AuthorizedRelayers)
+
+	// the relayer set must meet the quorum
+	_, err = msgServer.UpdateQualifiedRelayers(goCtx, types.NewMsgUpdateSendersRequest(k.GetAuthority(), relayers[:1]))
+	require.ErrorIs(t, err, types.ErrInvalidSenders)
+
+	_, err = msgServer.UpdateQualifiedRelayers(goCtx, types.NewMsgUpdateSendersRequest(k.GetAuthority(), relayers[1:]))
+	require.NoError(t, err)
+	require.Equal(t, relayers[1:], k.GetParams(ctx).AuthorizedRelayers)
+}
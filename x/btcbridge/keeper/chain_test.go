@@ -0,0 +1,197 @@
+package keeper_test
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sideprotocol/side/testutil/bitcoin"
+	"github.com/sideprotocol/side/testutil/sample"
+	"github.com/sideprotocol/side/x/btcbridge/keeper"
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+// litecoinChain is a litecoin regtest chain bridged along with bitcoin
+type litecoinChain struct {
+	chain         *bitcoin.Chain
+	vaultAddress  string
+	vaultPkScript []byte
+}
+
+// addLitecoinChain adds a litecoin regtest chain with its own vault to the params
+func (env *depositTestEnv) addLitecoinChain(t *testing.T, chainID string, denom string) *litecoinChain {
+	chain := bitcoin.NewChainWithParams(&types.LitecoinRegressionNetParams, types.ScryptHash)
+
+	vaultKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	vaultAddr, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(vaultKey.PubKey().SerializeCompressed()), chain.Params)
+	require.NoError(t, err)
+	vaultPkScript, err := txscript.PayToAddrScript(vaultAddr)
+	require.NoError(t, err)
+
+	params := env.app.BtcBridgeKeeper.GetParams(env.ctx)
+	params.Chains = append(params.Chains, &types.ChainParams{
+		ChainId:                 chainID,
+		Network:                 chain.Params.Name,
+		Confirmations:           1,
+		MaxAcceptableBlockDepth: 100,
+		VoucherDenom:            denom,
+		Vaults: []*types.Vault{{
+			Address:   vaultAddr.EncodeAddress(),
+			PubKey:    hex.EncodeToString(vaultKey.PubKey().SerializeCompressed()),
+			AssetType: types.AssetType_ASSET_TYPE_BTC,
+		}},
+	})
+	require.NoError(t, params.Validate())
+
+	env.app.BtcBridgeKeeper.SetParams(env.ctx, params)
+	env.app.BtcBridgeKeeper.WithChain(chainID).SetBestBlockHeader(env.ctx, types.NewBlockHeader(&chain.BlockAt(0).Header, 0, 1))
+
+	return &litecoinChain{
+		chain:         chain,
+		vaultAddress:  vaultAddr.EncodeAddress(),
+		vaultPkScript: vaultPkScript,
+	}
+}
+
+func TestBridgedChain(t *testing.T) {
+	env := newDepositTestEnv(t)
+	ltc := env.addLitecoinChain(t, "ltc", "ltcsat")
+
+	msgServer := keeper.NewMsgServerImpl(env.app.BtcBridgeKeeper)
+	goCtx := sdk.WrapSDKContext(env.ctx)
+
+	// mine a deposit on litecoin with a confirmation block
+	holder := sample.AccAddress()
+	memo, err := (&types.DepositMemo{Recipient: holder}).Script()
+	require.NoError(t, err)
+
+	funding := ltc.chain.MineBlock().Transactions[0]
+	deposit := bitcoin.NewTx(wire.NewOutPoint(&chainhash.Hash{}, 0), nil, wire.NewTxOut(100000, ltc.vaultPkScript), wire.NewTxOut(0, memo))
+	deposit.TxIn[0].PreviousOutPoint.Hash = funding.TxHash()
+
+	block := ltc.chain.MineBlock(deposit)
+	ltc.chain.MineBlock()
+
+	// the litecoin headers are verified against the scrypt proof of work
+	headers := make([]*types.BlockHeader, 0)
+	for height := int64(1); height <= ltc.chain.Height(); height++ {
+		b := ltc.chain.BlockAt(height)
+		headers = append(headers, types.NewBlockHeader(&b.Header, uint64(height), uint64(len(b.Transactions))))
+	}
+
+	headersMsg := types.NewMsgSubmitBlockHeaderRequest(env.relayer, headers)
+	headersMsg.ChainId = "ltc"
+	_, err = msgServer.SubmitBlockHeaders(goCtx, headersMsg)
+	require.NoError(t, err)
+
+	ltcKeeper := env.app.BtcBridgeKeeper.WithChain("ltc")
+	require.Equal(t, uint64(ltc.chain.Height()), ltcKeeper.GetBestBlockHeader(env.ctx).Height)
+	require.Zero(t, env.app.BtcBridgeKeeper.GetBestBlockHeader(env.ctx).Height)
+
+	// the deposit mints the litecoin voucher
+	txHash := deposit.TxHash()
+	txOutProof, err := types.BuildTxOutProof(block, &txHash)
+	require.NoError(t, err)
+
+	depositMsg := types.NewMsgSubmitDepositTransactionRequest(env.relayer, block.BlockHash().String(), serializeTx(t, funding), serializeTx(t, deposit), nil)
+	depositMsg.TxOutProof = txOutProof
+
+	// the deposit is unknown to bitcoin
+	_, err = msgServer.SubmitDepositTransaction(goCtx, depositMsg)
+	require.Error(t, err)
+
+	depositMsg.ChainId = "ltc"
+	_, err = msgServer.SubmitDepositTransaction(goCtx, depositMsg)
+	require.NoError(t, err)
+
+	require.Equal(t, int64(100000), env.balance(holder, "ltcsat").Int64())
+	require.True(t, env.balance(holder, "sat").IsZero())
+
+	// the utxos are kept apart from bitcoin
+	require.Len(t, ltcKeeper.GetUTXOsByAddr(env.ctx, ltc.vaultAddress), 1)
+	require.Empty(t, env.app.BtcBridgeKeeper.GetAllUTXOs(env.ctx))
+
+	res, err := env.app.BtcBridgeKeeper.QueryReserves(goCtx, &types.QueryReservesRequest{ChainId: "ltc"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("ltcsat", 100000), res.VoucherSupply)
+	require.Equal(t, uint64(100000), res.Vaults[0].Unspent)
+
+	for _, invariant := range []sdk.Invariant{
+		keeper.ReservesInvariant(env.app.BtcBridgeKeeper),
+		keeper.EscrowInvariant(env.app.BtcBridgeKeeper),
+		keeper.UTXOsInvariant(env.app.BtcBridgeKeeper),
+	} {
+		msg, broken := invariant(env.ctx)
+		require.False(t, broken, msg)
+	}
+
+	// the withdrawal of the litecoin voucher goes to the linked litecoin address
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	addr, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(key.PubKey().SerializeCompressed()), ltc.chain.Params)
+	require.NoError(t, err)
+	sig, err := ecdsa.SignCompact(key, types.SignedMessageHash(types.AddressLinkMessage(env.ctx.ChainID(), holder)), true)
+	require.NoError(t, err)
+
+	linkMsg := types.NewMsgLinkBitcoinAddressRequest(holder, addr.EncodeAddress(), base64.StdEncoding.EncodeToString(sig))
+	linkMsg.ChainId = "ltc"
+	_, err = msgServer.LinkBitcoinAddress(goCtx, linkMsg)
+	require.NoError(t, err)
+	require.Empty(t, env.app.BtcBridgeKeeper.GetLinkedBitcoinAddress(env.ctx, holder))
+
+	// the withdrawal is charged by the fee schedule of litecoin rather than bitcoin
+	env.setFees(t, 5000, 3000)
+
+	params := env.app.BtcBridgeKeeper.GetParams(env.ctx)
+	params.Chain("ltc").FeeSchedules = []*types.FeeSchedule{{AssetType: types.AssetType_ASSET_TYPE_BTC, WithdrawFlatFee: 300}}
+	require.NoError(t, params.Validate())
+	env.app.BtcBridgeKeeper.SetParams(env.ctx, params)
+
+	_, err = msgServer.WithdrawBitcoin(goCtx, types.NewMsgWithdrawBitcoinRequest(holder, "40000ltcsat", 10))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ltcsat", 300)), ltcKeeper.GetCollectedFees(env.ctx))
+	require.True(t, env.app.BtcBridgeKeeper.GetCollectedFees(env.ctx).IsZero())
+
+	requests := ltcKeeper.FilterSigningRequestsByStatus(env.ctx, &types.QuerySigningRequestRequest{Status: types.SigningStatus_SIGNING_STATUS_CREATED})
+	require.Len(t, requests, 1)
+	require.Equal(t, addr.EncodeAddress(), requests[0].Address)
+	require.Equal(t, ltc.vaultAddress, requests[0].VaultAddress)
+	require.Empty(t, env.app.BtcBridgeKeeper.FilterSigningRequestsByStatus(env.ctx, &types.QuerySigningRequestRequest{Status: types.SigningStatus_SIGNING_STATUS_CREATED}))
+
+	// the relayer reports the litecoin request broadcasted
+	statusMsg := &types.MsgSubmitWithdrawStatusRequest{
+		Sender:  sample.AccAddress(),
+		Txid:    requests[0].Txid,
+		Status:  types.SigningStatus_SIGNING_STATUS_BROADCASTED,
+		ChainId: "ltc",
+	}
+	_, err = msgServer.SubmitWithdrawStatus(goCtx, statusMsg)
+	require.ErrorIs(t, err, types.ErrSenderAddressNotAuthorized)
+
+	statusMsg.Sender = env.relayer
+	_, err = msgServer.SubmitWithdrawStatus(goCtx, statusMsg)
+	require.NoError(t, err)
+	require.Equal(t, types.SigningStatus_SIGNING_STATUS_BROADCASTED, ltcKeeper.GetSigningRequest(env.ctx, requests[0].Txid).Status)
+
+	// unknown chains are rejected
+	statusMsg.ChainId = "doge"
+	_, err = msgServer.SubmitWithdrawStatus(goCtx, statusMsg)
+	require.ErrorIs(t, err, types.ErrUnknownChain)
+
+	headersMsg.ChainId = "doge"
+	_, err = msgServer.SubmitBlockHeaders(goCtx, headersMsg)
+	require.ErrorIs(t, err, types.ErrUnknownChain)
+
+	_, err = env.app.BtcBridgeKeeper.QueryChainTip(goCtx, &types.QueryChainTipRequest{ChainId: "doge"})
+	require.Error(t, err)
+}
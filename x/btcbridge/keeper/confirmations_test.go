@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sideprotocol/side/testutil/sample"
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+func TestConfirmationTiers(t *testing.T) {
+	env := newDepositTestEnv(t)
+
+	// the deposits and withdrawals of 0.01 btc or more require 3 confirmations instead of 1
+	params := env.app.BtcBridgeKeeper.GetParams(env.ctx)
+	params.ConfirmationTiers = []*types.ConfirmationTier{{AssetType: types.AssetType_ASSET_TYPE_BTC, MinAmount: 1000000, Confirmations: 3}}
+	require.NoError(t, params.Validate())
+	env.app.BtcBridgeKeeper.SetParams(env.ctx, params)
+
+	for amount, confirmations := range map[uint64]int32{100000: 1, 1000000: 3, 2000000: 3} {
+		res, err := env.app.BtcBridgeKeeper.QueryRequiredConfirmations(sdk.WrapSDKContext(env.ctx), &types.QueryRequiredConfirmationsRequest{Amount: amount})
+		require.NoError(t, err)
+		require.Equal(t, confirmations, res.Confirmations, amount)
+	}
+
+	holder := sample.AccAddress()
+	memo := env.memoOut(t, &types.DepositMemo{Recipient: holder})
+
+	// the small deposit is minted with 1 confirmation
+	require.NoError(t, env.deposit(t, wire.NewTxOut(100000, env.vaultPkScript), memo))
+
+	// the large deposit is pending for 3 confirmations
+	msg := env.depositMsg(t, wire.NewTxOut(2000000, env.vaultPkScript), memo)
+	require.NoError(t, env.app.BtcBridgeKeeper.ProcessBitcoinDepositTransaction(env.ctx, msg))
+	require.Equal(t, int64(100000), env.balance(holder, "sat").Int64())
+	require.Len(t, env.app.BtcBridgeKeeper.GetPendingDeposits(env.ctx), 1)
+
+	env.chain.MineBlock()
+	env.chain.MineBlock()
+	env.syncHeaders(t)
+
+	require.NoError(t, env.app.BtcBridgeKeeper.ProcessBitcoinDepositTransaction(env.ctx, msg))
+	require.Equal(t, int64(2100000), env.balance(holder, "sat").Int64())
+	require.Empty(t, env.app.BtcBridgeKeeper.GetPendingDeposits(env.ctx))
+
+	// the large withdrawal waits for 3 confirmations as well
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	addr, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(key.PubKey().SerializeCompressed()), env.chain.Params)
+	require.NoError(t, err)
+
+	request, err := env.app.BtcBridgeKeeper.NewSigningRequest(env.ctx, addr.EncodeAddress(), sdk.NewInt64Coin("sat", 1500000), 10, "")
+	require.NoError(t, err)
+
+	escrow := sdk.NewCoins(sdk.NewInt64Coin("sat", int64(env.checkReserves(t).PendingWithdrawals)))
+	require.NoError(t, env.app.BankKeeper.SendCoinsFromAccountToModule(env.ctx, sdk.MustAccAddressFromBech32(holder), types.ModuleName, escrow))
+
+	tx := env.signWithdrawal(t, request)
+	block, txOutProof := env.mine(t, tx)
+
+	withdrawMsg := types.NewMsgSubmitWithdrawTransactionRequest(env.relayer, block.BlockHash().String(), serializeTx(t, tx), nil)
+	withdrawMsg.TxOutProof = txOutProof
+	require.ErrorIs(t, env.app.BtcBridgeKeeper.ProcessBitcoinWithdrawTransaction(env.ctx, withdrawMsg), types.ErrNotConfirmed)
+
+	env.chain.MineBlock()
+	env.chain.MineBlock()
+	env.syncHeaders(t)
+
+	require.NoError(t, env.app.BtcBridgeKeeper.ProcessBitcoinWithdrawTransaction(env.ctx, withdrawMsg))
+	require.Zero(t, env.checkReserves(t).PendingWithdrawals)
+}
@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+
+	"github.com/sideprotocol/side/x/btcbridge/types"
+	gmmtypes "github.com/sideprotocol/side/x/gmm/types"
+)
+
+// extractDepositRecipient extracts the recipient of the deposit.
+// The recipient of the memo takes precedence, then the account linked to the sender address,
+// otherwise fallback to the recipient address of the deposit tx.
+// An invalid memo is ignored.
+func (k Keeper) extractDepositRecipient(ctx sdk.Context, tx *wire.MsgTx, prevTx *wire.MsgTx, vaults []*types.Vault, chainCfg *chaincfg.Params) (string, *types.DepositMemo) {
+	memo, err := types.ExtractDepositMemo(tx)
+	if err != nil {
+		k.Logger(ctx).Error("invalid deposit memo", "txid", tx.TxHash(), "error", err)
+	}
+
+	if memo != nil {
+		return memo.Recipient, memo
+	}
+
+	// the deposit from a linked bitcoin address goes to the linked account
+	if sender, err := types.ExtractSenderAddr(tx, prevTx, chainCfg); err == nil {
+		if account := k.GetLinkedAccount(ctx, sender.EncodeAddress()); len(account) != 0 {
+			return account, nil
+		}
+	}
+
+	recipient, err := types.ExtractRecipientAddr(tx, prevTx, vaults, chainCfg)
+	if err != nil {
+		k.Logger(ctx).Error("failed to extract recipient", "txid", tx.TxHash(), "error", err)
+		return "", nil
+	}
+
+	return recipient.EncodeAddress(), nil
+}
+
+// executeDepositAction performs the memo action with the minted voucher token atomically.
+// The minted voucher token remains credited to the recipient if the action fails.
+func (k Keeper) executeDepositAction(ctx sdk.Context, memo *types.DepositMemo, minted sdk.Coin) {
+	cacheCtx, write := ctx.CacheContext()
+
+	var err error
+	switch {
+	case memo.IBCTransfer != nil:
+		err = k.depositIBCTransfer(cacheCtx, memo.Recipient, memo.IBCTransfer, minted)
+	case memo.Swap != nil:
+		err = k.depositSwap(cacheCtx, memo.Recipient, memo.Swap, minted)
+	}
+
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyRecipient, memo.Recipient),
+		sdk.NewAttribute(types.AttributeKeyDepositAction, memo.Action()),
+		sdk.NewAttribute(types.AttributeKeyAmount, minted.String()),
+	}
+
+	if err != nil {
+		k.Logger(ctx).Info("deposit action failed, fallback to plain credit", "action", memo.Action(), "recipient", memo.Recipient, "error", err)
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeDepositActionFailed, append(attrs, sdk.NewAttribute(types.AttributeKeyError, err.Error()))...))
+		return
+	}
+
+	write()
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeDepositAction, attrs...))
+}
+
+// depositIBCTransfer transfers the minted voucher token from the recipient over the given channel
+func (k Keeper) depositIBCTransfer(ctx sdk.Context, recipient string, transfer *types.DepositIBCTransfer, minted sdk.Coin) error {
+	msg := ibctransfertypes.NewMsgTransfer(
+		ibctransfertypes.PortID,
+		transfer.Channel,
+		minted,
+		recipient,
+		transfer.Receiver,
+		clienttypes.ZeroHeight(),
+		uint64(ctx.BlockTime().Add(types.DepositIBCTransferTimeout).UnixNano()),
+		"",
+	)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	_, err := k.transferKeeper.Transfer(sdk.WrapSDKContext(ctx), msg)
+
+	return err
+}
+
+// depositSwap swaps the minted voucher token of the recipient in the given gmm pool
+func (k Keeper) depositSwap(ctx sdk.Context, recipient string, swap *types.DepositSwap, minted sdk.Coin) error {
+	recipientAddr, err := sdk.AccAddressFromBech32(recipient)
+	if err != nil {
+		return err
+	}
+
+	balance := k.bankKeeper.GetBalance(ctx, recipientAddr, swap.Denom)
+
+	// no slippage allowed from the min out
+	msg := &gmmtypes.MsgSwap{
+		Sender:   recipient,
+		PoolId:   swap.PoolId,
+		TokenIn:  minted,
+		TokenOut: sdk.NewCoin(swap.Denom, swap.MinOut),
+		Slippage: sdkmath.ZeroInt(),
+	}
+
+	if _, err := k.swapKeeper.Swap(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return err
+	}
+
+	// double check the output in case of the pool rounding
+	if out := k.bankKeeper.GetBalance(ctx, recipientAddr, swap.Denom).Sub(balance); out.Amount.LT(swap.MinOut) {
+		return fmt.Errorf("swap output %s less than min out %s", out, swap.MinOut)
+	}
+
+	return nil
+}
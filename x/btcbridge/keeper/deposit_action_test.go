@@ -0,0 +1,242 @@
+package keeper_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	simapp "github.com/sideprotocol/side/app"
+	"github.com/sideprotocol/side/testutil/bitcoin"
+	"github.com/sideprotocol/side/testutil/sample"
+	"github.com/sideprotocol/side/x/btcbridge/types"
+	gmmkeeper "github.com/sideprotocol/side/x/gmm/keeper"
+	gmmtypes "github.com/sideprotocol/side/x/gmm/types"
+)
+
+// depositTestEnv is a side app with a bitcoin regtest chain whose headers are synced to the bridge
+type depositTestEnv struct {
+	app   *simapp.App
+	ctx   sdk.Context
+	chain *bitcoin.Chain
+
+	relayer       string
+	vaultKey      *btcec.PrivateKey
+	vaultPkScript []byte
+}
+
+func newDepositTestEnv(t *testing.T) *depositTestEnv {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+
+	chain := bitcoin.NewChain()
+
+	vaultKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	vaultAddr, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(vaultKey.PubKey().SerializeCompressed()), chain.Params)
+	require.NoError(t, err)
+	vaultPkScript, err := txscript.PayToAddrScript(vaultAddr)
+	require.NoError(t, err)
+
+	relayer := sample.AccAddress()
+
+	params := types.DefaultParams()
+	params.AuthorizedRelayers = []string{relayer}
+	params.Confirmations = 1
+	params.Network = chain.Params.Name
+	params.Vaults = []*types.Vault{{
+		Address:   vaultAddr.EncodeAddress(),
+		PubKey:    hex.EncodeToString(vaultKey.PubKey().SerializeCompressed()),
+		AssetType: types.AssetType_ASSET_TYPE_BTC,
+	}}
+
+	app.BtcBridgeKeeper.SetParams(ctx, params)
+	app.BtcBridgeKeeper.SetBestBlockHeader(ctx, types.NewBlockHeader(&chain.BlockAt(0).Header, 0, 1))
+
+	return &depositTestEnv{
+		app:           app,
+		ctx:           ctx,
+		chain:         chain,
+		relayer:       relayer,
+		vaultKey:      vaultKey,
+		vaultPkScript: vaultPkScript,
+	}
+}
+
+// deposit mines a deposit tx with the given outputs and submits it to the bridge
+func (env *depositTestEnv) deposit(t *testing.T, outs ...*wire.TxOut) error {
+	return env.app.BtcBridgeKeeper.ProcessBitcoinDepositTransaction(env.ctx, env.depositMsg(t, outs...))
+}
+
+// depositMsg mines a deposit tx with the given outputs and builds the deposit message
+func (env *depositTestEnv) depositMsg(t *testing.T, outs ...*wire.TxOut) *types.MsgSubmitDepositTransactionRequest {
+	return env.depositMsgFrom(t, env.chain.MineBlock().Transactions[0], outs...)
+}
+
+// depositMsgFrom mines a deposit tx spending the first output of the funding tx and builds the deposit message
+func (env *depositTestEnv) depositMsgFrom(t *testing.T, funding *wire.MsgTx, outs ...*wire.TxOut) *types.MsgSubmitDepositTransactionRequest {
+	deposit := bitcoin.NewTx(wire.NewOutPoint(&chainhash.Hash{}, 0), nil, outs...)
+	deposit.TxIn[0].PreviousOutPoint.Hash = funding.TxHash()
+
+	block, txOutProof := env.mine(t, deposit)
+
+	msg := types.NewMsgSubmitDepositTransactionRequest(env.relayer, block.BlockHash().String(), serializeTx(t, funding), serializeTx(t, deposit), nil)
+	msg.TxOutProof = txOutProof
+
+	return msg
+}
+
+// mine mines a block with the given tx and a confirmation block, syncs the headers to the bridge
+// and returns the block with the tx out proof of the tx
+func (env *depositTestEnv) mine(t *testing.T, tx *wire.MsgTx) (*wire.MsgBlock, string) {
+	block := env.chain.MineBlock(tx)
+	env.chain.MineBlock()
+
+	env.syncHeaders(t)
+
+	txHash := tx.TxHash()
+	txOutProof, err := types.BuildTxOutProof(block, &txHash)
+	require.NoError(t, err)
+
+	return block, txOutProof
+}
+
+// syncHeaders submits the headers mined since the best block of the bridge
+func (env *depositTestEnv) syncHeaders(t *testing.T) {
+	headers := make([]*types.BlockHeader, 0)
+	for height := env.app.BtcBridgeKeeper.GetBestBlockHeader(env.ctx).Height + 1; height <= uint64(env.chain.Height()); height++ {
+		b := env.chain.BlockAt(int64(height))
+		headers = append(headers, types.NewBlockHeader(&b.Header, height, uint64(len(b.Transactions))))
+	}
+
+	require.NoError(t, env.app.BtcBridgeKeeper.SetBlockHeaders(env.ctx, headers))
+}
+
+func (env *depositTestEnv) memoOut(t *testing.T, memo *types.DepositMemo) *wire.TxOut {
+	script, err := memo.Script()
+	require.NoError(t, err)
+
+	return wire.NewTxOut(0, script)
+}
+
+func (env *depositTestEnv) balance(addr string, denom string) sdkmath.Int {
+	return env.app.BankKeeper.GetBalance(env.ctx, sdk.MustAccAddressFromBech32(addr), denom).Amount
+}
+
+// createPool creates a gmm pool of the voucher and the bond denom
+func (env *depositTestEnv) createPool(t *testing.T) string {
+	creator := sample.AccAddress()
+	liquidity := sdk.NewCoins(sdk.NewInt64Coin("sat", 1000000), sdk.NewInt64Coin(simapp.DefaultBondDenom, 1000000))
+
+	require.NoError(t, env.app.BankKeeper.MintCoins(env.ctx, types.ModuleName, liquidity))
+	require.NoError(t, env.app.BankKeeper.SendCoinsFromModuleToAccount(env.ctx, types.ModuleName, sdk.MustAccAddressFromBech32(creator), liquidity))
+
+	weight := sdk.NewInt(50)
+	amp := sdk.NewInt(100)
+
+	res, err := gmmkeeper.NewMsgServerImpl(env.app.GmmKeeper).CreatePool(sdk.WrapSDKContext(env.ctx), gmmtypes.NewMsgCreatePool(
+		creator,
+		gmmtypes.PoolParams{
+			Type:    gmmtypes.PoolType_WEIGHT,
+			SwapFee: sdkmath.LegacyDec(sdk.NewInt(100)),
+			Amp:     &amp,
+		},
+		[]gmmtypes.PoolAsset{
+			{Token: liquidity[0], Weight: &weight, Decimal: sdk.NewInt(8)},
+			{Token: liquidity[1], Weight: &weight, Decimal: sdk.NewInt(6)},
+		},
+	))
+	require.NoError(t, err)
+
+	return res.PoolId
+}
+
+func serializeTx(t *testing.T, tx *wire.MsgTx) string {
+	var buf bytes.Buffer
+	require.NoError(t, tx.Serialize(&buf))
+
+	return base64.StdEncoding.EncodeToString(buf.Bytes())
+}
+
+func TestDepositMemo(t *testing.T) {
+	env := newDepositTestEnv(t)
+
+	recipient := sample.AccAddress()
+
+	err := env.deposit(t, wire.NewTxOut(100000, env.vaultPkScript), env.memoOut(t, &types.DepositMemo{Recipient: recipient}))
+	require.NoError(t, err)
+	require.Equal(t, int64(100000), env.balance(recipient, "sat").Int64())
+}
+
+func TestDepositMemoSwap(t *testing.T) {
+	env := newDepositTestEnv(t)
+	poolId := env.createPool(t)
+
+	recipient := sample.AccAddress()
+
+	memo := &types.DepositMemo{
+		Recipient: recipient,
+		Swap:      &types.DepositSwap{PoolId: poolId, Denom: simapp.DefaultBondDenom, MinOut: sdk.NewInt(1)},
+	}
+
+	err := env.deposit(t, wire.NewTxOut(100000, env.vaultPkScript), env.memoOut(t, memo))
+	require.NoError(t, err)
+	require.True(t, env.balance(recipient, "sat").IsZero())
+	require.True(t, env.balance(recipient, simapp.DefaultBondDenom).IsPositive())
+	require.True(t, hasEvent(env.ctx.EventManager().Events(), types.EventTypeDepositAction))
+}
+
+func TestDepositMemoFallback(t *testing.T) {
+	testCases := []struct {
+		name   string
+		action func(memo *types.DepositMemo, poolId string)
+	}{
+		{
+			name: "swap output less than min out",
+			action: func(memo *types.DepositMemo, poolId string) {
+				memo.Swap = &types.DepositSwap{PoolId: poolId, Denom: simapp.DefaultBondDenom, MinOut: sdk.NewInt(1000000)}
+			},
+		},
+		{
+			name: "pool not found",
+			action: func(memo *types.DepositMemo, poolId string) {
+				memo.Swap = &types.DepositSwap{PoolId: "unknown", Denom: simapp.DefaultBondDenom, MinOut: sdk.NewInt(1)}
+			},
+		},
+		{
+			name: "ibc channel not found",
+			action: func(memo *types.DepositMemo, poolId string) {
+				memo.IBCTransfer = &types.DepositIBCTransfer{Channel: "channel-0", Receiver: sample.AccAddress()}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			env := newDepositTestEnv(t)
+			poolId := env.createPool(t)
+
+			memo := &types.DepositMemo{Recipient: sample.AccAddress()}
+			tc.action(memo, poolId)
+
+			err := env.deposit(t, wire.NewTxOut(100000, env.vaultPkScript), env.memoOut(t, memo))
+			require.NoError(t, err)
+
+			// the minted vouchers are credited to the recipient
+			require.Equal(t, int64(100000), env.balance(memo.Recipient, "sat").Int64())
+			require.True(t, hasEvent(env.ctx.EventManager().Events(), types.EventTypeDepositActionFailed))
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sideprotocol/side/testutil/bitcoin"
+	"github.com/sideprotocol/side/testutil/sample"
+	"github.com/sideprotocol/side/x/btcbridge/keeper"
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+// typedEvents returns the typed events of the given type emitted so far
+func typedEvents[T proto.Message](t *testing.T, ctx sdk.Context) []T {
+	events := make([]T, 0)
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type != proto.MessageName(*new(T)) {
+			continue
+		}
+
+		msg, err := sdk.ParseTypedEvent(abci.Event(e))
+		require.NoError(t, err)
+
+		events = append(events, msg.(T))
+	}
+
+	return events
+}
+
+func TestDepositEvents(t *testing.T) {
+	env := newDepositTestEnv(t)
+
+	recipient := sample.AccAddress()
+	err := env.deposit(t, wire.NewTxOut(100000, env.vaultPkScript), env.memoOut(t, &types.DepositMemo{Recipient: recipient}))
+	require.NoError(t, err)
+
+	accepted := typedEvents[*types.EventHeadersAccepted](t, env.ctx)
+	require.Len(t, accepted, 1)
+	require.Equal(t, uint64(1), accepted[0].StartHeight)
+	require.Equal(t, uint64(env.chain.Height()), accepted[0].EndHeight)
+	require.Equal(t, env.chain.Tip().BlockHash().String(), accepted[0].BestHash)
+
+	minted := typedEvents[*types.EventDepositMinted](t, env.ctx)
+	require.Len(t, minted, 1)
+	require.Equal(t, recipient, minted[0].Recipient)
+	require.Equal(t, sdk.NewInt64Coin("sat", 100000), minted[0].Amount)
+	require.Equal(t, uint64(0), minted[0].Vout)
+}
+
+func TestReorgEvent(t *testing.T) {
+	env := newDepositTestEnv(t)
+
+	env.mine(t, bitcoin.NewTx(wire.NewOutPoint(&chainhash.Hash{}, 0), nil, wire.NewTxOut(1000, env.vaultPkScript)))
+	oldBest := env.app.BtcBridgeKeeper.GetBestBlockHeader(env.ctx)
+
+	// a fork block with more work at the tip height
+	fork := bitcoin.NewBlock(env.chain.BlockAt(1), 2)
+	fork.Header.Bits = 0x1f7fffff
+	bitcoin.Solve(&fork.Header)
+
+	err := env.app.BtcBridgeKeeper.SetBlockHeaders(env.ctx, []*types.BlockHeader{types.NewBlockHeader(&fork.Header, 2, 1)})
+	require.NoError(t, err)
+
+	reorgs := typedEvents[*types.EventReorg](t, env.ctx)
+	require.Len(t, reorgs, 1)
+	require.Equal(t, &types.EventReorg{ForkHeight: 2, OldBestHash: oldBest.Hash, OldBestHeight: oldBest.Height}, reorgs[0])
+}
+
+func TestWithdrawalEvents(t *testing.T) {
+	env := newDepositTestEnv(t)
+	msgServer := keeper.NewMsgServerImpl(env.app.BtcBridgeKeeper)
+
+	account := sample.AccAddress()
+	addr, _ := env.linkBitcoinAddress(t, account)
+
+	err := env.deposit(t, wire.NewTxOut(100000, env.vaultPkScript), env.memoOut(t, &types.DepositMemo{Recipient: account}))
+	require.NoError(t, err)
+
+	_, err = msgServer.WithdrawBitcoin(sdk.WrapSDKContext(env.ctx), types.NewMsgWithdrawBitcoinRequest(account, "40000sat", 10))
+	require.NoError(t, err)
+
+	requested := typedEvents[*types.EventWithdrawalRequested](t, env.ctx)
+	require.Len(t, requested, 1)
+	require.Equal(t, account, requested[0].Sender)
+	require.Equal(t, addr.EncodeAddress(), requested[0].Recipient)
+	require.Equal(t, sdk.NewInt64Coin("sat", 40000), requested[0].Amount)
+	require.Positive(t, requested[0].Fee)
+
+	request := env.app.BtcBridgeKeeper.GetSigningRequest(env.ctx, requested[0].Txid)
+
+	_, err = msgServer.SubmitWithdrawStatus(sdk.WrapSDKContext(env.ctx), &types.MsgSubmitWithdrawStatusRequest{
+		Sender: env.relayer,
+		Txid:   request.Txid,
+		Status: types.SigningStatus_SIGNING_STATUS_BROADCASTED,
+	})
+	require.NoError(t, err)
+
+	tx := env.signWithdrawal(t, request)
+	block, txOutProof := env.mine(t, tx)
+
+	msg := types.NewMsgSubmitWithdrawTransactionRequest(env.relayer, block.BlockHash().String(), serializeTx(t, tx), nil)
+	msg.TxOutProof = txOutProof
+	require.NoError(t, env.app.BtcBridgeKeeper.ProcessBitcoinWithdrawTransaction(env.ctx, msg))
+
+	changed := typedEvents[*types.EventSigningStatusChanged](t, env.ctx)
+	require.Equal(t, []*types.EventSigningStatusChanged{
+		{Txid: request.Txid, OldStatus: types.SigningStatus_SIGNING_STATUS_CREATED, NewStatus: types.SigningStatus_SIGNING_STATUS_BROADCASTED},
+		{Txid: request.Txid, OldStatus: types.SigningStatus_SIGNING_STATUS_BROADCASTED, NewStatus: types.SigningStatus_SIGNING_STATUS_CONFIRMED},
+	}, changed)
+
+	spent := typedEvents[*types.EventUTXOSpent](t, env.ctx)
+	require.Len(t, spent, 1)
+	require.Equal(t, uint64(100000), spent[0].Amount)
+	require.Equal(t, request.Txid, spent[0].SpendingTxid)
+}
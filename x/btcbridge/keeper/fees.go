@@ -0,0 +1,228 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+// collectFee adds the given bridge fee held by the module account to the fees of the current epoch
+func (k Keeper) collectFee(ctx sdk.Context, operation types.BridgeOperation, txid string, fee sdk.Coin) error {
+	if !fee.IsPositive() {
+		return nil
+	}
+
+	k.setCollectedFee(ctx, k.GetCollectedFees(ctx).AmountOf(fee.Denom).Add(fee.Amount), fee.Denom)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventFeeCollected{
+		Operation: operation,
+		Txid:      txid,
+		Fee:       fee,
+	})
+}
+
+// GetCollectedFees returns the fees collected in the current epoch
+func (k Keeper) GetCollectedFees(ctx sdk.Context) sdk.Coins {
+	store := k.store(ctx)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.CollectedFeeKeyPrefix)
+	defer iterator.Close()
+
+	fees := sdk.NewCoins()
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdkmath.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+
+		denom := string(iterator.Key()[len(types.CollectedFeeKeyPrefix):])
+		fees = fees.Add(sdk.NewCoin(denom, amount))
+	}
+
+	return fees
+}
+
+// SetCollectedFees sets the fees collected in the current epoch
+func (k Keeper) SetCollectedFees(ctx sdk.Context, fees sdk.Coins) {
+	for _, fee := range fees {
+		k.setCollectedFee(ctx, fee.Amount, fee.Denom)
+	}
+}
+
+// setCollectedFee sets the fee collected in the current epoch for the given denom
+func (k Keeper) setCollectedFee(ctx sdk.Context, amount sdkmath.Int, denom string) {
+	store := k.store(ctx)
+
+	if !amount.IsPositive() {
+		store.Delete(types.CollectedFeeKey(denom))
+		return
+	}
+
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	store.Set(types.CollectedFeeKey(denom), bz)
+}
+
+// AddFeeContribution counts a contribution of the given relayer or signer in the current epoch
+func (k Keeper) AddFeeContribution(ctx sdk.Context, role types.ContributorRole, address string) {
+	store := k.store(ctx)
+
+	count := uint64(0)
+	if bz := store.Get(types.FeeContributionKey(role, address)); bz != nil {
+		count = sdk.BigEndianToUint64(bz)
+	}
+
+	k.SetFeeContribution(ctx, &types.FeeContribution{Address: address, Role: role, Count: count + 1})
+}
+
+// SetFeeContribution sets the contributions of the relayer or signer in the current epoch
+func (k Keeper) SetFeeContribution(ctx sdk.Context, contribution *types.FeeContribution) {
+	store := k.store(ctx)
+
+	store.Set(types.FeeContributionKey(contribution.Role, contribution.Address), sdk.Uint64ToBigEndian(contribution.Count))
+}
+
+// GetFeeContributions returns the contributions of the relayers and signers in the current epoch
+func (k Keeper) GetFeeContributions(ctx sdk.Context) []*types.FeeContribution {
+	store := k.store(ctx)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.FeeContributionKeyPrefix)
+	defer iterator.Close()
+
+	contributions := make([]*types.FeeContribution, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(types.FeeContributionKeyPrefix):]
+
+		contributions = append(contributions, &types.FeeContribution{
+			Address: string(key[1:]),
+			Role:    types.ContributorRole(key[0]),
+			Count:   sdk.BigEndianToUint64(iterator.Value()),
+		})
+	}
+
+	return contributions
+}
+
+// NextFeeDistributionHeight returns the side block height at which the collected fees are distributed next
+func (k Keeper) NextFeeDistributionHeight(ctx sdk.Context) int64 {
+	epoch := k.GetParams(ctx).FeeEpoch
+	if epoch <= 0 {
+		return 0
+	}
+
+	return (ctx.BlockHeight()/epoch + 1) * epoch
+}
+
+// DistributeFees distributes the fees collected on the chain in the current epoch to the relayers and signers
+// of the chain pro rata to their contributions, the rest goes to the community pool.
+// The share of a role without any contribution goes to the community pool as well.
+func (k Keeper) DistributeFees(ctx sdk.Context) error {
+	params := k.GetParams(ctx)
+
+	fees := k.GetCollectedFees(ctx)
+	contributions := k.GetFeeContributions(ctx)
+
+	// reset the epoch
+	store := k.store(ctx)
+	for _, fee := range fees {
+		store.Delete(types.CollectedFeeKey(fee.Denom))
+	}
+	for _, contribution := range contributions {
+		store.Delete(types.FeeContributionKey(contribution.Role, contribution.Address))
+	}
+
+	if fees.IsZero() {
+		return nil
+	}
+
+	relayerRewards, err := k.distributeFeeShare(ctx, fees, params.RelayerFeeShare, contributions, types.ContributorRole_CONTRIBUTOR_ROLE_RELAYER)
+	if err != nil {
+		return err
+	}
+
+	signerRewards, err := k.distributeFeeShare(ctx, fees, params.SignerFeeShare, contributions, types.ContributorRole_CONTRIBUTOR_ROLE_SIGNER)
+	if err != nil {
+		return err
+	}
+
+	communityPool := fees.Sub(relayerRewards...).Sub(signerRewards...)
+	if !communityPool.IsZero() {
+		if err := k.distrKeeper.FundCommunityPool(ctx, communityPool, authtypes.NewModuleAddress(types.ModuleName)); err != nil {
+			return err
+		}
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventFeesDistributed{
+		RelayerRewards: relayerRewards,
+		SignerRewards:  signerRewards,
+		CommunityPool:  communityPool,
+		ChainId:        k.chainID,
+	})
+}
+
+// distributeFeeShare distributes the given share of the fees to the contributors of the given role
+// pro rata to their contributions and returns the distributed rewards
+func (k Keeper) distributeFeeShare(ctx sdk.Context, fees sdk.Coins, share uint32, contributions []*types.FeeContribution, role types.ContributorRole) (sdk.Coins, error) {
+	total := uint64(0)
+	contributors := make([]*types.FeeContribution, 0)
+
+	for _, contribution := range contributions {
+		if contribution.Role == role && contribution.Count > 0 {
+			total += contribution.Count
+			contributors = append(contributors, contribution)
+		}
+	}
+
+	distributed := sdk.NewCoins()
+	if total == 0 || share == 0 {
+		return distributed, nil
+	}
+
+	for _, contributor := range contributors {
+		rewards := sdk.NewCoins()
+		for _, fee := range fees {
+			amount := fee.Amount.MulRaw(int64(share)).QuoRaw(types.MaxBasisPoints).Mul(sdkmath.NewIntFromUint64(contributor.Count)).Quo(sdkmath.NewIntFromUint64(total))
+			rewards = rewards.Add(sdk.NewCoin(fee.Denom, amount))
+		}
+
+		if rewards.IsZero() {
+			continue
+		}
+
+		recipient, err := sdk.AccAddressFromBech32(contributor.Address)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, rewards); err != nil {
+			return nil, err
+		}
+
+		distributed = distributed.Add(rewards...)
+	}
+
+	return distributed, nil
+}
+
+// QuoteFee returns the bridge fee of the given operation and amount of the bridged chain,
+// along with the vouchers minted to the recipient of a deposit or charged to the sender of a withdrawal
+func (k Keeper) QuoteFee(ctx sdk.Context, operation types.BridgeOperation, assetType types.AssetType, amount sdkmath.Int) (sdk.Coin, sdk.Coin, error) {
+	params := k.GetParams(ctx)
+	schedule := params.FeeSchedule(assetType)
+
+	switch operation {
+	case types.BridgeOperation_BRIDGE_OPERATION_DEPOSIT:
+		fee := schedule.DepositFee(amount)
+		return sdk.NewCoin(params.BtcVoucherDenom, fee), sdk.NewCoin(params.BtcVoucherDenom, amount.Sub(fee)), nil
+	case types.BridgeOperation_BRIDGE_OPERATION_WITHDRAW:
+		fee := schedule.WithdrawFee(amount)
+		return sdk.NewCoin(params.BtcVoucherDenom, fee), sdk.NewCoin(params.BtcVoucherDenom, amount.Add(fee)), nil
+	}
+
+	return sdk.Coin{}, sdk.Coin{}, types.ErrInvalidBridgeOperation
+}
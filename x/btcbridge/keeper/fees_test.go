@@ -0,0 +1,216 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sideprotocol/side/testutil/sample"
+	btclightclient "github.com/sideprotocol/side/x/btcbridge"
+	"github.com/sideprotocol/side/x/btcbridge/keeper"
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+// setFees sets a fee schedule of the btc vaults with the given fee shares of the relayers and signers
+func (env *depositTestEnv) setFees(t *testing.T, relayerShare uint32, signerShare uint32) {
+	params := env.app.BtcBridgeKeeper.GetParams(env.ctx)
+	params.FeeSchedules = []*types.FeeSchedule{{
+		AssetType:       types.AssetType_ASSET_TYPE_BTC,
+		DepositFlatFee:  1000,
+		DepositFeeBps:   100,
+		WithdrawFlatFee: 500,
+		WithdrawFeeBps:  50,
+	}}
+	params.RelayerFeeShare = relayerShare
+	params.SignerFeeShare = signerShare
+	require.NoError(t, params.Validate())
+
+	env.app.BtcBridgeKeeper.SetParams(env.ctx, params)
+}
+
+func TestBridgeFees(t *testing.T) {
+	env := newDepositTestEnv(t)
+	env.setFees(t, 5000, 3000)
+
+	msgServer := keeper.NewMsgServerImpl(env.app.BtcBridgeKeeper)
+	goCtx := sdk.WrapSDKContext(env.ctx)
+
+	// the deposit fee is deducted from the minted vouchers
+	account := sample.AccAddress()
+	env.linkBitcoinAddress(t, account)
+
+	_, err := msgServer.SubmitDepositTransaction(goCtx, env.depositMsg(t, wire.NewTxOut(100000, env.vaultPkScript), env.memoOut(t, &types.DepositMemo{Recipient: account})))
+	require.NoError(t, err)
+
+	require.Equal(t, int64(98000), env.balance(account, "sat").Int64())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("sat", 2000)), env.app.BtcBridgeKeeper.GetCollectedFees(env.ctx))
+
+	// the withdrawal fee is charged on top of the escrow
+	_, err = msgServer.WithdrawBitcoin(goCtx, types.NewMsgWithdrawBitcoinRequest(account, "40000sat", 10))
+	require.NoError(t, err)
+
+	res := env.checkReserves(t)
+	require.Equal(t, int64(98000-700)-int64(res.PendingWithdrawals), env.balance(account, "sat").Int64())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("sat", 2700)), env.app.BtcBridgeKeeper.GetCollectedFees(env.ctx))
+
+	msg, broken := keeper.EscrowInvariant(env.app.BtcBridgeKeeper)(env.ctx)
+	require.False(t, broken, msg)
+
+	// the quotes match the charged fees
+	quote, err := env.app.BtcBridgeKeeper.QueryFeeQuote(goCtx, &types.QueryFeeQuoteRequest{Operation: types.BridgeOperation_BRIDGE_OPERATION_DEPOSIT, Amount: 100000})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("sat", 2000), quote.Fee)
+	require.Equal(t, sdk.NewInt64Coin("sat", 98000), quote.Total)
+
+	quote, err = env.app.BtcBridgeKeeper.QueryFeeQuote(goCtx, &types.QueryFeeQuoteRequest{Operation: types.BridgeOperation_BRIDGE_OPERATION_WITHDRAW, Amount: 40000})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("sat", 700), quote.Fee)
+	require.Equal(t, sdk.NewInt64Coin("sat", 40700), quote.Total)
+
+	_, err = env.app.BtcBridgeKeeper.QueryFeeQuote(goCtx, &types.QueryFeeQuoteRequest{Amount: 40000})
+	require.Error(t, err)
+
+	// the deposit fee never exceeds the deposit
+	quote, err = env.app.BtcBridgeKeeper.QueryFeeQuote(goCtx, &types.QueryFeeQuoteRequest{Operation: types.BridgeOperation_BRIDGE_OPERATION_DEPOSIT, Amount: 600})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("sat", 600), quote.Fee)
+	require.True(t, quote.Total.IsZero())
+
+	// the relayer submitting the deposit contributed, the signers are weighted by their contributions
+	signers := []string{sample.AccAddress(), sample.AccAddress()}
+	env.app.BtcBridgeKeeper.AddFeeContribution(env.ctx, types.ContributorRole_CONTRIBUTOR_ROLE_SIGNER, signers[0])
+	env.app.BtcBridgeKeeper.AddFeeContribution(env.ctx, types.ContributorRole_CONTRIBUTOR_ROLE_SIGNER, signers[1])
+	env.app.BtcBridgeKeeper.AddFeeContribution(env.ctx, types.ContributorRole_CONTRIBUTOR_ROLE_SIGNER, signers[1])
+
+	pool, err := env.app.BtcBridgeKeeper.QueryFeePool(goCtx, &types.QueryFeePoolRequest{})
+	require.NoError(t, err)
+	require.Len(t, pool.Contributions, 3)
+	require.Equal(t, int64(types.DefaultFeeEpoch), pool.NextDistributionHeight)
+
+	communityPool := env.app.DistrKeeper.GetFeePoolCommunityCoins(env.ctx).AmountOf("sat")
+
+	require.NoError(t, env.app.BtcBridgeKeeper.DistributeFees(env.ctx))
+
+	// 50% to the relayer, 30% to the signers pro rata and the rest to the community pool
+	require.Equal(t, int64(1350), env.balance(env.relayer, "sat").Int64())
+	require.Equal(t, int64(270), env.balance(signers[0], "sat").Int64())
+	require.Equal(t, int64(540), env.balance(signers[1], "sat").Int64())
+	require.Equal(t, int64(2700-1350-270-540), env.app.DistrKeeper.GetFeePoolCommunityCoins(env.ctx).AmountOf("sat").Sub(communityPool).TruncateInt64())
+
+	require.True(t, env.app.BtcBridgeKeeper.GetCollectedFees(env.ctx).IsZero())
+	require.Empty(t, env.app.BtcBridgeKeeper.GetFeeContributions(env.ctx))
+
+	distributed := typedEvents[*types.EventFeesDistributed](t, env.ctx)
+	require.Len(t, distributed, 1)
+
+	msg, broken = keeper.EscrowInvariant(env.app.BtcBridgeKeeper)(env.ctx)
+	require.False(t, broken, msg)
+	env.checkReserves(t)
+}
+
+func TestBridgeFeesWithoutContributors(t *testing.T) {
+	env := newDepositTestEnv(t)
+	env.setFees(t, 5000, 3000)
+
+	holder := sample.AccAddress()
+	err := env.deposit(t, wire.NewTxOut(100000, env.vaultPkScript), env.memoOut(t, &types.DepositMemo{Recipient: holder}))
+	require.NoError(t, err)
+
+	// the shares of the roles without contributions go to the community pool
+	communityPool := env.app.DistrKeeper.GetFeePoolCommunityCoins(env.ctx).AmountOf("sat")
+	require.NoError(t, env.app.BtcBridgeKeeper.DistributeFees(env.ctx))
+	require.Equal(t, int64(2000), env.app.DistrKeeper.GetFeePoolCommunityCoins(env.ctx).AmountOf("sat").Sub(communityPool).TruncateInt64())
+}
+
+func TestBridgeFeesPerChain(t *testing.T) {
+	env := newDepositTestEnv(t)
+	env.addLitecoinChain(t, "ltc", "ltcsat")
+	env.setFees(t, 5000, 0)
+
+	ltcKeeper := env.app.BtcBridgeKeeper.WithChain("ltc")
+
+	// the fees and the contributions are kept apart by chain
+	fees := sdk.NewCoins(sdk.NewInt64Coin("sat", 1000), sdk.NewInt64Coin("ltcsat", 3000))
+	require.NoError(t, env.app.BankKeeper.MintCoins(env.ctx, types.ModuleName, fees))
+
+	env.app.BtcBridgeKeeper.SetCollectedFees(env.ctx, sdk.NewCoins(sdk.NewInt64Coin("sat", 1000)))
+	ltcKeeper.SetCollectedFees(env.ctx, sdk.NewCoins(sdk.NewInt64Coin("ltcsat", 3000)))
+
+	btcRelayer, ltcRelayer := sample.AccAddress(), sample.AccAddress()
+	env.app.BtcBridgeKeeper.AddFeeContribution(env.ctx, types.ContributorRole_CONTRIBUTOR_ROLE_RELAYER, btcRelayer)
+	ltcKeeper.AddFeeContribution(env.ctx, types.ContributorRole_CONTRIBUTOR_ROLE_RELAYER, ltcRelayer)
+
+	pool, err := env.app.BtcBridgeKeeper.QueryFeePool(sdk.WrapSDKContext(env.ctx), &types.QueryFeePoolRequest{ChainId: "ltc"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ltcsat", 3000)), pool.CollectedFees)
+	require.Len(t, pool.Contributions, 1)
+	require.Equal(t, ltcRelayer, pool.Contributions[0].Address)
+
+	// each relayer is rewarded by the fees of its own chain only
+	btclightclient.EndBlocker(env.ctx.WithBlockHeight(types.DefaultFeeEpoch), env.app.BtcBridgeKeeper)
+
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("sat", 500)), env.app.BankKeeper.GetAllBalances(env.ctx, sdk.MustAccAddressFromBech32(btcRelayer)))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ltcsat", 1500)), env.app.BankKeeper.GetAllBalances(env.ctx, sdk.MustAccAddressFromBech32(ltcRelayer)))
+
+	require.True(t, env.app.BtcBridgeKeeper.GetCollectedFees(env.ctx).IsZero())
+	require.True(t, ltcKeeper.GetCollectedFees(env.ctx).IsZero())
+	require.Empty(t, ltcKeeper.GetFeeContributions(env.ctx))
+
+	distributed := typedEvents[*types.EventFeesDistributed](t, env.ctx)
+	require.Len(t, distributed, 2)
+}
+
+func TestSignerContribution(t *testing.T) {
+	env := newDepositTestEnv(t)
+	msgServer := keeper.NewMsgServerImpl(env.app.BtcBridgeKeeper)
+	goCtx := sdk.WrapSDKContext(env.ctx)
+
+	signer := sample.AccAddress()
+
+	params := env.app.BtcBridgeKeeper.GetParams(env.ctx)
+	params.Vaults[0].Signers = []string{signer}
+	env.app.BtcBridgeKeeper.SetParams(env.ctx, params)
+
+	holder := sample.AccAddress()
+	require.NoError(t, env.deposit(t, wire.NewTxOut(100000, env.vaultPkScript), env.memoOut(t, &types.DepositMemo{Recipient: holder})))
+
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	addr, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(key.PubKey().SerializeCompressed()), env.chain.Params)
+	require.NoError(t, err)
+
+	signerContributions := func() []*types.FeeContribution {
+		contributions := make([]*types.FeeContribution, 0)
+		for _, contribution := range env.app.BtcBridgeKeeper.GetFeeContributions(env.ctx) {
+			if contribution.Role == types.ContributorRole_CONTRIBUTOR_ROLE_SIGNER {
+				contributions = append(contributions, contribution)
+			}
+		}
+
+		return contributions
+	}
+
+	// the signatures relayed by a non-signer are accepted but not rewarded
+	request, err := env.app.BtcBridgeKeeper.NewSigningRequest(env.ctx, addr.EncodeAddress(), sdk.NewInt64Coin("sat", 20000), 10, "")
+	require.NoError(t, err)
+
+	_, err = msgServer.SubmitWithdrawSignatures(goCtx, types.NewMsgSubmitWithdrawSignaturesRequest(sample.AccAddress(), request.Txid, env.signedPsbt(t, request)))
+	require.NoError(t, err)
+	require.Equal(t, types.SigningStatus_SIGNING_STATUS_SIGNED, env.app.BtcBridgeKeeper.GetSigningRequest(env.ctx, request.Txid).Status)
+	require.Empty(t, signerContributions())
+
+	// the signer of the vault is rewarded
+	request, err = env.app.BtcBridgeKeeper.NewSigningRequest(env.ctx, addr.EncodeAddress(), sdk.NewInt64Coin("sat", 20000), 10, "")
+	require.NoError(t, err)
+
+	_, err = msgServer.SubmitWithdrawSignatures(goCtx, types.NewMsgSubmitWithdrawSignaturesRequest(signer, request.Txid, env.signedPsbt(t, request)))
+	require.NoError(t, err)
+
+	contributions := signerContributions()
+	require.Len(t, contributions, 1)
+	require.Equal(t, signer, contributions[0].Address)
+}
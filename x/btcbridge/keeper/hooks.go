@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+// SetHooks sets the bridge hooks
+func (k *Keeper) SetHooks(hooks types.BtcBridgeHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set btcbridge hooks twice")
+	}
+
+	k.hooks = hooks
+
+	return k
+}
+
+// callHook calls the given hook in a cached context.
+// The state changes are committed only if the hook succeeds, and the error is logged otherwise,
+// so that the bridge operation is never reverted by the hook.
+func (k Keeper) callHook(ctx sdk.Context, name string, hook func(ctx sdk.Context, hooks types.BtcBridgeHooks) error) {
+	if k.hooks == nil {
+		return
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	if err := hook(cacheCtx, k.hooks); err != nil {
+		k.Logger(ctx).Error("btcbridge hook failed", "hook", name, "error", err)
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeHookFailed,
+			sdk.NewAttribute(types.AttributeKeyHook, name),
+			sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+		))
+
+		return
+	}
+
+	write()
+}
+
+func (k Keeper) afterDepositMinted(ctx sdk.Context, recipient sdk.AccAddress, txid string, amount sdk.Coin) {
+	k.callHook(ctx, "AfterDepositMinted", func(ctx sdk.Context, hooks types.BtcBridgeHooks) error {
+		return hooks.AfterDepositMinted(ctx, recipient, txid, amount)
+	})
+}
+
+func (k Keeper) afterWithdrawalRequested(ctx sdk.Context, sender sdk.AccAddress, request *types.BitcoinSigningRequest, amount sdk.Coin) {
+	k.callHook(ctx, "AfterWithdrawalRequested", func(ctx sdk.Context, hooks types.BtcBridgeHooks) error {
+		return hooks.AfterWithdrawalRequested(ctx, sender, request, amount)
+	})
+}
+
+func (k Keeper) afterWithdrawalConfirmed(ctx sdk.Context, request *types.BitcoinSigningRequest) {
+	k.callHook(ctx, "AfterWithdrawalConfirmed", func(ctx sdk.Context, hooks types.BtcBridgeHooks) error {
+		return hooks.AfterWithdrawalConfirmed(ctx, request)
+	})
+}
+
+func (k Keeper) afterReorg(ctx sdk.Context, forkHeight uint64, oldBestHeight uint64) {
+	k.callHook(ctx, "AfterReorg", func(ctx sdk.Context, hooks types.BtcBridgeHooks) error {
+		return hooks.AfterReorg(ctx, forkHeight, oldBestHeight)
+	})
+}
@@ -0,0 +1,145 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sideprotocol/side/testutil/bitcoin"
+	"github.com/sideprotocol/side/testutil/sample"
+	"github.com/sideprotocol/side/x/btcbridge/keeper"
+	"github.com/sideprotocol/side/x/btcbridge/types"
+	gmmkeeper "github.com/sideprotocol/side/x/gmm/keeper"
+)
+
+// mockHooks records the hook calls, writes to the state and optionally fails
+type mockHooks struct {
+	bankKeeper types.BankKeeper
+	fail       bool
+
+	deposits  []sdk.Coin
+	requested []*types.BitcoinSigningRequest
+	confirmed []*types.BitcoinSigningRequest
+	reorgs    []uint64
+}
+
+var _ types.BtcBridgeHooks = &mockHooks{}
+
+func (h *mockHooks) call(ctx sdk.Context) error {
+	// the state changes of the failed hook must be discarded
+	if err := h.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("hook", 1))); err != nil {
+		return err
+	}
+
+	if h.fail {
+		return errors.New("hook failed")
+	}
+
+	return nil
+}
+
+func (h *mockHooks) AfterDepositMinted(ctx sdk.Context, _ sdk.AccAddress, _ string, amount sdk.Coin) error {
+	h.deposits = append(h.deposits, amount)
+	return h.call(ctx)
+}
+
+func (h *mockHooks) AfterWithdrawalRequested(ctx sdk.Context, _ sdk.AccAddress, request *types.BitcoinSigningRequest, _ sdk.Coin) error {
+	h.requested = append(h.requested, request)
+	return h.call(ctx)
+}
+
+func (h *mockHooks) AfterWithdrawalConfirmed(ctx sdk.Context, request *types.BitcoinSigningRequest) error {
+	h.confirmed = append(h.confirmed, request)
+	return h.call(ctx)
+}
+
+func (h *mockHooks) AfterReorg(ctx sdk.Context, forkHeight uint64, _ uint64) error {
+	h.reorgs = append(h.reorgs, forkHeight)
+	return h.call(ctx)
+}
+
+// setHooks replaces the bridge keeper of the env by a keeper with the given hooks
+func (env *depositTestEnv) setHooks(hooks types.BtcBridgeHooks) {
+	k := keeper.NewKeeper(
+		env.app.AppCodec(),
+		env.app.GetKey(types.StoreKey),
+		env.app.GetKey(types.StoreKey),
+		env.app.BankKeeper,
+		env.app.DistrKeeper,
+		env.app.TransferKeeper,
+		gmmkeeper.NewMsgServerImpl(env.app.GmmKeeper),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	env.app.BtcBridgeKeeper = *k.SetHooks(hooks)
+}
+
+func TestHooks(t *testing.T) {
+	for _, fail := range []bool{false, true} {
+		env := newDepositTestEnv(t)
+
+		hooks := &mockHooks{bankKeeper: env.app.BankKeeper, fail: fail}
+		env.setHooks(types.NewMultiBtcBridgeHooks(hooks))
+
+		msgServer := keeper.NewMsgServerImpl(env.app.BtcBridgeKeeper)
+
+		// failed hooks do not revert the mint
+		account := sample.AccAddress()
+		env.linkBitcoinAddress(t, account)
+
+		err := env.deposit(t, wire.NewTxOut(100000, env.vaultPkScript), env.memoOut(t, &types.DepositMemo{Recipient: account}))
+		require.NoError(t, err)
+		require.Equal(t, []sdk.Coin{sdk.NewInt64Coin("sat", 100000)}, hooks.deposits)
+		require.Equal(t, int64(100000), env.balance(account, "sat").Int64())
+
+		_, err = msgServer.WithdrawBitcoin(sdk.WrapSDKContext(env.ctx), types.NewMsgWithdrawBitcoinRequest(account, "40000sat", 10))
+		require.NoError(t, err)
+		require.Len(t, hooks.requested, 1)
+
+		tx := env.signWithdrawal(t, hooks.requested[0])
+		block, txOutProof := env.mine(t, tx)
+
+		msg := types.NewMsgSubmitWithdrawTransactionRequest(env.relayer, block.BlockHash().String(), serializeTx(t, tx), nil)
+		msg.TxOutProof = txOutProof
+		require.NoError(t, env.app.BtcBridgeKeeper.ProcessBitcoinWithdrawTransaction(env.ctx, msg))
+		require.Len(t, hooks.confirmed, 1)
+		require.Equal(t, hooks.requested[0].Txid, hooks.confirmed[0].Txid)
+
+		// a fork block with more work at the tip height
+		best := env.app.BtcBridgeKeeper.GetBestBlockHeader(env.ctx)
+		fork := bitcoin.NewBlock(env.chain.BlockAt(int64(best.Height)-1), int64(best.Height))
+		fork.Header.Bits = 0x1f7fffff
+		bitcoin.Solve(&fork.Header)
+
+		err = env.app.BtcBridgeKeeper.SetBlockHeaders(env.ctx, []*types.BlockHeader{types.NewBlockHeader(&fork.Header, best.Height, 1)})
+		require.NoError(t, err)
+		require.Equal(t, []uint64{best.Height}, hooks.reorgs)
+
+		// the state changes of the hooks are committed on success only
+		supply := env.app.BankKeeper.GetSupply(env.ctx, "hook")
+		if fail {
+			require.True(t, supply.IsZero())
+			require.Len(t, eventsOfType(env.ctx, types.EventTypeHookFailed), 4)
+		} else {
+			require.Equal(t, int64(4), supply.Amount.Int64())
+			require.Empty(t, eventsOfType(env.ctx, types.EventTypeHookFailed))
+		}
+	}
+}
+
+// eventsOfType returns the events of the given type emitted so far
+func eventsOfType(ctx sdk.Context, eventType string) []sdk.Event {
+	events := make([]sdk.Event, 0)
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == eventType {
+			events = append(events, event)
+		}
+	}
+
+	return events
+}
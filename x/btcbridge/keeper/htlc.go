@@ -0,0 +1,277 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+// CreateHTLC locks the tokens of the maker until the HTLC is claimed by the taker or refunded on timeout
+func (k Keeper) CreateHTLC(ctx sdk.Context, msg *types.MsgCreateHTLCRequest) (*types.HTLC, error) {
+	ck, err := k.ChainKeeper(ctx, msg.ChainId)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.TimeoutHeight <= ctx.BlockHeight() {
+		return nil, errorsmod.Wrap(types.ErrInvalidHTLC, "timeout height must be in the future")
+	}
+
+	amount, err := sdk.ParseCoinNormalized(msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	pkScript, err := hex.DecodeString(msg.PkScript)
+	if err != nil {
+		return nil, err
+	}
+
+	maker := sdk.MustAccAddressFromBech32(msg.Sender)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, maker, types.ModuleName, sdk.NewCoins(amount)); err != nil {
+		return nil, err
+	}
+
+	htlc := &types.HTLC{
+		Id:            k.incrementHTLCSequence(ctx),
+		Maker:         msg.Sender,
+		Taker:         msg.Taker,
+		Amount:        amount,
+		HashLock:      msg.HashLock,
+		TimeoutHeight: msg.TimeoutHeight,
+		PkScript:      pkScript,
+		BtcAmount:     msg.BtcAmount,
+		ChainId:       msg.ChainId,
+		Status:        types.HTLCStatus_HTLC_STATUS_OPEN,
+		StartHeight:   ck.GetBestBlockHeader(ctx).Height,
+	}
+
+	k.SetHTLC(ctx, htlc)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventHTLCCreated{
+		Id:            htlc.Id,
+		Maker:         htlc.Maker,
+		Taker:         htlc.Taker,
+		Amount:        htlc.Amount,
+		HashLock:      htlc.HashLock,
+		TimeoutHeight: htlc.TimeoutHeight,
+	}); err != nil {
+		return nil, err
+	}
+
+	return htlc, nil
+}
+
+// ClaimHTLC releases the tokens of the HTLC to the taker, given the preimage of the hash lock
+// and the bitcoin tx paying the expected output, which is verified against the light client of the bridged chain
+// and must be included above the best block on creation of the HTLC.
+// The preimage is revealed by the event, so that the maker can claim the counter leg on bitcoin.
+func (k Keeper) ClaimHTLC(ctx sdk.Context, msg *types.MsgClaimHTLCRequest) error {
+	htlc := k.GetHTLC(ctx, msg.Id)
+	if htlc == nil {
+		return types.ErrHTLCNotFound
+	}
+
+	if htlc.Status != types.HTLCStatus_HTLC_STATUS_OPEN {
+		return types.ErrHTLCNotOpen
+	}
+
+	if ctx.BlockHeight() >= htlc.TimeoutHeight {
+		return types.ErrHTLCExpired
+	}
+
+	if !htlc.VerifyPreimage(msg.Preimage) {
+		return types.ErrInvalidPreimage
+	}
+
+	ck, err := k.ChainKeeper(ctx, htlc.ChainId)
+	if err != nil {
+		return err
+	}
+
+	txBytes, err := base64.StdEncoding.DecodeString(msg.TxBytes)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrInvalidBtcTransaction, err.Error())
+	}
+
+	inclusion, err := ck.VerifyTxInclusion(ctx, txBytes, msg.Blockhash, msg.Proof, uint64(ck.GetParams(ctx).Confirmations))
+	if err != nil {
+		return err
+	}
+
+	// the payments made before the HTLC can not claim it, e.g. an earlier payment to the maker's address
+	if inclusion.Height <= htlc.StartHeight {
+		return errorsmod.Wrapf(types.ErrHTLCPaidTooEarly, "tx included at %d, htlc created at %d", inclusion.Height, htlc.StartHeight)
+	}
+
+	// the first unclaimed output paying the expected script and amount claims the HTLC
+	var output *types.TxOutput
+	for _, out := range inclusion.Outputs {
+		if !bytes.Equal(out.PkScript, htlc.PkScript) || out.Value < 0 || uint64(out.Value) < htlc.BtcAmount {
+			continue
+		}
+
+		if k.IsHTLCOutputClaimed(ctx, htlc.ChainId, inclusion.Txid, out.Vout) {
+			err = types.ErrHTLCOutputClaimed
+			continue
+		}
+
+		output = out
+		break
+	}
+
+	if output == nil {
+		if err != nil {
+			return err
+		}
+
+		return types.ErrHTLCOutputNotFound
+	}
+
+	taker := sdk.MustAccAddressFromBech32(htlc.Taker)
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, taker, sdk.NewCoins(htlc.Amount)); err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Delete(types.HTLCTimeoutKey(htlc.TimeoutHeight, htlc.Id))
+
+	htlc.Status = types.HTLCStatus_HTLC_STATUS_CLAIMED
+	htlc.Preimage = msg.Preimage
+	htlc.ClaimTxid = inclusion.Txid
+	htlc.ClaimVout = output.Vout
+
+	k.SetHTLC(ctx, htlc)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventHTLCClaimed{
+		Id:       htlc.Id,
+		Taker:    htlc.Taker,
+		Preimage: htlc.Preimage,
+		Txid:     htlc.ClaimTxid,
+		Vout:     htlc.ClaimVout,
+	})
+}
+
+// RefundExpiredHTLCs refunds the tokens of the open HTLCs timed out at the current height to the makers.
+// The HTLC failing to be refunded is kept open and retried in the next block.
+func (k Keeper) RefundExpiredHTLCs(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.Iterator(types.HTLCTimeoutKeyPrefix, types.HTLCTimeoutKey(ctx.BlockHeight()+1, 0))
+	defer iterator.Close()
+
+	ids := make([]uint64, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		ids = append(ids, sdk.BigEndianToUint64(key[len(key)-8:]))
+	}
+
+	for _, id := range ids {
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.refundHTLC(cacheCtx, k.GetHTLC(ctx, id)); err != nil {
+			k.Logger(ctx).Error("failed to refund the htlc", "id", id, "error", err)
+			continue
+		}
+
+		write()
+	}
+}
+
+// refundHTLC refunds the tokens of the given open HTLC to the maker
+func (k Keeper) refundHTLC(ctx sdk.Context, htlc *types.HTLC) error {
+	maker := sdk.MustAccAddressFromBech32(htlc.Maker)
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, maker, sdk.NewCoins(htlc.Amount)); err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Delete(types.HTLCTimeoutKey(htlc.TimeoutHeight, htlc.Id))
+
+	htlc.Status = types.HTLCStatus_HTLC_STATUS_REFUNDED
+	k.SetHTLC(ctx, htlc)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventHTLCRefunded{
+		Id:     htlc.Id,
+		Maker:  htlc.Maker,
+		Amount: htlc.Amount,
+	})
+}
+
+// GetHTLC returns the HTLC of the given id, nil if not found
+func (k Keeper) GetHTLC(ctx sdk.Context, id uint64) *types.HTLC {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.HTLCKey(id))
+	if bz == nil {
+		return nil
+	}
+
+	var htlc types.HTLC
+	k.cdc.MustUnmarshal(bz, &htlc)
+
+	return &htlc
+}
+
+// SetHTLC sets the HTLC along with the timeout index if open, or the claimed output if claimed
+func (k Keeper) SetHTLC(ctx sdk.Context, htlc *types.HTLC) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.HTLCKey(htlc.Id), k.cdc.MustMarshal(htlc))
+
+	switch htlc.Status {
+	case types.HTLCStatus_HTLC_STATUS_OPEN:
+		store.Set(types.HTLCTimeoutKey(htlc.TimeoutHeight, htlc.Id), []byte{1})
+	case types.HTLCStatus_HTLC_STATUS_CLAIMED:
+		store.Set(types.HTLCClaimedOutputKey(htlc.ChainId, htlc.ClaimTxid, htlc.ClaimVout), sdk.Uint64ToBigEndian(htlc.Id))
+	}
+
+	if htlc.Id >= k.getHTLCSequence(ctx) {
+		store.Set(types.HTLCSequenceKey, sdk.Uint64ToBigEndian(htlc.Id+1))
+	}
+}
+
+// GetHTLCs returns the HTLCs of the given status, all HTLCs if unspecified
+func (k Keeper) GetHTLCs(ctx sdk.Context, status types.HTLCStatus) []*types.HTLC {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.HTLCKeyPrefix)
+	defer iterator.Close()
+
+	htlcs := make([]*types.HTLC, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var htlc types.HTLC
+		k.cdc.MustUnmarshal(iterator.Value(), &htlc)
+
+		if status == types.HTLCStatus_HTLC_STATUS_UNSPECIFIED || htlc.Status == status {
+			htlcs = append(htlcs, &htlc)
+		}
+	}
+
+	return htlcs
+}
+
+// IsHTLCOutputClaimed returns true if the given bitcoin output of the bridged chain already claimed an HTLC
+func (k Keeper) IsHTLCOutputClaimed(ctx sdk.Context, chainID string, txid string, vout uint32) bool {
+	return ctx.KVStore(k.storeKey).Has(types.HTLCClaimedOutputKey(chainID, txid, vout))
+}
+
+// getHTLCSequence returns the id of the next HTLC
+func (k Keeper) getHTLCSequence(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.HTLCSequenceKey)
+	if bz == nil {
+		return 1
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// incrementHTLCSequence returns the id of the next HTLC and increments the sequence
+func (k Keeper) incrementHTLCSequence(ctx sdk.Context) uint64 {
+	id := k.getHTLCSequence(ctx)
+	ctx.KVStore(k.storeKey).Set(types.HTLCSequenceKey, sdk.Uint64ToBigEndian(id+1))
+
+	return id
+}
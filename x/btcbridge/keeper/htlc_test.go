@@ -0,0 +1,132 @@
+package keeper_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	simapp "github.com/sideprotocol/side/app"
+	"github.com/sideprotocol/side/testutil/bitcoin"
+	"github.com/sideprotocol/side/testutil/sample"
+	"github.com/sideprotocol/side/x/btcbridge/keeper"
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+func TestHTLC(t *testing.T) {
+	env := newDepositTestEnv(t)
+
+	msgServer := keeper.NewMsgServerImpl(env.app.BtcBridgeKeeper)
+	goCtx := sdk.WrapSDKContext(env.ctx)
+
+	maker := sample.AccAddress()
+	taker := sample.AccAddress()
+
+	funds := sdk.NewCoins(sdk.NewInt64Coin(simapp.DefaultBondDenom, 4000))
+	require.NoError(t, env.app.BankKeeper.MintCoins(env.ctx, types.ModuleName, funds))
+	require.NoError(t, env.app.BankKeeper.SendCoinsFromModuleToAccount(env.ctx, types.ModuleName, sdk.MustAccAddressFromBech32(maker), funds))
+
+	// the maker expects the btc paid to its own address
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	addr, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(key.PubKey().SerializeCompressed()), env.chain.Params)
+	require.NoError(t, err)
+	pkScript, err := txscript.PayToAddrScript(addr)
+	require.NoError(t, err)
+
+	preimage := []byte("the preimage of the atomic swap")
+	hash := sha256.Sum256(preimage)
+	hashLock := hex.EncodeToString(hash[:])
+
+	createHTLC := func(btcAmount uint64, timeoutHeight int64) uint64 {
+		res, err := msgServer.CreateHTLC(goCtx, types.NewMsgCreateHTLCRequest(maker, taker, "1000"+simapp.DefaultBondDenom, hashLock, timeoutHeight, hex.EncodeToString(pkScript), btcAmount))
+		require.NoError(t, err)
+
+		return res.Id
+	}
+
+	_, err = msgServer.CreateHTLC(goCtx, types.NewMsgCreateHTLCRequest(maker, taker, "1000"+simapp.DefaultBondDenom, hashLock, env.ctx.BlockHeight(), hex.EncodeToString(pkScript), 50000))
+	require.ErrorIs(t, err, types.ErrInvalidHTLC)
+
+	id := createHTLC(50000, 10)
+	unpaidID := createHTLC(60000, 10)
+	reusedID := createHTLC(50000, 10)
+	require.Equal(t, uint64(1), id)
+	require.Equal(t, int64(1000), env.balance(maker, simapp.DefaultBondDenom).Int64())
+
+	// the taker pays the maker on bitcoin
+	funding := env.chain.MineBlock().Transactions[0]
+	tx := bitcoin.NewTx(wire.NewOutPoint(&chainhash.Hash{}, 0), nil, wire.NewTxOut(10000, env.vaultPkScript), wire.NewTxOut(50000, pkScript))
+	tx.TxIn[0].PreviousOutPoint.Hash = funding.TxHash()
+
+	block, _ := env.mine(t, tx)
+
+	var buf bytes.Buffer
+	require.NoError(t, tx.Serialize(&buf))
+
+	hashes := make([]*chainhash.Hash, 0, len(block.Transactions))
+	for _, blockTx := range block.Transactions {
+		txHash := blockTx.TxHash()
+		hashes = append(hashes, &txHash)
+	}
+
+	claim := func(id uint64, preimage []byte) error {
+		_, err := msgServer.ClaimHTLC(goCtx, types.NewMsgClaimHTLCRequest(sample.AccAddress(), id, hex.EncodeToString(preimage), block.BlockHash().String(), base64.StdEncoding.EncodeToString(buf.Bytes()), types.GetMerkleProof(hashes, 1)))
+		return err
+	}
+
+	require.ErrorIs(t, claim(id, []byte("wrong preimage")), types.ErrInvalidPreimage)
+	require.ErrorIs(t, claim(unpaidID, preimage), types.ErrHTLCOutputNotFound)
+
+	// anyone may claim for the taker, revealing the preimage
+	require.NoError(t, claim(id, preimage))
+	require.Equal(t, int64(1000), env.balance(taker, simapp.DefaultBondDenom).Int64())
+
+	claimed := typedEvents[*types.EventHTLCClaimed](t, env.ctx)
+	require.Len(t, claimed, 1)
+	require.Equal(t, hex.EncodeToString(preimage), claimed[0].Preimage)
+	require.Equal(t, uint32(1), claimed[0].Vout)
+
+	require.ErrorIs(t, claim(id, preimage), types.ErrHTLCNotOpen)
+
+	// the output already claimed an HTLC
+	require.ErrorIs(t, claim(reusedID, preimage), types.ErrHTLCOutputClaimed)
+
+	// the payment mined before the HTLC was created can not claim it
+	staleID := createHTLC(50000, 10)
+	require.Equal(t, env.app.BtcBridgeKeeper.GetBestBlockHeader(env.ctx).Height, env.app.BtcBridgeKeeper.GetHTLC(env.ctx, staleID).StartHeight)
+	require.ErrorIs(t, claim(staleID, preimage), types.ErrHTLCPaidTooEarly)
+
+	// the open HTLCs are refunded on timeout
+	env.ctx = env.ctx.WithBlockHeight(10)
+	goCtx = sdk.WrapSDKContext(env.ctx)
+
+	require.ErrorIs(t, claim(unpaidID, preimage), types.ErrHTLCExpired)
+
+	env.app.BtcBridgeKeeper.RefundExpiredHTLCs(env.ctx)
+	require.Equal(t, int64(3000), env.balance(maker, simapp.DefaultBondDenom).Int64())
+	require.Len(t, typedEvents[*types.EventHTLCRefunded](t, env.ctx), 3)
+
+	res, err := env.app.BtcBridgeKeeper.QueryHTLCs(goCtx, &types.QueryHTLCsRequest{Status: types.HTLCStatus_HTLC_STATUS_REFUNDED})
+	require.NoError(t, err)
+	require.Len(t, res.Htlcs, 3)
+
+	htlc, err := env.app.BtcBridgeKeeper.QueryHTLC(goCtx, &types.QueryHTLCRequest{Id: id})
+	require.NoError(t, err)
+	require.Equal(t, types.HTLCStatus_HTLC_STATUS_CLAIMED, htlc.Htlc.Status)
+	require.Equal(t, tx.TxHash().String(), htlc.Htlc.ClaimTxid)
+	require.NoError(t, htlc.Htlc.Validate())
+
+	// the refunded HTLCs are not refunded again
+	env.app.BtcBridgeKeeper.RefundExpiredHTLCs(env.ctx.WithBlockHeight(11))
+	require.Equal(t, int64(3000), env.balance(maker, simapp.DefaultBondDenom).Int64())
+}
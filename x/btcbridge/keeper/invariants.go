@@ -0,0 +1,153 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+// RegisterInvariants registers all btcbridge invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "reserves", ReservesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "escrow", EscrowInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "utxos", UTXOsInvariant(k))
+}
+
+// ReservesInvariant checks that the supply of the btc voucher token is backed by the reserves of the btc vaults,
+// for each bridged chain. The vouchers escrowed by the in-flight withdrawals are backed by the utxos locked by them,
+// and the network fees of the recovery sweeps not covered by the collected fees are deducted from the supply.
+func ReservesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		params := k.GetParams(ctx)
+		if len(params.BtcVoucherDenom) == 0 {
+			// the module is not initialized yet, e.g. crisis genesis runs ahead of the module genesis
+			return sdk.FormatInvariant(types.ModuleName, "reserves", "module not initialized\n"), false
+		}
+
+		var (
+			msg    string
+			broken bool
+		)
+
+		for _, chainID := range params.ChainIDs() {
+			ck := k.WithChain(chainID)
+
+			supply := k.bankKeeper.GetSupply(ctx, ck.GetParams(ctx).BtcVoucherDenom)
+			reserve := ck.GetBtcReserve(ctx)
+			pending := ck.GetPendingWithdrawals(ctx)
+			deficit := ck.GetRecoveryDeficit(ctx)
+
+			broken = broken || supply.Amount.GT(sdk.NewIntFromUint64(reserve+deficit))
+
+			msg += fmt.Sprintf(
+				"%s\tvoucher supply: %s\n\tvault reserves: %d\n\tpending withdrawals: %d\n\trecovery deficit: %d\n",
+				chainLabel(chainID), supply, reserve, pending, deficit,
+			)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "reserves", msg), broken
+	}
+}
+
+// EscrowInvariant checks that the btc voucher token escrowed by the module covers the in-flight withdrawals,
+// for each bridged chain
+func EscrowInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		params := k.GetParams(ctx)
+		if len(params.BtcVoucherDenom) == 0 {
+			return sdk.FormatInvariant(types.ModuleName, "escrow", "module not initialized\n"), false
+		}
+
+		var (
+			msg    string
+			broken bool
+		)
+
+		for _, chainID := range params.ChainIDs() {
+			ck := k.WithChain(chainID)
+
+			escrowed := k.bankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(types.ModuleName), ck.GetParams(ctx).BtcVoucherDenom)
+			pending := ck.GetPendingWithdrawals(ctx)
+
+			broken = broken || escrowed.Amount.LT(sdk.NewIntFromUint64(pending))
+
+			msg += fmt.Sprintf(
+				"%s\tescrowed vouchers: %s\n\tpending withdrawals: %d\n",
+				chainLabel(chainID), escrowed, pending,
+			)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "escrow", msg), broken
+	}
+}
+
+// UTXOsInvariant checks that each utxo is indexed by its owner and no owner index is left behind,
+// for each bridged chain
+func UTXOsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		for _, chainID := range k.GetParams(ctx).ChainIDs() {
+			chainMsg, chainBroken := utxosInvariant(ctx, k.WithChain(chainID))
+			if chainBroken {
+				msg += chainLabel(chainID) + chainMsg
+			}
+
+			broken = broken || chainBroken
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "utxos", msg), broken
+	}
+}
+
+// utxosInvariant checks the utxo indexes of the bridged chain of the given keeper
+func utxosInvariant(ctx sdk.Context, k Keeper) (string, bool) {
+	store := k.store(ctx)
+
+	var (
+		msg       string
+		utxos     int
+		indexes   int
+		unindexed int
+	)
+
+	k.IterateAllUTXOs(ctx, func(utxo *types.UTXO) (stop bool) {
+		utxos++
+
+		if !store.Has(types.BtcOwnerUtxoKey(utxo.Address, utxo.Txid, utxo.Vout)) {
+			unindexed++
+			msg += fmt.Sprintf("\tutxo %s:%d is not indexed by the owner %s\n", utxo.Txid, utxo.Vout, utxo.Address)
+		}
+
+		return false
+	})
+
+	iterator := sdk.KVStorePrefixIterator(store, types.BtcOwnerUtxoKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		indexes++
+	}
+
+	broken := unindexed != 0 || indexes != utxos
+	if indexes != utxos {
+		msg += fmt.Sprintf("\towner indexes: %d, utxos: %d\n", indexes, utxos)
+	}
+
+	return msg, broken
+}
+
+// chainLabel returns the label of the given bridged chain in the invariant messages
+func chainLabel(chainID string) string {
+	if len(chainID) == 0 {
+		return "bitcoin:\n"
+	}
+
+	return chainID + ":\n"
+}
@@ -3,9 +3,13 @@ package keeper
 import (
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/sideprotocol/side/x/btcbridge/types"
@@ -19,7 +23,16 @@ type (
 		storeKey storetypes.StoreKey
 		memKey   storetypes.StoreKey
 
-		bankKeeper types.BankKeeper
+		bankKeeper     types.BankKeeper
+		distrKeeper    types.DistrKeeper
+		transferKeeper types.TransferKeeper
+		swapKeeper     types.SwapKeeper
+
+		hooks    types.BtcBridgeHooks
+		screener types.AddressScreener
+
+		// the address capable of executing the governance messages, typically the gov module account
+		authority string
 	}
 )
 
@@ -29,36 +42,87 @@ func NewKeeper(
 	memKey storetypes.StoreKey,
 
 	bankKeeper types.BankKeeper,
+	distrKeeper types.DistrKeeper,
+	transferKeeper types.TransferKeeper,
+	swapKeeper types.SwapKeeper,
+	authority string,
 ) *Keeper {
 	return &Keeper{
 		cdc:            cdc,
 		storeKey:       storeKey,
 		memKey:         memKey,
 		bankKeeper:     bankKeeper,
+		distrKeeper:    distrKeeper,
+		transferKeeper: transferKeeper,
+		swapKeeper:     swapKeeper,
+		authority:      authority,
 		BaseUTXOKeeper: *NewBaseUTXOKeeper(cdc, storeKey),
 	}
 }
 
+// GetAuthority returns the governance account of the module
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
+// WithChain returns the keeper scoped to the given bridged chain, the empty chain id stands for bitcoin
+func (k Keeper) WithChain(chainID string) Keeper {
+	k.chainID = chainID
+
+	return k
+}
+
+// ChainKeeper returns the keeper scoped to the given bridged chain if the chain exists
+func (k Keeper) ChainKeeper(ctx sdk.Context, chainID string) (Keeper, error) {
+	if !k.GetParams(ctx).HasChain(chainID) {
+		return Keeper{}, errorsmod.Wrapf(types.ErrUnknownChain, "chain: %s", chainID)
+	}
+
+	return k.WithChain(chainID), nil
+}
+
+// ChainID returns the bridged chain to which the keeper is scoped, empty for bitcoin
+func (k Keeper) ChainID() string {
+	return k.chainID
+}
+
+// store returns the store of the bridged chain
+func (k Keeper) store(ctx sdk.Context) sdk.KVStore {
+	return chainStore(ctx, k.storeKey, k.chainID)
+}
+
+// SetParams sets the params of the module.
+// The params are shared by all bridged chains, so they can only be set by the keeper of bitcoin.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	if len(k.chainID) != 0 {
+		panic("params can only be set by the keeper of bitcoin")
+	}
+
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshal(&params)
 	store.Set(types.ParamsStoreKey, bz)
 }
 
+// GetParams returns the params of the module scoped to the bridged chain of the keeper
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	store := ctx.KVStore(k.storeKey)
 	var params types.Params
 	bz := store.Get(types.ParamsStoreKey)
 	k.cdc.MustUnmarshal(bz, &params)
-	return params
+	return params.ForChain(k.chainID)
+}
+
+// ChainCfg returns the params of the bitcoin network of the module
+func (k Keeper) ChainCfg(ctx sdk.Context) *chaincfg.Params {
+	return k.GetParams(ctx).ChainCfg()
 }
 
 func (k Keeper) GetBestBlockHeader(ctx sdk.Context) *types.BlockHeader {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	var blockHeader types.BlockHeader
 	bz := store.Get(types.BtcBestBlockHeaderKey)
 	k.cdc.MustUnmarshal(bz, &blockHeader)
@@ -66,24 +130,20 @@ func (k Keeper) GetBestBlockHeader(ctx sdk.Context) *types.BlockHeader {
 }
 
 func (k Keeper) SetBestBlockHeader(ctx sdk.Context, header *types.BlockHeader) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	bz := k.cdc.MustMarshal(header)
 	store.Set(types.BtcBestBlockHeaderKey, bz)
 }
 
 func (k Keeper) SetBlockHeaders(ctx sdk.Context, blockHeader []*types.BlockHeader) error {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
+	chainCfg := k.ChainCfg(ctx)
 	// check if the previous block header exists
 	best := k.GetBestBlockHeader(ctx)
 	for _, header := range blockHeader {
 
-		// check the block header sanity
-		err := blockchain.CheckBlockHeaderSanity(
-			HeaderConvert(header),
-			sdk.GetConfig().GetBtcChainCfg().PowLimit,
-			blockchain.NewMedianTime(),
-			blockchain.BFNone,
-		)
+		// check the block header sanity, including the proof of work of the network
+		err := types.CheckBlockHeaderSanity(chainCfg, HeaderConvert(header))
 		if err != nil {
 			return err
 		}
@@ -105,6 +165,17 @@ func (k Keeper) SetBlockHeaders(ctx sdk.Context, blockHeader []*types.BlockHeade
 				return types.ErrForkedBlockHeader
 			}
 
+			if err := ctx.EventManager().EmitTypedEvent(&types.EventReorg{
+				ForkHeight:    header.Height,
+				OldBestHash:   best.Hash,
+				OldBestHeight: best.Height,
+			}); err != nil {
+				return err
+			}
+
+			k.afterReorg(ctx, header.Height, best.Height)
+			k.dropReorganizedPendingDeposits(ctx, header.Height)
+
 			// remove the block headers after the forked block header
 			// and consider the forked block header as the best block header
 			for i := header.Height; i <= best.Height; i++ {
@@ -127,13 +198,19 @@ func (k Keeper) SetBlockHeaders(ctx sdk.Context, blockHeader []*types.BlockHeade
 	if len(blockHeader) > 0 {
 		// set the best block header
 		k.SetBestBlockHeader(ctx, best)
+
+		return ctx.EventManager().EmitTypedEvent(&types.EventHeadersAccepted{
+			StartHeight: blockHeader[0].Height,
+			EndHeight:   best.Height,
+			BestHash:    best.Hash,
+		})
 	}
 
 	return nil
 }
 
 func (k Keeper) GetBlockHeader(ctx sdk.Context, hash string) *types.BlockHeader {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	var blockHeader types.BlockHeader
 	bz := store.Get(types.BtcBlockHeaderHashKey(hash))
 	k.cdc.MustUnmarshal(bz, &blockHeader)
@@ -141,13 +218,13 @@ func (k Keeper) GetBlockHeader(ctx sdk.Context, hash string) *types.BlockHeader
 }
 
 func (k Keeper) GetBlockHashByHeight(ctx sdk.Context, height uint64) string {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	hash := store.Get(types.BtcBlockHeaderHeightKey(height))
 	return string(hash)
 }
 
 func (k Keeper) GetBlockHeaderByHeight(ctx sdk.Context, height uint64) *types.BlockHeader {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	hash := store.Get(types.BtcBlockHeaderHeightKey(height))
 	return k.GetBlockHeader(ctx, string(hash))
 }
@@ -164,7 +241,7 @@ func (k Keeper) GetAllBlockHeaders(ctx sdk.Context) []*types.BlockHeader {
 
 // IterateBlockHeaders iterates through all block headers
 func (k Keeper) IterateBlockHeaders(ctx sdk.Context, process func(header types.BlockHeader) (stop bool)) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	iterator := sdk.KVStorePrefixIterator(store, types.BtcBlockHeaderHashPrefix)
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
@@ -175,3 +252,33 @@ func (k Keeper) IterateBlockHeaders(ctx sdk.Context, process func(header types.B
 		}
 	}
 }
+
+// verifyTxProof verifies that the given tx is included in the block of the given header.
+// The tx out proof in the bitcoind gettxoutproof format takes precedence over the merkle proof.
+func (k Keeper) verifyTxProof(ctx sdk.Context, header *types.BlockHeader, txHash *chainhash.Hash, proof []string, txOutProof string) error {
+	if len(txOutProof) != 0 {
+		return types.VerifyTxOutProof(txOutProof, txHash, header.Hash)
+	}
+
+	root, err := chainhash.NewHashFromStr(header.MerkleRoot)
+	if err != nil {
+		return err
+	}
+
+	if !types.VerifyMerkleProof(proof, txHash, root) {
+		k.Logger(ctx).Error("Invalid merkle proof", "txhash", txHash, "root", root, "proof", proof)
+		return types.ErrTransactionNotIncluded
+	}
+
+	return nil
+}
+
+// chainStore returns the store of the given bridged chain, which is prefixed by the chain id except for bitcoin
+func chainStore(ctx sdk.Context, storeKey storetypes.StoreKey, chainID string) sdk.KVStore {
+	store := ctx.KVStore(storeKey)
+	if len(chainID) == 0 {
+		return store
+	}
+
+	return prefix.NewStore(store, types.ChainKey(chainID))
+}
@@ -3,7 +3,8 @@ package keeper
 import (
 	"bytes"
 	"encoding/base64"
-	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
 
 	"github.com/btcsuite/btcd/blockchain"
 	"github.com/btcsuite/btcd/btcutil"
@@ -19,12 +20,18 @@ func (k Keeper) ProcessBitcoinDepositTransaction(ctx sdk.Context, msg *types.Msg
 
 	ctx.Logger().Info("accept bitcoin deposit tx", "blockhash", msg.Blockhash)
 
-	param := k.GetParams(ctx)
-
-	if !param.IsAuthorizedSender(msg.Sender) {
+	if !k.GetParams(ctx).IsAuthorizedSender(msg.Sender) {
 		return types.ErrSenderAddressNotAuthorized
 	}
 
+	return k.processDeposit(ctx, msg)
+}
+
+// processDeposit mints the deposit submitted by the authorized relayer,
+// or registers it as pending if its block is not deep enough
+func (k Keeper) processDeposit(ctx sdk.Context, msg *types.MsgSubmitDepositTransactionRequest) error {
+	param := k.GetParams(ctx)
+
 	header := k.GetBlockHeader(ctx, msg.Blockhash)
 	// Check if block confirmed
 	if header == nil || header.Height == 0 {
@@ -32,10 +39,6 @@ func (k Keeper) ProcessBitcoinDepositTransaction(ctx sdk.Context, msg *types.Msg
 	}
 
 	best := k.GetBestBlockHeader(ctx)
-	// Check if the block is confirmed
-	if best.Height-header.Height < uint64(param.Confirmations) {
-		return types.ErrNotConfirmed
-	}
 	// Check if the block is within the acceptable depth
 	// if best.Height-header.Height > param.MaxAcceptableBlockDepth {
 	// 	return types.ErrExceedMaxAcceptanceDepth
@@ -44,7 +47,7 @@ func (k Keeper) ProcessBitcoinDepositTransaction(ctx sdk.Context, msg *types.Msg
 	// Decode the base64 transaction
 	txBytes, err := base64.StdEncoding.DecodeString(msg.TxBytes)
 	if err != nil {
-		fmt.Println("Error decoding transaction from base64:", err)
+		k.Logger(ctx).Debug("failed to decode the deposit tx", "error", err)
 		return err
 	}
 
@@ -52,7 +55,7 @@ func (k Keeper) ProcessBitcoinDepositTransaction(ctx sdk.Context, msg *types.Msg
 	var tx wire.MsgTx
 	err = tx.Deserialize(bytes.NewReader(txBytes))
 	if err != nil {
-		fmt.Println("Error deserializing transaction:", err)
+		k.Logger(ctx).Debug("failed to deserialize the deposit tx", "error", err)
 		return err
 	}
 	uTx := btcutil.NewTx(&tx)
@@ -62,45 +65,40 @@ func (k Keeper) ProcessBitcoinDepositTransaction(ctx sdk.Context, msg *types.Msg
 
 	// Validate the transaction
 	if err := blockchain.CheckTransactionSanity(uTx); err != nil {
-		fmt.Println("Transaction is not valid:", err)
+		k.Logger(ctx).Debug("invalid deposit tx", "error", err)
 		return err
 	}
 
-	// Decode the previous transaction
-	prevTxBytes, err := base64.StdEncoding.DecodeString(msg.PrevTxBytes)
-	if err != nil {
-		fmt.Println("Error decoding transaction from base64:", err)
-		return err
-	}
+	// the coinbase deposit spends no previous tx
+	coinbase := blockchain.IsCoinBase(uTx)
+
+	chainCfg := param.ChainCfg()
+
+	// the bitcoin addresses spent by all inputs, which are screened,
+	// along with the number of the inputs whose previous txs are not submitted by the relayer
+	var (
+		btcSenders []string
+		unresolved int
+	)
 
-	// Create a new transaction
 	var prevMsgTx wire.MsgTx
-	err = prevMsgTx.Deserialize(bytes.NewReader(prevTxBytes))
-	if err != nil {
-		fmt.Println("Error deserializing transaction:", err)
-		return err
-	}
+	if !coinbase {
+		if err := k.decodePrevTx(msg.PrevTxBytes, uTx, &prevMsgTx); err != nil {
+			return err
+		}
 
-	prevTx := btcutil.NewTx(&prevMsgTx)
-	if len(prevTx.MsgTx().TxOut) < 1 {
-		return types.ErrInvalidBtcTransaction
-	}
-	// Validate the transaction
-	if err := blockchain.CheckTransactionSanity(prevTx); err != nil {
-		fmt.Println("Transaction is not valid:", err)
-		return err
-	}
+		prevTxs, err := k.decodeInputPrevTxs(msg.InputPrevTxs, &prevMsgTx)
+		if err != nil {
+			return err
+		}
 
-	if uTx.MsgTx().TxIn[0].PreviousOutPoint.Hash.String() != prevTx.Hash().String() {
-		return types.ErrInvalidBtcTransaction
+		btcSenders, unresolved = types.ExtractInputAddrs(&tx, prevTxs, chainCfg)
 	}
 
-	chainCfg := sdk.GetConfig().GetBtcChainCfg()
-
-	// Extract the recipient address
-	recipient, err := types.ExtractRecipientAddr(&tx, &prevMsgTx, param.Vaults, chainCfg)
-	if err != nil {
-		return err
+	// Extract the recipient from the memo, fallback to the recipient address
+	recipient, memo := k.extractDepositRecipient(ctx, &tx, &prevMsgTx, param.Vaults, chainCfg)
+	if len(recipient) == 0 {
+		return types.ErrInvalidDepositTransaction
 	}
 
 	// if pk.Class() != txscript.WitnessV1TaprootTy || pk.Class() != txscript.WitnessV0PubKeyHashTy || pk.Class() != txscript.WitnessV0ScriptHashTy {
@@ -109,27 +107,76 @@ func (k Keeper) ProcessBitcoinDepositTransaction(ctx sdk.Context, msg *types.Msg
 	// }
 
 	// check if the proof is valid
-	root, err := chainhash.NewHashFromStr(header.MerkleRoot)
-	if err != nil {
+	txhash := uTx.MsgTx().TxHash()
+	if err := k.verifyTxProof(ctx, header, &txhash, msg.Proof, msg.TxOutProof); err != nil {
 		return err
 	}
 
-	txhash := uTx.MsgTx().TxHash()
-	if !types.VerifyMerkleProof(msg.Proof, &txhash, root) {
-		k.Logger(ctx).Error("Invalid merkle proof", "txhash", tx, "root", root, "proof", msg.Proof)
-		return types.ErrTransactionNotIncluded
+	// the tx is excluded from minting, e.g. a withdrawal tx paying change to the vault
+	if k.existsInHistory(ctx, txhash.String()) {
+		return types.ErrTransactionAlreadyMinted
+	}
+
+	// the deposit whose block is not deep enough for the deposited amount, or which is an immature coinbase,
+	// is registered as pending, and minted in the end block once confirmed
+	required := param.DepositConfirmations(&tx, chainCfg)
+	if best.Height-header.Height < uint64(required) {
+		return k.addPendingDeposit(ctx, msg, txhash.String(), header, required, coinbase)
 	}
 
+	k.removePendingDeposit(ctx, txhash.String())
+
+	// the deposit rejected by the screening is quarantined instead of being minted
+	btcSender := ""
+	if sender, err := types.ExtractSenderAddr(&tx, &prevMsgTx, chainCfg); err == nil {
+		btcSender = sender.EncodeAddress()
+	}
+
+	// the deposit with the inputs which can not be screened is quarantined as well
+	screenErr := k.screenDeposit(ctx, txhash.String(), btcSenders, recipient)
+	if screenErr == nil && unresolved > 0 {
+		screenErr = errorsmod.Wrapf(types.ErrInputsNotScreened, "%d inputs spend unknown txs", unresolved)
+	}
+
+	var quarantine *types.QuarantinedDeposit
+	if err := screenErr; err != nil {
+		quarantine = &types.QuarantinedDeposit{
+			ChainId:   k.chainID,
+			Txid:      txhash.String(),
+			Recipient: recipient,
+			BtcSender: btcSender,
+			Reason:    err.Error(),
+			Status:    types.QuarantineStatus_QUARANTINE_STATUS_HELD,
+		}
+	}
+
+	// total minted voucher token to be routed by the memo action
+	minted := sdk.Coin{Denom: param.BtcVoucherDenom, Amount: sdk.ZeroInt()}
+
+	// number of the vault outputs which are newly credited or credited before
+	credited, alreadyCredited := 0, 0
+
+	// number of the rune vault outputs, which are not supported to be credited yet
+	runeOutputs := 0
+
+	// the inscriptions and runes carried by the outputs, whose utxos are excluded from the btc coin selection
+	assets := types.DetectOutputAssets(uTx.MsgTx())
+
 	// mint voucher token and save utxo if the receiver is a vault address
 	for i, out := range uTx.MsgTx().TxOut {
-		// check if the output is a valid address
+		// skip the memo output
+		if types.IsOpReturnScript(out.PkScript) {
+			continue
+		}
+
+		// skip the output which is not a standard address
 		pks, err := txscript.ParsePkScript(out.PkScript)
 		if err != nil {
-			return err
+			continue
 		}
 		addr, err := pks.Address(chainCfg)
 		if err != nil {
-			return err
+			continue
 		}
 		// check if the receiver is one of the voucher addresses
 		vault := types.SelectVaultByBitcoinAddress(param.Vaults, addr.EncodeAddress())
@@ -137,47 +184,157 @@ func (k Keeper) ProcessBitcoinDepositTransaction(ctx sdk.Context, msg *types.Msg
 			continue
 		}
 
+		// each vault output is minted only once
+		if k.IsOutputMinted(ctx, txhash.String(), uint64(i)) {
+			alreadyCredited++
+			continue
+		}
+
 		// mint the voucher token by asset type and save utxos
 		// skip if the asset type of the sender address is unspecified
 		switch vault.AssetType {
 		case types.AssetType_ASSET_TYPE_BTC:
-			err := k.mintBTC(ctx, uTx, header.Height, recipient.EncodeAddress(), vault, out, i, param.BtcVoucherDenom)
+			if quarantine != nil {
+				k.holdBTC(ctx, uTx, header.Height, vault, out, i, assets[i])
+
+				quarantine.Vouts = append(quarantine.Vouts, uint64(i))
+				quarantine.Amount += uint64(out.Value)
+				credited++
+
+				continue
+			}
+
+			err := k.mintBTC(ctx, uTx, header.Height, recipient, vault, out, i, param.BtcVoucherDenom, assets[i])
 			if err != nil {
 				return err
 			}
+
+			minted.Amount = minted.Amount.AddRaw(out.Value)
+			credited++
 		case types.AssetType_ASSET_TYPE_RUNE:
-			k.mintRUNE(ctx, uTx, header.Height, recipient.EncodeAddress(), vault, out, i, "rune")
+			runeOutputs++
+		}
+	}
+
+	if credited == 0 && alreadyCredited > 0 {
+		return types.ErrTransactionAlreadyMinted
+	}
+
+	if credited == 0 {
+		if runeOutputs > 0 {
+			return errorsmod.Wrap(types.ErrInvalidDepositTransaction, "rune deposits are not supported")
 		}
+
+		return errorsmod.Wrap(types.ErrInvalidDepositTransaction, "no vault output to be credited")
+	}
+
+	if quarantine != nil && len(quarantine.Vouts) > 0 {
+		return k.quarantineDeposit(ctx, quarantine)
+	}
+
+	return k.creditDeposit(ctx, txhash.String(), recipient, memo, minted)
+}
+
+// decodePrevTx decodes the tx spent by the first input of the given deposit tx
+func (k Keeper) decodePrevTx(prevTxBase64 string, uTx *btcutil.Tx, prevMsgTx *wire.MsgTx) error {
+	// Decode the previous transaction
+	prevTxBytes, err := base64.StdEncoding.DecodeString(prevTxBase64)
+	if err != nil {
+		return err
+	}
+
+	// Create a new transaction
+	err = prevMsgTx.Deserialize(bytes.NewReader(prevTxBytes))
+	if err != nil {
+		return err
+	}
+
+	prevTx := btcutil.NewTx(prevMsgTx)
+	if len(prevTx.MsgTx().TxOut) < 1 {
+		return types.ErrInvalidBtcTransaction
+	}
+	// Validate the transaction
+	if err := blockchain.CheckTransactionSanity(prevTx); err != nil {
+		return err
+	}
+
+	if uTx.MsgTx().TxIn[0].PreviousOutPoint.Hash.String() != prevTx.Hash().String() {
+		return types.ErrInvalidBtcTransaction
 	}
 
 	return nil
 }
 
-func (k Keeper) mintBTC(ctx sdk.Context, uTx *btcutil.Tx, height uint64, sender string, vault *types.Vault, out *wire.TxOut, vout int, denom string) error {
+// decodeInputPrevTxs decodes the txs spent by the inputs other than the first one,
+// which are returned with the given tx spent by the first input keyed by the tx hash
+func (k Keeper) decodeInputPrevTxs(inputPrevTxs []string, prevMsgTx *wire.MsgTx) (map[chainhash.Hash]*wire.MsgTx, error) {
+	prevTxs := map[chainhash.Hash]*wire.MsgTx{prevMsgTx.TxHash(): prevMsgTx}
 
-	// save the hash of the transaction to prevent double minting
-	hash := uTx.Hash().String()
-	if k.existsInHistory(ctx, hash) {
-		return types.ErrTransactionAlreadyMinted
+	for _, encoded := range inputPrevTxs {
+		txBytes, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, err
+		}
+
+		var tx wire.MsgTx
+		if err := tx.Deserialize(bytes.NewReader(txBytes)); err != nil {
+			return nil, err
+		}
+
+		prevTxs[tx.TxHash()] = &tx
 	}
-	k.addToMintHistory(ctx, hash)
 
-	// mint the voucher token
-	if len(denom) == 0 {
-		denom = "sat"
+	return prevTxs, nil
+}
+
+// creditDeposit credits the recipient with the voucher token minted by the given deposit tx after deducting the bridge fee,
+// and executes the deposit action of the memo if any
+func (k Keeper) creditDeposit(ctx sdk.Context, txid string, recipient string, memo *types.DepositMemo, minted sdk.Coin) error {
+	if !minted.IsPositive() {
+		return nil
+	}
+
+	fee := sdk.NewCoin(minted.Denom, k.GetParams(ctx).FeeSchedule(types.AssetType_ASSET_TYPE_BTC).DepositFee(minted.Amount))
+	if err := k.collectFee(ctx, types.BridgeOperation_BRIDGE_OPERATION_DEPOSIT, txid, fee); err != nil {
+		return err
+	}
+
+	credit := minted.Sub(fee)
+	if !credit.IsPositive() {
+		return nil
 	}
-	coins := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(out.Value)))
 
-	receipient, err := sdk.AccAddressFromBech32(sender)
+	receipient, err := sdk.AccAddressFromBech32(recipient)
 	if err != nil {
 		return err
 	}
 
-	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receipient, sdk.NewCoins(credit)); err != nil {
 		return err
 	}
 
-	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receipient, coins); err != nil {
+	if memo != nil && memo.HasAction() {
+		k.executeDepositAction(ctx, memo, credit)
+	}
+
+	k.afterDepositMinted(ctx, receipient, txid, credit)
+
+	return nil
+}
+
+func (k Keeper) mintBTC(ctx sdk.Context, uTx *btcutil.Tx, height uint64, sender string, vault *types.Vault, out *wire.TxOut, vout int, denom string, assets types.OutputAssets) error {
+
+	// save the outpoint to prevent double minting
+	k.markOutputMinted(ctx, uTx.Hash().String(), uint64(vout))
+
+	// mint the voucher token
+	if len(denom) == 0 {
+		denom = "sat"
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(out.Value)))
+
+	// the voucher token is credited to the recipient once all outputs are minted, less the bridge fee
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
 		return err
 	}
 
@@ -188,28 +345,112 @@ func (k Keeper) mintBTC(ctx sdk.Context, uTx *btcutil.Tx, height uint64, sender
 		PubKeyScript: out.PkScript,
 		Height:       height,
 		Address:      vault.Address,
-		IsCoinbase:   false,
+		IsCoinbase:   blockchain.IsCoinBase(uTx),
 		IsLocked:     false,
+		Inscriptions: assets.Inscriptions,
+		Runes:        assets.Runes,
 	}
 
 	k.saveUTXO(ctx, &utxo)
+	k.emitUTXOTagged(ctx, &utxo)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventDepositMinted{
+		Txid:         utxo.Txid,
+		Vout:         utxo.Vout,
+		VaultAddress: vault.Address,
+		Recipient:    sender,
+		Amount:       coins[0],
+		Height:       height,
+	})
+}
+
+// holdBTC saves the vault output of the quarantined deposit as a locked utxo without minting the voucher token
+func (k Keeper) holdBTC(ctx sdk.Context, uTx *btcutil.Tx, height uint64, vault *types.Vault, out *wire.TxOut, vout int, assets types.OutputAssets) {
+	// save the outpoint to prevent double minting
+	k.markOutputMinted(ctx, uTx.Hash().String(), uint64(vout))
+
+	utxo := &types.UTXO{
+		Txid:         uTx.Hash().String(),
+		Vout:         uint64(vout),
+		Amount:       uint64(out.Value),
+		PubKeyScript: out.PkScript,
+		Height:       height,
+		Address:      vault.Address,
+		IsCoinbase:   blockchain.IsCoinBase(uTx),
+		IsLocked:     true,
+		Inscriptions: assets.Inscriptions,
+		Runes:        assets.Runes,
+	}
+
+	k.saveUTXO(ctx, utxo)
+	k.emitUTXOTagged(ctx, utxo)
+}
+
+// TagUTXO sets the inscriptions and runes carried by the given vault utxo, which replace the detected ones.
+// The assets moved to the vault by the inputs of the deposit tx can not be detected without an indexer,
+// so that they are tagged explicitly to be excluded from the coin selection; clearing the tags releases the utxo.
+func (k Keeper) TagUTXO(ctx sdk.Context, hash string, vout uint64, inscriptions uint32, runes []*types.RuneBalance) error {
+	if !k.HasUTXO(ctx, hash, vout) {
+		return types.ErrUTXODoesNotExist
+	}
+
+	// the utxo being spent is not retagged
+	utxo := k.GetUTXO(ctx, hash, vout)
+	if utxo.IsLocked {
+		return types.ErrUTXOLocked
+	}
+
+	utxo.Inscriptions = inscriptions
+	utxo.Runes = runes
+
+	k.saveUTXO(ctx, utxo)
+	k.emitUTXOTagged(ctx, utxo)
 
 	return nil
 }
 
-func (k Keeper) mintRUNE(ctx sdk.Context, uTx *btcutil.Tx, height uint64, sender string, vault *types.Vault, out *wire.TxOut, vout int, denom string) {
+// emitUTXOTagged alerts that the given vault utxo carries inscriptions or runes
+func (k Keeper) emitUTXOTagged(ctx sdk.Context, utxo *types.UTXO) {
+	if !utxo.IsTagged() {
+		return
+	}
+
+	k.Logger(ctx).Info("vault utxo carrying inscriptions or runes received", "txid", utxo.Txid, "vout", utxo.Vout, "address", utxo.Address)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventUTXOTagged{
+		Txid:         utxo.Txid,
+		Vout:         utxo.Vout,
+		Address:      utxo.Address,
+		Inscriptions: utxo.Inscriptions,
+		Runes:        utxo.Runes,
+	}); err != nil {
+		k.Logger(ctx).Error("failed to emit the event", "error", err)
+	}
 }
 
 func (k Keeper) existsInHistory(ctx sdk.Context, txHash string) bool {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 
 	return store.Has(types.BtcMintedTxHashKey(txHash))
 }
 
 func (k Keeper) addToMintHistory(ctx sdk.Context, txHash string) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 
 	store.Set(types.BtcMintedTxHashKey(txHash), []byte{1})
 }
 
+// IsOutputMinted returns true if the given deposit output has been minted
+func (k Keeper) IsOutputMinted(ctx sdk.Context, txHash string, vout uint64) bool {
+	store := k.store(ctx)
+
+	return store.Has(types.BtcMintedOutpointKey(txHash, vout))
+}
+
+func (k Keeper) markOutputMinted(ctx sdk.Context, txHash string, vout uint64) {
+	store := k.store(ctx)
+
+	store.Set(types.BtcMintedOutpointKey(txHash, vout), []byte{1})
+}
+
 // need a query all history for exporting
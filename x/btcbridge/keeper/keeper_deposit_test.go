@@ -0,0 +1,96 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sideprotocol/side/testutil/sample"
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+// addVault adds a vault of the given asset type and returns its pk script
+func (env *depositTestEnv) addVault(t *testing.T, assetType types.AssetType) []byte {
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	addr, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(key.PubKey().SerializeCompressed()), env.chain.Params)
+	require.NoError(t, err)
+	pkScript, err := txscript.PayToAddrScript(addr)
+	require.NoError(t, err)
+
+	params := env.app.BtcBridgeKeeper.GetParams(env.ctx)
+	params.Vaults = append(params.Vaults, &types.Vault{
+		Address:   addr.EncodeAddress(),
+		PubKey:    hex.EncodeToString(key.PubKey().SerializeCompressed()),
+		AssetType: assetType,
+	})
+	env.app.BtcBridgeKeeper.SetParams(env.ctx, params)
+
+	return pkScript
+}
+
+func TestDepositMultipleVaultOutputs(t *testing.T) {
+	env := newDepositTestEnv(t)
+	vaultPkScript := env.addVault(t, types.AssetType_ASSET_TYPE_BTC)
+
+	recipient := sample.AccAddress()
+	memo := env.memoOut(t, &types.DepositMemo{Recipient: recipient})
+
+	msg := env.depositMsg(t, wire.NewTxOut(100000, env.vaultPkScript), wire.NewTxOut(50000, vaultPkScript), memo)
+
+	err := env.app.BtcBridgeKeeper.ProcessBitcoinDepositTransaction(env.ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, int64(150000), env.balance(recipient, "sat").Int64())
+	require.Len(t, env.app.BtcBridgeKeeper.GetAllUTXOs(env.ctx), 2)
+
+	// the resubmitted deposit is rejected
+	err = env.app.BtcBridgeKeeper.ProcessBitcoinDepositTransaction(env.ctx, msg)
+	require.ErrorIs(t, err, types.ErrTransactionAlreadyMinted)
+	require.Equal(t, int64(150000), env.balance(recipient, "sat").Int64())
+}
+
+func TestDepositVaultOutputsOfDifferentAssets(t *testing.T) {
+	env := newDepositTestEnv(t)
+	runeVaultPkScript := env.addVault(t, types.AssetType_ASSET_TYPE_RUNE)
+
+	recipient := sample.AccAddress()
+	memo := env.memoOut(t, &types.DepositMemo{Recipient: recipient})
+
+	err := env.deposit(t, wire.NewTxOut(546, runeVaultPkScript), wire.NewTxOut(100000, env.vaultPkScript), memo)
+	require.NoError(t, err)
+
+	// the btc output is credited regardless of the rune output
+	require.Equal(t, int64(100000), env.balance(recipient, "sat").Int64())
+	require.Len(t, env.app.BtcBridgeKeeper.GetAllUTXOs(env.ctx), 1)
+}
+
+func TestDepositWithoutCreditedOutputs(t *testing.T) {
+	env := newDepositTestEnv(t)
+	runeVaultPkScript := env.addVault(t, types.AssetType_ASSET_TYPE_RUNE)
+
+	recipient := sample.AccAddress()
+	memo := env.memoOut(t, &types.DepositMemo{Recipient: recipient})
+
+	// the rune deposit is rejected rather than accepted without being credited
+	err := env.deposit(t, wire.NewTxOut(546, runeVaultPkScript), memo)
+	require.ErrorIs(t, err, types.ErrInvalidDepositTransaction)
+
+	// so is the deposit without any vault output
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	addr, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(key.PubKey().SerializeCompressed()), env.chain.Params)
+	require.NoError(t, err)
+	pkScript, err := txscript.PayToAddrScript(addr)
+	require.NoError(t, err)
+
+	err = env.deposit(t, wire.NewTxOut(100000, pkScript), memo)
+	require.ErrorIs(t, err, types.ErrInvalidDepositTransaction)
+
+	require.True(t, env.balance(recipient, "sat").IsZero())
+	require.Empty(t, env.app.BtcBridgeKeeper.GetAllUTXOs(env.ctx))
+}
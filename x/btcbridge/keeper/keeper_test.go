@@ -2,6 +2,8 @@ package keeper_test
 
 import (
 	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestCreatePsbt(t *testing.T) {
@@ -12,3 +14,14 @@ func TestCreatePsbt(t *testing.T) {
 
 	// pbst := txauthor.NewUnsignedTransaction()
 }
+
+// hasEvent returns true if the event of the given type is emitted
+func hasEvent(events sdk.Events, eventType string) bool {
+	for _, e := range events {
+		if e.Type == eventType {
+			return true
+		}
+	}
+
+	return false
+}
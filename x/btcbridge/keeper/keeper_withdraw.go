@@ -3,21 +3,23 @@ package keeper
 import (
 	"bytes"
 	"encoding/base64"
-	"encoding/hex"
-	"fmt"
+	"strings"
 
 	"github.com/btcsuite/btcd/blockchain"
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/wire"
 
+	sdkerrors "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/sideprotocol/side/x/btcbridge/types"
 )
 
 // GetRequestSeqence returns the request sequence
 func (k Keeper) GetRequestSeqence(ctx sdk.Context) uint64 {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	bz := store.Get(types.SequenceKey)
 	if bz == nil {
 		return 0
@@ -27,7 +29,7 @@ func (k Keeper) GetRequestSeqence(ctx sdk.Context) uint64 {
 
 // IncrementRequestSequence increments the request sequence and returns the new sequence
 func (k Keeper) IncrementRequestSequence(ctx sdk.Context) uint64 {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	seq := k.GetRequestSeqence(ctx) + 1
 	store.Set(types.SequenceKey, sdk.Uint64ToBigEndian(seq))
 	return seq
@@ -39,10 +41,21 @@ func (k Keeper) IncrementRequestSequence(ctx sdk.Context) uint64 {
 // vault: the address of the vault, default is empty.
 // If empty, the vault will be Bitcoin vault, otherwise it will be Ordinals or Runes vault
 func (k Keeper) NewSigningRequest(ctx sdk.Context, sender string, coin sdk.Coin, feeRate int64, vault string) (*types.BitcoinSigningRequest, error) {
+	return k.NewMultiSigningRequest(ctx, []*types.WithdrawRecipient{{BtcAddress: sender, Amount: coin.String()}}, feeRate, vault)
+}
+
+// NewMultiSigningRequest creates a signing request which pays each of the given recipients in a single tx.
+// The address of the request is the first recipient.
+func (k Keeper) NewMultiSigningRequest(ctx sdk.Context, recipients []*types.WithdrawRecipient, feeRate int64, vault string) (*types.BitcoinSigningRequest, error) {
+	p := k.GetParams(ctx)
+
+	if len(recipients) == 0 {
+		return nil, types.ErrInvalidRecipients
+	}
+
 	if len(vault) == 0 {
 		// default to the first vault in the params for now
 		// TODO: select an appropriate vault according to the utxos
-		p := k.GetParams(ctx)
 		for i, v := range p.Vaults {
 			if v.AssetType == types.AssetType_ASSET_TYPE_BTC {
 				vault = p.Vaults[i].Address
@@ -51,12 +64,30 @@ func (k Keeper) NewSigningRequest(ctx sdk.Context, sender string, coin sdk.Coin,
 		}
 	}
 
+	txOuts := make([]*wire.TxOut, 0, len(recipients))
+	addresses := make([]string, 0, len(recipients))
+
+	for _, recipient := range recipients {
+		coin, err := sdk.ParseCoinNormalized(recipient.Amount)
+		if err != nil {
+			return nil, types.ErrInvalidAmount
+		}
+
+		txOut, err := types.PayToAddrTxOut(recipient.BtcAddress, coin.Amount.Int64(), p.ChainCfg())
+		if err != nil {
+			return nil, err
+		}
+
+		txOuts = append(txOuts, txOut)
+		addresses = append(addresses, recipient.BtcAddress)
+	}
+
 	utxos := k.GetOrderedUTXOsByAddr(ctx, vault)
 	if len(utxos) == 0 {
 		return nil, types.ErrInsufficientUTXOs
 	}
 
-	psbt, selectedUTXOs, changeUTXO, err := types.BuildPsbt(utxos, sender, coin.Amount.Int64(), feeRate, vault)
+	psbt, selectedUTXOs, changeUTXO, err := types.BuildPsbtWithOutputs(utxos, txOuts, feeRate, vault, p.ChainCfg())
 	if err != nil {
 		return nil, err
 	}
@@ -66,6 +97,12 @@ func (k Keeper) NewSigningRequest(ctx sdk.Context, sender string, coin sdk.Coin,
 		return nil, types.ErrFailToSerializePsbt
 	}
 
+	// the amount escrowed by the withdrawal is fixed by the psbt built here
+	amount, err := withdrawalAmount(psbt)
+	if err != nil {
+		return nil, err
+	}
+
 	// lock the selected utxos
 	k.LockUTXOs(ctx, selectedUTXOs)
 
@@ -74,12 +111,112 @@ func (k Keeper) NewSigningRequest(ctx sdk.Context, sender string, coin sdk.Coin,
 	k.addToMintHistory(ctx, psbt.UnsignedTx.TxHash().String())
 
 	signingRequest := &types.BitcoinSigningRequest{
-		Address:      sender,
+		Address:      addresses[0],
 		Txid:         psbt.UnsignedTx.TxHash().String(),
 		Psbt:         psbtB64,
 		Status:       types.SigningStatus_SIGNING_STATUS_CREATED,
 		Sequence:     k.IncrementRequestSequence(ctx),
 		VaultAddress: vault,
+		Amount:       amount,
+	}
+
+	if len(addresses) > 1 {
+		signingRequest.Recipients = addresses
+	}
+
+	k.SetSigningRequest(ctx, signingRequest)
+
+	return signingRequest, nil
+}
+
+// NewRecoverySigningRequest creates a signing request which sweeps the unlocked utxos of the given taproot vault
+// which have aged past the recovery delay to another vault of the same asset type by the recovery script path.
+// It is meant for the case that the signer committee of the vault is lost; the request is signed by the recovery key.
+// The network fee is paid by the vault as no voucher is escrowed, which is covered once the sweep is confirmed.
+func (k Keeper) NewRecoverySigningRequest(ctx sdk.Context, vaultAddress string, recipient string, feeRate int64) (*types.BitcoinSigningRequest, error) {
+	p := k.GetParams(ctx)
+
+	vault := types.SelectVaultByBitcoinAddress(p.Vaults, vaultAddress)
+	if vault == nil || vault.TapDescriptor == nil {
+		return nil, types.ErrVaultNotRecoverable
+	}
+
+	// the reserves are kept within the bridge
+	target := types.SelectVaultByBitcoinAddress(p.Vaults, recipient)
+	if target == nil || target.Address == vault.Address || target.AssetType != vault.AssetType {
+		return nil, sdkerrors.Wrapf(types.ErrVaultNotRecoverable, "recipient %s is not another vault of the same asset type", recipient)
+	}
+
+	bestHeight := k.GetBestBlockHeader(ctx).Height
+
+	// the utxos carrying inscriptions or runes are swept along, so that the assets move to the target vault
+	candidates := k.GetOrderedUTXOsByAddr(ctx, vault.Address)
+	for _, utxo := range k.GetTaggedUTXOsByAddr(ctx, vault.Address) {
+		if !utxo.IsLocked && utxo.IsMature(bestHeight, p.ChainCfg()) {
+			candidates = append(candidates, utxo)
+		}
+	}
+
+	// the utxos younger than the recovery delay can not be spent by the recovery script path yet
+	utxos := make([]*types.UTXO, 0, len(candidates))
+	for _, utxo := range candidates {
+		if utxo.IsRecoverable(bestHeight, vault.TapDescriptor.RecoveryDelay) {
+			utxos = append(utxos, utxo)
+		}
+	}
+
+	if len(utxos) == 0 {
+		return nil, types.ErrInsufficientUTXOs
+	}
+
+	psbt, err := types.BuildRecoveryPsbt(utxos, recipient, feeRate, vault.TapDescriptor, p.ChainCfg())
+	if err != nil {
+		return nil, err
+	}
+
+	psbtB64, err := psbt.B64Encode()
+	if err != nil {
+		return nil, types.ErrFailToSerializePsbt
+	}
+
+	// lock the swept utxos
+	if err := k.LockUTXOs(ctx, utxos); err != nil {
+		return nil, err
+	}
+
+	// credit the target vault and mark minted
+	txid := psbt.UnsignedTx.TxHash().String()
+	out := psbt.UnsignedTx.TxOut[0]
+
+	// the assets of the swept utxos are carried over to the single output
+	assets := types.MergeAssets(utxos)
+
+	// all of the swept utxos leave the vault
+	inAmount := uint64(0)
+	for _, utxo := range utxos {
+		inAmount += utxo.Amount
+	}
+
+	k.saveUTXO(ctx, &types.UTXO{
+		Txid:         txid,
+		Vout:         0,
+		Address:      recipient,
+		Amount:       uint64(out.Value),
+		PubKeyScript: out.PkScript,
+		Inscriptions: assets.Inscriptions,
+		Runes:        assets.Runes,
+	})
+	k.addToMintHistory(ctx, txid)
+
+	signingRequest := &types.BitcoinSigningRequest{
+		Address:      recipient,
+		Txid:         txid,
+		Psbt:         psbtB64,
+		Status:       types.SigningStatus_SIGNING_STATUS_CREATED,
+		Sequence:     k.IncrementRequestSequence(ctx),
+		VaultAddress: vault.Address,
+		Recovery:     true,
+		Amount:       inAmount,
 	}
 
 	k.SetSigningRequest(ctx, signingRequest)
@@ -89,13 +226,13 @@ func (k Keeper) NewSigningRequest(ctx sdk.Context, sender string, coin sdk.Coin,
 
 // GetSigningRequest returns the signing request
 func (k Keeper) HasSigningRequest(ctx sdk.Context, hash string) bool {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	return store.Has(types.BtcSigningRequestHashKey(hash))
 }
 
 // GetSigningRequest returns the signing request
 func (k Keeper) GetSigningRequest(ctx sdk.Context, hash string) *types.BitcoinSigningRequest {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	var signingRequest types.BitcoinSigningRequest
 	// TODO replace the key with the hash
 	bz := store.Get(types.BtcSigningRequestHashKey(hash))
@@ -105,7 +242,7 @@ func (k Keeper) GetSigningRequest(ctx sdk.Context, hash string) *types.BitcoinSi
 
 // SetSigningRequest sets the signing request
 func (k Keeper) SetSigningRequest(ctx sdk.Context, signingRequest *types.BitcoinSigningRequest) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	bz := k.cdc.MustMarshal(signingRequest)
 	// TODO replace the key with the hash
 	store.Set(types.BtcSigningRequestHashKey(signingRequest.Txid), bz)
@@ -113,7 +250,7 @@ func (k Keeper) SetSigningRequest(ctx sdk.Context, signingRequest *types.Bitcoin
 
 // IterateSigningRequests iterates through all signing requests
 func (k Keeper) IterateSigningRequests(ctx sdk.Context, process func(signingRequest types.BitcoinSigningRequest) (stop bool)) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	iterator := sdk.KVStorePrefixIterator(store, types.BtcSigningRequestPrefix)
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
@@ -159,10 +296,6 @@ func (k Keeper) ProcessBitcoinWithdrawTransaction(ctx sdk.Context, msg *types.Ms
 	}
 
 	best := k.GetBestBlockHeader(ctx)
-	// Check if the block is confirmed
-	if best.Height-header.Height < uint64(param.Confirmations) {
-		return types.ErrNotConfirmed
-	}
 	// Check if the block is within the acceptable depth
 	if best.Height-header.Height > param.MaxAcceptableBlockDepth {
 		return types.ErrExceedMaxAcceptanceDepth
@@ -171,7 +304,7 @@ func (k Keeper) ProcessBitcoinWithdrawTransaction(ctx sdk.Context, msg *types.Ms
 	// Decode the base64 transaction
 	txBytes, err := base64.StdEncoding.DecodeString(msg.TxBytes)
 	if err != nil {
-		fmt.Println("Error decoding transaction from base64:", err)
+		k.Logger(ctx).Debug("failed to decode the withdrawal tx", "error", err)
 		return err
 	}
 
@@ -179,7 +312,7 @@ func (k Keeper) ProcessBitcoinWithdrawTransaction(ctx sdk.Context, msg *types.Ms
 	var tx wire.MsgTx
 	err = tx.Deserialize(bytes.NewReader(txBytes))
 	if err != nil {
-		fmt.Println("Error deserializing transaction:", err)
+		k.Logger(ctx).Debug("failed to deserialize the withdrawal tx", "error", err)
 		return err
 	}
 	uTx := btcutil.NewTx(&tx)
@@ -191,44 +324,249 @@ func (k Keeper) ProcessBitcoinWithdrawTransaction(ctx sdk.Context, msg *types.Ms
 		return types.ErrSigningRequestNotExist
 	}
 
+	// check if the proof is valid
+	txHash := uTx.MsgTx().TxHash()
+	if err := k.verifyTxProof(ctx, header, &txHash, msg.Proof, msg.TxOutProof); err != nil {
+		return err
+	}
+
 	signingRequest := k.GetSigningRequest(ctx, uTx.MsgTx().TxHash().String())
 	// if signingRequest.Status != types.SigningStatus_SIGNING_STATUS_BROADCASTED || signingRequest.Status != types.SigningStatus_SIGNING_STATUS_SIGNED {
 	// 	return types.ErrInvalidStatus
 	// }
-	signingRequest.Status = types.SigningStatus_SIGNING_STATUS_CONFIRMED
-	k.SetSigningRequest(ctx, signingRequest)
+	// the rejected request has released its utxos and escrow
+	if !isWithdrawalPending(signingRequest.Status) {
+		return types.ErrInvalidStatus
+	}
+
+	// Check if the block is confirmed by the confirmations required by the withdrawn amount
+	if best.Height-header.Height < uint64(k.withdrawalConfirmations(ctx, param, signingRequest)) {
+		return types.ErrNotConfirmed
+	}
+	if err := k.UpdateSigningStatus(ctx, signingRequest, types.SigningStatus_SIGNING_STATUS_CONFIRMED); err != nil {
+		return err
+	}
+
+	// burn the escrowed voucher token as the btc has left the vault
+	// recovery sweeps stay within the bridge and escrow nothing
+	if !signingRequest.Recovery {
+		if err := k.burnWithdrawal(ctx, signingRequest, param.BtcVoucherDenom); err != nil {
+			return err
+		}
+	}
 
 	// Validate the transaction
 	if err := blockchain.CheckTransactionSanity(uTx); err != nil {
-		fmt.Println("Transaction is not valid:", err)
+		k.Logger(ctx).Debug("invalid withdrawal tx", "error", err)
 		return err
 	}
 
-	if len(uTx.MsgTx().TxIn[0].Witness) != 2 {
+	// check if the first input spends a vault utxo
+	// the witness does not reveal the vault key for taproot vaults
+	prevOut := uTx.MsgTx().TxIn[0].PreviousOutPoint
+	if !k.HasUTXO(ctx, prevOut.Hash.String(), uint64(prevOut.Index)) {
 		return types.ErrInvalidSenders
 	}
 
-	senderPubKey := uTx.MsgTx().TxIn[0].Witness[1]
-
-	// check if the first sender is one of the vault addresses
-	vault := types.SelectVaultByPubKey(param.Vaults, hex.EncodeToString(senderPubKey))
-	if vault == nil {
+	utxo := k.GetUTXO(ctx, prevOut.Hash.String(), uint64(prevOut.Index))
+	if types.SelectVaultByBitcoinAddress(param.Vaults, utxo.Address) == nil {
 		return types.ErrInvalidSenders
 	}
 
-	k.spendUTXOs(ctx, uTx)
+	if err := k.spendUTXOs(ctx, uTx); err != nil {
+		return err
+	}
+
+	// the outputs saved by the request are confirmed at the height of the block
+	k.confirmOutputs(ctx, uTx, header.Height)
+
+	// the network fee of the recovery sweep is paid by the vault
+	if signingRequest.Recovery {
+		if err := k.coverRecoveryFee(ctx, signingRequest, uTx, param.BtcVoucherDenom); err != nil {
+			return err
+		}
+	}
+
+	k.afterWithdrawalConfirmed(ctx, signingRequest)
 
 	return nil
 }
 
 // spendUTXOs spends locked utxos
-func (k Keeper) spendUTXOs(ctx sdk.Context, uTx *btcutil.Tx) {
+func (k Keeper) spendUTXOs(ctx sdk.Context, uTx *btcutil.Tx) error {
 	for _, in := range uTx.MsgTx().TxIn {
 		hash := in.PreviousOutPoint.Hash.String()
-		vout := in.PreviousOutPoint.Index
+		vout := uint64(in.PreviousOutPoint.Index)
+
+		if !k.IsUTXOLocked(ctx, hash, vout) {
+			continue
+		}
 
-		if k.IsUTXOLocked(ctx, hash, uint64(vout)) {
-			k.SpendUTXO(ctx, hash, uint64(vout))
+		utxo := k.GetUTXO(ctx, hash, vout)
+		if err := k.SpendUTXO(ctx, hash, vout); err != nil {
+			return err
 		}
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventUTXOSpent{
+			Txid:         utxo.Txid,
+			Vout:         utxo.Vout,
+			Address:      utxo.Address,
+			Amount:       utxo.Amount,
+			SpendingTxid: uTx.Hash().String(),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// rejectWithdrawal rejects the given signing request which has not been broadcasted yet.
+// The utxos locked by the request are unlocked and the outputs saved for the request are deleted,
+// and the escrow is refunded to the sender of the withdrawal, or to the community pool if the sender is unknown.
+// The return of a quarantined deposit is rejected back to the quarantine, whose utxos stay locked.
+func (k Keeper) rejectWithdrawal(ctx sdk.Context, request *types.BitcoinSigningRequest) error {
+	// the broadcasted tx may still be confirmed
+	if request.Status == types.SigningStatus_SIGNING_STATUS_BROADCASTED {
+		return sdkerrors.Wrap(types.ErrInvalidStatus, "can not reject the broadcasted request")
 	}
+
+	packet, err := psbt.NewFromRawBytes(strings.NewReader(request.Psbt), true)
+	if err != nil {
+		return err
+	}
+
+	tx := packet.UnsignedTx
+
+	// the change of the request may have been spent by a later request, which must be rejected first
+	for i := range tx.TxOut {
+		if k.IsUTXOLocked(ctx, request.Txid, uint64(i)) {
+			return sdkerrors.Wrapf(types.ErrInvalidStatus, "output %d of the request is spent by another request", i)
+		}
+	}
+
+	for i := range tx.TxOut {
+		if k.HasUTXO(ctx, request.Txid, uint64(i)) {
+			k.removeUTXO(ctx, request.Txid, uint64(i))
+		}
+	}
+
+	escrow := sdk.NewCoins(sdk.NewCoin(k.GetParams(ctx).BtcVoucherDenom, sdk.NewIntFromUint64(request.Amount)))
+
+	if deposit := k.getReturnedQuarantinedDeposit(ctx, request.Txid); deposit != nil {
+		// the escrow was minted for the return
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, escrow); err != nil {
+			return err
+		}
+
+		deposit.Status = types.QuarantineStatus_QUARANTINE_STATUS_HELD
+		deposit.ReturnTxid = ""
+		k.SetQuarantinedDeposit(ctx, deposit)
+
+		return k.UpdateSigningStatus(ctx, request, types.SigningStatus_SIGNING_STATUS_REJECTED)
+	}
+
+	for _, in := range tx.TxIn {
+		hash, vout := in.PreviousOutPoint.Hash.String(), uint64(in.PreviousOutPoint.Index)
+		if k.IsUTXOLocked(ctx, hash, vout) {
+			if err := k.UnlockUTXO(ctx, hash, vout); err != nil {
+				return err
+			}
+		}
+	}
+
+	// recovery sweeps escrow nothing
+	if !request.Recovery {
+		if len(request.Sender) != 0 {
+			err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sdk.MustAccAddressFromBech32(request.Sender), escrow)
+		} else {
+			err = k.distrKeeper.FundCommunityPool(ctx, escrow, authtypes.NewModuleAddress(types.ModuleName))
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return k.UpdateSigningStatus(ctx, request, types.SigningStatus_SIGNING_STATUS_REJECTED)
+}
+
+// confirmOutputs sets the given height to the utxos saved for the outputs of the given tx,
+// i.e. the change of the withdrawals and the output of the recovery sweeps
+func (k Keeper) confirmOutputs(ctx sdk.Context, uTx *btcutil.Tx, height uint64) {
+	txid := uTx.Hash().String()
+
+	for i := range uTx.MsgTx().TxOut {
+		if !k.HasUTXO(ctx, txid, uint64(i)) {
+			continue
+		}
+
+		utxo := k.GetUTXO(ctx, txid, uint64(i))
+		utxo.Height = height
+		k.SetUTXO(ctx, utxo)
+	}
+}
+
+// coverRecoveryFee covers the network fee of the given confirmed recovery sweep, by which the reserves fall short
+// of the voucher supply. The vouchers of the fee are burned from the collected fees as far as possible,
+// and the rest is recorded as the recovery deficit, which is deducted from the supply by the reserves invariant.
+func (k Keeper) coverRecoveryFee(ctx sdk.Context, request *types.BitcoinSigningRequest, uTx *btcutil.Tx, denom string) error {
+	outAmount := uint64(0)
+	for _, out := range uTx.MsgTx().TxOut {
+		outAmount += uint64(out.Value)
+	}
+
+	if request.Amount <= outAmount {
+		return nil
+	}
+
+	fee := sdk.NewIntFromUint64(request.Amount - outAmount)
+
+	burned := sdk.MinInt(fee, k.GetCollectedFees(ctx).AmountOf(denom))
+	if burned.IsPositive() {
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(denom, burned))); err != nil {
+			return err
+		}
+
+		k.setCollectedFee(ctx, k.GetCollectedFees(ctx).AmountOf(denom).Sub(burned), denom)
+	}
+
+	if deficit := fee.Sub(burned); deficit.IsPositive() {
+		k.setRecoveryDeficit(ctx, k.GetRecoveryDeficit(ctx)+deficit.Uint64())
+	}
+
+	return nil
+}
+
+// UpdateSigningStatus updates the status of the given signing request
+func (k Keeper) UpdateSigningStatus(ctx sdk.Context, request *types.BitcoinSigningRequest, status types.SigningStatus) error {
+	oldStatus := request.Status
+
+	request.Status = status
+	k.SetSigningRequest(ctx, request)
+
+	if oldStatus == status {
+		return nil
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventSigningStatusChanged{
+		Txid:      request.Txid,
+		OldStatus: oldStatus,
+		NewStatus: status,
+	})
+}
+
+// withdrawalConfirmations returns the confirmations required by the amount leaving the vault by the signing request
+func (k Keeper) withdrawalConfirmations(ctx sdk.Context, param types.Params, request *types.BitcoinSigningRequest) int32 {
+	assetType := types.AssetType_ASSET_TYPE_BTC
+	if vault := types.SelectVaultByBitcoinAddress(param.Vaults, request.VaultAddress); vault != nil {
+		assetType = vault.AssetType
+	}
+
+	return param.RequiredConfirmations(assetType, request.Amount)
+}
+
+// burnWithdrawal burns the voucher token escrowed by the given signing request
+func (k Keeper) burnWithdrawal(ctx sdk.Context, request *types.BitcoinSigningRequest, denom string) error {
+	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewIntFromUint64(request.Amount))))
 }
@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+// CheckHeaderLiveness compares the time of the best block header of the bridged chain with the side block time.
+// The bridge is degraded once the lag exceeds the max header lag, and recovers as soon as the headers catch up.
+func (k Keeper) CheckHeaderLiveness(ctx sdk.Context) {
+	best := k.GetBestBlockHeader(ctx)
+	lag := k.HeaderLag(ctx)
+	maxLag := k.GetParams(ctx).MaxHeaderLag
+
+	telemetry.ModuleSetGauge(types.ModuleName, float32(lag), "header_lag_seconds", k.metricChainLabel())
+
+	degradation := k.GetDegradation(ctx)
+
+	switch {
+	case degradation == nil && maxLag > 0 && lag > maxLag:
+		k.setDegradation(ctx, &types.BridgeDegradation{
+			Since:       ctx.BlockTime().Unix(),
+			SinceHeight: ctx.BlockHeight(),
+			BestHash:    best.Hash,
+			BestHeight:  best.Height,
+		})
+
+		k.Logger(ctx).Error("bridge degraded as the block headers lag behind", "chain", k.chainID, "best", best.Height, "lag", lag)
+		telemetry.IncrCounter(1, types.ModuleName, "degraded", k.metricChainLabel())
+
+		k.emitLivenessEvent(ctx, &types.EventBridgeDegraded{
+			ChainId:    k.chainID,
+			BestHash:   best.Hash,
+			BestHeight: best.Height,
+			Lag:        lag,
+		})
+
+	case degradation != nil && (maxLag == 0 || lag <= maxLag):
+		k.removeDegradation(ctx)
+
+		k.Logger(ctx).Info("bridge recovered as the block headers caught up", "chain", k.chainID, "best", best.Height)
+		telemetry.IncrCounter(1, types.ModuleName, "recovered", k.metricChainLabel())
+
+		k.emitLivenessEvent(ctx, &types.EventBridgeRecovered{
+			ChainId:    k.chainID,
+			BestHash:   best.Hash,
+			BestHeight: best.Height,
+			Duration:   ctx.BlockTime().Unix() - degradation.Since,
+		})
+	}
+
+	degraded := float32(0)
+	if k.IsDegraded(ctx) {
+		degraded = 1
+	}
+
+	telemetry.ModuleSetGauge(types.ModuleName, degraded, "degraded", k.metricChainLabel())
+}
+
+// HeaderLag returns the lag in seconds of the best block header behind the side block time,
+// which is zero until the first block header is submitted so that the new chain is not degraded from the start
+func (k Keeper) HeaderLag(ctx sdk.Context) int64 {
+	best := k.GetBestBlockHeader(ctx)
+	if best.Time == 0 {
+		return 0
+	}
+
+	return ctx.BlockTime().Unix() - int64(best.Time)
+}
+
+// IsDegraded returns true if the bridge is degraded as the block headers lag behind
+func (k Keeper) IsDegraded(ctx sdk.Context) bool {
+	return k.store(ctx).Has(types.BtcDegradationKey)
+}
+
+// GetDegradation returns the degradation of the bridge, nil if the bridge operates normally
+func (k Keeper) GetDegradation(ctx sdk.Context) *types.BridgeDegradation {
+	bz := k.store(ctx).Get(types.BtcDegradationKey)
+	if bz == nil {
+		return nil
+	}
+
+	var degradation types.BridgeDegradation
+	k.cdc.MustUnmarshal(bz, &degradation)
+
+	return &degradation
+}
+
+func (k Keeper) setDegradation(ctx sdk.Context, degradation *types.BridgeDegradation) {
+	k.store(ctx).Set(types.BtcDegradationKey, k.cdc.MustMarshal(degradation))
+}
+
+func (k Keeper) removeDegradation(ctx sdk.Context) {
+	k.store(ctx).Delete(types.BtcDegradationKey)
+}
+
+// checkLiveness returns an error if the bridge is degraded, by which the new withdrawals are paused
+func (k Keeper) checkLiveness(ctx sdk.Context) error {
+	if k.IsDegraded(ctx) {
+		return types.ErrBridgeDegraded
+	}
+
+	return nil
+}
+
+func (k Keeper) emitLivenessEvent(ctx sdk.Context, event proto.Message) {
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		k.Logger(ctx).Error("failed to emit the event", "error", err)
+	}
+}
+
+// metricChainLabel returns the name of the bridged chain in the metrics keys
+func (k Keeper) metricChainLabel() string {
+	if len(k.chainID) == 0 {
+		return "btc"
+	}
+
+	return k.chainID
+}
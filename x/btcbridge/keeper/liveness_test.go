@@ -0,0 +1,91 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/sideprotocol/side/testutil/keeper"
+	"github.com/sideprotocol/side/testutil/sample"
+	"github.com/sideprotocol/side/x/btcbridge/keeper"
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+func TestHeaderLiveness(t *testing.T) {
+	env := newDepositTestEnv(t)
+
+	params := env.app.BtcBridgeKeeper.GetParams(env.ctx)
+	params.MaxHeaderLag = 3 * 60 * 60
+	env.app.BtcBridgeKeeper.SetParams(env.ctx, params)
+
+	env.chain.MineBlock()
+	env.syncHeaders(t)
+
+	bestTime := time.Unix(int64(env.app.BtcBridgeKeeper.GetBestBlockHeader(env.ctx).Time), 0)
+
+	// the headers within the max lag
+	env.ctx = env.ctx.WithBlockTime(bestTime.Add(time.Hour))
+	env.app.BtcBridgeKeeper.CheckHeaderLiveness(env.ctx)
+	require.False(t, env.app.BtcBridgeKeeper.IsDegraded(env.ctx))
+
+	// the bridge is degraded once the headers lag behind
+	env.ctx = env.ctx.WithBlockTime(bestTime.Add(4 * time.Hour))
+	env.app.BtcBridgeKeeper.CheckHeaderLiveness(env.ctx)
+	require.True(t, env.app.BtcBridgeKeeper.IsDegraded(env.ctx))
+
+	degraded := typedEvents[*types.EventBridgeDegraded](t, env.ctx)
+	require.Len(t, degraded, 1)
+	require.Equal(t, int64(4*60*60), degraded[0].Lag)
+
+	res, err := env.app.BtcBridgeKeeper.QueryBridgeStatus(sdk.WrapSDKContext(env.ctx), &types.QueryBridgeStatusRequest{})
+	require.NoError(t, err)
+	require.NotNil(t, res.Degradation)
+	require.Equal(t, int64(4*60*60), res.HeaderLag)
+
+	// the new withdrawals are paused
+	msgServer := keeper.NewMsgServerImpl(env.app.BtcBridgeKeeper)
+
+	_, err = msgServer.WithdrawBitcoin(sdk.WrapSDKContext(env.ctx), types.NewMsgWithdrawBitcoinRequest(sample.AccAddress(), "10000sat", 10))
+	require.ErrorIs(t, err, types.ErrBridgeDegraded)
+
+	// the degradation is reported once
+	env.app.BtcBridgeKeeper.CheckHeaderLiveness(env.ctx)
+	require.Len(t, typedEvents[*types.EventBridgeDegraded](t, env.ctx), 1)
+
+	// the bridge recovers once the headers catch up
+	for i := 0; i < 24; i++ {
+		env.chain.MineBlock()
+	}
+	env.syncHeaders(t)
+
+	env.app.BtcBridgeKeeper.CheckHeaderLiveness(env.ctx)
+	require.False(t, env.app.BtcBridgeKeeper.IsDegraded(env.ctx))
+	require.Len(t, typedEvents[*types.EventBridgeRecovered](t, env.ctx), 1)
+
+	_, err = msgServer.WithdrawBitcoin(sdk.WrapSDKContext(env.ctx), types.NewMsgWithdrawBitcoinRequest(sample.AccAddress(), "10000sat", 10))
+	require.NotErrorIs(t, err, types.ErrBridgeDegraded)
+
+	// the check is disabled by the zero max lag
+	params.MaxHeaderLag = 0
+	env.app.BtcBridgeKeeper.SetParams(env.ctx, params)
+
+	env.ctx = env.ctx.WithBlockTime(bestTime.Add(24 * time.Hour))
+	env.app.BtcBridgeKeeper.CheckHeaderLiveness(env.ctx)
+	require.False(t, env.app.BtcBridgeKeeper.IsDegraded(env.ctx))
+}
+
+func TestHeaderLivenessWithoutHeaders(t *testing.T) {
+	k, ctx := keepertest.BtcLightClientKeeper(t)
+
+	params := k.GetParams(ctx)
+	params.MaxHeaderLag = 3 * 60 * 60
+	k.SetParams(ctx, params)
+
+	// the chain without any block header is not degraded
+	ctx = ctx.WithBlockTime(time.Now())
+	k.CheckHeaderLiveness(ctx)
+	require.False(t, k.IsDegraded(ctx))
+	require.Zero(t, k.HeaderLag(ctx))
+}
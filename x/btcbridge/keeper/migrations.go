@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"strings"
+
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+// Migrator is a struct for handling in-place store migrations
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the store from consensus version 1 to 2.
+// The keys of version 1 keep their prefixes in the store of bitcoin, while the bridged chains, the attestations,
+// the pending deposits and the fee epoch did not exist, so that their keys start empty.
+// The params added since version 1 are set to their defaults, the in-flight withdrawals are given their escrowed amounts,
+// and the vouchers escrowed by the confirmed withdrawals of version 1, which were never burned, are burned.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	k := m.keeper
+
+	k.SetParams(ctx, migrateParams(k.GetParams(ctx)))
+
+	// the amount of the in-flight withdrawal is fixed by its psbt
+	pending := uint64(0)
+
+	var err error
+	k.IterateSigningRequests(ctx, func(request types.BitcoinSigningRequest) (stop bool) {
+		if !isWithdrawalPending(request.Status) {
+			return false
+		}
+
+		var packet *psbt.Packet
+		if packet, err = psbt.NewFromRawBytes(strings.NewReader(request.Psbt), true); err != nil {
+			return true
+		}
+
+		if request.Amount, err = withdrawalAmount(packet); err != nil {
+			return true
+		}
+
+		k.SetSigningRequest(ctx, &request)
+		pending += request.Amount
+
+		return false
+	})
+	if err != nil {
+		return err
+	}
+
+	denom := k.GetParams(ctx).BtcVoucherDenom
+
+	escrowed := k.bankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(types.ModuleName), denom)
+	if stranded := escrowed.Amount.Sub(sdk.NewIntFromUint64(pending)); stranded.IsPositive() {
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(denom, stranded))); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// migrateParams sets the params added since version 1 to their defaults.
+// The network is left empty, by which the network of the config is used as in version 1.
+func migrateParams(params types.Params) types.Params {
+	defaults := types.DefaultParams()
+
+	if params.RelayerQuorum == 0 {
+		params.RelayerQuorum = defaults.RelayerQuorum
+	}
+
+	if params.AttestationExpiry == 0 {
+		params.AttestationExpiry = defaults.AttestationExpiry
+	}
+
+	if params.FeeEpoch == 0 {
+		params.FeeEpoch = defaults.FeeEpoch
+	}
+
+	if params.MaxHeaderLag == 0 {
+		params.MaxHeaderLag = defaults.MaxHeaderLag
+	}
+
+	return params
+}
@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sideprotocol/side/testutil/sample"
+	"github.com/sideprotocol/side/x/btcbridge/keeper"
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	env := newDepositTestEnv(t)
+
+	msgServer := keeper.NewMsgServerImpl(env.app.BtcBridgeKeeper)
+
+	holder := sample.AccAddress()
+	env.linkBitcoinAddress(t, holder)
+
+	require.NoError(t, env.deposit(t, wire.NewTxOut(100000, env.vaultPkScript), env.memoOut(t, &types.DepositMemo{Recipient: holder})))
+
+	_, err := msgServer.WithdrawBitcoin(sdk.WrapSDKContext(env.ctx), types.NewMsgWithdrawBitcoinRequest(holder, "40000sat", 10))
+	require.NoError(t, err)
+
+	requests := env.app.BtcBridgeKeeper.FilterSigningRequestsByStatus(env.ctx, &types.QuerySigningRequestRequest{Status: types.SigningStatus_SIGNING_STATUS_CREATED})
+	require.Len(t, requests, 1)
+	amount := requests[0].Amount
+
+	// the state of version 1, without the params added since then and the amounts of the withdrawals,
+	// along with the vouchers escrowed by a confirmed withdrawal which were never burned
+	params := env.app.BtcBridgeKeeper.GetParams(env.ctx)
+	params.RelayerQuorum = 0
+	params.AttestationExpiry = 0
+	params.FeeEpoch = 0
+	params.MaxHeaderLag = 0
+	env.app.BtcBridgeKeeper.SetParams(env.ctx, params)
+
+	requests[0].Amount = 0
+	env.app.BtcBridgeKeeper.SetSigningRequest(env.ctx, requests[0])
+
+	require.NoError(t, env.app.BankKeeper.MintCoins(env.ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("sat", 5000))))
+
+	_, broken := keeper.ReservesInvariant(env.app.BtcBridgeKeeper)(env.ctx)
+	require.True(t, broken)
+
+	require.NoError(t, keeper.NewMigrator(env.app.BtcBridgeKeeper).Migrate1to2(env.ctx))
+
+	params = env.app.BtcBridgeKeeper.GetParams(env.ctx)
+	defaults := types.DefaultParams()
+	require.Equal(t, defaults.RelayerQuorum, params.RelayerQuorum)
+	require.Equal(t, defaults.AttestationExpiry, params.AttestationExpiry)
+	require.Equal(t, defaults.FeeEpoch, params.FeeEpoch)
+	require.Equal(t, defaults.MaxHeaderLag, params.MaxHeaderLag)
+	require.NoError(t, params.Validate())
+
+	require.Equal(t, amount, env.app.BtcBridgeKeeper.GetSigningRequest(env.ctx, requests[0].Txid).Amount)
+
+	// only the stranded vouchers are burned
+	msg, broken := keeper.EscrowInvariant(env.app.BtcBridgeKeeper)(env.ctx)
+	require.False(t, broken, msg)
+	env.checkReserves(t)
+}
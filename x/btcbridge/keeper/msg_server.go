@@ -4,7 +4,10 @@ import (
 	"bytes"
 	"context"
 	"encoding/base64"
+	"strings"
 
+	errorsmod "cosmossdk.io/errors"
+	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/btcutil/psbt"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/sideprotocol/side/x/btcbridge/types"
@@ -21,18 +24,44 @@ func (m msgServer) SubmitBlockHeaders(goCtx context.Context, msg *types.MsgSubmi
 		return nil, err
 	}
 
+	k, err := m.ChainKeeper(ctx, msg.ChainId)
+	if err != nil {
+		return nil, err
+	}
+
 	// check if the sender is one of the authorized senders
-	param := m.GetParams(ctx)
+	param := k.GetParams(ctx)
 	if !param.IsAuthorizedSender(msg.Sender) {
 		return nil, types.ErrSenderAddressNotAuthorized
 	}
 
+	// Wait for enough relayers to submit the same headers
+	if param.RequiresQuorum() {
+		subject, content, err := msg.AttestationContent()
+		if err != nil {
+			return nil, err
+		}
+
+		attested, err := k.Attest(ctx, msg.Sender, types.AttestationType_ATTESTATION_TYPE_HEADERS, subject, content)
+		if err != nil {
+			return nil, err
+		}
+		if !attested {
+			return &types.MsgSubmitBlockHeadersResponse{}, nil
+		}
+	}
+
 	// Set block headers
-	err := m.SetBlockHeaders(ctx, msg.BlockHeaders)
+	err = k.SetBlockHeaders(ctx, msg.BlockHeaders)
 	if err != nil {
 		return nil, err
 	}
 
+	// the attesting relayers are rewarded on the quorum
+	if !param.RequiresQuorum() {
+		k.AddFeeContribution(ctx, types.ContributorRole_CONTRIBUTOR_ROLE_RELAYER, msg.Sender)
+	}
+
 	// Emit events
 	// m.EmitEvent(
 	// 	ctx,
@@ -57,15 +86,45 @@ func (m msgServer) SubmitDepositTransaction(goCtx context.Context, msg *types.Ms
 		return nil, err
 	}
 
-	if err := m.ProcessBitcoinDepositTransaction(ctx, msg); err != nil {
+	k, err := m.ChainKeeper(ctx, msg.ChainId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Wait for enough relayers to submit the same deposit
+	param := k.GetParams(ctx)
+	if param.RequiresQuorum() {
+		if !param.IsAuthorizedSender(msg.Sender) {
+			return nil, types.ErrSenderAddressNotAuthorized
+		}
+
+		subject, content, err := msg.AttestationContent()
+		if err != nil {
+			return nil, err
+		}
+
+		attested, err := k.Attest(ctx, msg.Sender, types.AttestationType_ATTESTATION_TYPE_DEPOSIT, subject, content)
+		if err != nil {
+			return nil, err
+		}
+		if !attested {
+			return &types.MsgSubmitDepositTransactionResponse{}, nil
+		}
+	}
+
+	if err := k.ProcessBitcoinDepositTransaction(ctx, msg); err != nil {
 		ctx.Logger().Error("Error processing bitcoin deposit transaction", "error", err)
 		return nil, err
 	}
 
+	// the attesting relayers are rewarded on the quorum
+	if !param.RequiresQuorum() {
+		k.AddFeeContribution(ctx, types.ContributorRole_CONTRIBUTOR_ROLE_RELAYER, msg.Sender)
+	}
+
 	// Emit Events
-	m.EmitEvent(ctx, msg.Sender,
+	k.EmitEvent(ctx, msg.Sender,
 		sdk.NewAttribute("blockhash", msg.Blockhash),
-		sdk.NewAttribute("txBytes", msg.TxBytes),
 	)
 
 	return &types.MsgSubmitDepositTransactionResponse{}, nil
@@ -84,15 +143,19 @@ func (m msgServer) SubmitWithdrawTransaction(goCtx context.Context, msg *types.M
 		return nil, err
 	}
 
-	if err := m.ProcessBitcoinWithdrawTransaction(ctx, msg); err != nil {
+	k, err := m.ChainKeeper(ctx, msg.ChainId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.ProcessBitcoinWithdrawTransaction(ctx, msg); err != nil {
 		ctx.Logger().Error("Error processing bitcoin deposit transaction", "error", err)
 		return nil, err
 	}
 
 	// Emit Events
-	m.EmitEvent(ctx, msg.Sender,
+	k.EmitEvent(ctx, msg.Sender,
 		sdk.NewAttribute("blockhash", msg.Blockhash),
-		sdk.NewAttribute("txBytes", msg.TxBytes),
 	)
 
 	return &types.MsgSubmitWithdrawTransactionResponse{}, nil
@@ -106,14 +169,23 @@ func (m msgServer) UpdateQualifiedRelayers(goCtx context.Context, msg *types.Msg
 		return nil, err
 	}
 
-	// check if the sender is one of the authorized senders
-	param := m.GetParams(ctx)
-	if !param.IsAuthorizedSender(msg.Sender) {
-		return nil, types.ErrSenderAddressNotAuthorized
+	// the relayer set is only replaced by the gov authority, as any single relayer could otherwise
+	// replace it with itself and meet the relayer quorum alone
+	if msg.Sender != m.authority {
+		return nil, errorsmod.Wrapf(types.ErrSenderAddressNotAuthorized, "expected %s, got %s", m.authority, msg.Sender)
 	}
 
-	// Set block headers
-	m.SetParams(ctx, types.NewParams(msg.Relayers))
+	// the relayers are shared by all bridged chains, so they are updated on the params of the keeper of bitcoin,
+	// as the params scoped to another chain would write its overrides back
+	param := m.WithChain("").GetParams(ctx)
+
+	// Update relayers
+	param.AuthorizedRelayers = msg.Relayers
+	if err := param.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidSenders, err.Error())
+	}
+
+	m.WithChain("").SetParams(ctx, param)
 
 	// Emit events
 
@@ -128,35 +200,138 @@ func (m msgServer) WithdrawBitcoin(goCtx context.Context, msg *types.MsgWithdraw
 
 	sender := sdk.MustAccAddressFromBech32(msg.Sender)
 
-	coin, err := sdk.ParseCoinNormalized(msg.Amount)
+	coin, err := msg.TotalAmount()
 	if err != nil {
 		return nil, err
 	}
 
-	if err = m.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.NewCoins(coin)); err != nil {
+	// the bridged chain is resolved by the voucher denom
+	chainID, found := m.GetParams(ctx).ChainIDByDenom(coin.Denom)
+	if !found {
+		return nil, types.ErrInvalidAmount
+	}
+
+	k := m.WithChain(chainID)
+
+	// the withdrawals are paused while the block headers lag behind
+	if err := k.checkLiveness(ctx); err != nil {
+		return nil, err
+	}
+
+	recipients, err := k.resolveWithdrawRecipients(ctx, msg)
+	if err != nil {
 		return nil, err
 	}
 
-	_, err = m.Keeper.NewSigningRequest(ctx, msg.Sender, coin, msg.FeeRate, "")
+	request, err := k.NewMultiSigningRequest(ctx, recipients, msg.FeeRate, "")
 	if err != nil {
 		return nil, err
 	}
 
+	request.Sender = msg.Sender
+	k.SetSigningRequest(ctx, request)
+
+	// escrow the withdrawal amount along with the network fee, which are burned on confirmation
+	amount := request.Amount
+	escrow := sdk.NewCoin(coin.Denom, sdk.NewIntFromUint64(amount))
+
+	// the bridge fee is charged on top of the escrow
+	fee := sdk.NewCoin(coin.Denom, k.GetParams(ctx).FeeSchedule(types.AssetType_ASSET_TYPE_BTC).WithdrawFee(coin.Amount))
+	if err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.NewCoins(escrow.Add(fee))); err != nil {
+		return nil, err
+	}
+
+	if err := k.collectFee(ctx, types.BridgeOperation_BRIDGE_OPERATION_WITHDRAW, request.Txid, fee); err != nil {
+		return nil, err
+	}
+
 	// Emit events
-	m.EmitEvent(ctx, msg.Sender,
-		sdk.NewAttribute("withdraw", msg.Amount),
-	)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventWithdrawalRequested{
+		Sender:       msg.Sender,
+		Recipient:    request.Address,
+		Amount:       coin,
+		Fee:          amount - coin.Amount.Uint64(),
+		FeeRate:      msg.FeeRate,
+		Txid:         request.Txid,
+		VaultAddress: request.VaultAddress,
+		Sequence:     request.Sequence,
+		Recipients:   request.Recipients,
+	}); err != nil {
+		return nil, err
+	}
+
+	k.afterWithdrawalRequested(ctx, sender, request, escrow)
 
 	return &types.MsgWithdrawBitcoinResponse{}, nil
 }
 
+// resolveWithdrawRecipients resolves the destinations of the withdrawal, of which the empty btc address
+// goes to the linked bitcoin address or the sender. Each destination is screened and checked against the network
+// of the bridged chain.
+func (k Keeper) resolveWithdrawRecipients(ctx sdk.Context, msg *types.MsgWithdrawBitcoinRequest) ([]*types.WithdrawRecipient, error) {
+	p := k.GetParams(ctx)
+
+	recipients := make([]*types.WithdrawRecipient, 0)
+	addresses := make(map[string]bool)
+
+	for _, recipient := range msg.WithdrawRecipients() {
+		address := recipient.BtcAddress
+		if len(address) == 0 {
+			// withdraw to the linked bitcoin address by default
+			address = k.GetLinkedBitcoinAddress(ctx, msg.Sender)
+			if len(address) == 0 {
+				address = msg.Sender
+			}
+		}
+
+		// the address is screened and paid in the canonical encoding
+		if addr, err := btcutil.DecodeAddress(address, p.ChainCfg()); err == nil {
+			address = addr.EncodeAddress()
+		}
+
+		// the different encodings of the same address are taken as duplicates
+		if addresses[address] {
+			return nil, errorsmod.Wrapf(types.ErrInvalidRecipients, "duplicate btc address %s", address)
+		}
+
+		addresses[address] = true
+
+		if err := k.screenWithdrawal(ctx, msg.Sender, address); err != nil {
+			return nil, err
+		}
+
+		coin, err := sdk.ParseCoinNormalized(recipient.Amount)
+		if err != nil {
+			return nil, types.ErrInvalidAmount
+		}
+
+		if err := types.CheckWithdrawOutput(address, coin.Amount.Int64(), p.ChainCfg()); err != nil {
+			return nil, err
+		}
+
+		// the vault outputs are taken as the change of the withdrawal
+		if types.SelectVaultByBitcoinAddress(p.Vaults, address) != nil {
+			return nil, errorsmod.Wrapf(types.ErrInvalidBtcAddress, "can not withdraw to the vault %s", address)
+		}
+
+		recipients = append(recipients, &types.WithdrawRecipient{BtcAddress: address, Amount: recipient.Amount})
+	}
+
+	return recipients, nil
+}
+
 // SubmitWithdrawSignatures submits the signatures of the withdraw transaction.
 func (m msgServer) SubmitWithdrawSignatures(goCtx context.Context, msg *types.MsgSubmitWithdrawSignaturesRequest) (*types.MsgSubmitWithdrawSignaturesResponse, error) {
 	if err := msg.ValidateBasic(); err != nil {
 		return nil, err
 	}
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	exist := m.HasSigningRequest(ctx, msg.Txid)
+	k, err := m.ChainKeeper(ctx, msg.ChainId)
+	if err != nil {
+		return nil, err
+	}
+
+	exist := k.HasSigningRequest(ctx, msg.Txid)
 	if !exist {
 		return nil, types.ErrSigningRequestNotExist
 	}
@@ -177,16 +352,46 @@ func (m msgServer) SubmitWithdrawSignatures(goCtx context.Context, msg *types.Ms
 		return nil, types.ErrInvalidSignatures
 	}
 
+	// the signed tx must be exactly the one of the request, whose inputs and outputs are fixed by the txid
+	if packet.UnsignedTx.TxHash().String() != msg.Txid {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSignatures, "signed tx %s does not match the request", packet.UnsignedTx.TxHash())
+	}
+
+	// the signatures are verified against the witness utxos of the request rather than the ones of the signer
+	request := k.GetSigningRequest(ctx, msg.Txid)
+	if !isWithdrawalPending(request.Status) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidStatus, "can not sign the %s request", request.Status)
+	}
+
+	expected, err := psbt.NewFromRawBytes(strings.NewReader(request.Psbt), true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !types.MatchPsbtInputs(packet, expected) {
+		return nil, errorsmod.Wrap(types.ErrInvalidSignatures, "witness utxos do not match the request")
+	}
+
 	// verify the signatures
 	if !types.VerifyPsbtSignatures(packet) {
 		return nil, types.ErrInvalidSignatures
 	}
 
 	// Set the signing request status to signed
-	request := m.GetSigningRequest(ctx, msg.Txid)
+	firstSigned := request.Status == types.SigningStatus_SIGNING_STATUS_CREATED
+
 	request.Psbt = msg.Psbt
-	request.Status = types.SigningStatus_SIGNING_STATUS_SIGNED
-	m.SetSigningRequest(ctx, request)
+	if err := k.UpdateSigningStatus(ctx, request, types.SigningStatus_SIGNING_STATUS_SIGNED); err != nil {
+		return nil, err
+	}
+
+	// only the signer completing the request is rewarded, rather than anyone relaying the signatures
+	if firstSigned {
+		vault := types.SelectVaultByBitcoinAddress(k.GetParams(ctx).Vaults, request.VaultAddress)
+		if vault != nil && vault.IsSigner(msg.Sender) {
+			k.AddFeeContribution(ctx, types.ContributorRole_CONTRIBUTOR_ROLE_SIGNER, msg.Sender)
+		}
+	}
 
 	return &types.MsgSubmitWithdrawSignaturesResponse{}, nil
 
@@ -196,23 +401,205 @@ func (m msgServer) SubmitWithdrawStatus(goCtx context.Context, msg *types.MsgSub
 	if err := msg.ValidateBasic(); err != nil {
 		return nil, err
 	}
-	param := m.GetParams(sdk.UnwrapSDKContext(goCtx))
-	if !param.IsAuthorizedSender(msg.Sender) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k, err := m.ChainKeeper(ctx, msg.ChainId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !k.GetParams(ctx).IsAuthorizedSender(msg.Sender) {
 		return nil, types.ErrSenderAddressNotAuthorized
 	}
-	ctx := sdk.UnwrapSDKContext(goCtx)
-	exist := m.HasSigningRequest(ctx, msg.Txid)
+
+	exist := k.HasSigningRequest(ctx, msg.Txid)
 	if !exist {
 		return nil, types.ErrSigningRequestNotExist
 	}
 
-	request := m.GetSigningRequest(ctx, msg.Txid)
-	request.Status = msg.Status
-	m.SetSigningRequest(ctx, request)
+	request := k.GetSigningRequest(ctx, msg.Txid)
+
+	// the request is confirmed only by the withdrawal tx, and the settled request never goes back to pending
+	if msg.Status == types.SigningStatus_SIGNING_STATUS_CONFIRMED || !isWithdrawalPending(request.Status) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidStatus, "can not update the status of the %s request to %s", request.Status, msg.Status)
+	}
+
+	if msg.Status == types.SigningStatus_SIGNING_STATUS_REJECTED {
+		if err := k.rejectWithdrawal(ctx, request); err != nil {
+			return nil, err
+		}
+
+		return &types.MsgSubmitWithdrawStatusResponse{}, nil
+	}
+
+	if err := k.UpdateSigningStatus(ctx, request, msg.Status); err != nil {
+		return nil, err
+	}
 
 	return &types.MsgSubmitWithdrawStatusResponse{}, nil
 }
 
+// LinkBitcoinAddress links the bitcoin address to the sender
+func (m msgServer) LinkBitcoinAddress(goCtx context.Context, msg *types.MsgLinkBitcoinAddressRequest) (*types.MsgLinkBitcoinAddressResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	k, err := m.ChainKeeper(ctx, msg.ChainId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.LinkBitcoinAddress(ctx, msg.Sender, msg.BtcAddress, msg.Signature); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeLinkBitcoinAddress,
+		sdk.NewAttribute(types.AttributeKeyAddress, msg.Sender),
+		sdk.NewAttribute(types.AttributeKeyBtcAddress, msg.BtcAddress),
+	))
+
+	return &types.MsgLinkBitcoinAddressResponse{}, nil
+}
+
+// CreateHTLC implements types.MsgServer.
+func (m msgServer) CreateHTLC(goCtx context.Context, msg *types.MsgCreateHTLCRequest) (*types.MsgCreateHTLCResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	htlc, err := m.Keeper.CreateHTLC(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateHTLCResponse{Id: htlc.Id}, nil
+}
+
+// ClaimHTLC implements types.MsgServer.
+func (m msgServer) ClaimHTLC(goCtx context.Context, msg *types.MsgClaimHTLCRequest) (*types.MsgClaimHTLCResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.ClaimHTLC(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimHTLCResponse{}, nil
+}
+
+// UpdateParams implements types.MsgServer.
+func (m msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParamsRequest) (*types.MsgUpdateParamsResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != m.authority {
+		return nil, errorsmod.Wrapf(types.ErrSenderAddressNotAuthorized, "expected %s, got %s", m.authority, msg.Authority)
+	}
+
+	m.SetParams(ctx, msg.Params)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// UpdateBlocklist implements types.MsgServer.
+func (m msgServer) UpdateBlocklist(goCtx context.Context, msg *types.MsgUpdateBlocklistRequest) (*types.MsgUpdateBlocklistResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != m.authority {
+		return nil, errorsmod.Wrapf(types.ErrSenderAddressNotAuthorized, "expected %s, got %s", m.authority, msg.Authority)
+	}
+
+	if err := m.Keeper.UpdateBlocklist(ctx, msg.Add, msg.Remove); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateBlocklistResponse{}, nil
+}
+
+// ReleaseQuarantinedDeposit implements types.MsgServer.
+func (m msgServer) ReleaseQuarantinedDeposit(goCtx context.Context, msg *types.MsgReleaseQuarantinedDepositRequest) (*types.MsgReleaseQuarantinedDepositResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.ReleaseQuarantinedDeposit(ctx, msg.Sender, msg.Id); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReleaseQuarantinedDepositResponse{}, nil
+}
+
+// ReturnQuarantinedDeposit implements types.MsgServer.
+func (m msgServer) ReturnQuarantinedDeposit(goCtx context.Context, msg *types.MsgReturnQuarantinedDepositRequest) (*types.MsgReturnQuarantinedDepositResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := m.Keeper.ReturnQuarantinedDeposit(ctx, msg.Sender, msg.Id, msg.BtcAddress, msg.FeeRate); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReturnQuarantinedDepositResponse{}, nil
+}
+
+// RecoverVault implements types.MsgServer.
+func (m msgServer) RecoverVault(goCtx context.Context, msg *types.MsgRecoverVaultRequest) (*types.MsgRecoverVaultResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != m.authority {
+		return nil, errorsmod.Wrapf(types.ErrSenderAddressNotAuthorized, "expected %s, got %s", m.authority, msg.Authority)
+	}
+
+	k, err := m.ChainKeeper(ctx, msg.ChainId)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := k.NewRecoverySigningRequest(ctx, msg.VaultAddress, msg.Recipient, msg.FeeRate)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRecoverVaultResponse{Txid: request.Txid}, nil
+}
+
+// TagUTXO implements types.MsgServer.
+func (m msgServer) TagUTXO(goCtx context.Context, msg *types.MsgTagUTXORequest) (*types.MsgTagUTXOResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != m.authority {
+		return nil, errorsmod.Wrapf(types.ErrSenderAddressNotAuthorized, "expected %s, got %s", m.authority, msg.Authority)
+	}
+
+	k, err := m.ChainKeeper(ctx, msg.ChainId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.TagUTXO(ctx, msg.Txid, msg.Vout, msg.Inscriptions, msg.Runes); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTagUTXOResponse{}, nil
+}
+
 // NewMsgServerImpl returns an implementation of the MsgServer interface
 // for the provided Keeper.
 func NewMsgServerImpl(keeper Keeper) types.MsgServer {
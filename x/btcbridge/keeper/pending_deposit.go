@@ -0,0 +1,158 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+// addPendingDeposit registers the given deposit whose block is not yet deep enough, so that it is minted on confirmation
+func (k Keeper) addPendingDeposit(ctx sdk.Context, msg *types.MsgSubmitDepositTransactionRequest, txid string, header *types.BlockHeader, required int32, coinbase bool) error {
+	if k.HasPendingDeposit(ctx, txid) {
+		return types.ErrDepositAlreadyPending
+	}
+
+	deposit := &types.PendingDeposit{
+		Txid:                  txid,
+		Blockhash:             msg.Blockhash,
+		Height:                header.Height,
+		RequiredConfirmations: required,
+		Relayer:               msg.Sender,
+		PrevTxBytes:           msg.PrevTxBytes,
+		InputPrevTxs:          msg.InputPrevTxs,
+		TxBytes:               msg.TxBytes,
+		Proof:                 msg.Proof,
+		TxOutProof:            msg.TxOutProof,
+		IsCoinbase:            coinbase,
+	}
+
+	k.SetPendingDeposit(ctx, deposit)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventDepositPending{
+		Txid:                  deposit.Txid,
+		Blockhash:             deposit.Blockhash,
+		Height:                deposit.Height,
+		RequiredConfirmations: deposit.RequiredConfirmations,
+	})
+}
+
+// MintPendingDeposits mints the pending deposits of the bridged chain whose blocks are deep enough.
+// Only the deposits indexed by the confirmed heights up to the best block height are visited.
+// The relayer is authorized on registration, so each deposit is processed in a cached context without
+// checking the relayer again; the deposit failing to be minted is dropped.
+func (k Keeper) MintPendingDeposits(ctx sdk.Context) {
+	best := k.GetBestBlockHeader(ctx)
+
+	iterator := k.store(ctx).Iterator(types.BtcPendingDepositHeightKeyPrefix, types.BtcPendingDepositHeightKey(best.Height+1, ""))
+	defer iterator.Close()
+
+	txids := make([]string, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		txids = append(txids, string(iterator.Key()[len(types.BtcPendingDepositHeightKeyPrefix)+8:]))
+	}
+
+	for _, txid := range txids {
+		deposit := k.GetPendingDeposit(ctx, txid)
+		if deposit == nil {
+			continue
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+
+		// the deposit requiring more confirmations since the params changed is registered again
+		k.removePendingDeposit(cacheCtx, deposit.Txid)
+
+		if err := k.processDeposit(cacheCtx, &types.MsgSubmitDepositTransactionRequest{
+			Sender:       deposit.Relayer,
+			Blockhash:    deposit.Blockhash,
+			PrevTxBytes:  deposit.PrevTxBytes,
+			InputPrevTxs: deposit.InputPrevTxs,
+			TxBytes:      deposit.TxBytes,
+			Proof:        deposit.Proof,
+			TxOutProof:   deposit.TxOutProof,
+			ChainId:      k.chainID,
+		}); err != nil {
+			k.dropPendingDeposit(ctx, deposit, err)
+			continue
+		}
+
+		write()
+	}
+}
+
+// dropReorganizedPendingDeposits drops the pending deposits whose blocks are reorganized out from the given fork height
+func (k Keeper) dropReorganizedPendingDeposits(ctx sdk.Context, forkHeight uint64) {
+	for _, deposit := range k.GetPendingDeposits(ctx) {
+		if deposit.Height >= forkHeight {
+			k.dropPendingDeposit(ctx, deposit, types.ErrBlockNotFound)
+		}
+	}
+}
+
+// dropPendingDeposit removes the pending deposit which fails to be minted
+func (k Keeper) dropPendingDeposit(ctx sdk.Context, deposit *types.PendingDeposit, reason error) {
+	k.Logger(ctx).Error("failed to mint the pending deposit", "txid", deposit.Txid, "chain", k.chainID, "error", reason)
+
+	k.removePendingDeposit(ctx, deposit.Txid)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventPendingDepositDropped{
+		Txid:   deposit.Txid,
+		Reason: reason.Error(),
+	}); err != nil {
+		k.Logger(ctx).Error("failed to emit the event", "error", err)
+	}
+}
+
+// HasPendingDeposit returns true if the given deposit tx is waiting for confirmations
+func (k Keeper) HasPendingDeposit(ctx sdk.Context, txid string) bool {
+	return k.store(ctx).Has(types.BtcPendingDepositKey(txid))
+}
+
+// GetPendingDeposit returns the pending deposit of the given txid, nil if not found
+func (k Keeper) GetPendingDeposit(ctx sdk.Context, txid string) *types.PendingDeposit {
+	bz := k.store(ctx).Get(types.BtcPendingDepositKey(txid))
+	if bz == nil {
+		return nil
+	}
+
+	var deposit types.PendingDeposit
+	k.cdc.MustUnmarshal(bz, &deposit)
+
+	return &deposit
+}
+
+// SetPendingDeposit sets the pending deposit, which is indexed by the height at which it is confirmed
+func (k Keeper) SetPendingDeposit(ctx sdk.Context, deposit *types.PendingDeposit) {
+	store := k.store(ctx)
+
+	store.Set(types.BtcPendingDepositKey(deposit.Txid), k.cdc.MustMarshal(deposit))
+	store.Set(types.BtcPendingDepositHeightKey(deposit.ConfirmedHeight(), deposit.Txid), []byte{1})
+}
+
+func (k Keeper) removePendingDeposit(ctx sdk.Context, txid string) {
+	deposit := k.GetPendingDeposit(ctx, txid)
+	if deposit == nil {
+		return
+	}
+
+	store := k.store(ctx)
+
+	store.Delete(types.BtcPendingDepositKey(txid))
+	store.Delete(types.BtcPendingDepositHeightKey(deposit.ConfirmedHeight(), txid))
+}
+
+// GetPendingDeposits returns all pending deposits of the bridged chain
+func (k Keeper) GetPendingDeposits(ctx sdk.Context) []*types.PendingDeposit {
+	iterator := sdk.KVStorePrefixIterator(k.store(ctx), types.BtcPendingDepositKeyPrefix)
+	defer iterator.Close()
+
+	deposits := make([]*types.PendingDeposit, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var deposit types.PendingDeposit
+		k.cdc.MustUnmarshal(iterator.Value(), &deposit)
+
+		deposits = append(deposits, &deposit)
+	}
+
+	return deposits
+}
@@ -0,0 +1,176 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sideprotocol/side/testutil/bitcoin"
+	"github.com/sideprotocol/side/testutil/sample"
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+func TestPendingDeposits(t *testing.T) {
+	env := newDepositTestEnv(t)
+
+	params := env.app.BtcBridgeKeeper.GetParams(env.ctx)
+	params.Confirmations = 3
+	env.app.BtcBridgeKeeper.SetParams(env.ctx, params)
+
+	holder := sample.AccAddress()
+	memo := env.memoOut(t, &types.DepositMemo{Recipient: holder})
+
+	// the deposit is registered as soon as its block is known
+	msg := env.depositMsg(t, wire.NewTxOut(100000, env.vaultPkScript), memo)
+	require.NoError(t, env.app.BtcBridgeKeeper.ProcessBitcoinDepositTransaction(env.ctx, msg))
+	require.Zero(t, env.balance(holder, "sat").Int64())
+	require.Len(t, typedEvents[*types.EventDepositPending](t, env.ctx), 1)
+
+	// the relayers need not submit it again
+	require.ErrorIs(t, env.app.BtcBridgeKeeper.ProcessBitcoinDepositTransaction(env.ctx, msg), types.ErrDepositAlreadyPending)
+
+	pending := env.app.BtcBridgeKeeper.GetPendingDeposits(env.ctx)
+	require.Len(t, pending, 1)
+
+	res, err := env.app.BtcBridgeKeeper.QueryPendingDeposit(sdk.WrapSDKContext(env.ctx), &types.QueryPendingDepositRequest{Txid: pending[0].Txid})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.Confirmations)
+	require.Equal(t, int32(3), res.Deposit.RequiredConfirmations)
+
+	env.app.BtcBridgeKeeper.MintPendingDeposits(env.ctx)
+	require.Zero(t, env.balance(holder, "sat").Int64())
+
+	// the deposit is minted in the end block once confirmed
+	env.chain.MineBlock()
+	env.chain.MineBlock()
+	env.syncHeaders(t)
+
+	env.app.BtcBridgeKeeper.MintPendingDeposits(env.ctx)
+	require.Equal(t, int64(100000), env.balance(holder, "sat").Int64())
+	require.Empty(t, env.app.BtcBridgeKeeper.GetPendingDeposits(env.ctx))
+	require.Len(t, typedEvents[*types.EventDepositMinted](t, env.ctx), 1)
+
+	env.checkReserves(t)
+
+	// the deposit whose block is reorganized out is dropped
+	require.NoError(t, env.app.BtcBridgeKeeper.ProcessBitcoinDepositTransaction(env.ctx, env.depositMsg(t, wire.NewTxOut(50000, env.vaultPkScript), memo)))
+
+	pending = env.app.BtcBridgeKeeper.GetPendingDeposits(env.ctx)
+	require.Len(t, pending, 1)
+
+	// a fork block with more work at the deposit height
+	fork := bitcoin.NewBlock(env.chain.BlockAt(int64(pending[0].Height)-1), int64(pending[0].Height))
+	fork.Header.Bits = 0x1f7fffff
+	bitcoin.Solve(&fork.Header)
+
+	require.NoError(t, env.app.BtcBridgeKeeper.SetBlockHeaders(env.ctx, []*types.BlockHeader{types.NewBlockHeader(&fork.Header, pending[0].Height, 1)}))
+
+	env.app.BtcBridgeKeeper.MintPendingDeposits(env.ctx)
+	require.Empty(t, env.app.BtcBridgeKeeper.GetPendingDeposits(env.ctx))
+	require.Len(t, typedEvents[*types.EventPendingDepositDropped](t, env.ctx), 1)
+	require.Equal(t, int64(100000), env.balance(holder, "sat").Int64())
+}
+
+func TestPendingDepositRelayerRemoved(t *testing.T) {
+	env := newDepositTestEnv(t)
+
+	params := env.app.BtcBridgeKeeper.GetParams(env.ctx)
+	params.Confirmations = 3
+	env.app.BtcBridgeKeeper.SetParams(env.ctx, params)
+
+	holder := sample.AccAddress()
+	msg := env.depositMsg(t, wire.NewTxOut(100000, env.vaultPkScript), env.memoOut(t, &types.DepositMemo{Recipient: holder}))
+	require.NoError(t, env.app.BtcBridgeKeeper.ProcessBitcoinDepositTransaction(env.ctx, msg))
+
+	// the relayer is authorized on registration only
+	params.AuthorizedRelayers = []string{sample.AccAddress()}
+	env.app.BtcBridgeKeeper.SetParams(env.ctx, params)
+
+	env.chain.MineBlock()
+	env.chain.MineBlock()
+	env.syncHeaders(t)
+
+	env.app.BtcBridgeKeeper.MintPendingDeposits(env.ctx)
+	require.Equal(t, int64(100000), env.balance(holder, "sat").Int64())
+	require.Empty(t, env.app.BtcBridgeKeeper.GetPendingDeposits(env.ctx))
+	require.Empty(t, typedEvents[*types.EventPendingDepositDropped](t, env.ctx))
+}
+
+func TestCoinbaseDeposit(t *testing.T) {
+	env := newDepositTestEnv(t)
+
+	vault := env.app.BtcBridgeKeeper.GetParams(env.ctx).Vaults[0].Address
+
+	// the coinbase tx of a mining pool pays to the vault
+	holder := sample.AccAddress()
+	block := env.chain.MineCoinbaseBlock([]*wire.TxOut{wire.NewTxOut(100000, env.vaultPkScript), env.memoOut(t, &types.DepositMemo{Recipient: holder})})
+	env.chain.MineBlock()
+	env.syncHeaders(t)
+
+	coinbase := block.Transactions[0]
+	coinbaseHash := coinbase.TxHash()
+	txOutProof, err := types.BuildTxOutProof(block, &coinbaseHash)
+	require.NoError(t, err)
+
+	msg := types.NewMsgSubmitDepositTransactionRequest(env.relayer, block.BlockHash().String(), "", serializeTx(t, coinbase), nil)
+	msg.TxOutProof = txOutProof
+	require.NoError(t, msg.ValidateBasic())
+
+	// the coinbase deposit waits for the coinbase maturity
+	require.NoError(t, env.app.BtcBridgeKeeper.ProcessBitcoinDepositTransaction(env.ctx, msg))
+	require.Zero(t, env.balance(holder, "sat").Int64())
+
+	goCtx := sdk.WrapSDKContext(env.ctx)
+
+	res, err := env.app.BtcBridgeKeeper.QueryPendingDeposit(goCtx, &types.QueryPendingDepositRequest{Txid: coinbaseHash.String()})
+	require.NoError(t, err)
+	require.Equal(t, types.PendingDepositState_PENDING_DEPOSIT_STATE_MATURING, res.State)
+	require.Equal(t, int32(env.chain.Params.CoinbaseMaturity), res.Deposit.RequiredConfirmations)
+
+	confirming, err := env.app.BtcBridgeKeeper.QueryPendingDeposits(goCtx, &types.QueryPendingDepositsRequest{State: types.PendingDepositState_PENDING_DEPOSIT_STATE_CONFIRMING})
+	require.NoError(t, err)
+	require.Empty(t, confirming.Deposits)
+
+	maturing, err := env.app.BtcBridgeKeeper.QueryPendingDeposits(goCtx, &types.QueryPendingDepositsRequest{State: types.PendingDepositState_PENDING_DEPOSIT_STATE_MATURING})
+	require.NoError(t, err)
+	require.Len(t, maturing.Deposits, 1)
+
+	for i := 1; i < int(env.chain.Params.CoinbaseMaturity)-1; i++ {
+		env.chain.MineBlock()
+	}
+	env.syncHeaders(t)
+
+	env.app.BtcBridgeKeeper.MintPendingDeposits(env.ctx)
+	require.Zero(t, env.balance(holder, "sat").Int64())
+
+	// the mature coinbase deposit is minted
+	env.chain.MineBlock()
+	env.syncHeaders(t)
+
+	env.app.BtcBridgeKeeper.MintPendingDeposits(env.ctx)
+	require.Equal(t, int64(100000), env.balance(holder, "sat").Int64())
+	require.Empty(t, env.app.BtcBridgeKeeper.GetPendingDeposits(env.ctx))
+
+	utxo := env.app.BtcBridgeKeeper.GetUTXO(env.ctx, coinbaseHash.String(), 0)
+	require.True(t, utxo.IsCoinbase)
+	require.Len(t, env.app.BtcBridgeKeeper.GetOrderedUTXOsByAddr(env.ctx, vault), 1)
+
+	env.checkReserves(t)
+
+	// the immature coinbase utxo is not selected
+	best := env.app.BtcBridgeKeeper.GetBestBlockHeader(env.ctx)
+	env.app.BtcBridgeKeeper.SetUTXO(env.ctx, &types.UTXO{
+		Txid:         env.chain.Tip().Transactions[0].TxHash().String(),
+		Address:      vault,
+		Amount:       200000,
+		PubKeyScript: env.vaultPkScript,
+		Height:       best.Height,
+		IsCoinbase:   true,
+	})
+
+	utxos := env.app.BtcBridgeKeeper.GetOrderedUTXOsByAddr(env.ctx, vault)
+	require.Len(t, utxos, 1)
+	require.Equal(t, coinbaseHash.String(), utxos[0].Txid)
+}
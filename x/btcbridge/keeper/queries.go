@@ -2,7 +2,10 @@ package keeper
 
 import (
 	"context"
+	"encoding/base64"
 
+	sdkmath "cosmossdk.io/math"
+	"github.com/btcsuite/btcd/btcutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/sideprotocol/side/x/btcbridge/types"
 	"google.golang.org/grpc/codes"
@@ -26,6 +29,11 @@ func (k Keeper) QueryChainTip(goCtx context.Context, req *types.QueryChainTipReq
 	}
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	k, err := k.queryChainKeeper(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
+
 	best := k.GetBestBlockHeader(ctx)
 
 	return &types.QueryChainTipResponse{
@@ -41,6 +49,11 @@ func (k Keeper) QueryBlockHeaderByHash(goCtx context.Context, req *types.QueryBl
 	}
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	k, err := k.queryChainKeeper(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
+
 	header := k.GetBlockHeader(ctx, req.Hash)
 	if header == nil {
 		return nil, status.Error(codes.NotFound, "block header not found")
@@ -55,6 +68,11 @@ func (k Keeper) QueryBlockHeaderByHeight(goCtx context.Context, req *types.Query
 	}
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	k, err := k.queryChainKeeper(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
+
 	header := k.GetBlockHeaderByHeight(ctx, req.Height)
 	if header == nil {
 		return nil, status.Error(codes.NotFound, "block header not found")
@@ -73,6 +91,11 @@ func (k Keeper) QuerySigningRequest(goCtx context.Context, req *types.QuerySigni
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	k, err := k.queryChainKeeper(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
+
 	requests := k.FilterSigningRequestsByStatus(ctx, req)
 
 	return &types.QuerySigningRequestResponse{Requests: requests}, nil
@@ -85,6 +108,11 @@ func (k Keeper) QueryUTXOs(goCtx context.Context, req *types.QueryUTXOsRequest)
 	}
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	k, err := k.queryChainKeeper(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
+
 	utxos := k.GetAllUTXOs(ctx)
 
 	return &types.QueryUTXOsResponse{Utxos: utxos}, nil
@@ -96,12 +124,273 @@ func (k Keeper) QueryUTXOsByAddress(goCtx context.Context, req *types.QueryUTXOs
 	}
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	_, err := sdk.AccAddressFromBech32(req.Address)
+	k, err := k.queryChainKeeper(ctx, req.ChainId)
 	if err != nil {
 		return nil, err
 	}
 
+	if _, err := btcutil.DecodeAddress(req.Address, k.ChainCfg(ctx)); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	utxos := k.GetUTXOsByAddr(ctx, req.Address)
 
 	return &types.QueryUTXOsByAddressResponse{Utxos: utxos}, nil
 }
+
+func (k Keeper) QueryReserves(goCtx context.Context, req *types.QueryReservesRequest) (*types.QueryReservesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	k, err := k.queryChainKeeper(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryReservesResponse{
+		Vaults:             k.GetVaultReserves(ctx),
+		VoucherSupply:      k.bankKeeper.GetSupply(ctx, k.GetParams(ctx).BtcVoucherDenom),
+		PendingWithdrawals: k.GetPendingWithdrawals(ctx),
+	}, nil
+}
+
+func (k Keeper) QueryAddressLink(goCtx context.Context, req *types.QueryAddressLinkRequest) (*types.QueryAddressLinkResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	k, err := k.queryChainKeeper(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
+
+	link := &types.AddressLink{Address: req.Address, BtcAddress: k.GetLinkedBitcoinAddress(ctx, req.Address)}
+	if len(link.BtcAddress) == 0 {
+		link = &types.AddressLink{Address: k.GetLinkedAccount(ctx, req.Address), BtcAddress: req.Address}
+	}
+
+	if len(link.Address) == 0 {
+		return nil, status.Error(codes.NotFound, "address link not found")
+	}
+
+	return &types.QueryAddressLinkResponse{Link: link}, nil
+}
+
+func (k Keeper) QueryFeeQuote(goCtx context.Context, req *types.QueryFeeQuoteRequest) (*types.QueryFeeQuoteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	k, err := k.queryChainKeeper(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
+
+	assetType := req.AssetType
+	if assetType == types.AssetType_ASSET_TYPE_UNSPECIFIED {
+		assetType = types.AssetType_ASSET_TYPE_BTC
+	}
+
+	fee, total, err := k.QuoteFee(ctx, req.Operation, assetType, sdkmath.NewIntFromUint64(req.Amount))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryFeeQuoteResponse{Fee: fee, Total: total}, nil
+}
+
+func (k Keeper) QueryFeePool(goCtx context.Context, req *types.QueryFeePoolRequest) (*types.QueryFeePoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	k, err := k.queryChainKeeper(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFeePoolResponse{
+		CollectedFees:          k.GetCollectedFees(ctx),
+		Contributions:          k.GetFeeContributions(ctx),
+		NextDistributionHeight: k.NextFeeDistributionHeight(ctx),
+	}, nil
+}
+
+func (k Keeper) QueryVerifyTxInclusion(goCtx context.Context, req *types.QueryVerifyTxInclusionRequest) (*types.QueryVerifyTxInclusionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	k, err := k.queryChainKeeper(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
+
+	txBytes, err := base64.StdEncoding.DecodeString(req.TxBytes)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid tx bytes")
+	}
+
+	inclusion, err := k.VerifyTxInclusion(ctx, txBytes, req.Blockhash, req.Proof, req.MinConfirmations)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryVerifyTxInclusionResponse{Inclusion: inclusion}, nil
+}
+
+// queryChainKeeper returns the keeper scoped to the queried bridged chain
+func (k Keeper) queryChainKeeper(ctx sdk.Context, chainID string) (Keeper, error) {
+	if !k.GetParams(ctx).HasChain(chainID) {
+		return Keeper{}, status.Errorf(codes.NotFound, "chain %s not found", chainID)
+	}
+
+	return k.WithChain(chainID), nil
+}
+
+func (k Keeper) QueryHTLC(goCtx context.Context, req *types.QueryHTLCRequest) (*types.QueryHTLCResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	htlc := k.GetHTLC(ctx, req.Id)
+	if htlc == nil {
+		return nil, status.Error(codes.NotFound, "htlc not found")
+	}
+
+	return &types.QueryHTLCResponse{Htlc: htlc}, nil
+}
+
+func (k Keeper) QueryHTLCs(goCtx context.Context, req *types.QueryHTLCsRequest) (*types.QueryHTLCsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryHTLCsResponse{Htlcs: k.GetHTLCs(ctx, req.Status)}, nil
+}
+
+func (k Keeper) QueryRequiredConfirmations(goCtx context.Context, req *types.QueryRequiredConfirmationsRequest) (*types.QueryRequiredConfirmationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	k, err := k.queryChainKeeper(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
+
+	assetType := req.AssetType
+	if assetType == types.AssetType_ASSET_TYPE_UNSPECIFIED {
+		assetType = types.AssetType_ASSET_TYPE_BTC
+	}
+
+	return &types.QueryRequiredConfirmationsResponse{Confirmations: k.GetParams(ctx).RequiredConfirmations(assetType, req.Amount)}, nil
+}
+
+func (k Keeper) QueryBlocklist(goCtx context.Context, req *types.QueryBlocklistRequest) (*types.QueryBlocklistResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryBlocklistResponse{Addresses: k.GetBlocklist(ctx)}, nil
+}
+
+func (k Keeper) QueryQuarantinedDeposits(goCtx context.Context, req *types.QueryQuarantinedDepositsRequest) (*types.QueryQuarantinedDepositsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryQuarantinedDepositsResponse{Deposits: k.GetQuarantinedDeposits(ctx, req.Status)}, nil
+}
+
+func (k Keeper) QueryPendingDeposit(goCtx context.Context, req *types.QueryPendingDepositRequest) (*types.QueryPendingDepositResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	k, err := k.queryChainKeeper(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
+
+	deposit := k.GetPendingDeposit(ctx, req.Txid)
+	if deposit == nil {
+		return nil, status.Error(codes.NotFound, "pending deposit not found")
+	}
+
+	confirmations := uint64(0)
+	if best := k.GetBestBlockHeader(ctx); best.Height > deposit.Height {
+		confirmations = best.Height - deposit.Height
+	}
+
+	return &types.QueryPendingDepositResponse{Deposit: deposit, Confirmations: confirmations, State: deposit.State()}, nil
+}
+
+func (k Keeper) QueryPendingDeposits(goCtx context.Context, req *types.QueryPendingDepositsRequest) (*types.QueryPendingDepositsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	k, err := k.queryChainKeeper(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
+
+	deposits := make([]*types.PendingDeposit, 0)
+	for _, deposit := range k.GetPendingDeposits(ctx) {
+		if req.State == types.PendingDepositState_PENDING_DEPOSIT_STATE_UNSPECIFIED || deposit.State() == req.State {
+			deposits = append(deposits, deposit)
+		}
+	}
+
+	return &types.QueryPendingDepositsResponse{Deposits: deposits, BestHeight: k.GetBestBlockHeader(ctx).Height}, nil
+}
+
+func (k Keeper) QueryBridgeStatus(goCtx context.Context, req *types.QueryBridgeStatusRequest) (*types.QueryBridgeStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	k, err := k.queryChainKeeper(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
+
+	best := k.GetBestBlockHeader(ctx)
+
+	return &types.QueryBridgeStatusResponse{
+		Degradation:  k.GetDegradation(ctx),
+		BestHeight:   best.Height,
+		BestTime:     best.Time,
+		HeaderLag:    k.HeaderLag(ctx),
+		MaxHeaderLag: k.GetParams(ctx).MaxHeaderLag,
+	}, nil
+}
+
+func (k Keeper) QueryTaggedUTXOs(goCtx context.Context, req *types.QueryTaggedUTXOsRequest) (*types.QueryTaggedUTXOsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	k, err := k.queryChainKeeper(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTaggedUTXOsResponse{Utxos: k.GetTaggedUTXOsByAddr(ctx, req.Address)}, nil
+}
@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"bytes"
+
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+// GetVaultReserves returns the reserves held by each vault.
+// The utxos created by the unconfirmed signing requests are reported apart, as the utxos spent by the requests
+// stay locked in the vaults until confirmed; counting both would count the same btc twice.
+func (k Keeper) GetVaultReserves(ctx sdk.Context) []*types.VaultReserve {
+	vaults := k.GetParams(ctx).Vaults
+
+	unconfirmed := make(map[string]bool)
+	k.IterateSigningRequests(ctx, func(request types.BitcoinSigningRequest) (stop bool) {
+		if isWithdrawalPending(request.Status) {
+			unconfirmed[request.Txid] = true
+		}
+
+		return false
+	})
+
+	reserves := make([]*types.VaultReserve, 0, len(vaults))
+	for _, vault := range vaults {
+		reserve := &types.VaultReserve{
+			Address:   vault.Address,
+			AssetType: vault.AssetType,
+		}
+
+		k.IterateUTXOsByAddr(ctx, vault.Address, func(_ string, utxo *types.UTXO) (stop bool) {
+			if unconfirmed[utxo.Txid] {
+				reserve.Unconfirmed += utxo.Amount
+			} else if utxo.IsLocked {
+				reserve.Locked += utxo.Amount
+			} else {
+				reserve.Unspent += utxo.Amount
+			}
+
+			return false
+		})
+
+		reserves = append(reserves, reserve)
+	}
+
+	return reserves
+}
+
+// GetBtcReserve returns the total reserve held by the btc vaults
+func (k Keeper) GetBtcReserve(ctx sdk.Context) uint64 {
+	total := uint64(0)
+	for _, reserve := range k.GetVaultReserves(ctx) {
+		if reserve.AssetType == types.AssetType_ASSET_TYPE_BTC {
+			total += reserve.Unspent + reserve.Locked
+		}
+	}
+
+	return total
+}
+
+// GetRecoveryDeficit returns the network fees of the confirmed recovery sweeps not covered by the collected fees
+func (k Keeper) GetRecoveryDeficit(ctx sdk.Context) uint64 {
+	bz := k.store(ctx).Get(types.BtcRecoveryDeficitKey)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setRecoveryDeficit sets the network fees of the confirmed recovery sweeps not covered by the collected fees
+func (k Keeper) setRecoveryDeficit(ctx sdk.Context, deficit uint64) {
+	k.store(ctx).Set(types.BtcRecoveryDeficitKey, sdk.Uint64ToBigEndian(deficit))
+}
+
+// GetPendingWithdrawals returns the total amount of the in-flight withdrawals
+func (k Keeper) GetPendingWithdrawals(ctx sdk.Context) uint64 {
+	total := uint64(0)
+	k.IterateSigningRequests(ctx, func(request types.BitcoinSigningRequest) (stop bool) {
+		// recovery sweeps move the reserves between vaults and escrow nothing
+		if !isWithdrawalPending(request.Status) || request.Recovery {
+			return false
+		}
+
+		total += request.Amount
+
+		return false
+	})
+
+	return total
+}
+
+// isWithdrawalPending returns true if the withdrawal has not been confirmed or rejected yet
+func isWithdrawalPending(status types.SigningStatus) bool {
+	switch status {
+	case types.SigningStatus_SIGNING_STATUS_CREATED,
+		types.SigningStatus_SIGNING_STATUS_SIGNED,
+		types.SigningStatus_SIGNING_STATUS_BROADCASTED:
+		return true
+	}
+
+	return false
+}
+
+// withdrawalAmount returns the amount leaving the vault by the given psbt,
+// i.e. the total input amount minus the change to the vault, including the network fee
+func withdrawalAmount(packet *psbt.Packet) (uint64, error) {
+	vaultPkScript := []byte(nil)
+
+	amount := int64(0)
+	for _, input := range packet.Inputs {
+		if input.WitnessUtxo == nil {
+			return 0, types.ErrInvalidBtcTransaction
+		}
+
+		amount += input.WitnessUtxo.Value
+		vaultPkScript = input.WitnessUtxo.PkScript
+	}
+
+	for _, out := range packet.UnsignedTx.TxOut {
+		if bytes.Equal(out.PkScript, vaultPkScript) {
+			amount -= out.Value
+		}
+	}
+
+	if amount <= 0 {
+		return 0, types.ErrInvalidAmount
+	}
+
+	return uint64(amount), nil
+}
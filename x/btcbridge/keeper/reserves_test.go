@@ -0,0 +1,363 @@
+package keeper_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sideprotocol/side/testutil/sample"
+	"github.com/sideprotocol/side/x/btcbridge/keeper"
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+// checkReserves checks the reserves invariant and returns the reserves
+func (env *depositTestEnv) checkReserves(t *testing.T) *types.QueryReservesResponse {
+	msg, broken := keeper.ReservesInvariant(env.app.BtcBridgeKeeper)(env.ctx)
+	require.False(t, broken, msg)
+
+	msg, broken = keeper.UTXOsInvariant(env.app.BtcBridgeKeeper)(env.ctx)
+	require.False(t, broken, msg)
+
+	res, err := env.app.BtcBridgeKeeper.QueryReserves(sdk.WrapSDKContext(env.ctx), &types.QueryReservesRequest{})
+	require.NoError(t, err)
+
+	return res
+}
+
+// signWithdrawal signs the withdrawal tx of the given signing request with the vault key
+func (env *depositTestEnv) signWithdrawal(t *testing.T, request *types.BitcoinSigningRequest) *wire.MsgTx {
+	b, err := base64.StdEncoding.DecodeString(request.Psbt)
+	require.NoError(t, err)
+	packet, err := psbt.NewFromRawBytes(bytes.NewReader(b), false)
+	require.NoError(t, err)
+
+	prevOuts := txscript.NewMultiPrevOutFetcher(nil)
+	for i, in := range packet.UnsignedTx.TxIn {
+		prevOuts.AddPrevOut(in.PreviousOutPoint, packet.Inputs[i].WitnessUtxo)
+	}
+
+	tx := packet.UnsignedTx
+	sigHashes := txscript.NewTxSigHashes(tx, prevOuts)
+	for i, input := range packet.Inputs {
+		witness, err := txscript.WitnessSignature(tx, sigHashes, i, input.WitnessUtxo.Value, input.WitnessUtxo.PkScript, txscript.SigHashAll, env.vaultKey, true)
+		require.NoError(t, err)
+
+		tx.TxIn[i].Witness = witness
+	}
+
+	return tx
+}
+
+// signedPsbt signs the psbt of the given signing request with the vault key and returns the finalized psbt
+func (env *depositTestEnv) signedPsbt(t *testing.T, request *types.BitcoinSigningRequest) string {
+	packet, err := psbt.NewFromRawBytes(strings.NewReader(request.Psbt), true)
+	require.NoError(t, err)
+
+	return signPsbt(t, packet, env.vaultKey)
+}
+
+// signPsbt signs all p2wpkh inputs of the given psbt with the given key against the witness utxos of the psbt
+func signPsbt(t *testing.T, packet *psbt.Packet, key *btcec.PrivateKey) string {
+	prevOuts := txscript.NewMultiPrevOutFetcher(nil)
+	for i, in := range packet.UnsignedTx.TxIn {
+		prevOuts.AddPrevOut(in.PreviousOutPoint, packet.Inputs[i].WitnessUtxo)
+	}
+
+	sigHashes := txscript.NewTxSigHashes(packet.UnsignedTx, prevOuts)
+	for i, input := range packet.Inputs {
+		sig, err := txscript.RawTxInWitnessSignature(packet.UnsignedTx, sigHashes, i, input.WitnessUtxo.Value, input.WitnessUtxo.PkScript, txscript.SigHashAll, key)
+		require.NoError(t, err)
+
+		packet.Inputs[i].PartialSigs = []*psbt.PartialSig{{PubKey: key.PubKey().SerializeCompressed(), Signature: sig}}
+	}
+
+	require.NoError(t, psbt.MaybeFinalizeAll(packet))
+
+	signed, err := packet.B64Encode()
+	require.NoError(t, err)
+
+	return signed
+}
+
+func TestReserves(t *testing.T) {
+	env := newDepositTestEnv(t)
+
+	holder := sample.AccAddress()
+	err := env.deposit(t, wire.NewTxOut(100000, env.vaultPkScript), env.memoOut(t, &types.DepositMemo{Recipient: holder}))
+	require.NoError(t, err)
+
+	res := env.checkReserves(t)
+	require.Equal(t, int64(100000), res.VoucherSupply.Amount.Int64())
+	require.Equal(t, uint64(100000), res.Vaults[0].Unspent)
+	require.Zero(t, res.PendingWithdrawals)
+
+	// withdraw to a bitcoin address, which is not an account address on regtest
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	addr, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(key.PubKey().SerializeCompressed()), env.chain.Params)
+	require.NoError(t, err)
+
+	request, err := env.app.BtcBridgeKeeper.NewSigningRequest(env.ctx, addr.EncodeAddress(), sdk.NewInt64Coin("sat", 40000), 10, "")
+	require.NoError(t, err)
+
+	res = env.checkReserves(t)
+	require.Equal(t, uint64(100000), res.Vaults[0].Locked)
+	require.Greater(t, res.PendingWithdrawals, uint64(40000))
+
+	// the change is not counted as the reserve until confirmed, as the spent utxo is still locked
+	fee := int64(res.PendingWithdrawals) - 40000
+	require.Equal(t, uint64(60000-fee), res.Vaults[0].Unconfirmed)
+	require.Zero(t, res.Vaults[0].Unspent)
+
+	// escrow the withdrawal amount along with the network fee
+	escrow := sdk.NewCoins(sdk.NewInt64Coin("sat", int64(res.PendingWithdrawals)))
+	require.NoError(t, env.app.BankKeeper.SendCoinsFromAccountToModule(env.ctx, sdk.MustAccAddressFromBech32(holder), types.ModuleName, escrow))
+
+	// confirm the withdrawal
+	tx := env.signWithdrawal(t, request)
+	block, txOutProof := env.mine(t, tx)
+
+	msg := types.NewMsgSubmitWithdrawTransactionRequest(env.relayer, block.BlockHash().String(), serializeTx(t, tx), nil)
+	msg.TxOutProof = txOutProof
+	require.NoError(t, env.app.BtcBridgeKeeper.ProcessBitcoinWithdrawTransaction(env.ctx, msg))
+
+	// the escrowed voucher token is burned
+	res = env.checkReserves(t)
+	require.Equal(t, 60000-fee, res.VoucherSupply.Amount.Int64())
+	require.Equal(t, uint64(60000-fee), res.Vaults[0].Unspent)
+	require.Zero(t, res.Vaults[0].Locked)
+	require.Zero(t, res.Vaults[0].Unconfirmed)
+	require.Zero(t, res.PendingWithdrawals)
+
+	// the confirmed withdrawal can not be processed again
+	err = env.app.BtcBridgeKeeper.ProcessBitcoinWithdrawTransaction(env.ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidStatus)
+
+	// nor be reported as pending again
+	_, err = keeper.NewMsgServerImpl(env.app.BtcBridgeKeeper).SubmitWithdrawStatus(sdk.WrapSDKContext(env.ctx), &types.MsgSubmitWithdrawStatusRequest{
+		Sender: env.relayer,
+		Txid:   request.Txid,
+		Status: types.SigningStatus_SIGNING_STATUS_BROADCASTED,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidStatus)
+}
+
+func TestReservesInvariantBroken(t *testing.T) {
+	env := newDepositTestEnv(t)
+
+	recipient := sample.AccAddress()
+	err := env.deposit(t, wire.NewTxOut(100000, env.vaultPkScript), env.memoOut(t, &types.DepositMemo{Recipient: recipient}))
+	require.NoError(t, err)
+
+	// mint the unbacked voucher token
+	require.NoError(t, env.app.BankKeeper.MintCoins(env.ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("sat", 1))))
+
+	_, broken := keeper.ReservesInvariant(env.app.BtcBridgeKeeper)(env.ctx)
+	require.True(t, broken)
+}
+
+func TestEscrowInvariant(t *testing.T) {
+	env := newDepositTestEnv(t)
+
+	holder := sample.AccAddress()
+	err := env.deposit(t, wire.NewTxOut(100000, env.vaultPkScript), env.memoOut(t, &types.DepositMemo{Recipient: holder}))
+	require.NoError(t, err)
+
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	addr, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(key.PubKey().SerializeCompressed()), env.chain.Params)
+	require.NoError(t, err)
+
+	_, err = env.app.BtcBridgeKeeper.NewSigningRequest(env.ctx, addr.EncodeAddress(), sdk.NewInt64Coin("sat", 40000), 10, "")
+	require.NoError(t, err)
+
+	// the withdrawal is not escrowed yet
+	_, broken := keeper.EscrowInvariant(env.app.BtcBridgeKeeper)(env.ctx)
+	require.True(t, broken)
+
+	escrow := sdk.NewCoins(sdk.NewInt64Coin("sat", int64(env.app.BtcBridgeKeeper.GetPendingWithdrawals(env.ctx))))
+	require.NoError(t, env.app.BankKeeper.SendCoinsFromAccountToModule(env.ctx, sdk.MustAccAddressFromBech32(holder), types.ModuleName, escrow))
+
+	msg, broken := keeper.EscrowInvariant(env.app.BtcBridgeKeeper)(env.ctx)
+	require.False(t, broken, msg)
+}
+
+func TestUTXOsInvariantBroken(t *testing.T) {
+	env := newDepositTestEnv(t)
+
+	// the utxo is saved without the owner index
+	env.app.BtcBridgeKeeper.SetUTXO(env.ctx, &types.UTXO{
+		Txid:    "7b0ea4a4ec0ffbbb2d50ea9b5e9ee73b3b3c6a4d8e8e1c5b0c3e8a1f2d3c4b5a",
+		Vout:    0,
+		Amount:  100000,
+		Address: env.app.BtcBridgeKeeper.GetParams(env.ctx).Vaults[0].Address,
+	})
+
+	_, broken := keeper.UTXOsInvariant(env.app.BtcBridgeKeeper)(env.ctx)
+	require.True(t, broken)
+}
+
+func TestSubmitWithdrawSignatures(t *testing.T) {
+	env := newDepositTestEnv(t)
+	msgServer := keeper.NewMsgServerImpl(env.app.BtcBridgeKeeper)
+	goCtx := sdk.WrapSDKContext(env.ctx)
+
+	holder := sample.AccAddress()
+	for _, amount := range []int64{100000, 50000} {
+		require.NoError(t, env.deposit(t, wire.NewTxOut(amount, env.vaultPkScript), env.memoOut(t, &types.DepositMemo{Recipient: holder})))
+	}
+
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	addr, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(key.PubKey().SerializeCompressed()), env.chain.Params)
+	require.NoError(t, err)
+
+	request, err := env.app.BtcBridgeKeeper.NewSigningRequest(env.ctx, addr.EncodeAddress(), sdk.NewInt64Coin("sat", 40000), 10, "")
+	require.NoError(t, err)
+	other, err := env.app.BtcBridgeKeeper.NewSigningRequest(env.ctx, addr.EncodeAddress(), sdk.NewInt64Coin("sat", 20000), 10, "")
+	require.NoError(t, err)
+
+	// the psbt of another tx can not replace the request
+	_, err = msgServer.SubmitWithdrawSignatures(goCtx, types.NewMsgSubmitWithdrawSignaturesRequest(env.relayer, request.Txid, env.signedPsbt(t, other)))
+	require.ErrorIs(t, err, types.ErrInvalidSignatures)
+
+	// the psbt of the request can not be self-signed against forged witness utxos or by another key
+	forgedScript, err := txscript.PayToAddrScript(addr)
+	require.NoError(t, err)
+
+	for _, forge := range []func(packet *psbt.Packet) string{
+		func(packet *psbt.Packet) string {
+			for _, input := range packet.Inputs {
+				input.WitnessUtxo.PkScript = forgedScript
+			}
+			return signPsbt(t, packet, key)
+		},
+		func(packet *psbt.Packet) string {
+			for _, input := range packet.Inputs {
+				input.WitnessUtxo.Value++
+			}
+			return signPsbt(t, packet, env.vaultKey)
+		},
+		func(packet *psbt.Packet) string {
+			return signPsbt(t, packet, key)
+		},
+	} {
+		packet, err := psbt.NewFromRawBytes(strings.NewReader(request.Psbt), true)
+		require.NoError(t, err)
+
+		_, err = msgServer.SubmitWithdrawSignatures(goCtx, types.NewMsgSubmitWithdrawSignaturesRequest(env.relayer, request.Txid, forge(packet)))
+		require.ErrorIs(t, err, types.ErrInvalidSignatures)
+	}
+
+	stored := env.app.BtcBridgeKeeper.GetSigningRequest(env.ctx, request.Txid)
+	require.Equal(t, request.Psbt, stored.Psbt)
+	require.Equal(t, types.SigningStatus_SIGNING_STATUS_CREATED, stored.Status)
+
+	_, err = msgServer.SubmitWithdrawSignatures(goCtx, types.NewMsgSubmitWithdrawSignaturesRequest(env.relayer, request.Txid, env.signedPsbt(t, request)))
+	require.NoError(t, err)
+
+	stored = env.app.BtcBridgeKeeper.GetSigningRequest(env.ctx, request.Txid)
+	require.Equal(t, types.SigningStatus_SIGNING_STATUS_SIGNED, stored.Status)
+	require.Equal(t, request.Amount, stored.Amount)
+	require.Equal(t, request.Amount+other.Amount, env.app.BtcBridgeKeeper.GetPendingWithdrawals(env.ctx))
+}
+
+func TestRejectWithdrawal(t *testing.T) {
+	env := newDepositTestEnv(t)
+	msgServer := keeper.NewMsgServerImpl(env.app.BtcBridgeKeeper)
+	goCtx := sdk.WrapSDKContext(env.ctx)
+
+	holder := sample.AccAddress()
+	require.NoError(t, env.deposit(t, wire.NewTxOut(100000, env.vaultPkScript), env.memoOut(t, &types.DepositMemo{Recipient: holder})))
+
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	addr, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(key.PubKey().SerializeCompressed()), env.chain.Params)
+	require.NoError(t, err)
+
+	withdraw := func(amount string) *types.BitcoinSigningRequest {
+		msg := types.NewMsgWithdrawBitcoinRequest(holder, amount, 10)
+		msg.BtcAddress = addr.EncodeAddress()
+
+		_, err := msgServer.WithdrawBitcoin(goCtx, msg)
+		require.NoError(t, err)
+
+		events := typedEvents[*types.EventWithdrawalRequested](t, env.ctx)
+		return env.app.BtcBridgeKeeper.GetSigningRequest(env.ctx, events[len(events)-1].Txid)
+	}
+
+	reject := func(request *types.BitcoinSigningRequest) error {
+		_, err := msgServer.SubmitWithdrawStatus(goCtx, &types.MsgSubmitWithdrawStatusRequest{
+			Sender: env.relayer,
+			Txid:   request.Txid,
+			Status: types.SigningStatus_SIGNING_STATUS_REJECTED,
+		})
+		return err
+	}
+
+	// the chained withdrawal spends the change of the first one
+	first := withdraw("40000sat")
+	second := withdraw("20000sat")
+	require.Equal(t, holder, first.Sender)
+	require.Equal(t, int64(100000-first.Amount-second.Amount), env.balance(holder, "sat").Int64())
+
+	// the first request can not be rejected while its change is spent by the second one
+	require.ErrorIs(t, reject(first), types.ErrInvalidStatus)
+
+	// the rejection refunds the escrow, unlocks the inputs and deletes the change
+	require.NoError(t, reject(second))
+	require.NoError(t, reject(first))
+
+	require.Equal(t, int64(100000), env.balance(holder, "sat").Int64())
+	require.Equal(t, types.SigningStatus_SIGNING_STATUS_REJECTED, env.app.BtcBridgeKeeper.GetSigningRequest(env.ctx, first.Txid).Status)
+	require.False(t, env.app.BtcBridgeKeeper.HasUTXO(env.ctx, first.Txid, 1))
+	require.False(t, env.app.BtcBridgeKeeper.HasUTXO(env.ctx, second.Txid, 1))
+
+	res := env.checkReserves(t)
+	require.Equal(t, uint64(100000), res.Vaults[0].Unspent)
+	require.Zero(t, res.Vaults[0].Locked)
+	require.Zero(t, res.Vaults[0].Unconfirmed)
+	require.Zero(t, res.PendingWithdrawals)
+
+	// the rejected request is settled
+	require.ErrorIs(t, reject(first), types.ErrInvalidStatus)
+	_, err = msgServer.SubmitWithdrawSignatures(goCtx, types.NewMsgSubmitWithdrawSignaturesRequest(env.relayer, first.Txid, env.signedPsbt(t, first)))
+	require.ErrorIs(t, err, types.ErrInvalidStatus)
+
+	// the unlocked utxo is spent by a new withdrawal
+	request := withdraw("30000sat")
+
+	_, err = msgServer.SubmitWithdrawStatus(goCtx, &types.MsgSubmitWithdrawStatusRequest{
+		Sender: env.relayer,
+		Txid:   request.Txid,
+		Status: types.SigningStatus_SIGNING_STATUS_BROADCASTED,
+	})
+	require.NoError(t, err)
+
+	// the broadcasted request may still be confirmed
+	require.ErrorIs(t, reject(request), types.ErrInvalidStatus)
+
+	tx := env.signWithdrawal(t, request)
+	block, txOutProof := env.mine(t, tx)
+
+	msg := types.NewMsgSubmitWithdrawTransactionRequest(env.relayer, block.BlockHash().String(), serializeTx(t, tx), nil)
+	msg.TxOutProof = txOutProof
+	require.NoError(t, env.app.BtcBridgeKeeper.ProcessBitcoinWithdrawTransaction(env.ctx, msg))
+
+	res = env.checkReserves(t)
+	require.Equal(t, int64(100000-request.Amount), res.VoucherSupply.Amount.Int64())
+	require.Equal(t, 100000-request.Amount, res.Vaults[0].Unspent)
+	require.Zero(t, res.PendingWithdrawals)
+
+	escrowMsg, broken := keeper.EscrowInvariant(env.app.BtcBridgeKeeper)(env.ctx)
+	require.False(t, broken, escrowMsg)
+}
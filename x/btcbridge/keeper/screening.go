@@ -0,0 +1,372 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+var _ types.AddressScreener = BlocklistScreener{}
+
+// BlocklistScreener screens the bridge operations against the governance blocklist
+type BlocklistScreener struct {
+	k Keeper
+}
+
+// NewBlocklistScreener creates a new BlocklistScreener, which the app may combine with its own screener
+func NewBlocklistScreener(k Keeper) BlocklistScreener {
+	return BlocklistScreener{k: k}
+}
+
+// ScreenDeposit rejects the deposit if any bitcoin sender or the recipient is blocked
+func (s BlocklistScreener) ScreenDeposit(ctx sdk.Context, _ string, _ string, btcSenders []string, recipient string) error {
+	return s.screen(ctx, append([]string{recipient}, btcSenders...)...)
+}
+
+// ScreenWithdrawal rejects the withdrawal if the sender or the bitcoin address is blocked
+func (s BlocklistScreener) ScreenWithdrawal(ctx sdk.Context, _ string, sender string, btcAddress string) error {
+	return s.screen(ctx, sender, btcAddress)
+}
+
+// screen rejects the operation if any of the given addresses is blocked
+func (s BlocklistScreener) screen(ctx sdk.Context, addresses ...string) error {
+	for _, address := range addresses {
+		if len(address) != 0 && s.k.IsBlocked(ctx, address) {
+			return errorsmod.Wrapf(types.ErrAddressBlocked, "address: %s", address)
+		}
+	}
+
+	return nil
+}
+
+// SetScreener replaces the default blocklist screener
+func (k *Keeper) SetScreener(screener types.AddressScreener) *Keeper {
+	if k.screener != nil {
+		panic("cannot set btcbridge screener twice")
+	}
+
+	k.screener = screener
+
+	return k
+}
+
+// getScreener returns the screener set by the app, the blocklist screener by default
+func (k Keeper) getScreener() types.AddressScreener {
+	if k.screener != nil {
+		return k.screener
+	}
+
+	return NewBlocklistScreener(k)
+}
+
+// screenDeposit screens the deposit tx of the bridged chain of the keeper
+func (k Keeper) screenDeposit(ctx sdk.Context, txid string, btcSenders []string, recipient string) error {
+	return k.getScreener().ScreenDeposit(ctx, k.chainID, txid, btcSenders, recipient)
+}
+
+// screenWithdrawal screens the withdrawal on the bridged chain of the keeper
+func (k Keeper) screenWithdrawal(ctx sdk.Context, sender string, btcAddress string) error {
+	return k.getScreener().ScreenWithdrawal(ctx, k.chainID, sender, btcAddress)
+}
+
+// IsBlocked returns true if the given bitcoin address or side account is blocked.
+// The address is normalized as the blocklist entries of all bridged chains, so that it is matched regardless of the encoding.
+func (k Keeper) IsBlocked(ctx sdk.Context, address string) bool {
+	if normalized, err := k.WithChain("").GetParams(ctx).NormalizeBlocklistEntry(address); err == nil {
+		address = normalized
+	}
+
+	return ctx.KVStore(k.storeKey).Has(types.BlocklistKey(address))
+}
+
+// UpdateBlocklist adds and removes the given bitcoin addresses or side accounts to and from the blocklist
+func (k Keeper) UpdateBlocklist(ctx sdk.Context, add []string, remove []string) error {
+	params := k.GetParams(ctx)
+	store := ctx.KVStore(k.storeKey)
+
+	added := make([]string, 0, len(add))
+	for _, address := range add {
+		normalized, err := params.NormalizeBlocklistEntry(address)
+		if err != nil {
+			return errorsmod.Wrap(types.ErrInvalidBlocklist, err.Error())
+		}
+
+		store.Set(types.BlocklistKey(normalized), []byte{1})
+		added = append(added, normalized)
+	}
+
+	removed := make([]string, 0, len(remove))
+	for _, address := range remove {
+		normalized, err := params.NormalizeBlocklistEntry(address)
+		if err != nil {
+			return errorsmod.Wrap(types.ErrInvalidBlocklist, err.Error())
+		}
+
+		store.Delete(types.BlocklistKey(normalized))
+		removed = append(removed, normalized)
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventBlocklistUpdated{
+		Added:   added,
+		Removed: removed,
+	})
+}
+
+// GetBlocklist returns all blocked bitcoin addresses and side accounts
+func (k Keeper) GetBlocklist(ctx sdk.Context) []string {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.BlocklistKeyPrefix)
+	defer iterator.Close()
+
+	blocklist := make([]string, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		blocklist = append(blocklist, string(iterator.Key()[len(types.BlocklistKeyPrefix):]))
+	}
+
+	return blocklist
+}
+
+// quarantineDeposit saves the given deposit, the vault outputs of which are held as locked utxos
+func (k Keeper) quarantineDeposit(ctx sdk.Context, deposit *types.QuarantinedDeposit) error {
+	deposit.Id = k.incrementQuarantineSequence(ctx)
+	k.SetQuarantinedDeposit(ctx, deposit)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventDepositQuarantined{
+		Id:        deposit.Id,
+		ChainId:   deposit.ChainId,
+		Txid:      deposit.Txid,
+		Recipient: deposit.Recipient,
+		BtcSender: deposit.BtcSender,
+		Amount:    sdk.NewCoin(k.GetParams(ctx).BtcVoucherDenom, sdk.NewIntFromUint64(deposit.Amount)),
+		Reason:    deposit.Reason,
+	})
+}
+
+// ReleaseQuarantinedDeposit mints the voucher token of the held deposit and credits the recipient.
+// The deposit action of the memo, if any, is not executed.
+func (k Keeper) ReleaseQuarantinedDeposit(ctx sdk.Context, sender string, id uint64) error {
+	deposit, ck, err := k.heldQuarantinedDeposit(ctx, sender, id)
+	if err != nil {
+		return err
+	}
+
+	param := ck.GetParams(ctx)
+
+	minted := sdk.NewCoin(param.BtcVoucherDenom, sdk.NewIntFromUint64(deposit.Amount))
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(minted)); err != nil {
+		return err
+	}
+
+	for _, vout := range deposit.Vouts {
+		utxo := ck.GetUTXO(ctx, deposit.Txid, vout)
+		if utxo == nil {
+			return types.ErrUTXODoesNotExist
+		}
+
+		if err := ck.UnlockUTXO(ctx, deposit.Txid, vout); err != nil {
+			return err
+		}
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventDepositMinted{
+			Txid:         utxo.Txid,
+			Vout:         utxo.Vout,
+			VaultAddress: utxo.Address,
+			Recipient:    deposit.Recipient,
+			Amount:       sdk.NewCoin(param.BtcVoucherDenom, sdk.NewIntFromUint64(utxo.Amount)),
+			Height:       utxo.Height,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if err := ck.creditDeposit(ctx, deposit.Txid, deposit.Recipient, nil, minted); err != nil {
+		return err
+	}
+
+	deposit.Status = types.QuarantineStatus_QUARANTINE_STATUS_RELEASED
+	k.SetQuarantinedDeposit(ctx, deposit)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventQuarantinedDepositReleased{
+		Id:        deposit.Id,
+		Recipient: deposit.Recipient,
+		Amount:    minted,
+	})
+}
+
+// ReturnQuarantinedDeposit creates the signing request which sends the held deposit to the given bitcoin address,
+// the sender of the deposit by default. The network fee is deducted from the returned amount.
+// The voucher token of the deposit is minted to the module as the escrow of the request, which is burned on confirmation.
+func (k Keeper) ReturnQuarantinedDeposit(ctx sdk.Context, sender string, id uint64, btcAddress string, feeRate int64) (*types.BitcoinSigningRequest, error) {
+	deposit, ck, err := k.heldQuarantinedDeposit(ctx, sender, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(btcAddress) == 0 {
+		btcAddress = deposit.BtcSender
+	}
+
+	param := ck.GetParams(ctx)
+
+	// the deposit is returned out of the bridge
+	if len(btcAddress) == 0 || types.SelectVaultByBitcoinAddress(param.Vaults, btcAddress) != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidBtcAddress, "invalid return address %s", btcAddress)
+	}
+
+	utxos := make([]*types.UTXO, 0, len(deposit.Vouts))
+	for _, vout := range deposit.Vouts {
+		utxo := ck.GetUTXO(ctx, deposit.Txid, vout)
+		if utxo == nil {
+			return nil, types.ErrUTXODoesNotExist
+		}
+
+		utxos = append(utxos, utxo)
+	}
+
+	psbt, err := types.BuildSweepPsbt(utxos, btcAddress, feeRate, param.ChainCfg())
+	if err != nil {
+		return nil, err
+	}
+
+	psbtB64, err := psbt.B64Encode()
+	if err != nil {
+		return nil, types.ErrFailToSerializePsbt
+	}
+
+	escrow := sdk.NewCoin(param.BtcVoucherDenom, sdk.NewIntFromUint64(deposit.Amount))
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(escrow)); err != nil {
+		return nil, err
+	}
+
+	// the utxos are locked since quarantined
+	ck.addToMintHistory(ctx, psbt.UnsignedTx.TxHash().String())
+
+	request := &types.BitcoinSigningRequest{
+		Address:      btcAddress,
+		Txid:         psbt.UnsignedTx.TxHash().String(),
+		Psbt:         psbtB64,
+		Status:       types.SigningStatus_SIGNING_STATUS_CREATED,
+		Sequence:     ck.IncrementRequestSequence(ctx),
+		VaultAddress: utxos[0].Address,
+		Amount:       deposit.Amount,
+	}
+
+	ck.SetSigningRequest(ctx, request)
+
+	deposit.Status = types.QuarantineStatus_QUARANTINE_STATUS_RETURNED
+	deposit.ReturnTxid = request.Txid
+	k.SetQuarantinedDeposit(ctx, deposit)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventQuarantinedDepositReturned{
+		Id:         deposit.Id,
+		BtcAddress: btcAddress,
+		ReturnTxid: request.Txid,
+	}); err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+// heldQuarantinedDeposit returns the held deposit of the given id along with the keeper of its bridged chain,
+// if the sender is either the governance or the screening admin
+func (k Keeper) heldQuarantinedDeposit(ctx sdk.Context, sender string, id uint64) (*types.QuarantinedDeposit, Keeper, error) {
+	admin := k.GetParams(ctx).ScreeningAdmin
+	if sender != k.authority && (len(admin) == 0 || sender != admin) {
+		return nil, Keeper{}, types.ErrSenderAddressNotAuthorized
+	}
+
+	deposit := k.GetQuarantinedDeposit(ctx, id)
+	if deposit == nil {
+		return nil, Keeper{}, types.ErrQuarantinedDepositNotFound
+	}
+
+	if deposit.Status != types.QuarantineStatus_QUARANTINE_STATUS_HELD {
+		return nil, Keeper{}, types.ErrQuarantinedDepositNotHeld
+	}
+
+	ck, err := k.ChainKeeper(ctx, deposit.ChainId)
+	if err != nil {
+		return nil, Keeper{}, err
+	}
+
+	return deposit, ck, nil
+}
+
+// GetQuarantinedDeposit returns the quarantined deposit of the given id, nil if not found
+func (k Keeper) GetQuarantinedDeposit(ctx sdk.Context, id uint64) *types.QuarantinedDeposit {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.QuarantinedDepositKey(id))
+	if bz == nil {
+		return nil
+	}
+
+	var deposit types.QuarantinedDeposit
+	k.cdc.MustUnmarshal(bz, &deposit)
+
+	return &deposit
+}
+
+// SetQuarantinedDeposit sets the quarantined deposit
+func (k Keeper) SetQuarantinedDeposit(ctx sdk.Context, deposit *types.QuarantinedDeposit) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.QuarantinedDepositKey(deposit.Id), k.cdc.MustMarshal(deposit))
+
+	if deposit.Id >= k.getQuarantineSequence(ctx) {
+		store.Set(types.QuarantineSequenceKey, sdk.Uint64ToBigEndian(deposit.Id+1))
+	}
+}
+
+// GetQuarantinedDeposits returns the quarantined deposits of the given status, all quarantined deposits if unspecified
+func (k Keeper) GetQuarantinedDeposits(ctx sdk.Context, status types.QuarantineStatus) []*types.QuarantinedDeposit {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.QuarantinedDepositKeyPrefix)
+	defer iterator.Close()
+
+	deposits := make([]*types.QuarantinedDeposit, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var deposit types.QuarantinedDeposit
+		k.cdc.MustUnmarshal(iterator.Value(), &deposit)
+
+		if status == types.QuarantineStatus_QUARANTINE_STATUS_UNSPECIFIED || deposit.Status == status {
+			deposits = append(deposits, &deposit)
+		}
+	}
+
+	return deposits
+}
+
+// getReturnedQuarantinedDeposit returns the quarantined deposit returned by the given signing request of the chain of the keeper,
+// nil if not found
+func (k Keeper) getReturnedQuarantinedDeposit(ctx sdk.Context, txid string) *types.QuarantinedDeposit {
+	for _, deposit := range k.GetQuarantinedDeposits(ctx, types.QuarantineStatus_QUARANTINE_STATUS_RETURNED) {
+		if deposit.ChainId == k.chainID && deposit.ReturnTxid == txid {
+			return deposit
+		}
+	}
+
+	return nil
+}
+
+// getQuarantineSequence returns the id of the next quarantined deposit
+func (k Keeper) getQuarantineSequence(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.QuarantineSequenceKey)
+	if bz == nil {
+		return 1
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// incrementQuarantineSequence returns the id of the next quarantined deposit and increments the sequence
+func (k Keeper) incrementQuarantineSequence(ctx sdk.Context) uint64 {
+	id := k.getQuarantineSequence(ctx)
+	ctx.KVStore(k.storeKey).Set(types.QuarantineSequenceKey, sdk.Uint64ToBigEndian(id+1))
+
+	return id
+}
@@ -0,0 +1,225 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sideprotocol/side/testutil/bitcoin"
+	"github.com/sideprotocol/side/testutil/sample"
+	"github.com/sideprotocol/side/x/btcbridge/keeper"
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+func TestScreening(t *testing.T) {
+	env := newDepositTestEnv(t)
+
+	msgServer := keeper.NewMsgServerImpl(env.app.BtcBridgeKeeper)
+	goCtx := sdk.WrapSDKContext(env.ctx)
+	authority := env.app.BtcBridgeKeeper.GetAuthority()
+
+	// the sanctioned bitcoin address sends the deposits
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	sanctioned, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(key.PubKey().SerializeCompressed()), env.chain.Params)
+	require.NoError(t, err)
+	sanctionedPkScript, err := txscript.PayToAddrScript(sanctioned)
+	require.NoError(t, err)
+
+	blockedAccount := sample.AccAddress()
+
+	_, err = msgServer.UpdateBlocklist(goCtx, types.NewMsgUpdateBlocklistRequest(sample.AccAddress(), []string{blockedAccount}, nil))
+	require.ErrorIs(t, err, types.ErrSenderAddressNotAuthorized)
+
+	_, err = msgServer.UpdateBlocklist(goCtx, types.NewMsgUpdateBlocklistRequest(authority, []string{"invalid"}, nil))
+	require.ErrorIs(t, err, types.ErrInvalidBlocklist)
+
+	_, err = msgServer.UpdateBlocklist(goCtx, types.NewMsgUpdateBlocklistRequest(authority, []string{blockedAccount, sanctioned.EncodeAddress()}, nil))
+	require.NoError(t, err)
+
+	blocklist, err := env.app.BtcBridgeKeeper.QueryBlocklist(goCtx, &types.QueryBlocklistRequest{})
+	require.NoError(t, err)
+	require.Len(t, blocklist.Addresses, 2)
+
+	// the withdrawal of the blocked account fails
+	_, err = msgServer.WithdrawBitcoin(goCtx, types.NewMsgWithdrawBitcoinRequest(blockedAccount, "10000sat", 10))
+	require.ErrorIs(t, err, types.ErrAddressBlocked)
+
+	// the deposits from the sanctioned address are quarantined instead of being minted
+	holder := sample.AccAddress()
+	depositFromSanctioned := func(amount int64) {
+		funding := bitcoin.NewTx(wire.NewOutPoint(&chainhash.Hash{}, 0), nil, wire.NewTxOut(amount*2, sanctionedPkScript))
+		msg := env.depositMsgFrom(t, funding, wire.NewTxOut(amount, env.vaultPkScript), env.memoOut(t, &types.DepositMemo{Recipient: holder}))
+
+		require.NoError(t, env.app.BtcBridgeKeeper.ProcessBitcoinDepositTransaction(env.ctx, msg))
+	}
+
+	depositFromSanctioned(100000)
+	depositFromSanctioned(50000)
+
+	require.Zero(t, env.balance(holder, "sat").Int64())
+	require.Len(t, typedEvents[*types.EventDepositQuarantined](t, env.ctx), 2)
+
+	held := env.app.BtcBridgeKeeper.GetQuarantinedDeposits(env.ctx, types.QuarantineStatus_QUARANTINE_STATUS_HELD)
+	require.Len(t, held, 2)
+	require.Equal(t, sanctioned.EncodeAddress(), held[0].BtcSender)
+	require.Equal(t, uint64(100000), held[0].Amount)
+	require.True(t, env.app.BtcBridgeKeeper.IsUTXOLocked(env.ctx, held[0].Txid, held[0].Vouts[0]))
+
+	res := env.checkReserves(t)
+	require.Zero(t, res.VoucherSupply.Amount.Int64())
+
+	// only the governance or the screening admin releases the deposit
+	admin := sample.AccAddress()
+
+	_, err = msgServer.ReleaseQuarantinedDeposit(goCtx, types.NewMsgReleaseQuarantinedDepositRequest(admin, held[0].Id))
+	require.ErrorIs(t, err, types.ErrSenderAddressNotAuthorized)
+
+	params := env.app.BtcBridgeKeeper.GetParams(env.ctx)
+	params.ScreeningAdmin = admin
+	_, err = msgServer.UpdateParams(goCtx, types.NewMsgUpdateParamsRequest(authority, params))
+	require.NoError(t, err)
+
+	_, err = msgServer.ReleaseQuarantinedDeposit(goCtx, types.NewMsgReleaseQuarantinedDepositRequest(admin, held[0].Id))
+	require.NoError(t, err)
+	require.Equal(t, int64(100000), env.balance(holder, "sat").Int64())
+	require.False(t, env.app.BtcBridgeKeeper.IsUTXOLocked(env.ctx, held[0].Txid, held[0].Vouts[0]))
+
+	_, err = msgServer.ReleaseQuarantinedDeposit(goCtx, types.NewMsgReleaseQuarantinedDepositRequest(admin, held[0].Id))
+	require.ErrorIs(t, err, types.ErrQuarantinedDepositNotHeld)
+
+	res = env.checkReserves(t)
+	require.Equal(t, int64(100000), res.VoucherSupply.Amount.Int64())
+
+	// the other deposit is returned to the sender
+	_, err = msgServer.ReturnQuarantinedDeposit(goCtx, types.NewMsgReturnQuarantinedDepositRequest(authority, held[1].Id, "", 10))
+	require.NoError(t, err)
+
+	returned := env.app.BtcBridgeKeeper.GetQuarantinedDeposit(env.ctx, held[1].Id)
+	require.Equal(t, types.QuarantineStatus_QUARANTINE_STATUS_RETURNED, returned.Status)
+
+	request := env.app.BtcBridgeKeeper.GetSigningRequest(env.ctx, returned.ReturnTxid)
+	require.NotNil(t, request)
+	require.Equal(t, sanctioned.EncodeAddress(), request.Address)
+
+	tx := env.signWithdrawal(t, request)
+	require.Len(t, tx.TxOut, 1)
+	require.Equal(t, sanctionedPkScript, tx.TxOut[0].PkScript)
+	require.Less(t, tx.TxOut[0].Value, int64(50000))
+
+	res = env.checkReserves(t)
+	require.Equal(t, uint64(50000), res.PendingWithdrawals)
+
+	// the rejected return puts the deposit back into the quarantine
+	_, err = msgServer.SubmitWithdrawStatus(goCtx, &types.MsgSubmitWithdrawStatusRequest{
+		Sender: env.relayer,
+		Txid:   request.Txid,
+		Status: types.SigningStatus_SIGNING_STATUS_REJECTED,
+	})
+	require.NoError(t, err)
+
+	rejected := env.app.BtcBridgeKeeper.GetQuarantinedDeposit(env.ctx, held[1].Id)
+	require.Equal(t, types.QuarantineStatus_QUARANTINE_STATUS_HELD, rejected.Status)
+	require.Empty(t, rejected.ReturnTxid)
+
+	res = env.checkReserves(t)
+	require.Equal(t, int64(100000), res.VoucherSupply.Amount.Int64())
+	require.Zero(t, res.PendingWithdrawals)
+	require.Equal(t, uint64(50000), res.Vaults[0].Locked)
+
+	_, err = msgServer.ReturnQuarantinedDeposit(goCtx, types.NewMsgReturnQuarantinedDepositRequest(authority, held[1].Id, "", 10))
+	require.NoError(t, err)
+
+	deposits, err := env.app.BtcBridgeKeeper.QueryQuarantinedDeposits(goCtx, &types.QueryQuarantinedDepositsRequest{})
+	require.NoError(t, err)
+	require.Len(t, deposits.Deposits, 2)
+
+	// the unblocked sender deposits as usual
+	_, err = msgServer.UpdateBlocklist(goCtx, types.NewMsgUpdateBlocklistRequest(authority, nil, []string{sanctioned.EncodeAddress()}))
+	require.NoError(t, err)
+
+	depositFromSanctioned(20000)
+	require.Equal(t, int64(120000), env.balance(holder, "sat").Int64())
+}
+
+func TestScreeningAllInputs(t *testing.T) {
+	env := newDepositTestEnv(t)
+
+	msgServer := keeper.NewMsgServerImpl(env.app.BtcBridgeKeeper)
+	goCtx := sdk.WrapSDKContext(env.ctx)
+	authority := env.app.BtcBridgeKeeper.GetAuthority()
+
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	sanctioned, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(key.PubKey().SerializeCompressed()), env.chain.Params)
+	require.NoError(t, err)
+	sanctionedPkScript, err := txscript.PayToAddrScript(sanctioned)
+	require.NoError(t, err)
+
+	_, err = msgServer.UpdateBlocklist(goCtx, types.NewMsgUpdateBlocklistRequest(authority, []string{sanctioned.EncodeAddress()}, nil))
+	require.NoError(t, err)
+
+	// the blocked address is matched regardless of the case of the encoding
+	require.True(t, env.app.BtcBridgeKeeper.IsBlocked(env.ctx, strings.ToUpper(sanctioned.EncodeAddress())))
+
+	holder := sample.AccAddress()
+	recipients := []*types.WithdrawRecipient{{BtcAddress: strings.ToUpper(sanctioned.EncodeAddress()), Amount: "10000sat"}}
+
+	_, err = msgServer.WithdrawBitcoin(goCtx, types.NewMsgWithdrawBitcoinToRecipientsRequest(holder, recipients, 10))
+	require.ErrorIs(t, err, types.ErrAddressBlocked)
+
+	// the deposit whose second input can not be resolved is quarantined as the input is not screened
+	funding := env.chain.MineBlock().Transactions[0]
+	unknownFunding := bitcoin.NewTx(wire.NewOutPoint(&chainhash.Hash{}, 1), nil, wire.NewTxOut(100000, env.vaultPkScript))
+
+	unscreened := bitcoin.NewTx(wire.NewOutPoint(&chainhash.Hash{}, 0), nil, wire.NewTxOut(100000, env.vaultPkScript), env.memoOut(t, &types.DepositMemo{Recipient: holder}))
+	unscreened.TxIn[0].PreviousOutPoint.Hash = funding.TxHash()
+	unscreened.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{}, 0), nil, nil))
+	unscreened.TxIn[1].PreviousOutPoint.Hash = unknownFunding.TxHash()
+
+	block, txOutProof := env.mine(t, unscreened)
+
+	msg := types.NewMsgSubmitDepositTransactionRequest(env.relayer, block.BlockHash().String(), serializeTx(t, funding), serializeTx(t, unscreened), nil)
+	msg.TxOutProof = txOutProof
+	require.NoError(t, env.app.BtcBridgeKeeper.ProcessBitcoinDepositTransaction(env.ctx, msg))
+
+	require.Zero(t, env.balance(holder, "sat").Int64())
+
+	held := env.app.BtcBridgeKeeper.GetQuarantinedDeposits(env.ctx, types.QuarantineStatus_QUARANTINE_STATUS_HELD)
+	require.Len(t, held, 1)
+	require.Equal(t, unscreened.TxHash().String(), held[0].Txid)
+	require.Contains(t, held[0].Reason, types.ErrInputsNotScreened.Error())
+
+	// the deposit spending the sanctioned address by the second input is quarantined
+	funding = env.chain.MineBlock().Transactions[0]
+	sanctionedFunding := bitcoin.NewTx(wire.NewOutPoint(&chainhash.Hash{}, 0), nil, wire.NewTxOut(100000, sanctionedPkScript))
+
+	deposit := bitcoin.NewTx(wire.NewOutPoint(&chainhash.Hash{}, 0), nil, wire.NewTxOut(100000, env.vaultPkScript), env.memoOut(t, &types.DepositMemo{Recipient: holder}))
+	deposit.TxIn[0].PreviousOutPoint.Hash = funding.TxHash()
+	deposit.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{}, 0), nil, nil))
+	deposit.TxIn[1].PreviousOutPoint.Hash = sanctionedFunding.TxHash()
+
+	block, txOutProof = env.mine(t, deposit)
+
+	msg = types.NewMsgSubmitDepositTransactionRequest(env.relayer, block.BlockHash().String(), serializeTx(t, funding), serializeTx(t, deposit), nil)
+	msg.TxOutProof = txOutProof
+	msg.InputPrevTxs = []string{serializeTx(t, sanctionedFunding)}
+	require.NoError(t, env.app.BtcBridgeKeeper.ProcessBitcoinDepositTransaction(env.ctx, msg))
+
+	require.Zero(t, env.balance(holder, "sat").Int64())
+
+	held = env.app.BtcBridgeKeeper.GetQuarantinedDeposits(env.ctx, types.QuarantineStatus_QUARANTINE_STATUS_HELD)
+	require.Len(t, held, 2)
+	for _, d := range held {
+		if d.Txid == deposit.TxHash().String() {
+			require.Contains(t, d.Reason, types.ErrAddressBlocked.Error())
+		}
+	}
+}
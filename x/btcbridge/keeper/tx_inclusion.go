@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"bytes"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+// VerifyTxInclusion verifies that the given bitcoin transaction is included in the given block
+// of the best chain by the merkle proof, and that the block has at least the given number of blocks on top.
+// The parsed outputs are returned, so that other modules can build logic triggered by bitcoin payments
+// without going through the vaults.
+func (k Keeper) VerifyTxInclusion(ctx sdk.Context, txBytes []byte, blockHash string, proof []string, minConfirmations uint64) (*types.TxInclusion, error) {
+	var tx wire.MsgTx
+	if err := tx.Deserialize(bytes.NewReader(txBytes)); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidBtcTransaction, err.Error())
+	}
+
+	if err := blockchain.CheckTransactionSanity(btcutil.NewTx(&tx)); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidBtcTransaction, err.Error())
+	}
+
+	// the block must be on the best chain
+	header := k.GetBlockHeader(ctx, blockHash)
+	if len(header.Hash) == 0 || k.GetBlockHashByHeight(ctx, header.Height) != header.Hash {
+		return nil, types.ErrBlockNotFound
+	}
+
+	best := k.GetBestBlockHeader(ctx)
+	if best.Height < header.Height || best.Height-header.Height < minConfirmations {
+		return nil, types.ErrNotConfirmed
+	}
+
+	txHash := tx.TxHash()
+	if err := k.verifyTxProof(ctx, header, &txHash, proof, ""); err != nil {
+		return nil, err
+	}
+
+	chainCfg := k.ChainCfg(ctx)
+
+	outputs := make([]*types.TxOutput, 0, len(tx.TxOut))
+	for i, out := range tx.TxOut {
+		output := &types.TxOutput{
+			Vout:     uint32(i),
+			Value:    out.Value,
+			PkScript: out.PkScript,
+		}
+
+		if pks, err := txscript.ParsePkScript(out.PkScript); err == nil {
+			if addr, err := pks.Address(chainCfg); err == nil {
+				output.Address = addr.EncodeAddress()
+			}
+		}
+
+		outputs = append(outputs, output)
+	}
+
+	return &types.TxInclusion{
+		Txid:          txHash.String(),
+		Blockhash:     header.Hash,
+		Height:        header.Height,
+		Confirmations: best.Height - header.Height,
+		Outputs:       outputs,
+	}, nil
+}
@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sideprotocol/side/testutil/bitcoin"
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+func TestVerifyTxInclusion(t *testing.T) {
+	env := newDepositTestEnv(t)
+
+	// a payment to an address other than the vaults
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	addr, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(key.PubKey().SerializeCompressed()), env.chain.Params)
+	require.NoError(t, err)
+	pkScript, err := txscript.PayToAddrScript(addr)
+	require.NoError(t, err)
+
+	funding := env.chain.MineBlock().Transactions[0]
+	tx := bitcoin.NewTx(wire.NewOutPoint(&chainhash.Hash{}, 0), nil, wire.NewTxOut(50000, pkScript), env.memoOut(t, &types.DepositMemo{Recipient: "payment"}))
+	tx.TxIn[0].PreviousOutPoint.Hash = funding.TxHash()
+
+	block, _ := env.mine(t, tx)
+
+	var buf bytes.Buffer
+	require.NoError(t, tx.Serialize(&buf))
+
+	hashes := make([]*chainhash.Hash, 0, len(block.Transactions))
+	for _, blockTx := range block.Transactions {
+		hash := blockTx.TxHash()
+		hashes = append(hashes, &hash)
+	}
+	proof := types.GetMerkleProof(hashes, 1)
+
+	inclusion, err := env.app.BtcBridgeKeeper.VerifyTxInclusion(env.ctx, buf.Bytes(), block.BlockHash().String(), proof, 1)
+	require.NoError(t, err)
+	require.Equal(t, tx.TxHash().String(), inclusion.Txid)
+	require.Equal(t, uint64(1), inclusion.Confirmations)
+	require.Len(t, inclusion.Outputs, 2)
+	require.Equal(t, &types.TxOutput{Vout: 0, Value: 50000, PkScript: pkScript, Address: addr.EncodeAddress()}, inclusion.Outputs[0])
+	require.Empty(t, inclusion.Outputs[1].Address)
+
+	// the block is not deep enough
+	_, err = env.app.BtcBridgeKeeper.VerifyTxInclusion(env.ctx, buf.Bytes(), block.BlockHash().String(), proof, 2)
+	require.ErrorIs(t, err, types.ErrNotConfirmed)
+
+	// the proof of another tx
+	_, err = env.app.BtcBridgeKeeper.VerifyTxInclusion(env.ctx, buf.Bytes(), block.BlockHash().String(), types.GetMerkleProof(hashes, 0), 1)
+	require.ErrorIs(t, err, types.ErrTransactionNotIncluded)
+
+	// the block is unknown to the light client
+	_, err = env.app.BtcBridgeKeeper.VerifyTxInclusion(env.ctx, buf.Bytes(), chainhash.Hash{}.String(), proof, 1)
+	require.ErrorIs(t, err, types.ErrBlockNotFound)
+
+	_, err = env.app.BtcBridgeKeeper.VerifyTxInclusion(env.ctx, []byte("invalid"), block.BlockHash().String(), proof, 1)
+	require.ErrorIs(t, err, types.ErrInvalidBtcTransaction)
+
+	// the query verifies the base64 encoded tx
+	res, err := env.app.BtcBridgeKeeper.QueryVerifyTxInclusion(sdk.WrapSDKContext(env.ctx), &types.QueryVerifyTxInclusionRequest{
+		TxBytes:          base64.StdEncoding.EncodeToString(buf.Bytes()),
+		Blockhash:        block.BlockHash().String(),
+		Proof:            proof,
+		MinConfirmations: 1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, inclusion, res.Inclusion)
+}
@@ -4,6 +4,7 @@ import (
 	"math/big"
 	"sort"
 
+	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -21,6 +22,7 @@ type UTXOViewKeeper interface {
 	GetUTXOsByAddr(ctx sdk.Context, addr string) []*types.UTXO
 	GetUnlockedUTXOsByAddr(ctx sdk.Context, addr string) []*types.UTXO
 	GetOrderedUTXOsByAddr(ctx sdk.Context, addr string) []*types.UTXO
+	GetTaggedUTXOsByAddr(ctx sdk.Context, addr string) []*types.UTXO
 
 	IterateAllUTXOs(ctx sdk.Context, cb func(utxo *types.UTXO) (stop bool))
 	IterateUTXOsByAddr(ctx sdk.Context, addr string, cb func(addr string, utxo *types.UTXO) (stop bool))
@@ -44,17 +46,25 @@ var _ UTXOKeeper = (*BaseUTXOKeeper)(nil)
 type BaseUTXOViewKeeper struct {
 	cdc      codec.BinaryCodec
 	storeKey storetypes.StoreKey
+
+	// the bridged chain to which the utxos belong, empty for bitcoin
+	chainID string
 }
 
 func NewBaseUTXOViewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey) *BaseUTXOViewKeeper {
 	return &BaseUTXOViewKeeper{
-		cdc,
-		storeKey,
+		cdc:      cdc,
+		storeKey: storeKey,
 	}
 }
 
+// store returns the store of the bridged chain
+func (bvk *BaseUTXOViewKeeper) store(ctx sdk.Context) sdk.KVStore {
+	return chainStore(ctx, bvk.storeKey, bvk.chainID)
+}
+
 func (bvk *BaseUTXOViewKeeper) HasUTXO(ctx sdk.Context, hash string, vout uint64) bool {
-	store := ctx.KVStore(bvk.storeKey)
+	store := bvk.store(ctx)
 	return store.Has(types.BtcUtxoKey(hash, vout))
 }
 
@@ -71,7 +81,7 @@ func (bvk *BaseUTXOViewKeeper) IsUTXOLocked(ctx sdk.Context, hash string, vout u
 }
 
 func (bvk *BaseUTXOViewKeeper) GetUTXO(ctx sdk.Context, hash string, vout uint64) *types.UTXO {
-	store := ctx.KVStore(bvk.storeKey)
+	store := bvk.store(ctx)
 
 	var utxo types.UTXO
 	bz := store.Get(types.BtcUtxoKey(hash, vout))
@@ -116,12 +126,16 @@ func (bvk *BaseUTXOViewKeeper) GetUnlockedUTXOsByAddr(ctx sdk.Context, addr stri
 	return utxos
 }
 
-// GetOrderedUTXOsByAddr gets all unlocked utxos of the given address in the descending order by amount
+// GetOrderedUTXOsByAddr gets all unlocked and mature utxos of the given address in the descending order by amount.
+// The utxos carrying inscriptions or runes are excluded, so that the assets are not given away as plain sats.
 func (bvk *BaseUTXOViewKeeper) GetOrderedUTXOsByAddr(ctx sdk.Context, addr string) []*types.UTXO {
 	utxos := make([]*types.UTXO, 0)
 
+	bestHeight := bvk.bestHeight(ctx)
+	chainCfg := bvk.chainCfg(ctx)
+
 	bvk.IterateUTXOsByAddr(ctx, addr, func(addr string, utxo *types.UTXO) (stop bool) {
-		if !utxo.IsLocked {
+		if !utxo.IsLocked && utxo.IsMature(bestHeight, chainCfg) && !utxo.IsTagged() {
 			utxos = append(utxos, utxo)
 		}
 
@@ -136,8 +150,52 @@ func (bvk *BaseUTXOViewKeeper) GetOrderedUTXOsByAddr(ctx sdk.Context, addr strin
 	return utxos
 }
 
+// GetTaggedUTXOsByAddr gets all utxos of the given address carrying inscriptions or runes, of all vaults if the address is empty
+func (bvk *BaseUTXOViewKeeper) GetTaggedUTXOsByAddr(ctx sdk.Context, addr string) []*types.UTXO {
+	utxos := make([]*types.UTXO, 0)
+
+	cb := func(utxo *types.UTXO) (stop bool) {
+		if utxo.IsTagged() {
+			utxos = append(utxos, utxo)
+		}
+
+		return false
+	}
+
+	if len(addr) == 0 {
+		bvk.IterateAllUTXOs(ctx, cb)
+	} else {
+		bvk.IterateUTXOsByAddr(ctx, addr, func(_ string, utxo *types.UTXO) (stop bool) {
+			return cb(utxo)
+		})
+	}
+
+	return utxos
+}
+
+// chainCfg returns the params of the network of the bridged chain, which define the coinbase maturity
+func (bvk *BaseUTXOViewKeeper) chainCfg(ctx sdk.Context) *chaincfg.Params {
+	var params types.Params
+	bvk.cdc.MustUnmarshal(ctx.KVStore(bvk.storeKey).Get(types.ParamsStoreKey), &params)
+
+	return params.ForChain(bvk.chainID).ChainCfg()
+}
+
+// bestHeight returns the height of the best block of the bridged chain, against which the coinbase maturity is checked
+func (bvk *BaseUTXOViewKeeper) bestHeight(ctx sdk.Context) uint64 {
+	bz := bvk.store(ctx).Get(types.BtcBestBlockHeaderKey)
+	if bz == nil {
+		return 0
+	}
+
+	var header types.BlockHeader
+	bvk.cdc.MustUnmarshal(bz, &header)
+
+	return header.Height
+}
+
 func (bvk *BaseUTXOViewKeeper) IterateAllUTXOs(ctx sdk.Context, cb func(utxo *types.UTXO) (stop bool)) {
-	store := ctx.KVStore(bvk.storeKey)
+	store := bvk.store(ctx)
 
 	iterator := sdk.KVStorePrefixIterator(store, types.BtcUtxoKeyPrefix)
 	defer iterator.Close()
@@ -153,7 +211,7 @@ func (bvk *BaseUTXOViewKeeper) IterateAllUTXOs(ctx sdk.Context, cb func(utxo *ty
 }
 
 func (bvk *BaseUTXOViewKeeper) IterateUTXOsByAddr(ctx sdk.Context, addr string, cb func(addr string, utxo *types.UTXO) (stop bool)) {
-	store := ctx.KVStore(bvk.storeKey)
+	store := bvk.store(ctx)
 
 	keyPrefix := append(types.BtcOwnerUtxoKeyPrefix, []byte(addr)...)
 	iterator := sdk.KVStorePrefixIterator(store, keyPrefix)
@@ -188,14 +246,14 @@ func NewBaseUTXOKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey) *Bas
 }
 
 func (bk *BaseUTXOKeeper) SetUTXO(ctx sdk.Context, utxo *types.UTXO) {
-	store := ctx.KVStore(bk.storeKey)
+	store := bk.store(ctx)
 
 	bz := bk.cdc.MustMarshal(utxo)
 	store.Set(types.BtcUtxoKey(utxo.Txid, utxo.Vout), bz)
 }
 
 func (bk *BaseUTXOKeeper) SetOwnerUTXO(ctx sdk.Context, utxo *types.UTXO) {
-	store := ctx.KVStore(bk.storeKey)
+	store := bk.store(ctx)
 
 	store.Set(types.BtcOwnerUtxoKey(utxo.Address, utxo.Txid, utxo.Vout), []byte{1})
 }
@@ -280,7 +338,7 @@ func (bk *BaseUTXOKeeper) saveUTXO(ctx sdk.Context, utxo *types.UTXO) {
 
 // removeUTXO deletes the given utxo which is assumed to exist.
 func (bk *BaseUTXOKeeper) removeUTXO(ctx sdk.Context, hash string, vout uint64) {
-	store := ctx.KVStore(bk.storeKey)
+	store := bk.store(ctx)
 	utxo := bk.GetUTXO(ctx, hash, vout)
 
 	store.Delete(types.BtcUtxoKey(hash, vout))
@@ -0,0 +1,227 @@
+package keeper_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sideprotocol/side/testutil/sample"
+	"github.com/sideprotocol/side/x/btcbridge/keeper"
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+// taprootVault is a taproot vault added to the deposit test env along with its keys
+type taprootVault struct {
+	address      string
+	pkScript     []byte
+	descriptor   *types.VaultDescriptor
+	committeeKey *btcec.PrivateKey
+	recoveryKey  *btcec.PrivateKey
+}
+
+// addTaprootVault adds a taproot btc vault to the params
+func (env *depositTestEnv) addTaprootVault(t *testing.T) *taprootVault {
+	committeeKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	recoveryKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	descriptor := &types.VaultDescriptor{
+		InternalKey:   hex.EncodeToString(schnorr.SerializePubKey(committeeKey.PubKey())),
+		RecoveryKey:   hex.EncodeToString(schnorr.SerializePubKey(recoveryKey.PubKey())),
+		RecoveryDelay: 6,
+	}
+
+	address, err := descriptor.Address(env.chain.Params)
+	require.NoError(t, err)
+	addr, err := btcutil.DecodeAddress(address, env.chain.Params)
+	require.NoError(t, err)
+	pkScript, err := txscript.PayToAddrScript(addr)
+	require.NoError(t, err)
+
+	params := env.app.BtcBridgeKeeper.GetParams(env.ctx)
+	params.Vaults = append(params.Vaults, &types.Vault{
+		Address:       address,
+		PubKey:        descriptor.InternalKey,
+		AssetType:     types.AssetType_ASSET_TYPE_BTC,
+		TapDescriptor: descriptor,
+	})
+	require.NoError(t, params.Validate())
+	env.app.BtcBridgeKeeper.SetParams(env.ctx, params)
+
+	return &taprootVault{
+		address:      address,
+		pkScript:     pkScript,
+		descriptor:   descriptor,
+		committeeKey: committeeKey,
+		recoveryKey:  recoveryKey,
+	}
+}
+
+// decodeRequestPsbt decodes the psbt of the given signing request
+func decodeRequestPsbt(t *testing.T, request *types.BitcoinSigningRequest) (*psbt.Packet, *txscript.TxSigHashes) {
+	b, err := base64.StdEncoding.DecodeString(request.Psbt)
+	require.NoError(t, err)
+	packet, err := psbt.NewFromRawBytes(bytes.NewReader(b), false)
+	require.NoError(t, err)
+
+	prevOuts := txscript.NewMultiPrevOutFetcher(nil)
+	for i, in := range packet.UnsignedTx.TxIn {
+		prevOuts.AddPrevOut(in.PreviousOutPoint, packet.Inputs[i].WitnessUtxo)
+	}
+
+	return packet, txscript.NewTxSigHashes(packet.UnsignedTx, prevOuts)
+}
+
+func TestTaprootVaultWithdrawal(t *testing.T) {
+	env := newDepositTestEnv(t)
+	vault := env.addTaprootVault(t)
+
+	// deposits are recognized against the tweaked output key
+	holder := sample.AccAddress()
+	err := env.deposit(t, wire.NewTxOut(100000, vault.pkScript), env.memoOut(t, &types.DepositMemo{Recipient: holder}))
+	require.NoError(t, err)
+
+	require.Equal(t, int64(100000), env.balance(holder, "sat").Int64())
+	require.Len(t, env.app.BtcBridgeKeeper.GetUTXOsByAddr(env.ctx, vault.address), 1)
+
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	addr, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(key.PubKey().SerializeCompressed()), env.chain.Params)
+	require.NoError(t, err)
+
+	request, err := env.app.BtcBridgeKeeper.NewSigningRequest(env.ctx, addr.EncodeAddress(), sdk.NewInt64Coin("sat", 40000), 10, vault.address)
+	require.NoError(t, err)
+
+	res := env.checkReserves(t)
+	escrow := sdk.NewCoins(sdk.NewInt64Coin("sat", int64(res.PendingWithdrawals)))
+	require.NoError(t, env.app.BankKeeper.SendCoinsFromAccountToModule(env.ctx, sdk.MustAccAddressFromBech32(holder), types.ModuleName, escrow))
+
+	// the signer committee spends by the key path
+	packet, sigHashes := decodeRequestPsbt(t, request)
+	tree, err := vault.descriptor.TapScriptTree()
+	require.NoError(t, err)
+	merkleRoot := tree.RootNode.TapHash()
+
+	tx := packet.UnsignedTx
+	for i, input := range packet.Inputs {
+		sig, err := txscript.RawTxInTaprootSignature(tx, sigHashes, i, input.WitnessUtxo.Value, input.WitnessUtxo.PkScript, merkleRoot[:], txscript.SigHashAll, vault.committeeKey)
+		require.NoError(t, err)
+
+		tx.TxIn[i].Witness = wire.TxWitness{sig}
+	}
+
+	block, txOutProof := env.mine(t, tx)
+
+	msg := types.NewMsgSubmitWithdrawTransactionRequest(env.relayer, block.BlockHash().String(), serializeTx(t, tx), nil)
+	msg.TxOutProof = txOutProof
+	require.NoError(t, env.app.BtcBridgeKeeper.ProcessBitcoinWithdrawTransaction(env.ctx, msg))
+
+	res = env.checkReserves(t)
+	require.Zero(t, res.PendingWithdrawals)
+	require.Equal(t, res.VoucherSupply.Amount.Uint64(), res.Vaults[1].Unspent)
+}
+
+func TestVaultRecovery(t *testing.T) {
+	env := newDepositTestEnv(t)
+	env.setFees(t, 5000, 3000)
+
+	vault := env.addTaprootVault(t)
+	target := env.app.BtcBridgeKeeper.GetParams(env.ctx).Vaults[0].Address
+
+	msgServer := keeper.NewMsgServerImpl(env.app.BtcBridgeKeeper)
+	authority := env.app.BtcBridgeKeeper.GetAuthority()
+
+	holder := sample.AccAddress()
+	for _, amount := range []int64{100000, 50000} {
+		err := env.deposit(t, wire.NewTxOut(amount, vault.pkScript), env.memoOut(t, &types.DepositMemo{Recipient: holder}))
+		require.NoError(t, err)
+	}
+
+	// the collected fees cover only a part of the fee of the sweep
+	env.app.BtcBridgeKeeper.SetCollectedFees(env.ctx, sdk.NewCoins(sdk.NewInt64Coin("sat", 1000)))
+
+	// the recovery is only initiated by the governance
+	_, err := msgServer.RecoverVault(sdk.WrapSDKContext(env.ctx), types.NewMsgRecoverVaultRequest(holder, vault.address, target, 10))
+	require.ErrorIs(t, err, types.ErrSenderAddressNotAuthorized)
+
+	// only taproot vaults are recoverable to another vault
+	_, err = msgServer.RecoverVault(sdk.WrapSDKContext(env.ctx), types.NewMsgRecoverVaultRequest(authority, target, vault.address, 10))
+	require.ErrorIs(t, err, types.ErrVaultNotRecoverable)
+	_, err = msgServer.RecoverVault(sdk.WrapSDKContext(env.ctx), types.NewMsgRecoverVaultRequest(authority, vault.address, vault.address, 10))
+	require.ErrorIs(t, err, types.ErrVaultNotRecoverable)
+
+	// the utxos younger than the recovery delay are not swept
+	_, err = msgServer.RecoverVault(sdk.WrapSDKContext(env.ctx), types.NewMsgRecoverVaultRequest(authority, vault.address, target, 10))
+	require.ErrorIs(t, err, types.ErrInsufficientUTXOs)
+
+	for i := uint32(0); i < vault.descriptor.RecoveryDelay; i++ {
+		env.chain.MineBlock()
+	}
+	env.syncHeaders(t)
+
+	res, err := msgServer.RecoverVault(sdk.WrapSDKContext(env.ctx), types.NewMsgRecoverVaultRequest(authority, vault.address, target, 10))
+	require.NoError(t, err)
+
+	request := env.app.BtcBridgeKeeper.GetSigningRequest(env.ctx, res.Txid)
+	require.True(t, request.Recovery)
+	require.Equal(t, vault.address, request.VaultAddress)
+
+	// the sweep is credited to the target vault once confirmed, nothing is escrowed
+	reserves := env.checkReserves(t)
+	require.Zero(t, reserves.PendingWithdrawals)
+	require.Equal(t, uint64(150000), reserves.Vaults[1].Locked)
+	require.Zero(t, reserves.Vaults[1].Unspent)
+	require.Zero(t, reserves.Vaults[0].Unspent)
+
+	fee := 150000 - reserves.Vaults[0].Unconfirmed
+	require.Positive(t, fee)
+
+	// the recovery key spends by the script path after the delay
+	packet, sigHashes := decodeRequestPsbt(t, request)
+
+	tx := packet.UnsignedTx
+	for i, input := range packet.Inputs {
+		leafScript := input.TaprootLeafScript[0]
+		leaf := txscript.NewBaseTapLeaf(leafScript.Script)
+
+		sig, err := txscript.RawTxInTapscriptSignature(tx, sigHashes, i, input.WitnessUtxo.Value, input.WitnessUtxo.PkScript, leaf, txscript.SigHashDefault, vault.recoveryKey)
+		require.NoError(t, err)
+
+		tx.TxIn[i].Witness = wire.TxWitness{sig, leafScript.Script, leafScript.ControlBlock}
+	}
+
+	block, txOutProof := env.mine(t, tx)
+
+	msg := types.NewMsgSubmitWithdrawTransactionRequest(env.relayer, block.BlockHash().String(), serializeTx(t, tx), nil)
+	msg.TxOutProof = txOutProof
+	require.NoError(t, env.app.BtcBridgeKeeper.ProcessBitcoinWithdrawTransaction(env.ctx, msg))
+
+	// the fee of the sweep is burned from the collected fees, and the rest is taken as the recovery deficit
+	require.Greater(t, fee, uint64(1000))
+	require.True(t, env.app.BtcBridgeKeeper.GetCollectedFees(env.ctx).IsZero())
+	require.Equal(t, fee-1000, env.app.BtcBridgeKeeper.GetRecoveryDeficit(env.ctx))
+
+	// the reserves are moved to the target vault and still back the voucher supply
+	reserves = env.checkReserves(t)
+	require.Equal(t, int64(150000-1000), reserves.VoucherSupply.Amount.Int64())
+	require.Equal(t, 150000-fee, reserves.Vaults[0].Unspent)
+	require.Zero(t, reserves.Vaults[1].Locked)
+	require.Zero(t, reserves.Vaults[1].Unspent)
+
+	swept := env.app.BtcBridgeKeeper.GetUTXO(env.ctx, res.Txid, 0)
+	require.Equal(t, env.app.BtcBridgeKeeper.GetBlockHeader(env.ctx, block.BlockHash().String()).Height, swept.Height)
+
+	escrowMsg, broken := keeper.EscrowInvariant(env.app.BtcBridgeKeeper)(env.ctx)
+	require.False(t, broken, escrowMsg)
+}
@@ -0,0 +1,148 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sideprotocol/side/testutil/sample"
+	"github.com/sideprotocol/side/x/btcbridge/keeper"
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+func TestWithdrawToBtcAddress(t *testing.T) {
+	env := newDepositTestEnv(t)
+	msgServer := keeper.NewMsgServerImpl(env.app.BtcBridgeKeeper)
+	goCtx := sdk.WrapSDKContext(env.ctx)
+
+	account := sample.AccAddress()
+	require.NoError(t, env.deposit(t, wire.NewTxOut(100000, env.vaultPkScript), env.memoOut(t, &types.DepositMemo{Recipient: account})))
+
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	taproot, err := btcutil.NewAddressTaproot(schnorr.SerializePubKey(txscript.ComputeTaprootKeyNoScript(key.PubKey())), env.chain.Params)
+	require.NoError(t, err)
+	p2wsh, err := btcutil.NewAddressWitnessScriptHash(make([]byte, 32), env.chain.Params)
+	require.NoError(t, err)
+	p2pkh, err := btcutil.NewAddressPubKeyHash(btcutil.Hash160(key.PubKey().SerializeCompressed()), env.chain.Params)
+	require.NoError(t, err)
+	mainnet, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(key.PubKey().SerializeCompressed()), &chaincfg.MainNetParams)
+	require.NoError(t, err)
+
+	// the explicit btc address receives the withdrawal
+	msg := types.NewMsgWithdrawBitcoinRequest(account, "20000sat", 10)
+	msg.BtcAddress = taproot.EncodeAddress()
+	require.NoError(t, msg.ValidateBasic())
+
+	_, err = msgServer.WithdrawBitcoin(goCtx, msg)
+	require.NoError(t, err)
+
+	requests := env.app.BtcBridgeKeeper.FilterSigningRequestsByStatus(env.ctx, &types.QuerySigningRequestRequest{Status: types.SigningStatus_SIGNING_STATUS_CREATED})
+	require.Len(t, requests, 1)
+	require.Equal(t, taproot.EncodeAddress(), requests[0].Address)
+	require.Empty(t, requests[0].Recipients)
+
+	// the addresses of another network, the legacy addresses, the vaults and the dust outputs are rejected
+	for address, expected := range map[string]error{
+		mainnet.EncodeAddress(): types.ErrInvalidBtcAddress,
+		p2pkh.EncodeAddress():   types.ErrUnsupportedScriptType,
+		env.app.BtcBridgeKeeper.GetParams(env.ctx).Vaults[0].Address: types.ErrInvalidBtcAddress,
+	} {
+		msg := types.NewMsgWithdrawBitcoinRequest(account, "20000sat", 10)
+		msg.BtcAddress = address
+
+		_, err = msgServer.WithdrawBitcoin(goCtx, msg)
+		require.ErrorIs(t, err, expected, address)
+	}
+
+	msg = types.NewMsgWithdrawBitcoinRequest(account, "100sat", 10)
+	msg.BtcAddress = taproot.EncodeAddress()
+	_, err = msgServer.WithdrawBitcoin(goCtx, msg)
+	require.ErrorIs(t, err, types.ErrDustOutput)
+
+	// a single withdrawal pays several recipients
+	msg = types.NewMsgWithdrawBitcoinToRecipientsRequest(account, []*types.WithdrawRecipient{
+		{BtcAddress: taproot.EncodeAddress(), Amount: "10000sat"},
+		{BtcAddress: p2wsh.EncodeAddress(), Amount: "15000sat"},
+	}, 10)
+	require.NoError(t, msg.ValidateBasic())
+
+	balance := env.balance(account, "sat").Int64()
+
+	_, err = msgServer.WithdrawBitcoin(goCtx, msg)
+	require.NoError(t, err)
+
+	events := typedEvents[*types.EventWithdrawalRequested](t, env.ctx)
+	require.Len(t, events, 2)
+	require.Equal(t, int64(25000), events[1].Amount.Amount.Int64())
+	require.Equal(t, []string{taproot.EncodeAddress(), p2wsh.EncodeAddress()}, events[1].Recipients)
+	require.Equal(t, balance-25000-int64(events[1].Fee), env.balance(account, "sat").Int64())
+
+	request := env.app.BtcBridgeKeeper.GetSigningRequest(env.ctx, events[1].Txid)
+	require.Equal(t, taproot.EncodeAddress(), request.Address)
+	require.Equal(t, events[1].Recipients, request.RecipientAddresses())
+
+	tx := env.signWithdrawal(t, request)
+	require.Equal(t, int64(10000), tx.TxOut[0].Value)
+	require.Equal(t, int64(15000), tx.TxOut[1].Value)
+
+	env.checkReserves(t)
+
+	// each recipient is checked for dust
+	msg = types.NewMsgWithdrawBitcoinToRecipientsRequest(account, []*types.WithdrawRecipient{
+		{BtcAddress: taproot.EncodeAddress(), Amount: "10000sat"},
+		{BtcAddress: p2wsh.EncodeAddress(), Amount: "100sat"},
+	}, 10)
+
+	_, err = msgServer.WithdrawBitcoin(goCtx, msg)
+	require.ErrorIs(t, err, types.ErrDustOutput)
+
+	// the different encodings of the same recipient are rejected as duplicates
+	msg = types.NewMsgWithdrawBitcoinToRecipientsRequest(account, []*types.WithdrawRecipient{
+		{BtcAddress: p2wsh.EncodeAddress(), Amount: "10000sat"},
+		{BtcAddress: strings.ToUpper(p2wsh.EncodeAddress()), Amount: "10000sat"},
+	}, 10)
+	require.NoError(t, msg.ValidateBasic())
+
+	_, err = msgServer.WithdrawBitcoin(goCtx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidRecipients)
+
+	// the withdrawal is rejected if any recipient is blocked
+	authority := env.app.BtcBridgeKeeper.GetAuthority()
+	_, err = msgServer.UpdateBlocklist(goCtx, types.NewMsgUpdateBlocklistRequest(authority, []string{p2wsh.EncodeAddress()}, nil))
+	require.NoError(t, err)
+
+	msg = types.NewMsgWithdrawBitcoinToRecipientsRequest(account, []*types.WithdrawRecipient{
+		{BtcAddress: taproot.EncodeAddress(), Amount: "10000sat"},
+		{BtcAddress: p2wsh.EncodeAddress(), Amount: "10000sat"},
+	}, 10)
+
+	_, err = msgServer.WithdrawBitcoin(goCtx, msg)
+	require.ErrorIs(t, err, types.ErrAddressBlocked)
+
+	_, err = msgServer.UpdateBlocklist(goCtx, types.NewMsgUpdateBlocklistRequest(authority, nil, []string{p2wsh.EncodeAddress()}))
+	require.NoError(t, err)
+
+	// the withdrawal is rejected while the bridge is degraded
+	params := env.app.BtcBridgeKeeper.GetParams(env.ctx)
+	params.MaxHeaderLag = 60 * 60
+	env.app.BtcBridgeKeeper.SetParams(env.ctx, params)
+
+	bestTime := time.Unix(int64(env.app.BtcBridgeKeeper.GetBestBlockHeader(env.ctx).Time), 0)
+	env.ctx = env.ctx.WithBlockTime(bestTime.Add(2 * time.Hour))
+	env.app.BtcBridgeKeeper.CheckHeaderLiveness(env.ctx)
+	require.True(t, env.app.BtcBridgeKeeper.IsDegraded(env.ctx))
+
+	_, err = msgServer.WithdrawBitcoin(sdk.WrapSDKContext(env.ctx), msg)
+	require.ErrorIs(t, err, types.ErrBridgeDegraded)
+}
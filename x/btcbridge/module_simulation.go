@@ -1 +1,141 @@
 package btclightclient
+
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	btcbridgesimulation "github.com/sideprotocol/side/x/btcbridge/simulation"
+)
+
+var _ module.AppModuleSimulation = AppModule{}
+
+const (
+	opWeightMsgSubmitBlockHeaders = "op_weight_msg_submit_block_headers" // #nosec
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgSubmitBlockHeaders int = 100
+
+	opWeightMsgSubmitForkedBlockHeaders = "op_weight_msg_submit_forked_block_headers" // #nosec
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgSubmitForkedBlockHeaders int = 20
+
+	opWeightMsgSubmitDepositTransaction = "op_weight_msg_submit_deposit_transaction" // #nosec
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgSubmitDepositTransaction int = 100
+
+	opWeightMsgLinkBitcoinAddress = "op_weight_msg_link_bitcoin_address" // #nosec
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgLinkBitcoinAddress int = 50
+
+	opWeightMsgWithdrawBitcoin = "op_weight_msg_withdraw_bitcoin" // #nosec
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgWithdrawBitcoin int = 100
+
+	opWeightMsgSubmitWithdrawSignatures = "op_weight_msg_submit_withdraw_signatures" // #nosec
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgSubmitWithdrawSignatures int = 100
+
+	opWeightMsgSubmitWithdrawTransaction = "op_weight_msg_submit_withdraw_transaction" // #nosec
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgSubmitWithdrawTransaction int = 100
+)
+
+// GenerateGenesisState creates a randomized GenState of the module.
+func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	btcbridgesimulation.RandomizedGenState(simState)
+}
+
+// RegisterStoreDecoder registers a decoder.
+func (am AppModule) RegisterStoreDecoder(_ sdk.StoreDecoderRegistry) {}
+
+// WeightedOperations returns the all the btcbridge module operations with their respective weights.
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	operations := make([]simtypes.WeightedOperation, 0)
+
+	var weightMsgSubmitBlockHeaders int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgSubmitBlockHeaders, &weightMsgSubmitBlockHeaders, nil,
+		func(_ *rand.Rand) {
+			weightMsgSubmitBlockHeaders = defaultWeightMsgSubmitBlockHeaders
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSubmitBlockHeaders,
+		btcbridgesimulation.SimulateMsgSubmitBlockHeaders(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgSubmitForkedBlockHeaders int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgSubmitForkedBlockHeaders, &weightMsgSubmitForkedBlockHeaders, nil,
+		func(_ *rand.Rand) {
+			weightMsgSubmitForkedBlockHeaders = defaultWeightMsgSubmitForkedBlockHeaders
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSubmitForkedBlockHeaders,
+		btcbridgesimulation.SimulateMsgSubmitForkedBlockHeaders(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgSubmitDepositTransaction int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgSubmitDepositTransaction, &weightMsgSubmitDepositTransaction, nil,
+		func(_ *rand.Rand) {
+			weightMsgSubmitDepositTransaction = defaultWeightMsgSubmitDepositTransaction
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSubmitDepositTransaction,
+		btcbridgesimulation.SimulateMsgSubmitDepositTransaction(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgLinkBitcoinAddress int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgLinkBitcoinAddress, &weightMsgLinkBitcoinAddress, nil,
+		func(_ *rand.Rand) {
+			weightMsgLinkBitcoinAddress = defaultWeightMsgLinkBitcoinAddress
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgLinkBitcoinAddress,
+		btcbridgesimulation.SimulateMsgLinkBitcoinAddress(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgWithdrawBitcoin int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgWithdrawBitcoin, &weightMsgWithdrawBitcoin, nil,
+		func(_ *rand.Rand) {
+			weightMsgWithdrawBitcoin = defaultWeightMsgWithdrawBitcoin
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgWithdrawBitcoin,
+		btcbridgesimulation.SimulateMsgWithdrawBitcoin(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgSubmitWithdrawSignatures int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgSubmitWithdrawSignatures, &weightMsgSubmitWithdrawSignatures, nil,
+		func(_ *rand.Rand) {
+			weightMsgSubmitWithdrawSignatures = defaultWeightMsgSubmitWithdrawSignatures
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSubmitWithdrawSignatures,
+		btcbridgesimulation.SimulateMsgSubmitWithdrawSignatures(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgSubmitWithdrawTransaction int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgSubmitWithdrawTransaction, &weightMsgSubmitWithdrawTransaction, nil,
+		func(_ *rand.Rand) {
+			weightMsgSubmitWithdrawTransaction = defaultWeightMsgSubmitWithdrawTransaction
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSubmitWithdrawTransaction,
+		btcbridgesimulation.SimulateMsgSubmitWithdrawTransaction(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	return operations
+}
+
+// ProposalMsgs returns msgs used for governance proposals for simulations.
+func (am AppModule) ProposalMsgs(_ module.SimulationState) []simtypes.WeightedProposalMsg {
+	return nil
+}
@@ -0,0 +1,142 @@
+package simulation
+
+import (
+	"math/big"
+	"math/rand"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sideprotocol/side/testutil/bitcoin"
+	"github.com/sideprotocol/side/x/btcbridge/keeper"
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+// SyntheticChain generates bitcoin blocks with regtest difficulty and valid merkle roots
+// on top of the block headers known by the light client
+type SyntheticChain struct {
+	k   keeper.Keeper
+	ctx sdk.Context
+
+	// blocks generated on top of the best block of the light client
+	blocks []*wire.MsgBlock
+	// header of the block from which the blocks are generated
+	base *types.BlockHeader
+}
+
+// NewSyntheticChain creates a synthetic chain extending the best block of the light client.
+// It returns false if the light client does not follow the regtest network.
+func NewSyntheticChain(k keeper.Keeper, ctx sdk.Context) (*SyntheticChain, bool) {
+	if k.ChainCfg(ctx).Name != chaincfg.RegressionNetParams.Name {
+		return nil, false
+	}
+
+	return &SyntheticChain{
+		k:    k,
+		ctx:  ctx,
+		base: k.GetBestBlockHeader(ctx),
+	}, true
+}
+
+// NewForkedSyntheticChain creates a synthetic chain forking from the block at the given height,
+// whose first block replaces the existing block at the height with more work.
+// It returns false if the fork is not feasible.
+func NewForkedSyntheticChain(k keeper.Keeper, ctx sdk.Context, height uint64) (*SyntheticChain, bool) {
+	c, ok := NewSyntheticChain(k, ctx)
+	if !ok || height == 0 {
+		return nil, false
+	}
+
+	if len(k.GetBlockHashByHeight(ctx, height)) == 0 || len(k.GetBlockHashByHeight(ctx, height-1)) == 0 {
+		return nil, false
+	}
+
+	// the forked block requires twice the work of the replaced block
+	replaced := k.GetBlockHeaderByHeight(ctx, height)
+	target := blockchain.CompactToBig(keeper.BitsToTargetUint32(replaced.Bits))
+	target.Rsh(target, 1)
+
+	// keep the proof of work cheap enough
+	if target.Cmp(new(big.Int).Rsh(chaincfg.RegressionNetParams.PowLimit, 8)) < 0 {
+		return nil, false
+	}
+
+	c.base = k.GetBlockHeaderByHeight(ctx, height-1)
+	block := c.MineBlock()
+
+	block.Header.Bits = blockchain.BigToCompact(target)
+	block.Header.Nonce = 0
+	bitcoin.Solve(&block.Header)
+
+	return c, true
+}
+
+// Tip returns the header of the last block of the chain
+func (c *SyntheticChain) Tip() *types.BlockHeader {
+	if len(c.blocks) == 0 {
+		return c.base
+	}
+
+	return c.header(len(c.blocks) - 1)
+}
+
+// MineBlock mines a new block containing the given txs on top of the chain
+func (c *SyntheticChain) MineBlock(txs ...*wire.MsgTx) *wire.MsgBlock {
+	tip := c.Tip()
+
+	prev := &wire.MsgBlock{Header: *keeper.HeaderConvert(tip)}
+	block := bitcoin.NewBlock(prev, int64(tip.Height)+1, txs...)
+
+	c.blocks = append(c.blocks, block)
+
+	return block
+}
+
+// Headers returns the headers of the generated blocks
+func (c *SyntheticChain) Headers() []*types.BlockHeader {
+	headers := make([]*types.BlockHeader, len(c.blocks))
+	for i := range c.blocks {
+		headers[i] = c.header(i)
+	}
+
+	return headers
+}
+
+func (c *SyntheticChain) header(i int) *types.BlockHeader {
+	block := c.blocks[i]
+
+	return types.NewBlockHeader(&block.Header, c.base.Height+uint64(i)+1, uint64(len(block.Transactions)))
+}
+
+// RandomFundingTx generates a tx with a random p2wpkh output, which funds the deposit tx
+func RandomFundingTx(r *rand.Rand, chainCfg *chaincfg.Params) (*wire.MsgTx, error) {
+	key, _ := btcec.PrivKeyFromBytes(randomBytes(r, 32))
+
+	addr, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(key.PubKey().SerializeCompressed()), chainCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	var prevHash chainhash.Hash
+	copy(prevHash[:], randomBytes(r, chainhash.HashSize))
+
+	return bitcoin.NewTx(wire.NewOutPoint(&prevHash, 0), nil, wire.NewTxOut(btcutil.SatoshiPerBitcoin, pkScript)), nil
+}
+
+func randomBytes(r *rand.Rand, n int) []byte {
+	bz := make([]byte, n)
+	_, _ = r.Read(bz)
+
+	return bz
+}
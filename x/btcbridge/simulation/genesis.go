@@ -0,0 +1,45 @@
+package simulation
+
+import (
+	"encoding/hex"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+// RandomizedGenState generates a random GenesisState for the module.
+// The light client follows a synthetic regtest chain and the btc vault is controlled by VaultKey.
+func RandomizedGenState(simState *module.SimulationState) {
+	chainCfg := &chaincfg.RegressionNetParams
+
+	vaultAddr, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(VaultKey.PubKey().SerializeCompressed()), chainCfg)
+	if err != nil {
+		panic(err)
+	}
+
+	// pick the first few accounts as the relayers
+	relayers := make([]string, 0)
+	numRelayers := 1 + simState.Rand.Intn(3)
+	for i := 0; i < len(simState.Accounts) && i < numRelayers; i++ {
+		relayers = append(relayers, simState.Accounts[i].Address.String())
+	}
+
+	params := types.DefaultParams()
+	params.AuthorizedRelayers = relayers
+	params.Confirmations = int32(1 + simState.Rand.Intn(3))
+	params.Network = chainCfg.Name
+	params.Vaults = []*types.Vault{{
+		Address:   vaultAddr.EncodeAddress(),
+		PubKey:    hex.EncodeToString(VaultKey.PubKey().SerializeCompressed()),
+		AssetType: types.AssetType_ASSET_TYPE_BTC,
+	}}
+
+	genesis := types.DefaultGenesis()
+	genesis.Params = params
+	genesis.BestBlockHeader = types.DefaultRegtestBestBlockHeader()
+
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesis)
+}
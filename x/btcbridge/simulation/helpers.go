@@ -0,0 +1,82 @@
+package simulation
+
+import (
+	"bytes"
+	"encoding/base64"
+	"math/rand"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module/testutil"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/sideprotocol/side/x/btcbridge/keeper"
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+// VaultKey is the key of the simulated btc vault, which signs the withdrawal txs
+var VaultKey, _ = btcec.PrivKeyFromBytes(chainhash.HashB([]byte("btcbridge simulation vault")))
+
+// FindAccount find a specific address from an account list
+func FindAccount(accs []simtypes.Account, address string) (simtypes.Account, bool) {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return simtypes.Account{}, false
+	}
+	return simtypes.FindAccount(accs, addr)
+}
+
+// randomRelayer returns a random authorized relayer among the simulation accounts.
+// No relayer is returned if the relayed messages have to be attested by several relayers.
+func randomRelayer(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account) (simtypes.Account, bool) {
+	params := k.GetParams(ctx)
+
+	relayers := params.AuthorizedRelayers
+	if len(relayers) == 0 || params.RequiresQuorum() {
+		return simtypes.Account{}, false
+	}
+
+	return FindAccount(accs, relayers[r.Intn(len(relayers))])
+}
+
+// deliver signs and delivers the tx of the given message with random fees
+func deliver(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	chainID string,
+	account simtypes.Account,
+	msg sdk.Msg,
+	coinsSpentInMsg sdk.Coins,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	return simulation.GenAndDeliverTxWithRandFees(simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           testutil.MakeTestEncodingConfig().TxConfig,
+		Cdc:             nil,
+		Msg:             msg,
+		MsgType:         sdk.MsgTypeURL(msg),
+		Context:         ctx,
+		SimAccount:      account,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      types.ModuleName,
+		CoinsSpentInMsg: coinsSpentInMsg,
+	})
+}
+
+// serializeTx returns the base64 encoded tx
+func serializeTx(tx *wire.MsgTx) (string, error) {
+	var buf bytes.Buffer
+	if err := tx.Serialize(&buf); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
@@ -0,0 +1,47 @@
+package simulation
+
+import (
+	"encoding/base64"
+	"math/rand"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/sideprotocol/side/x/btcbridge/keeper"
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+// SimulateMsgLinkBitcoinAddress links a random p2wpkh address to a random account by the legacy signmessage proof
+func SimulateMsgLinkBitcoinAddress(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgLinkBitcoinAddressRequest{})
+
+		account, _ := simtypes.RandomAcc(r, accs)
+		sender := account.Address.String()
+
+		key, _ := btcec.PrivKeyFromBytes(randomBytes(r, 32))
+
+		btcAddr, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(key.PubKey().SerializeCompressed()), k.ChainCfg(ctx))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "failed to derive bitcoin address"), nil, err
+		}
+
+		sig, err := ecdsa.SignCompact(key, types.SignedMessageHash(types.AddressLinkMessage(ctx.ChainID(), sender)), true)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "failed to sign message"), nil, err
+		}
+
+		msg := types.NewMsgLinkBitcoinAddressRequest(sender, btcAddr.EncodeAddress(), base64.StdEncoding.EncodeToString(sig))
+
+		return deliver(r, app, ctx, ak, bk, chainID, account, msg, nil)
+	}
+}
@@ -0,0 +1,81 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/sideprotocol/side/x/btcbridge/keeper"
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+// SimulateMsgSubmitBlockHeaders extends the light client by a few synthetic blocks
+func SimulateMsgSubmitBlockHeaders(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgSubmitBlockHeaderRequest{})
+
+		relayer, found := randomRelayer(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no relayer found"), nil, nil
+		}
+
+		chain, ok := NewSyntheticChain(k, ctx)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "not a regtest light client"), nil, nil
+		}
+
+		blocks := 1 + r.Intn(3)
+		for i := 0; i < blocks; i++ {
+			chain.MineBlock()
+		}
+
+		msg := types.NewMsgSubmitBlockHeaderRequest(relayer.Address.String(), chain.Headers())
+
+		return deliver(r, app, ctx, ak, bk, chainID, relayer, msg, nil)
+	}
+}
+
+// SimulateMsgSubmitForkedBlockHeaders reorganizes the light client by a synthetic fork with more work
+func SimulateMsgSubmitForkedBlockHeaders(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgSubmitBlockHeaderRequest{})
+
+		relayer, found := randomRelayer(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no relayer found"), nil, nil
+		}
+
+		// fork within the last few blocks
+		best := k.GetBestBlockHeader(ctx)
+		depth := uint64(r.Intn(3))
+		if best.Height <= depth {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "light client too short"), nil, nil
+		}
+
+		chain, ok := NewForkedSyntheticChain(k, ctx, best.Height-depth)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "fork not feasible"), nil, nil
+		}
+
+		blocks := r.Intn(3)
+		for i := 0; i < blocks; i++ {
+			chain.MineBlock()
+		}
+
+		msg := types.NewMsgSubmitBlockHeaderRequest(relayer.Address.String(), chain.Headers())
+
+		return deliver(r, app, ctx, ak, bk, chainID, relayer, msg, nil)
+	}
+}
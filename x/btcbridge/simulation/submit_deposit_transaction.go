@@ -0,0 +1,155 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/sideprotocol/side/testutil/bitcoin"
+	"github.com/sideprotocol/side/x/btcbridge/keeper"
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+// SimulateMsgSubmitDepositTransaction deposits a random amount to the btc vault on behalf of a random account.
+// The deposit tx is mined into a synthetic block, whose headers are relayed along with the confirmations
+// ahead of the deposit tx with the tx out proof.
+func SimulateMsgSubmitDepositTransaction(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgSubmitDepositTransactionRequest{})
+
+		relayer, found := randomRelayer(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no relayer found"), nil, nil
+		}
+
+		params := k.GetParams(ctx)
+		chainCfg := params.ChainCfg()
+
+		vault := randomBtcVault(r, params.Vaults)
+		if vault == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no btc vault found"), nil, nil
+		}
+
+		chain, ok := NewSyntheticChain(k, ctx)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "not a regtest light client"), nil, nil
+		}
+
+		vaultAddr, err := btcutil.DecodeAddress(vault.Address, chainCfg)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "invalid vault address"), nil, err
+		}
+		vaultPkScript, err := txscript.PayToAddrScript(vaultAddr)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "invalid vault address"), nil, err
+		}
+
+		funding, err := RandomFundingTx(r, chainCfg)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "failed to build funding tx"), nil, err
+		}
+
+		outs := make([]*wire.TxOut, 0)
+
+		// deposit either from a linked bitcoin address, which is credited to the linked account,
+		// or to a random account specified by the memo
+		if link := randomAddressLink(r, ctx, k, accs); link != nil && r.Intn(2) == 0 {
+			linkedAddr, err := btcutil.DecodeAddress(link.BtcAddress, chainCfg)
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "invalid linked address"), nil, err
+			}
+			if funding.TxOut[0].PkScript, err = txscript.PayToAddrScript(linkedAddr); err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "invalid linked address"), nil, err
+			}
+		} else {
+			recipient, _ := simtypes.RandomAcc(r, accs)
+
+			memo := &types.DepositMemo{Recipient: recipient.Address.String()}
+			memoScript, err := memo.Script()
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "failed to build memo"), nil, err
+			}
+
+			outs = append(outs, wire.NewTxOut(0, memoScript))
+		}
+
+		amount := simtypes.RandIntBetween(r, 10000, 10000000)
+
+		outs = append([]*wire.TxOut{wire.NewTxOut(int64(amount), vaultPkScript)}, outs...)
+
+		fundingHash := funding.TxHash()
+		deposit := bitcoin.NewTx(wire.NewOutPoint(&fundingHash, 0), nil, outs...)
+
+		block := chain.MineBlock(deposit)
+		for i := int32(0); i < params.Confirmations; i++ {
+			chain.MineBlock()
+		}
+
+		headersMsg := types.NewMsgSubmitBlockHeaderRequest(relayer.Address.String(), chain.Headers())
+		if opMsg, _, err := deliver(r, app, ctx, ak, bk, chainID, relayer, headersMsg, nil); err != nil || !opMsg.OK {
+			return opMsg, nil, err
+		}
+
+		depositHash := deposit.TxHash()
+		txOutProof, err := types.BuildTxOutProof(block, &depositHash)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "failed to build tx out proof"), nil, err
+		}
+
+		fundingBytes, err := serializeTx(funding)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "failed to serialize tx"), nil, err
+		}
+		depositBytes, err := serializeTx(deposit)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "failed to serialize tx"), nil, err
+		}
+
+		msg := types.NewMsgSubmitDepositTransactionRequest(relayer.Address.String(), block.BlockHash().String(), fundingBytes, depositBytes, nil)
+		msg.TxOutProof = txOutProof
+
+		return deliver(r, app, ctx, ak, bk, chainID, relayer, msg, nil)
+	}
+}
+
+// randomBtcVault returns a random vault of the btc asset type
+func randomBtcVault(r *rand.Rand, vaults []*types.Vault) *types.Vault {
+	candidates := make([]*types.Vault, 0)
+	for _, vault := range vaults {
+		if vault.AssetType == types.AssetType_ASSET_TYPE_BTC && len(vault.Address) != 0 {
+			candidates = append(candidates, vault)
+		}
+	}
+
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	return candidates[r.Intn(len(candidates))]
+}
+
+// randomAddressLink returns a random address link of the simulation accounts
+func randomAddressLink(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account) *types.AddressLink {
+	links := make([]*types.AddressLink, 0)
+	for _, link := range k.GetAllAddressLinks(ctx) {
+		if _, found := FindAccount(accs, link.Address); found {
+			links = append(links, link)
+		}
+	}
+
+	if len(links) == 0 {
+		return nil
+	}
+
+	return links[r.Intn(len(links))]
+}
@@ -0,0 +1,236 @@
+package simulation
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"math/rand"
+
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/sideprotocol/side/x/btcbridge/keeper"
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+const (
+	// minimum voucher balance of the account to withdraw
+	minWithdrawalBalance = 100000
+
+	// reserved amount of the vault for the network fee
+	withdrawalFeeReserve = 100000
+)
+
+// SimulateMsgWithdrawBitcoin withdraws a random amount of the btc voucher to the linked bitcoin address of a random account
+func SimulateMsgWithdrawBitcoin(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgWithdrawBitcoinRequest{})
+
+		params := k.GetParams(ctx)
+
+		// the withdrawal is served by the first btc vault
+		var vault *types.Vault
+		for _, v := range params.Vaults {
+			if v.AssetType == types.AssetType_ASSET_TYPE_BTC {
+				vault = v
+				break
+			}
+		}
+		if vault == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no btc vault found"), nil, nil
+		}
+
+		available := uint64(0)
+		for _, utxo := range k.GetUnlockedUTXOsByAddr(ctx, vault.Address) {
+			available += utxo.Amount
+		}
+
+		// find an account which is linked to a bitcoin address and holds enough vouchers
+		var account simtypes.Account
+		var balance sdk.Coin
+		found := false
+		for _, i := range r.Perm(len(accs)) {
+			balance = bk.GetBalance(ctx, accs[i].Address, params.BtcVoucherDenom)
+			if len(k.GetLinkedBitcoinAddress(ctx, accs[i].Address.String())) != 0 && balance.Amount.GTE(sdk.NewInt(minWithdrawalBalance)) {
+				account = accs[i]
+				found = true
+				break
+			}
+		}
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no account to withdraw"), nil, nil
+		}
+
+		// leave room for the network fee
+		amount := simtypes.RandIntBetween(r, minWithdrawalBalance/10, int(balance.Amount.Int64()/2))
+		if uint64(amount)+withdrawalFeeReserve > available {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "insufficient vault reserves"), nil, nil
+		}
+
+		msg := types.NewMsgWithdrawBitcoinRequest(account.Address.String(), sdk.NewInt64Coin(params.BtcVoucherDenom, int64(amount)).String(), int64(1+r.Intn(10)))
+
+		// the tx fees are not paid by the vouchers to be escrowed
+		return deliver(r, app, ctx, ak, bk, chainID, account, msg, sdk.NewCoins(balance))
+	}
+}
+
+// SimulateMsgSubmitWithdrawSignatures signs a random created withdrawal with the simulated vault key
+func SimulateMsgSubmitWithdrawSignatures(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgSubmitWithdrawSignaturesRequest{})
+
+		request := randomSigningRequest(r, ctx, k, types.SigningStatus_SIGNING_STATUS_CREATED)
+		if request == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no signing request to sign"), nil, nil
+		}
+
+		packet, err := SignWithdrawal(request)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "failed to sign the withdrawal"), nil, err
+		}
+
+		signed, err := packet.B64Encode()
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "failed to serialize psbt"), nil, err
+		}
+
+		account, _ := simtypes.RandomAcc(r, accs)
+		msg := types.NewMsgSubmitWithdrawSignaturesRequest(account.Address.String(), request.Txid, signed)
+
+		return deliver(r, app, ctx, ak, bk, chainID, account, msg, nil)
+	}
+}
+
+// SimulateMsgSubmitWithdrawTransaction mines a random signed withdrawal into a synthetic block
+// and relays the headers ahead of the withdrawal tx with the tx out proof
+func SimulateMsgSubmitWithdrawTransaction(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgSubmitWithdrawTransactionRequest{})
+
+		relayer, found := randomRelayer(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no relayer found"), nil, nil
+		}
+
+		request := randomSigningRequest(r, ctx, k, types.SigningStatus_SIGNING_STATUS_SIGNED)
+		if request == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no signed withdrawal"), nil, nil
+		}
+
+		chain, ok := NewSyntheticChain(k, ctx)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "not a regtest light client"), nil, nil
+		}
+
+		packet, err := decodePsbt(request.Psbt)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "invalid psbt"), nil, err
+		}
+
+		tx, err := psbt.Extract(packet)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "failed to extract the withdrawal tx"), nil, err
+		}
+
+		block := chain.MineBlock(tx)
+		for i := int32(0); i < k.GetParams(ctx).Confirmations; i++ {
+			chain.MineBlock()
+		}
+
+		headersMsg := types.NewMsgSubmitBlockHeaderRequest(relayer.Address.String(), chain.Headers())
+		if opMsg, _, err := deliver(r, app, ctx, ak, bk, chainID, relayer, headersMsg, nil); err != nil || !opMsg.OK {
+			return opMsg, nil, err
+		}
+
+		txHash := tx.TxHash()
+		txOutProof, err := types.BuildTxOutProof(block, &txHash)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "failed to build tx out proof"), nil, err
+		}
+
+		txBytes, err := serializeTx(tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "failed to serialize tx"), nil, err
+		}
+
+		msg := types.NewMsgSubmitWithdrawTransactionRequest(relayer.Address.String(), block.BlockHash().String(), txBytes, nil)
+		msg.TxOutProof = txOutProof
+
+		return deliver(r, app, ctx, ak, bk, chainID, relayer, msg, nil)
+	}
+}
+
+// SignWithdrawal signs and finalizes the withdrawal psbt of the given signing request with VaultKey
+func SignWithdrawal(request *types.BitcoinSigningRequest) (*psbt.Packet, error) {
+	packet, err := decodePsbt(request.Psbt)
+	if err != nil {
+		return nil, err
+	}
+
+	prevOuts := txscript.NewMultiPrevOutFetcher(nil)
+	for i, in := range packet.UnsignedTx.TxIn {
+		prevOuts.AddPrevOut(in.PreviousOutPoint, packet.Inputs[i].WitnessUtxo)
+	}
+
+	sigHashes := txscript.NewTxSigHashes(packet.UnsignedTx, prevOuts)
+	for i, input := range packet.Inputs {
+		witness, err := txscript.WitnessSignature(packet.UnsignedTx, sigHashes, i, input.WitnessUtxo.Value, input.WitnessUtxo.PkScript, txscript.SigHashAll, VaultKey, true)
+		if err != nil {
+			return nil, err
+		}
+
+		packet.Inputs[i].FinalScriptWitness, err = types.SerializeWitness(witness)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return packet, nil
+}
+
+// randomSigningRequest returns a random signing request of the given status, which is signable by VaultKey
+func randomSigningRequest(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, status types.SigningStatus) *types.BitcoinSigningRequest {
+	vaults := k.GetParams(ctx).Vaults
+	vaultPubKey := hex.EncodeToString(VaultKey.PubKey().SerializeCompressed())
+
+	candidates := make([]*types.BitcoinSigningRequest, 0)
+	for _, request := range k.FilterSigningRequestsByStatus(ctx, &types.QuerySigningRequestRequest{Status: status}) {
+		vault := types.SelectVaultByBitcoinAddress(vaults, request.VaultAddress)
+		if vault != nil && vault.PubKey == vaultPubKey {
+			candidates = append(candidates, request)
+		}
+	}
+
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	return candidates[r.Intn(len(candidates))]
+}
+
+func decodePsbt(encoded string) (*psbt.Packet, error) {
+	b, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	return psbt.NewFromRawBytes(bytes.NewReader(b), false)
+}
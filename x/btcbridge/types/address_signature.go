@@ -0,0 +1,208 @@
+package types
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+)
+
+const (
+	// magic prefix of the legacy signmessage
+	SignedMessagePrefix = "Bitcoin Signed Message:\n"
+
+	// tag of the BIP-322 message hash
+	BIP322MessageTag = "BIP0322-signed-message"
+
+	// length of the legacy compact signature
+	CompactSignatureLength = 65
+
+	// prefix of the message signed by the bitcoin address to be linked
+	AddressLinkMessagePrefix = "side-btcbridge-link"
+)
+
+// AddressLinkMessage returns the message signed by the bitcoin address to be linked to the given side address,
+// which is bound to the module and the chain so that the signature can not be replayed elsewhere
+func AddressLinkMessage(chainID string, address string) string {
+	return fmt.Sprintf("%s/%s/%s", AddressLinkMessagePrefix, chainID, address)
+}
+
+// VerifyAddressSignature verifies the base64 encoded signature of the given bitcoin address over the message.
+// Both the BIP-322 simple signature and the legacy signmessage signature (including the BIP-137 variants) are supported.
+func VerifyAddressSignature(address string, message string, signature string, chainCfg *chaincfg.Params) error {
+	addr, err := btcutil.DecodeAddress(address, chainCfg)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidBtcAddress, "invalid address %s", address)
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidAddressSignature, "signature must be base64 encoded")
+	}
+
+	if len(sig) == CompactSignatureLength {
+		if err := VerifyLegacySignature(addr, message, sig, chainCfg); err == nil {
+			return nil
+		}
+	}
+
+	return VerifyBIP322Signature(addr, message, sig)
+}
+
+// VerifyLegacySignature verifies the legacy signmessage signature of the given address over the message
+func VerifyLegacySignature(addr btcutil.Address, message string, sig []byte, chainCfg *chaincfg.Params) error {
+	if len(sig) != CompactSignatureLength {
+		return ErrInvalidAddressSignature
+	}
+
+	// normalize the BIP-137 header to the compressed key header
+	header := sig[0]
+	switch {
+	case header >= 27 && header <= 34:
+	case header >= 35 && header <= 42:
+		header = 31 + (header-35)%4
+	default:
+		return ErrInvalidAddressSignature
+	}
+
+	compactSig := append([]byte{header}, sig[1:]...)
+
+	pubKey, compressed, err := ecdsa.RecoverCompact(compactSig, SignedMessageHash(message))
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidAddressSignature, err.Error())
+	}
+
+	var serializedPubKey []byte
+	if compressed {
+		serializedPubKey = pubKey.SerializeCompressed()
+	} else {
+		serializedPubKey = pubKey.SerializeUncompressed()
+	}
+
+	candidates := make([]btcutil.Address, 0)
+
+	pkh, err := btcutil.NewAddressPubKeyHash(btcutil.Hash160(serializedPubKey), chainCfg)
+	if err != nil {
+		return err
+	}
+	candidates = append(candidates, pkh)
+
+	// segwit addresses are only derived from the compressed key
+	if compressed {
+		wpkh, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(serializedPubKey), chainCfg)
+		if err != nil {
+			return err
+		}
+
+		redeemScript, err := txscript.PayToAddrScript(wpkh)
+		if err != nil {
+			return err
+		}
+
+		shwpkh, err := btcutil.NewAddressScriptHash(redeemScript, chainCfg)
+		if err != nil {
+			return err
+		}
+
+		candidates = append(candidates, wpkh, shwpkh)
+	}
+
+	for _, candidate := range candidates {
+		if candidate.EncodeAddress() == addr.EncodeAddress() {
+			return nil
+		}
+	}
+
+	return errorsmod.Wrap(ErrInvalidAddressSignature, "signature does not match the address")
+}
+
+// VerifyBIP322Signature verifies the BIP-322 simple signature of the given address over the message.
+// The signature is the serialized witness of the virtual to_sign transaction.
+func VerifyBIP322Signature(addr btcutil.Address, message string, sig []byte) error {
+	witness, err := DeserializeWitness(sig)
+	if err != nil || len(witness) == 0 {
+		return errorsmod.Wrap(ErrInvalidAddressSignature, "invalid BIP-322 signature")
+	}
+
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return err
+	}
+
+	// the simple signature only applies to the segwit addresses
+	if !txscript.IsWitnessProgram(pkScript) {
+		return errorsmod.Wrap(ErrInvalidAddressSignature, "BIP-322 simple signature requires a segwit address")
+	}
+
+	toSpend, err := BuildBIP322ToSpendTx(pkScript, message)
+	if err != nil {
+		return err
+	}
+
+	toSign := BuildBIP322ToSignTx(toSpend, witness)
+
+	prevOutFetcher := txscript.NewCannedPrevOutputFetcher(pkScript, 0)
+	sigHashes := txscript.NewTxSigHashes(toSign, prevOutFetcher)
+
+	vm, err := txscript.NewEngine(pkScript, toSign, 0, txscript.StandardVerifyFlags, nil, sigHashes, 0, prevOutFetcher)
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidAddressSignature, err.Error())
+	}
+
+	if err := vm.Execute(); err != nil {
+		return errorsmod.Wrap(ErrInvalidAddressSignature, err.Error())
+	}
+
+	return nil
+}
+
+// BuildBIP322ToSpendTx builds the virtual to_spend transaction of BIP-322
+func BuildBIP322ToSpendTx(pkScript []byte, message string) (*wire.MsgTx, error) {
+	messageHash := chainhash.TaggedHash([]byte(BIP322MessageTag), []byte(message))
+
+	scriptSig, err := txscript.NewScriptBuilder().AddOp(txscript.OP_0).AddData(messageHash[:]).Script()
+	if err != nil {
+		return nil, err
+	}
+
+	txIn := wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{}, wire.MaxPrevOutIndex), scriptSig, nil)
+	txIn.Sequence = 0
+
+	tx := wire.NewMsgTx(0)
+	tx.AddTxIn(txIn)
+	tx.AddTxOut(wire.NewTxOut(0, pkScript))
+
+	return tx, nil
+}
+
+// BuildBIP322ToSignTx builds the virtual to_sign transaction of BIP-322 with the given witness
+func BuildBIP322ToSignTx(toSpend *wire.MsgTx, witness wire.TxWitness) *wire.MsgTx {
+	toSpendHash := toSpend.TxHash()
+
+	txIn := wire.NewTxIn(wire.NewOutPoint(&toSpendHash, 0), nil, witness)
+	txIn.Sequence = 0
+
+	tx := wire.NewMsgTx(0)
+	tx.AddTxIn(txIn)
+	tx.AddTxOut(wire.NewTxOut(0, []byte{txscript.OP_RETURN}))
+
+	return tx
+}
+
+// SignedMessageHash returns the hash of the legacy signmessage
+func SignedMessageHash(message string) []byte {
+	var buf bytes.Buffer
+
+	_ = wire.WriteVarString(&buf, 0, SignedMessagePrefix)
+	_ = wire.WriteVarString(&buf, 0, message)
+
+	return chainhash.DoubleHashB(buf.Bytes())
+}
@@ -0,0 +1,112 @@
+package types_test
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+func TestVerifyBIP322Signature(t *testing.T) {
+	// test vectors from BIP-322
+	address := "bc1q9vza2e8x573nczrlzms0wvx3gsqjx7vavgkx0l"
+
+	testCases := []struct {
+		message   string
+		signature string
+		valid     bool
+	}{
+		{"", "AkcwRAIgM2gBAQqvZX15ZiysmKmQpDrG83avLIT492QBzLnQIxYCIBaTpOaD20qRlEylyxFSeEA2ba9YOixpX8z46TSDtS40ASECx/EgAxlkQpQ9hYjgGu6EBCPMVPwVIVJqO4XCsMvViHI=", true},
+		{"Hello World", "AkcwRAIgZRfIY3p7/DoVTty6YZbWS71bc5Vct9p9Fia83eRmw2QCICK/ENGfwLtptFluMGs2KsqoNSk89pO7F29zJLUx9a/sASECx/EgAxlkQpQ9hYjgGu6EBCPMVPwVIVJqO4XCsMvViHI=", true},
+		{"Hello World", "AkcwRAIgM2gBAQqvZX15ZiysmKmQpDrG83avLIT492QBzLnQIxYCIBaTpOaD20qRlEylyxFSeEA2ba9YOixpX8z46TSDtS40ASECx/EgAxlkQpQ9hYjgGu6EBCPMVPwVIVJqO4XCsMvViHI=", false},
+	}
+
+	for _, tc := range testCases {
+		err := types.VerifyAddressSignature(address, tc.message, tc.signature, &chaincfg.MainNetParams)
+		if tc.valid {
+			require.NoError(t, err, tc.message)
+		} else {
+			require.ErrorIs(t, err, types.ErrInvalidAddressSignature, tc.message)
+		}
+	}
+}
+
+func TestVerifyBIP322TaprootSignature(t *testing.T) {
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	addr, err := btcutil.NewAddressTaproot(txscript.ComputeTaprootKeyNoScript(key.PubKey()).SerializeCompressed()[1:], &chaincfg.RegressionNetParams)
+	require.NoError(t, err)
+	pkScript, err := txscript.PayToAddrScript(addr)
+	require.NoError(t, err)
+
+	message := "side1message"
+
+	toSpend, err := types.BuildBIP322ToSpendTx(pkScript, message)
+	require.NoError(t, err)
+	toSign := types.BuildBIP322ToSignTx(toSpend, nil)
+
+	prevOutFetcher := txscript.NewCannedPrevOutputFetcher(pkScript, 0)
+	witness, err := txscript.TaprootWitnessSignature(toSign, txscript.NewTxSigHashes(toSign, prevOutFetcher), 0, 0, pkScript, txscript.SigHashDefault, key)
+	require.NoError(t, err)
+
+	sig, err := types.SerializeWitness(witness)
+	require.NoError(t, err)
+
+	require.NoError(t, types.VerifyAddressSignature(addr.EncodeAddress(), message, base64.StdEncoding.EncodeToString(sig), &chaincfg.RegressionNetParams))
+
+	err = types.VerifyAddressSignature(addr.EncodeAddress(), "other message", base64.StdEncoding.EncodeToString(sig), &chaincfg.RegressionNetParams)
+	require.ErrorIs(t, err, types.ErrInvalidAddressSignature)
+}
+
+func TestVerifyLegacySignature(t *testing.T) {
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	pubKeyHash := btcutil.Hash160(key.PubKey().SerializeCompressed())
+
+	pkh, err := btcutil.NewAddressPubKeyHash(pubKeyHash, &chaincfg.RegressionNetParams)
+	require.NoError(t, err)
+	wpkh, err := btcutil.NewAddressWitnessPubKeyHash(pubKeyHash, &chaincfg.RegressionNetParams)
+	require.NoError(t, err)
+
+	message := "side1message"
+
+	sig, err := ecdsa.SignCompact(key, types.SignedMessageHash(message), true)
+	require.NoError(t, err)
+
+	// BIP-137 header for the p2wpkh address
+	segwitSig := append([]byte{sig[0] + 8}, sig[1:]...)
+
+	for _, tc := range []struct {
+		address string
+		sig     []byte
+	}{
+		{pkh.EncodeAddress(), sig},
+		{wpkh.EncodeAddress(), sig},
+		{wpkh.EncodeAddress(), segwitSig},
+	} {
+		err := types.VerifyAddressSignature(tc.address, message, base64.StdEncoding.EncodeToString(tc.sig), &chaincfg.RegressionNetParams)
+		require.NoError(t, err)
+	}
+
+	// signed by another key
+	otherKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	otherSig, err := ecdsa.SignCompact(otherKey, types.SignedMessageHash(message), true)
+	require.NoError(t, err)
+
+	err = types.VerifyAddressSignature(pkh.EncodeAddress(), message, base64.StdEncoding.EncodeToString(otherSig), &chaincfg.RegressionNetParams)
+	require.ErrorIs(t, err, types.ErrInvalidAddressSignature)
+
+	// signed over another message
+	err = types.VerifyAddressSignature(wpkh.EncodeAddress(), "other message", base64.StdEncoding.EncodeToString(sig), &chaincfg.RegressionNetParams)
+	require.ErrorIs(t, err, types.ErrInvalidAddressSignature)
+}
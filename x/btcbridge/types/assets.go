@@ -0,0 +1,374 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+)
+
+const (
+	// the protocol id of the inscription envelope
+	InscriptionProtocolID = "ord"
+
+	// the opcode following OP_RETURN by which the runestone is identified
+	RunestoneMagic = txscript.OP_13
+
+	// maximum length of a LEB128 encoded integer of the runestone
+	maxRuneVarintLength = 19
+)
+
+// runestone tags, the unrecognized even tags make the runestone a cenotaph
+const (
+	runeTagBody         = 0
+	runeTagDivisibility = 1
+	runeTagFlags        = 2
+	runeTagSpacers      = 3
+	runeTagRune         = 4
+	runeTagSymbol       = 5
+	runeTagPremine      = 6
+	runeTagCap          = 8
+	runeTagAmount       = 10
+	runeTagHeightStart  = 12
+	runeTagHeightEnd    = 14
+	runeTagOffsetStart  = 16
+	runeTagOffsetEnd    = 18
+	runeTagMint         = 20
+	runeTagPointer      = 22
+	runeTagNop          = 127
+)
+
+// OutputAssets is the inscriptions and runes detected on an output
+type OutputAssets struct {
+	Inscriptions uint32
+	Runes        []*RuneBalance
+}
+
+// IsEmpty returns true if no inscription or rune is detected
+func (a OutputAssets) IsEmpty() bool {
+	return a.Inscriptions == 0 && len(a.Runes) == 0
+}
+
+// DetectOutputAssets detects the inscriptions and runes carried by the outputs of the given tx, indexed by vout.
+// The inscriptions revealed by the first input are inscribed on the first sat of the tx, i.e. that of the first output,
+// while the sat offsets of the other inputs are unknown, so that their inscriptions are attributed to every output.
+// The runes are detected by the runestone of the tx only, as the rune balances of the inputs are unknown without an indexer.
+//
+// Thus only the inscriptions revealed by the tx and the runes allocated by its runestone are detected,
+// while the existing inscriptions and runes merely moved by a plain transfer are missed,
+// which are to be tagged by the governance through MsgTagUTXO.
+func DetectOutputAssets(tx *wire.MsgTx) []OutputAssets {
+	assets := make([]OutputAssets, len(tx.TxOut))
+
+	for i, txIn := range tx.TxIn {
+		count := CountInscriptions(txIn.Witness)
+		if count == 0 {
+			continue
+		}
+
+		for vout, out := range tx.TxOut {
+			if IsOpReturnScript(out.PkScript) || (i == 0 && vout != 0) {
+				continue
+			}
+
+			assets[vout].Inscriptions += count
+		}
+	}
+
+	runestone := decodeRunestone(tx)
+	if runestone == nil || runestone.cenotaph {
+		// the runes of the cenotaph are burned
+		return assets
+	}
+
+	for _, edict := range runestone.edicts {
+		amount := ""
+		if edict.amount.Sign() > 0 {
+			amount = edict.amount.String()
+		}
+
+		// the edict to the number of the outputs splits the runes among the non OP_RETURN outputs
+		for vout, out := range tx.TxOut {
+			if (edict.output != len(tx.TxOut) && edict.output != vout) || IsOpReturnScript(out.PkScript) {
+				continue
+			}
+
+			assets[vout].Runes = append(assets[vout].Runes, &RuneBalance{RuneId: edict.runeID, Amount: amount})
+		}
+	}
+
+	// the unallocated runes of the inputs, the etching and the mint go to the pointer or the first non OP_RETURN output
+	vout := runestone.pointer
+	if vout < 0 {
+		for i, out := range tx.TxOut {
+			if !IsOpReturnScript(out.PkScript) {
+				vout = i
+				break
+			}
+		}
+	}
+
+	if vout >= 0 && !IsOpReturnScript(tx.TxOut[vout].PkScript) {
+		// the balance without the rune id and the amount marks the output as receiving the unallocated runes if any,
+		// whose ids and amounts are unknown without the rune balances of the inputs
+		assets[vout].Runes = append(assets[vout].Runes, &RuneBalance{})
+
+		if len(runestone.mint) != 0 {
+			assets[vout].Runes = append(assets[vout].Runes, &RuneBalance{RuneId: runestone.mint})
+		}
+	}
+
+	return assets
+}
+
+// CountInscriptions returns the number of the inscription envelopes, i.e. OP_FALSE OP_IF "ord",
+// in the tapscript of the given witness
+func CountInscriptions(witness wire.TxWitness) uint32 {
+	script := tapscript(witness)
+	if len(script) == 0 {
+		return 0
+	}
+
+	count := uint32(0)
+
+	// the number of the envelope opcodes matched so far
+	matched := 0
+
+	tokenizer := txscript.MakeScriptTokenizer(0, script)
+	for tokenizer.Next() {
+		opcode := tokenizer.Opcode()
+
+		switch {
+		case matched == 0 && opcode == txscript.OP_FALSE:
+			matched = 1
+		case matched == 1 && opcode == txscript.OP_IF:
+			matched = 2
+		case matched == 2 && opcode <= txscript.OP_PUSHDATA4 && bytes.Equal(tokenizer.Data(), []byte(InscriptionProtocolID)):
+			count++
+			matched = 0
+		case opcode == txscript.OP_FALSE:
+			matched = 1
+		default:
+			matched = 0
+		}
+	}
+
+	return count
+}
+
+// tapscript returns the script of the taproot script path spend of the given witness, nil if not a script path spend
+func tapscript(witness wire.TxWitness) []byte {
+	// strip the annex
+	if len(witness) >= 2 && len(witness[len(witness)-1]) > 0 && witness[len(witness)-1][0] == txscript.TaprootAnnexTag {
+		witness = witness[:len(witness)-1]
+	}
+
+	if len(witness) < 2 {
+		return nil
+	}
+
+	controlBlock := witness[len(witness)-1]
+	if len(controlBlock) < txscript.ControlBlockBaseSize || (len(controlBlock)-txscript.ControlBlockBaseSize)%txscript.ControlBlockNodeSize != 0 {
+		return nil
+	}
+
+	return witness[len(witness)-2]
+}
+
+// runestone is the decoded runestone of a tx
+type runestone struct {
+	edicts []runeEdict
+	// the output receiving the unallocated runes, -1 if not specified
+	pointer int
+	// the id of the rune minted by the tx, if any
+	mint string
+	// the runestone is malformed, by which all runes of the inputs are burned
+	cenotaph bool
+}
+
+// runeEdict allocates the amount of the rune to the output
+type runeEdict struct {
+	runeID string
+	amount *big.Int
+	output int
+}
+
+// decodeRunestone decodes the runestone of the given tx, nil if none
+func decodeRunestone(tx *wire.MsgTx) *runestone {
+	var script []byte
+	for _, out := range tx.TxOut {
+		if len(out.PkScript) >= 2 && out.PkScript[0] == txscript.OP_RETURN && out.PkScript[1] == RunestoneMagic {
+			script = out.PkScript[2:]
+			break
+		}
+	}
+
+	if script == nil {
+		return nil
+	}
+
+	cenotaph := &runestone{pointer: -1, cenotaph: true}
+
+	// the payload is the concatenation of the data pushes
+	var payload []byte
+
+	tokenizer := txscript.MakeScriptTokenizer(0, script)
+	for tokenizer.Next() {
+		if tokenizer.Opcode() > txscript.OP_PUSHDATA4 {
+			return cenotaph
+		}
+
+		payload = append(payload, tokenizer.Data()...)
+	}
+
+	if tokenizer.Err() != nil {
+		return cenotaph
+	}
+
+	integers, err := decodeRuneVarints(payload)
+	if err != nil {
+		return cenotaph
+	}
+
+	rs := &runestone{pointer: -1}
+
+	// the fields before the body tag, each tag followed by a value
+	fields := make(map[uint64][]*big.Int)
+	i := 0
+	for ; i < len(integers); i += 2 {
+		if !integers[i].IsUint64() {
+			return cenotaph
+		}
+
+		tag := integers[i].Uint64()
+		if tag == runeTagBody {
+			i++
+			break
+		}
+
+		if i+1 >= len(integers) {
+			return cenotaph
+		}
+
+		fields[tag] = append(fields[tag], integers[i+1])
+	}
+
+	for tag := range fields {
+		if tag%2 == 0 && !isKnownRuneTag(tag) {
+			return cenotaph
+		}
+	}
+
+	if pointers := fields[runeTagPointer]; len(pointers) != 0 {
+		if !pointers[0].IsUint64() || pointers[0].Uint64() >= uint64(len(tx.TxOut)) {
+			return cenotaph
+		}
+
+		rs.pointer = int(pointers[0].Uint64())
+	}
+
+	if mint := fields[runeTagMint]; len(mint) >= 2 {
+		rs.mint = fmt.Sprintf("%s:%s", mint[0], mint[1])
+	}
+
+	// the edicts after the body tag, each of the rune id delta, the amount and the output
+	var edicts []*big.Int
+	if i < len(integers) {
+		edicts = integers[i:]
+	}
+
+	if len(edicts)%4 != 0 {
+		return cenotaph
+	}
+
+	block, txIndex := new(big.Int), new(big.Int)
+	for j := 0; j < len(edicts); j += 4 {
+		blockDelta, txDelta, amount, output := edicts[j], edicts[j+1], edicts[j+2], edicts[j+3]
+
+		block = new(big.Int).Add(block, blockDelta)
+		if blockDelta.Sign() == 0 {
+			txIndex = new(big.Int).Add(txIndex, txDelta)
+		} else {
+			txIndex = txDelta
+		}
+
+		if !output.IsUint64() || output.Uint64() > uint64(len(tx.TxOut)) {
+			return cenotaph
+		}
+
+		// the rune id 0:0 refers to the rune etched by the tx
+		runeID := ""
+		if block.Sign() != 0 || txIndex.Sign() != 0 {
+			runeID = fmt.Sprintf("%s:%s", block, txIndex)
+		}
+
+		rs.edicts = append(rs.edicts, runeEdict{runeID: runeID, amount: amount, output: int(output.Uint64())})
+	}
+
+	return rs
+}
+
+// decodeRuneVarints decodes the LEB128 encoded integers of the runestone payload
+func decodeRuneVarints(payload []byte) ([]*big.Int, error) {
+	integers := make([]*big.Int, 0)
+
+	for len(payload) > 0 {
+		value := new(big.Int)
+
+		n := 0
+		for ; ; n++ {
+			if n >= len(payload) {
+				return nil, fmt.Errorf("truncated varint")
+			}
+
+			if n >= maxRuneVarintLength {
+				return nil, fmt.Errorf("varint overflow")
+			}
+
+			value.Or(value, new(big.Int).Lsh(big.NewInt(int64(payload[n]&0x7f)), uint(7*n)))
+
+			if payload[n]&0x80 == 0 {
+				break
+			}
+		}
+
+		if value.BitLen() > 128 {
+			return nil, fmt.Errorf("varint overflow")
+		}
+
+		integers = append(integers, value)
+		payload = payload[n+1:]
+	}
+
+	return integers, nil
+}
+
+// isKnownRuneTag returns true if the given tag is recognized by the runes protocol
+func isKnownRuneTag(tag uint64) bool {
+	switch tag {
+	case runeTagBody, runeTagDivisibility, runeTagFlags, runeTagSpacers, runeTagRune, runeTagSymbol, runeTagPremine,
+		runeTagCap, runeTagAmount, runeTagHeightStart, runeTagHeightEnd, runeTagOffsetStart, runeTagOffsetEnd,
+		runeTagMint, runeTagPointer, runeTagNop:
+		return true
+	}
+
+	return false
+}
+
+// IsTagged returns true if the utxo carries inscriptions or runes, which is excluded from the coin selection of the btc withdrawals
+func (u *UTXO) IsTagged() bool {
+	return u.Inscriptions > 0 || len(u.Runes) > 0
+}
+
+// MergeAssets returns the inscriptions and runes carried by all of the given utxos
+func MergeAssets(utxos []*UTXO) OutputAssets {
+	var assets OutputAssets
+	for _, utxo := range utxos {
+		assets.Inscriptions += utxo.Inscriptions
+		assets.Runes = append(assets.Runes, utxo.Runes...)
+	}
+
+	return assets
+}
@@ -0,0 +1,134 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+// inscriptionWitness builds the witness of a taproot script path spend revealing the given number of inscriptions
+func inscriptionWitness(t *testing.T, count int) wire.TxWitness {
+	builder := txscript.NewScriptBuilder().AddData(bytes.Repeat([]byte{1}, 32)).AddOp(txscript.OP_CHECKSIG)
+	for i := 0; i < count; i++ {
+		builder.AddOp(txscript.OP_FALSE).AddOp(txscript.OP_IF).
+			AddData([]byte(types.InscriptionProtocolID)).AddOp(txscript.OP_1).AddData([]byte("text/plain")).
+			AddOp(txscript.OP_0).AddData([]byte("hello")).
+			AddOp(txscript.OP_ENDIF)
+	}
+
+	script, err := builder.Script()
+	require.NoError(t, err)
+
+	controlBlock := append([]byte{byte(txscript.BaseLeafVersion)}, bytes.Repeat([]byte{2}, 32)...)
+
+	return wire.TxWitness{bytes.Repeat([]byte{3}, 64), script, controlBlock}
+}
+
+// runestoneOut builds the OP_RETURN output of the runestone with the given integers
+func runestoneOut(t *testing.T, integers ...uint64) *wire.TxOut {
+	var payload []byte
+	for _, n := range integers {
+		for n >= 0x80 {
+			payload = append(payload, byte(n)|0x80)
+			n >>= 7
+		}
+		payload = append(payload, byte(n))
+	}
+
+	script, err := txscript.NewScriptBuilder().AddOp(txscript.OP_RETURN).AddOp(types.RunestoneMagic).AddData(payload).Script()
+	require.NoError(t, err)
+
+	return wire.NewTxOut(0, script)
+}
+
+func newAssetsTx(witnesses []wire.TxWitness, outs ...*wire.TxOut) *wire.MsgTx {
+	tx := wire.NewMsgTx(wire.TxVersion)
+	for _, witness := range witnesses {
+		tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{}, 0), nil, witness))
+	}
+
+	for _, out := range outs {
+		tx.AddTxOut(out)
+	}
+
+	return tx
+}
+
+func TestCountInscriptions(t *testing.T) {
+	require.Equal(t, uint32(0), types.CountInscriptions(nil))
+	require.Equal(t, uint32(1), types.CountInscriptions(inscriptionWitness(t, 1)))
+	require.Equal(t, uint32(2), types.CountInscriptions(inscriptionWitness(t, 2)))
+
+	// the key path spend
+	require.Equal(t, uint32(0), types.CountInscriptions(wire.TxWitness{bytes.Repeat([]byte{3}, 64)}))
+
+	// the annex is stripped
+	witness := append(inscriptionWitness(t, 1), []byte{txscript.TaprootAnnexTag, 1})
+	require.Equal(t, uint32(1), types.CountInscriptions(witness))
+
+	// the invalid control block
+	witness = inscriptionWitness(t, 1)
+	witness[2] = witness[2][:20]
+	require.Equal(t, uint32(0), types.CountInscriptions(witness))
+}
+
+func TestDetectInscriptions(t *testing.T) {
+	out := wire.NewTxOut(10000, []byte{txscript.OP_TRUE})
+
+	// the inscriptions of the first input go to the first output
+	assets := types.DetectOutputAssets(newAssetsTx([]wire.TxWitness{inscriptionWitness(t, 1)}, out, out))
+	require.Equal(t, uint32(1), assets[0].Inscriptions)
+	require.True(t, assets[1].IsEmpty())
+
+	// the inscriptions of the other inputs go to every output
+	assets = types.DetectOutputAssets(newAssetsTx([]wire.TxWitness{nil, inscriptionWitness(t, 2)}, out, out))
+	require.Equal(t, uint32(2), assets[0].Inscriptions)
+	require.Equal(t, uint32(2), assets[1].Inscriptions)
+}
+
+func TestDetectRunes(t *testing.T) {
+	out := wire.NewTxOut(10000, []byte{txscript.OP_TRUE})
+
+	// the edict of rune 840000:3 to the second output
+	assets := types.DetectOutputAssets(newAssetsTx([]wire.TxWitness{nil}, runestoneOut(t, 0, 840000, 3, 1000, 2), out, out))
+	require.True(t, assets[0].IsEmpty())
+	require.Equal(t, []*types.RuneBalance{{}}, assets[1].Runes)
+	require.Equal(t, []*types.RuneBalance{{RuneId: "840000:3", Amount: "1000"}}, assets[2].Runes)
+
+	// the edict to the number of the outputs splits the runes
+	assets = types.DetectOutputAssets(newAssetsTx([]wire.TxWitness{nil}, runestoneOut(t, 0, 840000, 3, 0, 3), out, out))
+	require.True(t, assets[0].IsEmpty())
+	require.Len(t, assets[1].Runes, 2)
+	require.Equal(t, []*types.RuneBalance{{RuneId: "840000:3"}}, assets[2].Runes)
+
+	// the pointer and the mint
+	assets = types.DetectOutputAssets(newAssetsTx([]wire.TxWitness{nil}, runestoneOut(t, 20, 840000, 20, 3, 22, 2), out, out))
+	require.True(t, assets[1].IsEmpty())
+	require.Equal(t, []*types.RuneBalance{{}, {RuneId: "840000:3"}}, assets[2].Runes)
+
+	// the cenotaph burns the runes
+	for _, cenotaph := range []*wire.TxOut{
+		runestoneOut(t, 24, 1),
+		runestoneOut(t, 22, 5),
+		runestoneOut(t, 0, 840000, 3, 1000),
+	} {
+		assets = types.DetectOutputAssets(newAssetsTx([]wire.TxWitness{nil}, cenotaph, out, out))
+		require.True(t, assets[1].IsEmpty())
+		require.True(t, assets[2].IsEmpty())
+	}
+
+	// the truncated varint
+	truncated := wire.NewTxOut(0, []byte{txscript.OP_RETURN, types.RunestoneMagic, 1, 0x80})
+	assets = types.DetectOutputAssets(newAssetsTx([]wire.TxWitness{nil}, truncated, out))
+	require.True(t, assets[1].IsEmpty())
+
+	// no runestone
+	assets = types.DetectOutputAssets(newAssetsTx([]wire.TxWitness{nil}, out))
+	require.True(t, assets[0].IsEmpty())
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil"
+)
+
+// AttestationHash returns the hash of the given attested content
+func AttestationHash(attestationType AttestationType, content []byte) string {
+	hash := sha256.Sum256(append([]byte{byte(attestationType)}, content...))
+
+	return hex.EncodeToString(hash[:])
+}
+
+// AttestationContent returns the content to be attested by relayers and the subject which the content is about.
+// The sender is excluded so that the content submitted by different relayers matches.
+func (msg *MsgSubmitBlockHeaderRequest) AttestationContent() (subject string, content []byte, err error) {
+	headers := MsgSubmitBlockHeaderRequest{BlockHeaders: msg.BlockHeaders}
+
+	content, err = headers.Marshal()
+	if err != nil {
+		return "", nil, err
+	}
+
+	return fmt.Sprintf("headers/%s", msg.BlockHeaders[0].PreviousBlockHash), content, nil
+}
+
+// AttestationContent returns the content to be attested by relayers and the subject which the content is about.
+// The sender is excluded so that the content submitted by different relayers matches.
+func (msg *MsgSubmitDepositTransactionRequest) AttestationContent() (subject string, content []byte, err error) {
+	deposit := *msg
+	deposit.Sender = ""
+
+	content, err = deposit.Marshal()
+	if err != nil {
+		return "", nil, err
+	}
+
+	txBytes, err := base64.StdEncoding.DecodeString(msg.TxBytes)
+	if err != nil {
+		return "", nil, err
+	}
+
+	tx, err := btcutil.NewTxFromBytes(txBytes)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return fmt.Sprintf("deposit/%s", tx.Hash()), content, nil
+}
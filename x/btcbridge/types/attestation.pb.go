@@ -0,0 +1,539 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: side/btcbridge/attestation.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AttestationType defines the type of the attested data
+type AttestationType int32
+
+const (
+	// ATTESTATION_TYPE_UNSPECIFIED - Default value, should not be used
+	AttestationType_ATTESTATION_TYPE_UNSPECIFIED AttestationType = 0
+	// ATTESTATION_TYPE_HEADERS - Bitcoin block headers
+	AttestationType_ATTESTATION_TYPE_HEADERS AttestationType = 1
+	// ATTESTATION_TYPE_DEPOSIT - Bitcoin deposit transaction with proof
+	AttestationType_ATTESTATION_TYPE_DEPOSIT AttestationType = 2
+)
+
+var AttestationType_name = map[int32]string{
+	0: "ATTESTATION_TYPE_UNSPECIFIED",
+	1: "ATTESTATION_TYPE_HEADERS",
+	2: "ATTESTATION_TYPE_DEPOSIT",
+}
+
+var AttestationType_value = map[string]int32{
+	"ATTESTATION_TYPE_UNSPECIFIED": 0,
+	"ATTESTATION_TYPE_HEADERS":     1,
+	"ATTESTATION_TYPE_DEPOSIT":     2,
+}
+
+func (x AttestationType) String() string {
+	return proto.EnumName(AttestationType_name, int32(x))
+}
+
+func (AttestationType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_79d689c1d2b75663, []int{0}
+}
+
+// Attestation defines the pending attestation of the data submitted by relayers
+type Attestation struct {
+	// the hash of the attested content
+	Hash string          `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Type AttestationType `protobuf:"varint,2,opt,name=type,proto3,enum=side.btcbridge.AttestationType" json:"type,omitempty"`
+	// the subject which the attested content is about, e.g. the previous block hash of the headers
+	// attestations of the same subject with different hashes indicate that relayers disagree
+	Subject string `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	// relayers who have submitted the matching content
+	Relayers []string `protobuf:"bytes,4,rep,name=relayers,proto3" json:"relayers,omitempty"`
+	// the side block height at which the attestation expires
+	ExpirationHeight int64 `protobuf:"varint,5,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
+}
+
+func (m *Attestation) Reset()         { *m = Attestation{} }
+func (m *Attestation) String() string { return proto.CompactTextString(m) }
+func (*Attestation) ProtoMessage()    {}
+func (*Attestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79d689c1d2b75663, []int{0}
+}
+func (m *Attestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Attestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Attestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Attestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Attestation.Merge(m, src)
+}
+func (m *Attestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Attestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Attestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Attestation proto.InternalMessageInfo
+
+func (m *Attestation) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *Attestation) GetType() AttestationType {
+	if m != nil {
+		return m.Type
+	}
+	return AttestationType_ATTESTATION_TYPE_UNSPECIFIED
+}
+
+func (m *Attestation) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *Attestation) GetRelayers() []string {
+	if m != nil {
+		return m.Relayers
+	}
+	return nil
+}
+
+func (m *Attestation) GetExpirationHeight() int64 {
+	if m != nil {
+		return m.ExpirationHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("side.btcbridge.AttestationType", AttestationType_name, AttestationType_value)
+	proto.RegisterType((*Attestation)(nil), "side.btcbridge.Attestation")
+}
+
+func init() { proto.RegisterFile("side/btcbridge/attestation.proto", fileDescriptor_79d689c1d2b75663) }
+
+var fileDescriptor_79d689c1d2b75663 = []byte{
+	// 313 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xcf, 0x4e, 0x32, 0x31,
+	0x14, 0xc5, 0xa7, 0xc0, 0xf7, 0x29, 0x35, 0xc1, 0xb1, 0xab, 0xc6, 0x90, 0xb1, 0x71, 0x35, 0xd1,
+	0xa4, 0x93, 0xc8, 0x13, 0x8c, 0x52, 0xc3, 0x6c, 0x80, 0xcc, 0xd4, 0x85, 0x6e, 0xc8, 0xcc, 0xd0,
+	0x30, 0x35, 0x68, 0x27, 0xd3, 0x92, 0xc0, 0x5b, 0xf8, 0x3a, 0xbe, 0x81, 0x4b, 0x96, 0x2e, 0x0d,
+	0xbc, 0x88, 0xa1, 0x89, 0x80, 0x7f, 0x76, 0x3d, 0x3d, 0xbf, 0x7b, 0x6e, 0x72, 0x2e, 0x24, 0x5a,
+	0x8e, 0x45, 0x90, 0x99, 0x3c, 0xab, 0xe4, 0x78, 0x22, 0x82, 0xd4, 0x18, 0xa1, 0x4d, 0x6a, 0xa4,
+	0x7a, 0xa6, 0x65, 0xa5, 0x8c, 0x42, 0xad, 0x0d, 0x41, 0xb7, 0xc4, 0xf9, 0x2b, 0x80, 0x47, 0xe1,
+	0x8e, 0x42, 0x08, 0x36, 0x8a, 0x54, 0x17, 0x18, 0x10, 0xe0, 0x37, 0x63, 0xfb, 0x46, 0x1d, 0xd8,
+	0x30, 0x8b, 0x52, 0xe0, 0x1a, 0x01, 0x7e, 0xeb, 0xea, 0x8c, 0x7e, 0x8f, 0xa0, 0x7b, 0xe3, 0x7c,
+	0x51, 0x8a, 0xd8, 0xc2, 0x08, 0xc3, 0x03, 0x3d, 0xcb, 0x1e, 0x45, 0x6e, 0x70, 0xdd, 0x66, 0x7d,
+	0x49, 0x74, 0x0a, 0x0f, 0x2b, 0x31, 0x4d, 0x17, 0xa2, 0xd2, 0xb8, 0x41, 0xea, 0x7e, 0x33, 0xde,
+	0x6a, 0x74, 0x09, 0x4f, 0xc4, 0xbc, 0x94, 0x95, 0x4d, 0x1b, 0x15, 0x42, 0x4e, 0x0a, 0x83, 0xff,
+	0x11, 0xe0, 0xd7, 0x63, 0x77, 0x67, 0xf4, 0xec, 0xff, 0x85, 0x82, 0xc7, 0x3f, 0x76, 0x23, 0x02,
+	0xdb, 0x21, 0xe7, 0x2c, 0xe1, 0x21, 0x8f, 0x06, 0xfd, 0x11, 0xbf, 0x1f, 0xb2, 0xd1, 0x5d, 0x3f,
+	0x19, 0xb2, 0x9b, 0xe8, 0x36, 0x62, 0x5d, 0xd7, 0x41, 0x6d, 0x88, 0x7f, 0x11, 0x3d, 0x16, 0x76,
+	0x59, 0x9c, 0xb8, 0xe0, 0x4f, 0xb7, 0xcb, 0x86, 0x83, 0x24, 0xe2, 0x6e, 0xed, 0xba, 0xf7, 0xb6,
+	0xf2, 0xc0, 0x72, 0xe5, 0x81, 0x8f, 0x95, 0x07, 0x5e, 0xd6, 0x9e, 0xb3, 0x5c, 0x7b, 0xce, 0xfb,
+	0xda, 0x73, 0x1e, 0xe8, 0x44, 0x9a, 0x62, 0x96, 0xd1, 0x5c, 0x3d, 0x05, 0x9b, 0x7a, 0x6c, 0xd9,
+	0xb9, 0x9a, 0x5a, 0x11, 0xcc, 0xf7, 0x4e, 0xb2, 0x29, 0x47, 0x67, 0xff, 0x2d, 0xd0, 0xf9, 0x1c,
+	0x00, 0x2f, 0x0b, 0xd9, 0xad, 0xb1, 0x01, 0x00, 0x00,
+}
+
+func (m *Attestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Attestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Attestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpirationHeight != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.ExpirationHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Relayers) > 0 {
+		for iNdEx := len(m.Relayers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Relayers[iNdEx])
+			copy(dAtA[i:], m.Relayers[iNdEx])
+			i = encodeVarintAttestation(dAtA, i, uint64(len(m.Relayers[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Subject) > 0 {
+		i -= len(m.Subject)
+		copy(dAtA[i:], m.Subject)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Subject)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Type != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAttestation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAttestation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Attestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovAttestation(uint64(m.Type))
+	}
+	l = len(m.Subject)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	if len(m.Relayers) > 0 {
+		for _, s := range m.Relayers {
+			l = len(s)
+			n += 1 + l + sovAttestation(uint64(l))
+		}
+	}
+	if m.ExpirationHeight != 0 {
+		n += 1 + sovAttestation(uint64(m.ExpirationHeight))
+	}
+	return n
+}
+
+func sovAttestation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAttestation(x uint64) (n int) {
+	return sovAttestation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Attestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttestation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Attestation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Attestation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= AttestationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayers = append(m.Relayers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
+			}
+			m.ExpirationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttestation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAttestation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAttestation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAttestation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAttestation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAttestation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAttestation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAttestation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAttestation = fmt.Errorf("proto: unexpected end of group")
+)
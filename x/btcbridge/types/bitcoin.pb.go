@@ -67,6 +67,37 @@ func (SigningStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_b004a69efe3c7d84, []int{0}
 }
 
+// PendingDepositState defines the state of the pending deposit
+type PendingDepositState int32
+
+const (
+	PendingDepositState_PENDING_DEPOSIT_STATE_UNSPECIFIED PendingDepositState = 0
+	// waiting for the confirmations required by the deposited amount
+	PendingDepositState_PENDING_DEPOSIT_STATE_CONFIRMING PendingDepositState = 1
+	// the coinbase deposit waiting for the coinbase maturity
+	PendingDepositState_PENDING_DEPOSIT_STATE_MATURING PendingDepositState = 2
+)
+
+var PendingDepositState_name = map[int32]string{
+	0: "PENDING_DEPOSIT_STATE_UNSPECIFIED",
+	1: "PENDING_DEPOSIT_STATE_CONFIRMING",
+	2: "PENDING_DEPOSIT_STATE_MATURING",
+}
+
+var PendingDepositState_value = map[string]int32{
+	"PENDING_DEPOSIT_STATE_UNSPECIFIED": 0,
+	"PENDING_DEPOSIT_STATE_CONFIRMING":  1,
+	"PENDING_DEPOSIT_STATE_MATURING":    2,
+}
+
+func (x PendingDepositState) String() string {
+	return proto.EnumName(PendingDepositState_name, int32(x))
+}
+
+func (PendingDepositState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b004a69efe3c7d84, []int{1}
+}
+
 // Bitcoin Block Header
 type BlockHeader struct {
 	Version           uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
@@ -185,6 +216,14 @@ type BitcoinSigningRequest struct {
 	Sequence uint64        `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
 	// The vault address that the request is associated with
 	VaultAddress string `protobuf:"bytes,6,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
+	// Whether the request sweeps the vault by the recovery script path
+	Recovery bool `protobuf:"varint,7,opt,name=recovery,proto3" json:"recovery,omitempty"`
+	// The recipients of the multi-recipient withdrawal, of which the address is the first
+	Recipients []string `protobuf:"bytes,8,rep,name=recipients,proto3" json:"recipients,omitempty"`
+	// The amount leaving the vault including the network fee, which is escrowed by the withdrawal unless recovery
+	Amount uint64 `protobuf:"varint,9,opt,name=amount,proto3" json:"amount,omitempty"`
+	// The side account which escrowed the withdrawal, to which the escrow is refunded if the request is rejected
+	Sender string `protobuf:"bytes,10,opt,name=sender,proto3" json:"sender,omitempty"`
 }
 
 func (m *BitcoinSigningRequest) Reset()         { *m = BitcoinSigningRequest{} }
@@ -262,6 +301,34 @@ func (m *BitcoinSigningRequest) GetVaultAddress() string {
 	return ""
 }
 
+func (m *BitcoinSigningRequest) GetRecovery() bool {
+	if m != nil {
+		return m.Recovery
+	}
+	return false
+}
+
+func (m *BitcoinSigningRequest) GetRecipients() []string {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
+
+func (m *BitcoinSigningRequest) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *BitcoinSigningRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
 // Bitcoin UTXO
 type UTXO struct {
 	Txid    string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
@@ -273,6 +340,10 @@ type UTXO struct {
 	PubKeyScript []byte `protobuf:"bytes,6,opt,name=pub_key_script,json=pubKeyScript,proto3" json:"pub_key_script,omitempty"`
 	IsCoinbase   bool   `protobuf:"varint,7,opt,name=is_coinbase,json=isCoinbase,proto3" json:"is_coinbase,omitempty"`
 	IsLocked     bool   `protobuf:"varint,8,opt,name=is_locked,json=isLocked,proto3" json:"is_locked,omitempty"`
+	// the number of inscriptions detected on the output
+	Inscriptions uint32 `protobuf:"varint,9,opt,name=inscriptions,proto3" json:"inscriptions,omitempty"`
+	// the runes detected on the output
+	Runes []*RuneBalance `protobuf:"bytes,10,rep,name=runes,proto3" json:"runes,omitempty"`
 }
 
 func (m *UTXO) Reset()         { *m = UTXO{} }
@@ -364,399 +435,2670 @@ func (m *UTXO) GetIsLocked() bool {
 	return false
 }
 
-func init() {
-	proto.RegisterEnum("side.btcbridge.SigningStatus", SigningStatus_name, SigningStatus_value)
-	proto.RegisterType((*BlockHeader)(nil), "side.btcbridge.BlockHeader")
-	proto.RegisterType((*BitcoinSigningRequest)(nil), "side.btcbridge.BitcoinSigningRequest")
-	proto.RegisterType((*UTXO)(nil), "side.btcbridge.UTXO")
+func (m *UTXO) GetInscriptions() uint32 {
+	if m != nil {
+		return m.Inscriptions
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("side/btcbridge/bitcoin.proto", fileDescriptor_b004a69efe3c7d84) }
+func (m *UTXO) GetRunes() []*RuneBalance {
+	if m != nil {
+		return m.Runes
+	}
+	return nil
+}
 
-var fileDescriptor_b004a69efe3c7d84 = []byte{
-	// 613 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x8e, 0x9b, 0x9f, 0x26, 0xd3, 0x1f, 0x85, 0xa5, 0x2d, 0x26, 0x2d, 0xa6, 0x2a, 0x1c, 0x2a,
-	0x0e, 0x8e, 0x04, 0xe2, 0x01, 0xf2, 0xe3, 0xb6, 0xe1, 0x27, 0x45, 0xeb, 0x54, 0x42, 0x5c, 0x2c,
-	0xff, 0xac, 0x9c, 0x55, 0x13, 0xaf, 0xf1, 0xae, 0xa3, 0xf6, 0x2d, 0x78, 0x25, 0x6e, 0x1c, 0xcb,
-	0x8d, 0x03, 0x07, 0xd4, 0xbe, 0x02, 0x0f, 0x80, 0x76, 0x9c, 0x54, 0x4d, 0xc4, 0xed, 0xfb, 0xbe,
-	0x99, 0x9d, 0xf9, 0x66, 0xc6, 0x86, 0x03, 0xc9, 0x23, 0xd6, 0x0e, 0x54, 0x18, 0x64, 0x3c, 0x8a,
-	0x59, 0x3b, 0xe0, 0x2a, 0x14, 0x3c, 0xb1, 0xd3, 0x4c, 0x28, 0x41, 0xb6, 0x75, 0xd4, 0xbe, 0x8f,
-	0xb6, 0x76, 0x62, 0x11, 0x0b, 0x0c, 0xb5, 0x35, 0x2a, 0xb2, 0x8e, 0xfe, 0x1a, 0xb0, 0xd1, 0x9d,
-	0x88, 0xf0, 0xf2, 0x8c, 0xf9, 0x11, 0xcb, 0x88, 0x09, 0xeb, 0x33, 0x96, 0x49, 0x2e, 0x12, 0xd3,
-	0x38, 0x34, 0x8e, 0x2b, 0x74, 0x41, 0x09, 0x81, 0xca, 0xd8, 0x97, 0x63, 0x73, 0xed, 0xd0, 0x38,
-	0x6e, 0x50, 0xc4, 0x64, 0x0f, 0x6a, 0x63, 0xc6, 0xe3, 0xb1, 0x32, 0xcb, 0x98, 0x3c, 0x67, 0xc4,
-	0x86, 0xc7, 0x69, 0xc6, 0x66, 0x5c, 0xe4, 0xd2, 0x0b, 0x74, 0x75, 0x0f, 0x9f, 0x56, 0xf0, 0xe9,
-	0xa3, 0x45, 0xa8, 0xe8, 0xab, 0xeb, 0x3c, 0x87, 0x8d, 0x29, 0xcb, 0x2e, 0x27, 0xcc, 0xcb, 0x84,
-	0x50, 0x66, 0x15, 0xf3, 0xa0, 0x90, 0xa8, 0x10, 0x8a, 0xec, 0x40, 0x35, 0x11, 0x49, 0xc8, 0xcc,
-	0x1a, 0xf6, 0x29, 0x88, 0xb6, 0x14, 0x70, 0x25, 0xcd, 0xf5, 0xc2, 0x92, 0xc6, 0x5a, 0x53, 0x7c,
-	0xca, 0xcc, 0x3a, 0x26, 0x22, 0x26, 0x4d, 0x28, 0x27, 0xea, 0xca, 0x6c, 0xa0, 0xa4, 0xe1, 0xd1,
-	0x4f, 0x03, 0x76, 0xbb, 0xc5, 0xba, 0x5c, 0x1e, 0x27, 0x3c, 0x89, 0x29, 0xfb, 0x9a, 0x33, 0xa9,
-	0xf4, 0x02, 0xfc, 0x28, 0xca, 0x98, 0x94, 0xb8, 0x80, 0x06, 0x5d, 0x50, 0xac, 0x7c, 0xc5, 0xa3,
-	0xc5, 0x02, 0x34, 0xd6, 0x5a, 0x2a, 0x83, 0x62, 0xfc, 0x06, 0x45, 0x4c, 0xde, 0x42, 0x4d, 0x2a,
-	0x5f, 0xe5, 0x12, 0xe7, 0xdd, 0x7e, 0xfd, 0xcc, 0x5e, 0xbe, 0x84, 0x3d, 0xef, 0xe8, 0x62, 0x12,
-	0x9d, 0x27, 0x93, 0x16, 0xd4, 0xa5, 0xf6, 0xa0, 0xa7, 0xac, 0xa2, 0xd3, 0x7b, 0x4e, 0x5e, 0xc0,
-	0xd6, 0xcc, 0xcf, 0x27, 0xca, 0x5b, 0x58, 0xab, 0x61, 0xbf, 0x4d, 0x14, 0x3b, 0x85, 0x76, 0xf4,
-	0xdb, 0x80, 0xca, 0xc5, 0xe8, 0xf3, 0xf9, 0xbd, 0x51, 0x63, 0xd9, 0xe8, 0x4c, 0xe4, 0x0a, 0xcd,
-	0x57, 0x28, 0xe2, 0x87, 0xa3, 0x96, 0x97, 0x47, 0xdd, 0x83, 0x9a, 0x3f, 0x15, 0x79, 0xa2, 0x70,
-	0x84, 0x0a, 0x9d, 0xb3, 0x07, 0xf7, 0xae, 0x2e, 0xdd, 0xfb, 0x25, 0x6c, 0xa7, 0x79, 0xe0, 0x5d,
-	0xb2, 0x6b, 0x4f, 0x86, 0x19, 0x4f, 0x15, 0x1a, 0xdc, 0xa4, 0x9b, 0x69, 0x1e, 0xbc, 0x67, 0xd7,
-	0x2e, 0x6a, 0xfa, 0xca, 0x5c, 0x7a, 0x7a, 0xe7, 0x81, 0x2f, 0x19, 0x5e, 0xad, 0x4e, 0x81, 0xcb,
-	0xde, 0x5c, 0x21, 0xfb, 0xd0, 0xe0, 0xd2, 0xd3, 0x5f, 0x05, 0x8b, 0xf0, 0x80, 0x75, 0x5a, 0xe7,
-	0xf2, 0x03, 0xf2, 0x57, 0xdf, 0x0d, 0xd8, 0x5a, 0xda, 0x1c, 0xb1, 0xa0, 0xe5, 0x0e, 0x4e, 0x87,
-	0x83, 0xe1, 0xa9, 0xe7, 0x8e, 0x3a, 0xa3, 0x0b, 0xd7, 0xbb, 0x18, 0xba, 0x9f, 0x9c, 0xde, 0xe0,
-	0x64, 0xe0, 0xf4, 0x9b, 0x25, 0xd2, 0x82, 0xbd, 0x95, 0x78, 0x8f, 0x3a, 0x9d, 0x91, 0xd3, 0x6f,
-	0x1a, 0xe4, 0x29, 0xec, 0xae, 0xc4, 0x34, 0x75, 0xfa, 0xcd, 0xb5, 0xff, 0x94, 0xed, 0xd2, 0xf3,
-	0x4e, 0xbf, 0xd7, 0x71, 0xf5, 0xd3, 0x32, 0x39, 0x00, 0x73, 0xb5, 0xec, 0xf9, 0xf0, 0x64, 0x40,
-	0x3f, 0x3a, 0xfd, 0x66, 0x85, 0xec, 0xc3, 0x93, 0x95, 0x28, 0x75, 0xde, 0x39, 0x3d, 0xfd, 0xb4,
-	0xda, 0x3d, 0xfb, 0x71, 0x6b, 0x19, 0x37, 0xb7, 0x96, 0xf1, 0xe7, 0xd6, 0x32, 0xbe, 0xdd, 0x59,
-	0xa5, 0x9b, 0x3b, 0xab, 0xf4, 0xeb, 0xce, 0x2a, 0x7d, 0xb1, 0x63, 0xae, 0xc6, 0x79, 0x60, 0x87,
-	0x62, 0xda, 0xd6, 0x9f, 0x0b, 0xfe, 0x9d, 0xa1, 0x98, 0x20, 0x69, 0x5f, 0x3d, 0xf8, 0xcb, 0xd5,
-	0x75, 0xca, 0x64, 0x50, 0xc3, 0x84, 0x37, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x8b, 0x6e, 0xf2,
-	0x5a, 0x04, 0x04, 0x00, 0x00,
+// RuneBalance defines the runes allocated to an output by the runestone of the tx.
+// The balance with both the rune id and the amount empty marks the output receiving the unallocated runes, if any.
+type RuneBalance struct {
+	// the rune id in the block:tx format, empty for the runes carried over from the inputs or etched by the tx
+	RuneId string `protobuf:"bytes,1,opt,name=rune_id,json=runeId,proto3" json:"rune_id,omitempty"`
+	// the amount allocated by the edict, empty if determined by the rune balances of the inputs
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (m *BlockHeader) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RuneBalance) Reset()         { *m = RuneBalance{} }
+func (m *RuneBalance) String() string { return proto.CompactTextString(m) }
+func (*RuneBalance) ProtoMessage()    {}
+func (*RuneBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b004a69efe3c7d84, []int{3}
+}
+func (m *RuneBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RuneBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RuneBalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *BlockHeader) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RuneBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RuneBalance.Merge(m, src)
+}
+func (m *RuneBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *RuneBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_RuneBalance.DiscardUnknown(m)
 }
 
-func (m *BlockHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Ntx != 0 {
-		i = encodeVarintBitcoin(dAtA, i, uint64(m.Ntx))
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.Time != 0 {
-		i = encodeVarintBitcoin(dAtA, i, uint64(m.Time))
-		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.Bits) > 0 {
-		i -= len(m.Bits)
-		copy(dAtA[i:], m.Bits)
-		i = encodeVarintBitcoin(dAtA, i, uint64(len(m.Bits)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.Nonce != 0 {
-		i = encodeVarintBitcoin(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.MerkleRoot) > 0 {
-		i -= len(m.MerkleRoot)
-		copy(dAtA[i:], m.MerkleRoot)
-		i = encodeVarintBitcoin(dAtA, i, uint64(len(m.MerkleRoot)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.PreviousBlockHash) > 0 {
-		i -= len(m.PreviousBlockHash)
-		copy(dAtA[i:], m.PreviousBlockHash)
-		i = encodeVarintBitcoin(dAtA, i, uint64(len(m.PreviousBlockHash)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Height != 0 {
-		i = encodeVarintBitcoin(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintBitcoin(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Version != 0 {
-		i = encodeVarintBitcoin(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_RuneBalance proto.InternalMessageInfo
+
+func (m *RuneBalance) GetRuneId() string {
+	if m != nil {
+		return m.RuneId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *BitcoinSigningRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RuneBalance) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *BitcoinSigningRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// TxInclusion is the verified inclusion of a bitcoin transaction in a block of the light client
+type TxInclusion struct {
+	Txid      string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	Blockhash string `protobuf:"bytes,2,opt,name=blockhash,proto3" json:"blockhash,omitempty"`
+	Height    uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// the number of blocks on top of the block, consistent with the confirmations param
+	Confirmations uint64      `protobuf:"varint,4,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	Outputs       []*TxOutput `protobuf:"bytes,5,rep,name=outputs,proto3" json:"outputs,omitempty"`
 }
 
-func (m *BitcoinSigningRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.VaultAddress) > 0 {
-		i -= len(m.VaultAddress)
-		copy(dAtA[i:], m.VaultAddress)
-		i = encodeVarintBitcoin(dAtA, i, uint64(len(m.VaultAddress)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Sequence != 0 {
-		i = encodeVarintBitcoin(dAtA, i, uint64(m.Sequence))
-		i--
-		dAtA[i] = 0x28
+func (m *TxInclusion) Reset()         { *m = TxInclusion{} }
+func (m *TxInclusion) String() string { return proto.CompactTextString(m) }
+func (*TxInclusion) ProtoMessage()    {}
+func (*TxInclusion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b004a69efe3c7d84, []int{4}
+}
+func (m *TxInclusion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxInclusion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxInclusion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Status != 0 {
-		i = encodeVarintBitcoin(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x20
+}
+func (m *TxInclusion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxInclusion.Merge(m, src)
+}
+func (m *TxInclusion) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxInclusion) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxInclusion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxInclusion proto.InternalMessageInfo
+
+func (m *TxInclusion) GetTxid() string {
+	if m != nil {
+		return m.Txid
 	}
-	if len(m.Psbt) > 0 {
-		i -= len(m.Psbt)
-		copy(dAtA[i:], m.Psbt)
-		i = encodeVarintBitcoin(dAtA, i, uint64(len(m.Psbt)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *TxInclusion) GetBlockhash() string {
+	if m != nil {
+		return m.Blockhash
 	}
-	if len(m.Txid) > 0 {
-		i -= len(m.Txid)
-		copy(dAtA[i:], m.Txid)
-		i = encodeVarintBitcoin(dAtA, i, uint64(len(m.Txid)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *TxInclusion) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintBitcoin(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *TxInclusion) GetConfirmations() uint64 {
+	if m != nil {
+		return m.Confirmations
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *UTXO) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TxInclusion) GetOutputs() []*TxOutput {
+	if m != nil {
+		return m.Outputs
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *UTXO) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// TxOutput is an output of a bitcoin transaction
+type TxOutput struct {
+	Vout     uint32 `protobuf:"varint,1,opt,name=vout,proto3" json:"vout,omitempty"`
+	Value    int64  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	PkScript []byte `protobuf:"bytes,3,opt,name=pk_script,json=pkScript,proto3" json:"pk_script,omitempty"`
+	// the address of the output, empty for non-standard and op_return scripts
+	Address string `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *UTXO) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsLocked {
-		i--
-		if m.IsLocked {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *TxOutput) Reset()         { *m = TxOutput{} }
+func (m *TxOutput) String() string { return proto.CompactTextString(m) }
+func (*TxOutput) ProtoMessage()    {}
+func (*TxOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b004a69efe3c7d84, []int{5}
+}
+func (m *TxOutput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxOutput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x40
+		return b[:n], nil
 	}
-	if m.IsCoinbase {
-		i--
-		if m.IsCoinbase {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x38
+}
+func (m *TxOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxOutput.Merge(m, src)
+}
+func (m *TxOutput) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxOutput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxOutput proto.InternalMessageInfo
+
+func (m *TxOutput) GetVout() uint32 {
+	if m != nil {
+		return m.Vout
 	}
-	if len(m.PubKeyScript) > 0 {
-		i -= len(m.PubKeyScript)
-		copy(dAtA[i:], m.PubKeyScript)
-		i = encodeVarintBitcoin(dAtA, i, uint64(len(m.PubKeyScript)))
-		i--
-		dAtA[i] = 0x32
+	return 0
+}
+
+func (m *TxOutput) GetValue() int64 {
+	if m != nil {
+		return m.Value
 	}
-	if m.Height != 0 {
-		i = encodeVarintBitcoin(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x28
+	return 0
+}
+
+func (m *TxOutput) GetPkScript() []byte {
+	if m != nil {
+		return m.PkScript
 	}
-	if m.Amount != 0 {
-		i = encodeVarintBitcoin(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x20
+	return nil
+}
+
+func (m *TxOutput) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintBitcoin(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+// PendingDeposit is a deposit registered before it is confirmed, which is minted in the end block once its block is deep enough
+type PendingDeposit struct {
+	Txid      string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	Blockhash string `protobuf:"bytes,2,opt,name=blockhash,proto3" json:"blockhash,omitempty"`
+	// the height of the block
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// the confirmations required by the deposit on registration
+	RequiredConfirmations int32 `protobuf:"varint,4,opt,name=required_confirmations,json=requiredConfirmations,proto3" json:"required_confirmations,omitempty"`
+	// the relayer who registered the deposit
+	Relayer string `protobuf:"bytes,5,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	// the tx bytes in base64 format
+	PrevTxBytes string `protobuf:"bytes,6,opt,name=prev_tx_bytes,json=prevTxBytes,proto3" json:"prev_tx_bytes,omitempty"`
+	// the tx bytes in base64 format
+	TxBytes string   `protobuf:"bytes,7,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	Proof   []string `protobuf:"bytes,8,rep,name=proof,proto3" json:"proof,omitempty"`
+	// the serialized merkle block in hex format
+	TxOutProof string `protobuf:"bytes,9,opt,name=tx_out_proof,json=txOutProof,proto3" json:"tx_out_proof,omitempty"`
+	// the coinbase deposit is minted only once mature
+	IsCoinbase bool `protobuf:"varint,10,opt,name=is_coinbase,json=isCoinbase,proto3" json:"is_coinbase,omitempty"`
+	// the txs spent by the inputs other than the first one, in base64 format
+	InputPrevTxs []string `protobuf:"bytes,11,rep,name=input_prev_txs,json=inputPrevTxs,proto3" json:"input_prev_txs,omitempty"`
+}
+
+func (m *PendingDeposit) Reset()         { *m = PendingDeposit{} }
+func (m *PendingDeposit) String() string { return proto.CompactTextString(m) }
+func (*PendingDeposit) ProtoMessage()    {}
+func (*PendingDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b004a69efe3c7d84, []int{6}
+}
+func (m *PendingDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Vout != 0 {
-		i = encodeVarintBitcoin(dAtA, i, uint64(m.Vout))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *PendingDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingDeposit.Merge(m, src)
+}
+func (m *PendingDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingDeposit proto.InternalMessageInfo
+
+func (m *PendingDeposit) GetTxid() string {
+	if m != nil {
+		return m.Txid
 	}
-	if len(m.Txid) > 0 {
-		i -= len(m.Txid)
-		copy(dAtA[i:], m.Txid)
-		i = encodeVarintBitcoin(dAtA, i, uint64(len(m.Txid)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *PendingDeposit) GetBlockhash() string {
+	if m != nil {
+		return m.Blockhash
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintBitcoin(dAtA []byte, offset int, v uint64) int {
-	offset -= sovBitcoin(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PendingDeposit) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *BlockHeader) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *PendingDeposit) GetRequiredConfirmations() int32 {
+	if m != nil {
+		return m.RequiredConfirmations
 	}
-	var l int
-	_ = l
-	if m.Version != 0 {
-		n += 1 + sovBitcoin(uint64(m.Version))
+	return 0
+}
+
+func (m *PendingDeposit) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
 	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovBitcoin(uint64(l))
+	return ""
+}
+
+func (m *PendingDeposit) GetPrevTxBytes() string {
+	if m != nil {
+		return m.PrevTxBytes
 	}
-	if m.Height != 0 {
-		n += 1 + sovBitcoin(uint64(m.Height))
+	return ""
+}
+
+func (m *PendingDeposit) GetTxBytes() string {
+	if m != nil {
+		return m.TxBytes
 	}
-	l = len(m.PreviousBlockHash)
-	if l > 0 {
-		n += 1 + l + sovBitcoin(uint64(l))
+	return ""
+}
+
+func (m *PendingDeposit) GetProof() []string {
+	if m != nil {
+		return m.Proof
 	}
-	l = len(m.MerkleRoot)
-	if l > 0 {
-		n += 1 + l + sovBitcoin(uint64(l))
+	return nil
+}
+
+func (m *PendingDeposit) GetTxOutProof() string {
+	if m != nil {
+		return m.TxOutProof
 	}
-	if m.Nonce != 0 {
-		n += 1 + sovBitcoin(uint64(m.Nonce))
+	return ""
+}
+
+func (m *PendingDeposit) GetIsCoinbase() bool {
+	if m != nil {
+		return m.IsCoinbase
 	}
-	l = len(m.Bits)
-	if l > 0 {
-		n += 1 + l + sovBitcoin(uint64(l))
+	return false
+}
+
+func (m *PendingDeposit) GetInputPrevTxs() []string {
+	if m != nil {
+		return m.InputPrevTxs
 	}
-	if m.Time != 0 {
-		n += 1 + sovBitcoin(uint64(m.Time))
+	return nil
+}
+
+// BridgeDegradation records that the bridge is degraded as the best block header lags behind the side block time
+type BridgeDegradation struct {
+	// the side block time since which the bridge is degraded, in unix seconds
+	Since int64 `protobuf:"varint,1,opt,name=since,proto3" json:"since,omitempty"`
+	// the side block height since which the bridge is degraded
+	SinceHeight int64 `protobuf:"varint,2,opt,name=since_height,json=sinceHeight,proto3" json:"since_height,omitempty"`
+	// the best block header when the bridge is degraded
+	BestHash   string `protobuf:"bytes,3,opt,name=best_hash,json=bestHash,proto3" json:"best_hash,omitempty"`
+	BestHeight uint64 `protobuf:"varint,4,opt,name=best_height,json=bestHeight,proto3" json:"best_height,omitempty"`
+}
+
+func (m *BridgeDegradation) Reset()         { *m = BridgeDegradation{} }
+func (m *BridgeDegradation) String() string { return proto.CompactTextString(m) }
+func (*BridgeDegradation) ProtoMessage()    {}
+func (*BridgeDegradation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b004a69efe3c7d84, []int{7}
+}
+func (m *BridgeDegradation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeDegradation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeDegradation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Ntx != 0 {
-		n += 1 + sovBitcoin(uint64(m.Ntx))
+}
+func (m *BridgeDegradation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeDegradation.Merge(m, src)
+}
+func (m *BridgeDegradation) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeDegradation) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeDegradation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeDegradation proto.InternalMessageInfo
+
+func (m *BridgeDegradation) GetSince() int64 {
+	if m != nil {
+		return m.Since
 	}
-	return n
+	return 0
 }
 
-func (m *BitcoinSigningRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BridgeDegradation) GetSinceHeight() int64 {
+	if m != nil {
+		return m.SinceHeight
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovBitcoin(uint64(l))
+	return 0
+}
+
+func (m *BridgeDegradation) GetBestHash() string {
+	if m != nil {
+		return m.BestHash
 	}
-	l = len(m.Txid)
-	if l > 0 {
-		n += 1 + l + sovBitcoin(uint64(l))
+	return ""
+}
+
+func (m *BridgeDegradation) GetBestHeight() uint64 {
+	if m != nil {
+		return m.BestHeight
 	}
-	l = len(m.Psbt)
-	if l > 0 {
-		n += 1 + l + sovBitcoin(uint64(l))
+	return 0
+}
+
+// Link between the side address and the bitcoin address
+type AddressLink struct {
+	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	BtcAddress string `protobuf:"bytes,2,opt,name=btc_address,json=btcAddress,proto3" json:"btc_address,omitempty"`
+}
+
+func (m *AddressLink) Reset()         { *m = AddressLink{} }
+func (m *AddressLink) String() string { return proto.CompactTextString(m) }
+func (*AddressLink) ProtoMessage()    {}
+func (*AddressLink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b004a69efe3c7d84, []int{8}
+}
+func (m *AddressLink) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddressLink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddressLink.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Status != 0 {
-		n += 1 + sovBitcoin(uint64(m.Status))
+}
+func (m *AddressLink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressLink.Merge(m, src)
+}
+func (m *AddressLink) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddressLink) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressLink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressLink proto.InternalMessageInfo
+
+func (m *AddressLink) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	if m.Sequence != 0 {
-		n += 1 + sovBitcoin(uint64(m.Sequence))
+	return ""
+}
+
+func (m *AddressLink) GetBtcAddress() string {
+	if m != nil {
+		return m.BtcAddress
 	}
-	l = len(m.VaultAddress)
-	if l > 0 {
-		n += 1 + l + sovBitcoin(uint64(l))
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("side.btcbridge.SigningStatus", SigningStatus_name, SigningStatus_value)
+	proto.RegisterEnum("side.btcbridge.PendingDepositState", PendingDepositState_name, PendingDepositState_value)
+	proto.RegisterType((*BlockHeader)(nil), "side.btcbridge.BlockHeader")
+	proto.RegisterType((*BitcoinSigningRequest)(nil), "side.btcbridge.BitcoinSigningRequest")
+	proto.RegisterType((*UTXO)(nil), "side.btcbridge.UTXO")
+	proto.RegisterType((*RuneBalance)(nil), "side.btcbridge.RuneBalance")
+	proto.RegisterType((*TxInclusion)(nil), "side.btcbridge.TxInclusion")
+	proto.RegisterType((*TxOutput)(nil), "side.btcbridge.TxOutput")
+	proto.RegisterType((*PendingDeposit)(nil), "side.btcbridge.PendingDeposit")
+	proto.RegisterType((*BridgeDegradation)(nil), "side.btcbridge.BridgeDegradation")
+	proto.RegisterType((*AddressLink)(nil), "side.btcbridge.AddressLink")
+}
+
+func init() { proto.RegisterFile("side/btcbridge/bitcoin.proto", fileDescriptor_b004a69efe3c7d84) }
+
+var fileDescriptor_b004a69efe3c7d84 = []byte{
+	// 1106 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x3b, 0x6f, 0x1b, 0xc7,
+	0x13, 0xd7, 0xf1, 0xcd, 0x21, 0x25, 0xd0, 0xeb, 0xd7, 0x59, 0xf2, 0x9f, 0x7f, 0xfa, 0xa2, 0x00,
+	0x86, 0x0b, 0x0a, 0x71, 0xe0, 0x36, 0x00, 0x5f, 0xb6, 0x98, 0xd8, 0x14, 0x71, 0xa4, 0x80, 0x20,
+	0xcd, 0xe1, 0x1e, 0x6b, 0x6a, 0x41, 0xea, 0xf6, 0x7c, 0xbb, 0x47, 0x90, 0x5f, 0x20, 0x45, 0xaa,
+	0x7c, 0x95, 0x20, 0x75, 0x8a, 0x74, 0x29, 0x5d, 0xa6, 0x0c, 0xec, 0x2a, 0x7d, 0x3e, 0x40, 0xb0,
+	0xb3, 0x77, 0x34, 0x49, 0x29, 0xa9, 0xd2, 0xcd, 0xfc, 0x66, 0x76, 0x77, 0xe6, 0x37, 0x8f, 0x3b,
+	0x78, 0x2c, 0x58, 0x40, 0xcf, 0x3c, 0xe9, 0x7b, 0x31, 0x0b, 0x66, 0xf4, 0xcc, 0x63, 0xd2, 0xe7,
+	0x2c, 0x6c, 0x47, 0x31, 0x97, 0x9c, 0x1c, 0x29, 0x6b, 0x7b, 0x63, 0x3d, 0xbe, 0x37, 0xe3, 0x33,
+	0x8e, 0xa6, 0x33, 0x25, 0x69, 0x2f, 0xeb, 0x2f, 0x03, 0x6a, 0xdd, 0x05, 0xf7, 0xe7, 0xe7, 0xd4,
+	0x0d, 0x68, 0x4c, 0x4c, 0x28, 0x2f, 0x69, 0x2c, 0x18, 0x0f, 0x4d, 0xa3, 0x65, 0x3c, 0x2d, 0xd8,
+	0x99, 0x4a, 0x08, 0x14, 0xae, 0x5c, 0x71, 0x65, 0xe6, 0x5a, 0xc6, 0xd3, 0xaa, 0x8d, 0x32, 0x79,
+	0x00, 0xa5, 0x2b, 0xca, 0x66, 0x57, 0xd2, 0xcc, 0xa3, 0x73, 0xaa, 0x91, 0x36, 0xdc, 0x8d, 0x62,
+	0xba, 0x64, 0x3c, 0x11, 0x8e, 0xa7, 0x6e, 0x77, 0xf0, 0x68, 0x01, 0x8f, 0xde, 0xc9, 0x4c, 0xfa,
+	0x5d, 0x75, 0xcf, 0xff, 0xa1, 0x76, 0x4d, 0xe3, 0xf9, 0x82, 0x3a, 0x31, 0xe7, 0xd2, 0x2c, 0xa2,
+	0x1f, 0x68, 0xc8, 0xe6, 0x5c, 0x92, 0x7b, 0x50, 0x0c, 0x79, 0xe8, 0x53, 0xb3, 0x84, 0xef, 0x68,
+	0x45, 0x85, 0xe4, 0x31, 0x29, 0xcc, 0xb2, 0x0e, 0x49, 0xc9, 0x0a, 0x93, 0xec, 0x9a, 0x9a, 0x15,
+	0x74, 0x44, 0x99, 0x34, 0x20, 0x1f, 0xca, 0x95, 0x59, 0x45, 0x48, 0x89, 0xd6, 0x2f, 0x39, 0xb8,
+	0xdf, 0xd5, 0x74, 0x4d, 0xd8, 0x2c, 0x64, 0xe1, 0xcc, 0xa6, 0xef, 0x12, 0x2a, 0xa4, 0x22, 0xc0,
+	0x0d, 0x82, 0x98, 0x0a, 0x81, 0x04, 0x54, 0xed, 0x4c, 0xc5, 0x9b, 0x57, 0x2c, 0xc8, 0x08, 0x50,
+	0xb2, 0xc2, 0x22, 0xe1, 0xe9, 0xf4, 0xab, 0x36, 0xca, 0xe4, 0x05, 0x94, 0x84, 0x74, 0x65, 0x22,
+	0x30, 0xdf, 0xa3, 0xe7, 0xff, 0x6b, 0xef, 0x56, 0xa2, 0x9d, 0xbe, 0x38, 0x41, 0x27, 0x3b, 0x75,
+	0x26, 0xc7, 0x50, 0x11, 0x2a, 0x06, 0x95, 0x65, 0x11, 0x23, 0xdd, 0xe8, 0xe4, 0x33, 0x38, 0x5c,
+	0xba, 0xc9, 0x42, 0x3a, 0x59, 0x68, 0x25, 0x7c, 0xaf, 0x8e, 0x60, 0x27, 0x8d, 0xef, 0x18, 0x2a,
+	0x31, 0xf5, 0xf9, 0x92, 0xc6, 0x6b, 0x64, 0xa4, 0x62, 0x6f, 0x74, 0xd2, 0x04, 0x88, 0xa9, 0xcf,
+	0x22, 0x46, 0x43, 0x29, 0xcc, 0x4a, 0x2b, 0xaf, 0xf8, 0xfd, 0x84, 0xa8, 0x42, 0xba, 0xd7, 0x3c,
+	0x09, 0x65, 0x4a, 0x52, 0xaa, 0x29, 0x5c, 0xd0, 0x30, 0xa0, 0xb1, 0x09, 0xf8, 0x62, 0xaa, 0x59,
+	0x3f, 0xe7, 0xa0, 0x70, 0x39, 0xfd, 0xf6, 0x62, 0x43, 0x8a, 0xb1, 0x4b, 0xca, 0x92, 0x27, 0x12,
+	0x89, 0x2a, 0xd8, 0x28, 0x6f, 0xd3, 0x9a, 0xdf, 0xa5, 0xf5, 0xd3, 0xd3, 0x85, 0xfd, 0xa7, 0xd3,
+	0xde, 0x2a, 0xee, 0xf4, 0xd6, 0x29, 0x1c, 0x45, 0x89, 0xe7, 0xcc, 0xe9, 0xda, 0x11, 0x7e, 0xcc,
+	0x22, 0x89, 0x64, 0xd4, 0xed, 0x7a, 0x94, 0x78, 0xdf, 0xd0, 0xf5, 0x04, 0x31, 0xd5, 0x51, 0x4c,
+	0x38, 0xaa, 0xbe, 0x9e, 0x2b, 0x68, 0xca, 0x07, 0x30, 0xd1, 0x4b, 0x11, 0x72, 0x02, 0x55, 0x26,
+	0x1c, 0xd5, 0x81, 0x34, 0xc0, 0x66, 0xa9, 0xd8, 0x15, 0x26, 0x5e, 0xa3, 0x4e, 0x2c, 0xa8, 0xb3,
+	0x50, 0xdf, 0xce, 0x78, 0x28, 0x90, 0x94, 0x43, 0x7b, 0x07, 0x23, 0x5f, 0x40, 0x31, 0x4e, 0x42,
+	0x2a, 0x4c, 0x68, 0xe5, 0x9f, 0xd6, 0x9e, 0x9f, 0xec, 0x57, 0xd9, 0x4e, 0x42, 0xda, 0x75, 0x17,
+	0x6e, 0xe8, 0x53, 0x5b, 0x7b, 0x5a, 0x5f, 0x41, 0x6d, 0x0b, 0x25, 0x0f, 0xa1, 0xac, 0x70, 0x67,
+	0x43, 0x5f, 0x49, 0xa9, 0xc3, 0x60, 0x8b, 0x12, 0xdd, 0x6b, 0xa9, 0x66, 0xfd, 0x64, 0x40, 0x6d,
+	0xba, 0x1a, 0x86, 0xfe, 0x22, 0xc9, 0x46, 0xf2, 0x06, 0xf9, 0x8f, 0xa1, 0x8a, 0x13, 0xb7, 0x35,
+	0xab, 0x9f, 0x80, 0x7f, 0x1c, 0xd8, 0x53, 0x38, 0xf4, 0x79, 0xf8, 0x96, 0xc5, 0xd7, 0xae, 0xce,
+	0x58, 0xd7, 0x62, 0x17, 0x24, 0xcf, 0xa1, 0xcc, 0x13, 0x19, 0x25, 0x52, 0x98, 0x45, 0x4c, 0xda,
+	0xdc, 0x4f, 0x7a, 0xba, 0xba, 0x40, 0x07, 0x3b, 0x73, 0xb4, 0xe6, 0x50, 0xc9, 0xc0, 0x4d, 0x63,
+	0x18, 0x48, 0x27, 0xca, 0x6a, 0xb2, 0x97, 0xee, 0x22, 0xa1, 0x18, 0x6b, 0xde, 0xd6, 0x8a, 0xaa,
+	0x4e, 0x34, 0xcf, 0xea, 0x9b, 0xc7, 0xfa, 0x56, 0xa2, 0x79, 0x5a, 0xdb, 0xad, 0x5e, 0x2a, 0xec,
+	0xf4, 0x92, 0xf5, 0x67, 0x0e, 0x8e, 0xc6, 0x34, 0x0c, 0x58, 0x38, 0xeb, 0xd3, 0x88, 0x0b, 0x26,
+	0xff, 0x43, 0x8e, 0x5e, 0xc0, 0x83, 0x98, 0xbe, 0x4b, 0x58, 0x4c, 0x03, 0xe7, 0x26, 0x59, 0x45,
+	0xfb, 0x7e, 0x66, 0xed, 0xed, 0x90, 0x66, 0x42, 0x39, 0xa6, 0x0b, 0x77, 0x4d, 0xe3, 0x74, 0xaf,
+	0x65, 0x2a, 0xb1, 0xe0, 0x50, 0xad, 0x42, 0x47, 0xae, 0x1c, 0x6f, 0x2d, 0x69, 0x36, 0xd5, 0x35,
+	0x05, 0x4e, 0x57, 0x5d, 0x05, 0x91, 0x47, 0x50, 0xd9, 0x98, 0xf5, 0x9a, 0x2b, 0xcb, 0xd4, 0x74,
+	0x0f, 0x8a, 0x51, 0xcc, 0xf9, 0xdb, 0x74, 0x9c, 0xb5, 0x42, 0x5a, 0x50, 0x97, 0x2b, 0x87, 0x27,
+	0xd2, 0xd1, 0xc6, 0x2a, 0x1e, 0x02, 0xa9, 0x6a, 0x30, 0x46, 0x8f, 0xbd, 0xd1, 0x80, 0x1b, 0xa3,
+	0x71, 0x0a, 0x47, 0x2c, 0x8c, 0xf0, 0x06, 0x8c, 0x4e, 0x98, 0x35, 0x7c, 0xa1, 0x8e, 0xe8, 0x18,
+	0xa3, 0x13, 0xd6, 0x0f, 0x06, 0xdc, 0xe9, 0x62, 0xd5, 0xfb, 0x74, 0x16, 0xbb, 0x01, 0xa6, 0xab,
+	0x82, 0x12, 0x4c, 0xad, 0x30, 0x43, 0x97, 0x13, 0x15, 0xf2, 0x04, 0xea, 0x28, 0x38, 0x29, 0xb1,
+	0xba, 0xd6, 0x35, 0xc4, 0xce, 0x35, 0xbb, 0x27, 0x50, 0xf5, 0xa8, 0x90, 0xfa, 0x43, 0xa1, 0x57,
+	0x44, 0x45, 0x01, 0xd9, 0xf7, 0x41, 0x1b, 0xf5, 0x71, 0xdd, 0x9c, 0x80, 0x66, 0x44, 0xac, 0x73,
+	0xa8, 0xa5, 0x6b, 0xf0, 0x35, 0x0b, 0xe7, 0xff, 0xb2, 0xc4, 0xd5, 0x4d, 0xd2, 0xdf, 0xec, 0x51,
+	0x5d, 0x7c, 0xf0, 0xa4, 0x9f, 0x1e, 0x7f, 0xf6, 0xab, 0x01, 0x87, 0x3b, 0x0b, 0x9a, 0x34, 0xe1,
+	0x78, 0x32, 0x7c, 0x35, 0x1a, 0x8e, 0x5e, 0x39, 0x93, 0x69, 0x67, 0x7a, 0x39, 0x71, 0x2e, 0x47,
+	0x93, 0xf1, 0xa0, 0x37, 0x7c, 0x39, 0x1c, 0xf4, 0x1b, 0x07, 0xe4, 0x18, 0x1e, 0xec, 0xd9, 0x7b,
+	0xf6, 0xa0, 0x33, 0x1d, 0xf4, 0x1b, 0x06, 0x79, 0x04, 0xf7, 0xf7, 0x6c, 0x4a, 0x1d, 0xf4, 0x1b,
+	0xb9, 0x5b, 0xae, 0xed, 0xda, 0x17, 0x9d, 0x7e, 0xaf, 0x33, 0x51, 0x47, 0xf3, 0xe4, 0x31, 0x98,
+	0xfb, 0xd7, 0x5e, 0x8c, 0x5e, 0x0e, 0xed, 0x37, 0x83, 0x7e, 0xa3, 0x40, 0x4e, 0xe0, 0xe1, 0x9e,
+	0xd5, 0x1e, 0x7c, 0x3d, 0xe8, 0xa9, 0xa3, 0xc5, 0x67, 0xdf, 0x1b, 0x70, 0x77, 0x77, 0x0c, 0x54,
+	0x2a, 0x94, 0x7c, 0x0e, 0x4f, 0xc6, 0x83, 0x51, 0x5f, 0x1d, 0xea, 0x0f, 0xc6, 0x17, 0x93, 0xe1,
+	0x14, 0x0f, 0x0f, 0xf6, 0x12, 0x3a, 0x85, 0xd6, 0xed, 0x6e, 0x69, 0x00, 0xc3, 0xd1, 0xab, 0x86,
+	0x41, 0x2c, 0x68, 0xde, 0xee, 0xf5, 0xa6, 0x33, 0xbd, 0xb4, 0x95, 0x4f, 0xae, 0x7b, 0xfe, 0xdb,
+	0x87, 0xa6, 0xf1, 0xfe, 0x43, 0xd3, 0xf8, 0xe3, 0x43, 0xd3, 0xf8, 0xf1, 0x63, 0xf3, 0xe0, 0xfd,
+	0xc7, 0xe6, 0xc1, 0xef, 0x1f, 0x9b, 0x07, 0xdf, 0xb5, 0x67, 0x4c, 0x5e, 0x25, 0x5e, 0xdb, 0xe7,
+	0xd7, 0x67, 0x6a, 0x87, 0xe0, 0xdf, 0x88, 0xcf, 0x17, 0xa8, 0x9c, 0xad, 0xb6, 0xfe, 0x6a, 0xe4,
+	0x3a, 0xa2, 0xc2, 0x2b, 0xa1, 0xc3, 0x97, 0x7f, 0x0f, 0x00, 0x2c, 0x7c, 0x17, 0x65, 0xf4, 0x08,
+	0x00, 0x00,
+}
+
+func (m *BlockHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Ntx != 0 {
+		i = encodeVarintBitcoin(dAtA, i, uint64(m.Ntx))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Time != 0 {
+		i = encodeVarintBitcoin(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Bits) > 0 {
+		i -= len(m.Bits)
+		copy(dAtA[i:], m.Bits)
+		i = encodeVarintBitcoin(dAtA, i, uint64(len(m.Bits)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintBitcoin(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintBitcoin(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PreviousBlockHash) > 0 {
+		i -= len(m.PreviousBlockHash)
+		copy(dAtA[i:], m.PreviousBlockHash)
+		i = encodeVarintBitcoin(dAtA, i, uint64(len(m.PreviousBlockHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Height != 0 {
+		i = encodeVarintBitcoin(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintBitcoin(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Version != 0 {
+		i = encodeVarintBitcoin(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BitcoinSigningRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BitcoinSigningRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BitcoinSigningRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintBitcoin(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Amount != 0 {
+		i = encodeVarintBitcoin(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Recipients[iNdEx])
+			copy(dAtA[i:], m.Recipients[iNdEx])
+			i = encodeVarintBitcoin(dAtA, i, uint64(len(m.Recipients[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.Recovery {
+		i--
+		if m.Recovery {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintBitcoin(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintBitcoin(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Status != 0 {
+		i = encodeVarintBitcoin(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Psbt) > 0 {
+		i -= len(m.Psbt)
+		copy(dAtA[i:], m.Psbt)
+		i = encodeVarintBitcoin(dAtA, i, uint64(len(m.Psbt)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Txid) > 0 {
+		i -= len(m.Txid)
+		copy(dAtA[i:], m.Txid)
+		i = encodeVarintBitcoin(dAtA, i, uint64(len(m.Txid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBitcoin(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UTXO) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UTXO) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UTXO) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Runes) > 0 {
+		for iNdEx := len(m.Runes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Runes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBitcoin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.Inscriptions != 0 {
+		i = encodeVarintBitcoin(dAtA, i, uint64(m.Inscriptions))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.IsLocked {
+		i--
+		if m.IsLocked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.IsCoinbase {
+		i--
+		if m.IsCoinbase {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.PubKeyScript) > 0 {
+		i -= len(m.PubKeyScript)
+		copy(dAtA[i:], m.PubKeyScript)
+		i = encodeVarintBitcoin(dAtA, i, uint64(len(m.PubKeyScript)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Height != 0 {
+		i = encodeVarintBitcoin(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Amount != 0 {
+		i = encodeVarintBitcoin(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBitcoin(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Vout != 0 {
+		i = encodeVarintBitcoin(dAtA, i, uint64(m.Vout))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Txid) > 0 {
+		i -= len(m.Txid)
+		copy(dAtA[i:], m.Txid)
+		i = encodeVarintBitcoin(dAtA, i, uint64(len(m.Txid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RuneBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RuneBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RuneBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintBitcoin(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RuneId) > 0 {
+		i -= len(m.RuneId)
+		copy(dAtA[i:], m.RuneId)
+		i = encodeVarintBitcoin(dAtA, i, uint64(len(m.RuneId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxInclusion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxInclusion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxInclusion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Outputs) > 0 {
+		for iNdEx := len(m.Outputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Outputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBitcoin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Confirmations != 0 {
+		i = encodeVarintBitcoin(dAtA, i, uint64(m.Confirmations))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Height != 0 {
+		i = encodeVarintBitcoin(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Blockhash) > 0 {
+		i -= len(m.Blockhash)
+		copy(dAtA[i:], m.Blockhash)
+		i = encodeVarintBitcoin(dAtA, i, uint64(len(m.Blockhash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Txid) > 0 {
+		i -= len(m.Txid)
+		copy(dAtA[i:], m.Txid)
+		i = encodeVarintBitcoin(dAtA, i, uint64(len(m.Txid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBitcoin(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PkScript) > 0 {
+		i -= len(m.PkScript)
+		copy(dAtA[i:], m.PkScript)
+		i = encodeVarintBitcoin(dAtA, i, uint64(len(m.PkScript)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Value != 0 {
+		i = encodeVarintBitcoin(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Vout != 0 {
+		i = encodeVarintBitcoin(dAtA, i, uint64(m.Vout))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InputPrevTxs) > 0 {
+		for iNdEx := len(m.InputPrevTxs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.InputPrevTxs[iNdEx])
+			copy(dAtA[i:], m.InputPrevTxs[iNdEx])
+			i = encodeVarintBitcoin(dAtA, i, uint64(len(m.InputPrevTxs[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.IsCoinbase {
+		i--
+		if m.IsCoinbase {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.TxOutProof) > 0 {
+		i -= len(m.TxOutProof)
+		copy(dAtA[i:], m.TxOutProof)
+		i = encodeVarintBitcoin(dAtA, i, uint64(len(m.TxOutProof)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintBitcoin(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintBitcoin(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.PrevTxBytes) > 0 {
+		i -= len(m.PrevTxBytes)
+		copy(dAtA[i:], m.PrevTxBytes)
+		i = encodeVarintBitcoin(dAtA, i, uint64(len(m.PrevTxBytes)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintBitcoin(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.RequiredConfirmations != 0 {
+		i = encodeVarintBitcoin(dAtA, i, uint64(m.RequiredConfirmations))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Height != 0 {
+		i = encodeVarintBitcoin(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Blockhash) > 0 {
+		i -= len(m.Blockhash)
+		copy(dAtA[i:], m.Blockhash)
+		i = encodeVarintBitcoin(dAtA, i, uint64(len(m.Blockhash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Txid) > 0 {
+		i -= len(m.Txid)
+		copy(dAtA[i:], m.Txid)
+		i = encodeVarintBitcoin(dAtA, i, uint64(len(m.Txid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeDegradation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeDegradation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeDegradation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BestHeight != 0 {
+		i = encodeVarintBitcoin(dAtA, i, uint64(m.BestHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.BestHash) > 0 {
+		i -= len(m.BestHash)
+		copy(dAtA[i:], m.BestHash)
+		i = encodeVarintBitcoin(dAtA, i, uint64(len(m.BestHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SinceHeight != 0 {
+		i = encodeVarintBitcoin(dAtA, i, uint64(m.SinceHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Since != 0 {
+		i = encodeVarintBitcoin(dAtA, i, uint64(m.Since))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddressLink) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressLink) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddressLink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BtcAddress) > 0 {
+		i -= len(m.BtcAddress)
+		copy(dAtA[i:], m.BtcAddress)
+		i = encodeVarintBitcoin(dAtA, i, uint64(len(m.BtcAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBitcoin(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBitcoin(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBitcoin(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BlockHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovBitcoin(uint64(m.Version))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovBitcoin(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovBitcoin(uint64(m.Height))
+	}
+	l = len(m.PreviousBlockHash)
+	if l > 0 {
+		n += 1 + l + sovBitcoin(uint64(l))
+	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovBitcoin(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovBitcoin(uint64(m.Nonce))
+	}
+	l = len(m.Bits)
+	if l > 0 {
+		n += 1 + l + sovBitcoin(uint64(l))
+	}
+	if m.Time != 0 {
+		n += 1 + sovBitcoin(uint64(m.Time))
+	}
+	if m.Ntx != 0 {
+		n += 1 + sovBitcoin(uint64(m.Ntx))
+	}
+	return n
+}
+
+func (m *BitcoinSigningRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBitcoin(uint64(l))
+	}
+	l = len(m.Txid)
+	if l > 0 {
+		n += 1 + l + sovBitcoin(uint64(l))
+	}
+	l = len(m.Psbt)
+	if l > 0 {
+		n += 1 + l + sovBitcoin(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovBitcoin(uint64(m.Status))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovBitcoin(uint64(m.Sequence))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovBitcoin(uint64(l))
+	}
+	if m.Recovery {
+		n += 2
+	}
+	if len(m.Recipients) > 0 {
+		for _, s := range m.Recipients {
+			l = len(s)
+			n += 1 + l + sovBitcoin(uint64(l))
+		}
+	}
+	if m.Amount != 0 {
+		n += 1 + sovBitcoin(uint64(m.Amount))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovBitcoin(uint64(l))
+	}
+	return n
+}
+
+func (m *UTXO) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Txid)
+	if l > 0 {
+		n += 1 + l + sovBitcoin(uint64(l))
+	}
+	if m.Vout != 0 {
+		n += 1 + sovBitcoin(uint64(m.Vout))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBitcoin(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovBitcoin(uint64(m.Amount))
+	}
+	if m.Height != 0 {
+		n += 1 + sovBitcoin(uint64(m.Height))
+	}
+	l = len(m.PubKeyScript)
+	if l > 0 {
+		n += 1 + l + sovBitcoin(uint64(l))
+	}
+	if m.IsCoinbase {
+		n += 2
+	}
+	if m.IsLocked {
+		n += 2
+	}
+	if m.Inscriptions != 0 {
+		n += 1 + sovBitcoin(uint64(m.Inscriptions))
+	}
+	if len(m.Runes) > 0 {
+		for _, e := range m.Runes {
+			l = e.Size()
+			n += 1 + l + sovBitcoin(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RuneBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RuneId)
+	if l > 0 {
+		n += 1 + l + sovBitcoin(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovBitcoin(uint64(l))
+	}
+	return n
+}
+
+func (m *TxInclusion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Txid)
+	if l > 0 {
+		n += 1 + l + sovBitcoin(uint64(l))
+	}
+	l = len(m.Blockhash)
+	if l > 0 {
+		n += 1 + l + sovBitcoin(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovBitcoin(uint64(m.Height))
+	}
+	if m.Confirmations != 0 {
+		n += 1 + sovBitcoin(uint64(m.Confirmations))
+	}
+	if len(m.Outputs) > 0 {
+		for _, e := range m.Outputs {
+			l = e.Size()
+			n += 1 + l + sovBitcoin(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TxOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Vout != 0 {
+		n += 1 + sovBitcoin(uint64(m.Vout))
+	}
+	if m.Value != 0 {
+		n += 1 + sovBitcoin(uint64(m.Value))
+	}
+	l = len(m.PkScript)
+	if l > 0 {
+		n += 1 + l + sovBitcoin(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBitcoin(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Txid)
+	if l > 0 {
+		n += 1 + l + sovBitcoin(uint64(l))
+	}
+	l = len(m.Blockhash)
+	if l > 0 {
+		n += 1 + l + sovBitcoin(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovBitcoin(uint64(m.Height))
+	}
+	if m.RequiredConfirmations != 0 {
+		n += 1 + sovBitcoin(uint64(m.RequiredConfirmations))
+	}
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovBitcoin(uint64(l))
+	}
+	l = len(m.PrevTxBytes)
+	if l > 0 {
+		n += 1 + l + sovBitcoin(uint64(l))
+	}
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovBitcoin(uint64(l))
+	}
+	if len(m.Proof) > 0 {
+		for _, s := range m.Proof {
+			l = len(s)
+			n += 1 + l + sovBitcoin(uint64(l))
+		}
+	}
+	l = len(m.TxOutProof)
+	if l > 0 {
+		n += 1 + l + sovBitcoin(uint64(l))
+	}
+	if m.IsCoinbase {
+		n += 2
+	}
+	if len(m.InputPrevTxs) > 0 {
+		for _, s := range m.InputPrevTxs {
+			l = len(s)
+			n += 1 + l + sovBitcoin(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BridgeDegradation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Since != 0 {
+		n += 1 + sovBitcoin(uint64(m.Since))
+	}
+	if m.SinceHeight != 0 {
+		n += 1 + sovBitcoin(uint64(m.SinceHeight))
+	}
+	l = len(m.BestHash)
+	if l > 0 {
+		n += 1 + l + sovBitcoin(uint64(l))
+	}
+	if m.BestHeight != 0 {
+		n += 1 + sovBitcoin(uint64(m.BestHeight))
+	}
+	return n
+}
+
+func (m *AddressLink) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBitcoin(uint64(l))
+	}
+	l = len(m.BtcAddress)
+	if l > 0 {
+		n += 1 + l + sovBitcoin(uint64(l))
+	}
+	return n
+}
+
+func sovBitcoin(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBitcoin(x uint64) (n int) {
+	return sovBitcoin(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BlockHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBitcoin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBitcoin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBitcoin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBitcoin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBitcoin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBitcoin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousBlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBitcoin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBitcoin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBitcoin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousBlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBitcoin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBitcoin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBitcoin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBitcoin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBitcoin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBitcoin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBitcoin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bits = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBitcoin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ntx", wireType)
+			}
+			m.Ntx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBitcoin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ntx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBitcoin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBitcoin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BitcoinSigningRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBitcoin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BitcoinSigningRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BitcoinSigningRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBitcoin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBitcoin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBitcoin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBitcoin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBitcoin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBitcoin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Psbt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBitcoin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBitcoin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBitcoin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Psbt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBitcoin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SigningStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBitcoin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBitcoin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBitcoin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBitcoin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recovery", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBitcoin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Recovery = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBitcoin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBitcoin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBitcoin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipients = append(m.Recipients, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBitcoin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBitcoin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBitcoin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBitcoin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBitcoin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBitcoin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UTXO) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBitcoin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UTXO: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UTXO: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBitcoin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBitcoin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBitcoin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vout", wireType)
+			}
+			m.Vout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBitcoin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Vout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBitcoin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBitcoin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBitcoin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBitcoin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBitcoin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyScript", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBitcoin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBitcoin
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBitcoin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKeyScript = append(m.PubKeyScript[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKeyScript == nil {
+				m.PubKeyScript = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsCoinbase", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBitcoin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsCoinbase = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLocked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBitcoin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsLocked = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inscriptions", wireType)
+			}
+			m.Inscriptions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBitcoin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Inscriptions |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBitcoin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBitcoin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBitcoin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Runes = append(m.Runes, &RuneBalance{})
+			if err := m.Runes[len(m.Runes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBitcoin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBitcoin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RuneBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBitcoin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RuneBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RuneBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuneId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBitcoin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBitcoin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBitcoin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuneId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBitcoin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBitcoin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBitcoin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBitcoin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBitcoin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxInclusion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBitcoin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxInclusion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxInclusion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBitcoin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBitcoin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBitcoin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blockhash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBitcoin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBitcoin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBitcoin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blockhash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBitcoin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmations", wireType)
+			}
+			m.Confirmations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBitcoin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Confirmations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBitcoin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBitcoin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBitcoin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Outputs = append(m.Outputs, &TxOutput{})
+			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBitcoin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBitcoin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *UTXO) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Txid)
-	if l > 0 {
-		n += 1 + l + sovBitcoin(uint64(l))
-	}
-	if m.Vout != 0 {
-		n += 1 + sovBitcoin(uint64(m.Vout))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovBitcoin(uint64(l))
-	}
-	if m.Amount != 0 {
-		n += 1 + sovBitcoin(uint64(m.Amount))
-	}
-	if m.Height != 0 {
-		n += 1 + sovBitcoin(uint64(m.Height))
-	}
-	l = len(m.PubKeyScript)
-	if l > 0 {
-		n += 1 + l + sovBitcoin(uint64(l))
-	}
-	if m.IsCoinbase {
-		n += 2
-	}
-	if m.IsLocked {
-		n += 2
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovBitcoin(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozBitcoin(x uint64) (n int) {
-	return sovBitcoin(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *BlockHeader) Unmarshal(dAtA []byte) error {
+func (m *TxOutput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -779,17 +3121,17 @@ func (m *BlockHeader) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlockHeader: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxOutput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxOutput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vout", wireType)
 			}
-			m.Version = 0
+			m.Vout = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBitcoin
@@ -799,16 +3141,16 @@ func (m *BlockHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint64(b&0x7F) << shift
+				m.Vout |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var stringLen uint64
+			m.Value = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBitcoin
@@ -818,29 +3160,16 @@ func (m *BlockHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Value |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBitcoin
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBitcoin
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PkScript", wireType)
 			}
-			m.Height = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBitcoin
@@ -850,14 +3179,29 @@ func (m *BlockHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBitcoin
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBitcoin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PkScript = append(m.PkScript[:0], dAtA[iNdEx:postIndex]...)
+			if m.PkScript == nil {
+				m.PkScript = []byte{}
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousBlockHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -885,11 +3229,61 @@ func (m *BlockHeader) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousBlockHash = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBitcoin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBitcoin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBitcoin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -917,30 +3311,11 @@ func (m *BlockHeader) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MerkleRoot = string(dAtA[iNdEx:postIndex])
+			m.Txid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBitcoin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Blockhash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -968,13 +3343,32 @@ func (m *BlockHeader) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bits = string(dAtA[iNdEx:postIndex])
+			m.Blockhash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBitcoin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredConfirmations", wireType)
 			}
-			m.Time = 0
+			m.RequiredConfirmations = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBitcoin
@@ -984,16 +3378,16 @@ func (m *BlockHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Time |= uint64(b&0x7F) << shift
+				m.RequiredConfirmations |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ntx", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
 			}
-			m.Ntx = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBitcoin
@@ -1003,64 +3397,27 @@ func (m *BlockHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Ntx |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBitcoin(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBitcoin
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BitcoinSigningRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBitcoin
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBitcoin
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BitcoinSigningRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BitcoinSigningRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevTxBytes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1088,11 +3445,11 @@ func (m *BitcoinSigningRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.PrevTxBytes = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1120,11 +3477,11 @@ func (m *BitcoinSigningRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Txid = string(dAtA[iNdEx:postIndex])
+			m.TxBytes = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Psbt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1152,13 +3509,13 @@ func (m *BitcoinSigningRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Psbt = string(dAtA[iNdEx:postIndex])
+			m.Proof = append(m.Proof, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxOutProof", wireType)
 			}
-			m.Status = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBitcoin
@@ -1168,16 +3525,29 @@ func (m *BitcoinSigningRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= SigningStatus(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBitcoin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBitcoin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxOutProof = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsCoinbase", wireType)
 			}
-			m.Sequence = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBitcoin
@@ -1187,14 +3557,15 @@ func (m *BitcoinSigningRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			m.IsCoinbase = bool(v != 0)
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InputPrevTxs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1222,7 +3593,7 @@ func (m *BitcoinSigningRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VaultAddress = string(dAtA[iNdEx:postIndex])
+			m.InputPrevTxs = append(m.InputPrevTxs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1245,7 +3616,7 @@ func (m *BitcoinSigningRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UTXO) Unmarshal(dAtA []byte) error {
+func (m *BridgeDegradation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1268,17 +3639,17 @@ func (m *UTXO) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UTXO: wiretype end group for non-group")
+			return fmt.Errorf("proto: BridgeDegradation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UTXO: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BridgeDegradation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
 			}
-			var stringLen uint64
+			m.Since = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBitcoin
@@ -1288,29 +3659,16 @@ func (m *UTXO) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Since |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBitcoin
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBitcoin
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Txid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceHeight", wireType)
 			}
-			m.Vout = 0
+			m.SinceHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBitcoin
@@ -1320,14 +3678,14 @@ func (m *UTXO) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Vout |= uint64(b&0x7F) << shift
+				m.SinceHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BestHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1355,13 +3713,13 @@ func (m *UTXO) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.BestHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BestHeight", wireType)
 			}
-			m.Amount = 0
+			m.BestHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBitcoin
@@ -1371,35 +3729,66 @@ func (m *UTXO) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				m.BestHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBitcoin(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBitcoin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBitcoin
 			}
-		case 6:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddressLink) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBitcoin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressLink: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressLink: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyScript", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBitcoin
@@ -1409,31 +3798,29 @@ func (m *UTXO) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBitcoin
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBitcoin
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PubKeyScript = append(m.PubKeyScript[:0], dAtA[iNdEx:postIndex]...)
-			if m.PubKeyScript == nil {
-				m.PubKeyScript = []byte{}
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsCoinbase", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcAddress", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBitcoin
@@ -1443,32 +3830,24 @@ func (m *UTXO) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsCoinbase = bool(v != 0)
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsLocked", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBitcoin
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBitcoin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBitcoin
 			}
-			m.IsLocked = bool(v != 0)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBitcoin(dAtA[iNdEx:])
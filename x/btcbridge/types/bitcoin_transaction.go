@@ -1,14 +1,16 @@
 package types
 
 import (
+	"encoding/hex"
+
+	sdkerrors "cosmossdk.io/errors"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/mempool"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
-
-	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
@@ -21,26 +23,23 @@ const (
 
 // BuildPsbt builds a bitcoin psbt from the given params.
 // Assume that the utxo script type is native segwit.
-func BuildPsbt(utxos []*UTXO, recipient string, amount int64, feeRate int64, change string) (*psbt.Packet, []*UTXO, *UTXO, error) {
-	chaincfg := sdk.GetConfig().GetBtcChainCfg()
-	recipientAddr, err := btcutil.DecodeAddress(recipient, chaincfg)
+func BuildPsbt(utxos []*UTXO, recipient string, amount int64, feeRate int64, change string, chainCfg *chaincfg.Params) (*psbt.Packet, []*UTXO, *UTXO, error) {
+	txOut, err := PayToAddrTxOut(recipient, amount, chainCfg)
 	if err != nil {
 		return nil, nil, nil, err
 	}
 
-	recipientPkScript, err := txscript.PayToAddrScript(recipientAddr)
-	if err != nil {
-		return nil, nil, nil, err
-	}
+	return BuildPsbtWithOutputs(utxos, []*wire.TxOut{txOut}, feeRate, change, chainCfg)
+}
 
-	changeAddr, err := btcutil.DecodeAddress(change, chaincfg)
+// BuildPsbtWithOutputs builds a bitcoin psbt which pays the given outputs, the change returned to the change address.
+// Assume that the utxo script type is native segwit.
+func BuildPsbtWithOutputs(utxos []*UTXO, txOuts []*wire.TxOut, feeRate int64, change string, chainCfg *chaincfg.Params) (*psbt.Packet, []*UTXO, *UTXO, error) {
+	changeAddr, err := btcutil.DecodeAddress(change, chainCfg)
 	if err != nil {
 		return nil, nil, nil, err
 	}
 
-	txOuts := make([]*wire.TxOut, 0)
-	txOuts = append(txOuts, wire.NewTxOut(amount, recipientPkScript))
-
 	unsignedTx, selectedUTXOs, changeUTXO, err := BuildUnsignedTransaction(utxos, txOuts, feeRate, changeAddr)
 	if err != nil {
 		return nil, nil, nil, err
@@ -59,6 +58,162 @@ func BuildPsbt(utxos []*UTXO, recipient string, amount int64, feeRate int64, cha
 	return p, selectedUTXOs, changeUTXO, nil
 }
 
+// PayToAddrTxOut returns the output which pays the given amount to the address
+func PayToAddrTxOut(address string, amount int64, chainCfg *chaincfg.Params) (*wire.TxOut, error) {
+	addr, err := btcutil.DecodeAddress(address, chainCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return wire.NewTxOut(amount, pkScript), nil
+}
+
+// BuildSweepPsbt builds a psbt which spends exactly the given utxos to the recipient, the fee deducted from the output.
+// Assume that the utxo script type is native segwit.
+func BuildSweepPsbt(utxos []*UTXO, recipient string, feeRate int64, chainCfg *chaincfg.Params) (*psbt.Packet, error) {
+	if len(utxos) == 0 {
+		return nil, ErrInsufficientUTXOs
+	}
+
+	recipientAddr, err := btcutil.DecodeAddress(recipient, chainCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	recipientPkScript, err := txscript.PayToAddrScript(recipientAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := wire.NewMsgTx(TxVersion)
+
+	inAmount := int64(0)
+	for _, utxo := range utxos {
+		hash, err := chainhash.NewHashFromStr(utxo.Txid)
+		if err != nil {
+			return nil, err
+		}
+
+		tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(hash, uint32(utxo.Vout)), nil, nil))
+		inAmount += int64(utxo.Amount)
+	}
+
+	tx.AddTxOut(wire.NewTxOut(0, recipientPkScript))
+
+	fee := GetTxVirtualSize(tx, utxos) * feeRate
+
+	tx.TxOut[0].Value = inAmount - fee
+	if tx.TxOut[0].Value <= 0 {
+		return nil, ErrInsufficientUTXOs
+	}
+
+	if mempool.IsDust(tx.TxOut[0], MinRelayFee) {
+		return nil, ErrDustOutput
+	}
+
+	p, err := psbt.NewFromUnsignedTx(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, utxo := range utxos {
+		p.Inputs[i].SighashType = txscript.SigHashAll
+		p.Inputs[i].WitnessUtxo = wire.NewTxOut(int64(utxo.Amount), utxo.PubKeyScript)
+	}
+
+	return p, nil
+}
+
+// BuildRecoveryPsbt builds a psbt which sweeps the given vault utxos to the recipient by the recovery script path
+// of the vault descriptor. The inputs carry the recovery delay as the relative lock time required by OP_CHECKSEQUENCEVERIFY.
+func BuildRecoveryPsbt(utxos []*UTXO, recipient string, feeRate int64, descriptor *VaultDescriptor, chainCfg *chaincfg.Params) (*psbt.Packet, error) {
+	if len(utxos) == 0 {
+		return nil, ErrInsufficientUTXOs
+	}
+
+	recipientAddr, err := btcutil.DecodeAddress(recipient, chainCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	recipientPkScript, err := txscript.PayToAddrScript(recipientAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	recoveryScript, err := descriptor.RecoveryScript()
+	if err != nil {
+		return nil, err
+	}
+
+	controlBlock, err := descriptor.RecoveryControlBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	internalKey, err := hex.DecodeString(descriptor.InternalKey)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := wire.NewMsgTx(TxVersion)
+
+	inAmount := int64(0)
+	for _, utxo := range utxos {
+		hash, err := chainhash.NewHashFromStr(utxo.Txid)
+		if err != nil {
+			return nil, err
+		}
+
+		txIn := wire.NewTxIn(wire.NewOutPoint(hash, uint32(utxo.Vout)), nil, nil)
+		txIn.Sequence = descriptor.RecoveryDelay
+
+		tx.AddTxIn(txIn)
+		inAmount += int64(utxo.Amount)
+	}
+
+	tx.AddTxOut(wire.NewTxOut(0, recipientPkScript))
+
+	// estimate the fee with the script path witness: <signature> <recovery script> <control block>
+	sizingTx := tx.Copy()
+	for _, txIn := range sizingTx.TxIn {
+		txIn.Witness = wire.TxWitness{make([]byte, 64), recoveryScript, controlBlock}
+	}
+
+	fee := mempool.GetTxVirtualSize(btcutil.NewTx(sizingTx)) * feeRate
+
+	tx.TxOut[0].Value = inAmount - fee
+	if tx.TxOut[0].Value <= 0 {
+		return nil, ErrInsufficientUTXOs
+	}
+
+	if mempool.IsDust(tx.TxOut[0], MinRelayFee) {
+		return nil, ErrDustOutput
+	}
+
+	p, err := psbt.NewFromUnsignedTx(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, utxo := range utxos {
+		p.Inputs[i].WitnessUtxo = wire.NewTxOut(int64(utxo.Amount), utxo.PubKeyScript)
+		p.Inputs[i].TaprootInternalKey = internalKey
+		p.Inputs[i].TaprootLeafScript = []*psbt.TaprootTapLeafScript{{
+			ControlBlock: controlBlock,
+			Script:       recoveryScript,
+			LeafVersion:  txscript.BaseLeafVersion,
+		}}
+	}
+
+	return p, nil
+}
+
 // BuildUnsignedTransaction builds an unsigned tx from the given params.
 func BuildUnsignedTransaction(utxos []*UTXO, txOuts []*wire.TxOut, feeRate int64, change btcutil.Address) (*wire.MsgTx, []*UTXO, *UTXO, error) {
 	tx := wire.NewMsgTx(TxVersion)
@@ -184,8 +339,8 @@ func GetTxVirtualSize(tx *wire.MsgTx, utxos []*UTXO) int64 {
 }
 
 // CheckOutput checks the given output
-func CheckOutput(address string, amount int64) error {
-	addr, err := btcutil.DecodeAddress(address, sdk.GetConfig().GetBtcChainCfg())
+func CheckOutput(address string, amount int64, chainCfg *chaincfg.Params) error {
+	addr, err := btcutil.DecodeAddress(address, chainCfg)
 	if err != nil {
 		return err
 	}
@@ -201,3 +356,33 @@ func CheckOutput(address string, amount int64) error {
 
 	return nil
 }
+
+// CheckWithdrawOutput checks the given withdrawal output, which must pay to a P2WPKH, P2TR, P2WSH or P2SH address
+// of the given network above the dust limit
+func CheckWithdrawOutput(address string, amount int64, chainCfg *chaincfg.Params) error {
+	addr, err := btcutil.DecodeAddress(address, chainCfg)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidBtcAddress, "%s: %s", address, err)
+	}
+
+	if !addr.IsForNet(chainCfg) {
+		return sdkerrors.Wrapf(ErrInvalidBtcAddress, "%s is not for the %s network", address, chainCfg.Name)
+	}
+
+	switch addr.(type) {
+	case *btcutil.AddressWitnessPubKeyHash, *btcutil.AddressTaproot, *btcutil.AddressWitnessScriptHash, *btcutil.AddressScriptHash:
+	default:
+		return sdkerrors.Wrapf(ErrUnsupportedScriptType, "unsupported withdrawal address %s", address)
+	}
+
+	return CheckOutput(address, amount, chainCfg)
+}
+
+// RecipientAddresses returns the bitcoin addresses paid by the signing request
+func (r *BitcoinSigningRequest) RecipientAddresses() []string {
+	if len(r.Recipients) != 0 {
+		return r.Recipients
+	}
+
+	return []string{r.Address}
+}
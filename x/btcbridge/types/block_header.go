@@ -0,0 +1,22 @@
+package types
+
+import (
+	"strconv"
+
+	"github.com/btcsuite/btcd/wire"
+)
+
+// NewBlockHeader creates the block header from the given bitcoin block header
+func NewBlockHeader(header *wire.BlockHeader, height uint64, ntx uint64) *BlockHeader {
+	return &BlockHeader{
+		Version:           uint64(header.Version),
+		Hash:              header.BlockHash().String(),
+		Height:            height,
+		PreviousBlockHash: header.PrevBlock.String(),
+		MerkleRoot:        header.MerkleRoot.String(),
+		Nonce:             uint64(header.Nonce),
+		Bits:              strconv.FormatUint(uint64(header.Bits), 16),
+		Time:              uint64(header.Timestamp.Unix()),
+		Ntx:               ntx,
+	}
+}
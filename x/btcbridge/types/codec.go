@@ -13,6 +13,15 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSubmitDepositTransactionRequest{}, "btcbridge/MsgSubmitDepositTransactionRequest", nil)
 	cdc.RegisterConcrete(&MsgSubmitWithdrawSignaturesRequest{}, "btcbridge/MsgSubmitWithdrawSignaturesRequest", nil)
 	cdc.RegisterConcrete(&MsgSubmitWithdrawTransactionRequest{}, "btcbridge/MsgSubmitWithdrawTransactionRequest", nil)
+	cdc.RegisterConcrete(&MsgLinkBitcoinAddressRequest{}, "btcbridge/MsgLinkBitcoinAddressRequest", nil)
+	cdc.RegisterConcrete(&MsgCreateHTLCRequest{}, "btcbridge/MsgCreateHTLCRequest", nil)
+	cdc.RegisterConcrete(&MsgClaimHTLCRequest{}, "btcbridge/MsgClaimHTLCRequest", nil)
+	cdc.RegisterConcrete(&MsgUpdateParamsRequest{}, "btcbridge/MsgUpdateParamsRequest", nil)
+	cdc.RegisterConcrete(&MsgUpdateBlocklistRequest{}, "btcbridge/MsgUpdateBlocklistRequest", nil)
+	cdc.RegisterConcrete(&MsgReleaseQuarantinedDepositRequest{}, "btcbridge/MsgReleaseQuarantinedDepositRequest", nil)
+	cdc.RegisterConcrete(&MsgReturnQuarantinedDepositRequest{}, "btcbridge/MsgReturnQuarantinedDepositRequest", nil)
+	cdc.RegisterConcrete(&MsgRecoverVaultRequest{}, "btcbridge/MsgRecoverVaultRequest", nil)
+	cdc.RegisterConcrete(&MsgTagUTXORequest{}, "btcbridge/MsgTagUTXORequest", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -22,6 +31,15 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSubmitDepositTransactionRequest{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSubmitWithdrawSignaturesRequest{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSubmitWithdrawTransactionRequest{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgLinkBitcoinAddressRequest{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgCreateHTLCRequest{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgClaimHTLCRequest{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUpdateParamsRequest{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUpdateBlocklistRequest{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgReleaseQuarantinedDepositRequest{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgReturnQuarantinedDepositRequest{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgRecoverVaultRequest{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgTagUTXORequest{})
 	// this line is used by starport scaffolding # 3
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,155 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// RequiredConfirmations returns the confirmations required by the deposit or withdrawal of the given asset type and amount,
+// i.e. those of the highest tier reached by the amount, or the default confirmations if no tier is reached
+func (p Params) RequiredConfirmations(assetType AssetType, amount uint64) int32 {
+	var tier *ConfirmationTier
+	for _, t := range p.ConfirmationTiers {
+		if t.AssetType != assetType || t.MinAmount > amount {
+			continue
+		}
+
+		if tier == nil || t.MinAmount > tier.MinAmount {
+			tier = t
+		}
+	}
+
+	if tier == nil {
+		return p.Confirmations
+	}
+
+	return tier.Confirmations
+}
+
+// DepositConfirmations returns the confirmations required by the given deposit tx,
+// i.e. the most required by the total amount paid to the vaults of each asset type.
+// The coinbase deposit requires the coinbase maturity of the network at least.
+func (p Params) DepositConfirmations(tx *wire.MsgTx, chainCfg *chaincfg.Params) int32 {
+	amounts := make(map[AssetType]uint64)
+	for _, out := range tx.TxOut {
+		pks, err := txscript.ParsePkScript(out.PkScript)
+		if err != nil {
+			continue
+		}
+		addr, err := pks.Address(chainCfg)
+		if err != nil {
+			continue
+		}
+
+		if vault := SelectVaultByBitcoinAddress(p.Vaults, addr.EncodeAddress()); vault != nil {
+			amounts[vault.AssetType] += uint64(out.Value)
+		}
+	}
+
+	// the tx paying no vault is not a deposit, which requires the default confirmations
+	if len(amounts) == 0 {
+		return p.Confirmations
+	}
+
+	confirmations := int32(0)
+	for assetType, amount := range amounts {
+		if required := p.RequiredConfirmations(assetType, amount); required > confirmations {
+			confirmations = required
+		}
+	}
+
+	if maturity := int32(chainCfg.CoinbaseMaturity); blockchain.IsCoinBaseTx(tx) && confirmations < maturity {
+		confirmations = maturity
+	}
+
+	return confirmations
+}
+
+// IsMature returns true if the utxo can be spent after the given best height,
+// i.e. it is not a coinbase output or the coinbase output has reached the coinbase maturity of the network
+func (u *UTXO) IsMature(bestHeight uint64, chainCfg *chaincfg.Params) bool {
+	return !u.IsCoinbase || bestHeight >= u.Height+uint64(chainCfg.CoinbaseMaturity)
+}
+
+// IsRecoverable returns true if the utxo can be spent by the recovery script path of the given delay
+// in the block following the given best height, i.e. the utxo is confirmed and the relative lock time has elapsed
+func (u *UTXO) IsRecoverable(bestHeight uint64, delay uint32) bool {
+	return u.Height != 0 && bestHeight+1 >= u.Height+uint64(delay)
+}
+
+// State returns the state of the pending deposit
+func (d *PendingDeposit) State() PendingDepositState {
+	if d.IsCoinbase {
+		return PendingDepositState_PENDING_DEPOSIT_STATE_MATURING
+	}
+
+	return PendingDepositState_PENDING_DEPOSIT_STATE_CONFIRMING
+}
+
+// ConfirmedHeight returns the height of the best block at which the pending deposit is confirmed and minted
+func (d *PendingDeposit) ConfirmedHeight() uint64 {
+	return d.Height + uint64(d.RequiredConfirmations)
+}
+
+// MinConfirmations returns the fewest confirmations required by any deposit or withdrawal
+func (p Params) MinConfirmations() int32 {
+	confirmations := p.Confirmations
+	for _, tier := range p.ConfirmationTiers {
+		if tier.Confirmations < confirmations {
+			confirmations = tier.Confirmations
+		}
+	}
+
+	return confirmations
+}
+
+// MaxConfirmations returns the most confirmations required by any deposit or withdrawal
+func (p Params) MaxConfirmations() int32 {
+	confirmations := p.Confirmations
+	for _, tier := range p.ConfirmationTiers {
+		if tier.Confirmations > confirmations {
+			confirmations = tier.Confirmations
+		}
+	}
+
+	return confirmations
+}
+
+// validateConfirmationTiers validates the confirmation tiers, which must not require
+// fewer confirmations for a higher amount of the same asset type
+func validateConfirmationTiers(tiers []*ConfirmationTier) error {
+	for i, tier := range tiers {
+		if tier.AssetType == AssetType_ASSET_TYPE_UNSPECIFIED {
+			return fmt.Errorf("asset type of the confirmation tier must be specified")
+		}
+
+		if tier.Confirmations < 0 {
+			return fmt.Errorf("confirmations of the %s tier %d must not be negative", tier.AssetType, tier.MinAmount)
+		}
+
+		for _, other := range tiers[:i] {
+			if other.AssetType != tier.AssetType {
+				continue
+			}
+
+			if other.MinAmount == tier.MinAmount {
+				return fmt.Errorf("duplicate %s confirmation tier %d", tier.AssetType, tier.MinAmount)
+			}
+
+			lower, higher := other, tier
+			if lower.MinAmount > higher.MinAmount {
+				lower, higher = tier, other
+			}
+
+			if lower.Confirmations > higher.Confirmations {
+				return fmt.Errorf("%s confirmation tiers must require more confirmations for higher amounts", tier.AssetType)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,212 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+)
+
+const (
+	// tag of the deposit memo, which is the first data push of the OP_RETURN output
+	DepositMemoTag = "side"
+
+	// deposit memo actions
+	DepositMemoActionIBCTransfer = "ibc"
+	DepositMemoActionSwap        = "swap"
+
+	// timeout of the ibc transfer of the minted vouchers
+	DepositIBCTransferTimeout = 10 * time.Minute
+)
+
+// DepositMemo is the memo carried by the OP_RETURN output of the deposit transaction.
+// The script format is:
+//
+//	OP_RETURN <"side"> <recipient>
+//	OP_RETURN <"side"> <recipient> <"ibc"> <channel> <receiver>
+//	OP_RETURN <"side"> <recipient> <"swap"> <pool id> <denom> <min out>
+type DepositMemo struct {
+	// the side address to be credited
+	Recipient string
+	// the optional ibc transfer of the minted vouchers
+	IBCTransfer *DepositIBCTransfer
+	// the optional swap of the minted vouchers
+	Swap *DepositSwap
+}
+
+// DepositIBCTransfer transfers the minted vouchers over the channel to the receiver
+type DepositIBCTransfer struct {
+	Channel  string
+	Receiver string
+}
+
+// DepositSwap swaps the minted vouchers into the denom in the gmm pool
+type DepositSwap struct {
+	PoolId string
+	Denom  string
+	MinOut sdkmath.Int
+}
+
+// HasAction returns true if the memo carries an action to be performed after minting
+func (m *DepositMemo) HasAction() bool {
+	return m.IBCTransfer != nil || m.Swap != nil
+}
+
+// Action returns the action name of the memo
+func (m *DepositMemo) Action() string {
+	switch {
+	case m.IBCTransfer != nil:
+		return DepositMemoActionIBCTransfer
+	case m.Swap != nil:
+		return DepositMemoActionSwap
+	default:
+		return ""
+	}
+}
+
+// Validate validates the memo
+func (m *DepositMemo) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+		return errorsmod.Wrapf(ErrInvalidDepositMemo, "invalid recipient %s", m.Recipient)
+	}
+
+	if m.IBCTransfer != nil && m.Swap != nil {
+		return errorsmod.Wrap(ErrInvalidDepositMemo, "only one action allowed")
+	}
+
+	if m.IBCTransfer != nil {
+		if !channeltypes.IsValidChannelID(m.IBCTransfer.Channel) {
+			return errorsmod.Wrapf(ErrInvalidDepositMemo, "invalid channel %s", m.IBCTransfer.Channel)
+		}
+
+		if len(m.IBCTransfer.Receiver) == 0 {
+			return errorsmod.Wrap(ErrInvalidDepositMemo, "receiver cannot be empty")
+		}
+	}
+
+	if m.Swap != nil {
+		if len(m.Swap.PoolId) == 0 {
+			return errorsmod.Wrap(ErrInvalidDepositMemo, "pool id cannot be empty")
+		}
+
+		if err := sdk.ValidateDenom(m.Swap.Denom); err != nil {
+			return errorsmod.Wrap(ErrInvalidDepositMemo, err.Error())
+		}
+
+		if m.Swap.MinOut.IsNil() || !m.Swap.MinOut.IsPositive() {
+			return errorsmod.Wrap(ErrInvalidDepositMemo, "min out must be positive")
+		}
+	}
+
+	return nil
+}
+
+// Script returns the OP_RETURN script of the memo
+func (m *DepositMemo) Script() ([]byte, error) {
+	builder := txscript.NewScriptBuilder().AddOp(txscript.OP_RETURN).
+		AddData([]byte(DepositMemoTag)).AddData([]byte(m.Recipient))
+
+	switch {
+	case m.IBCTransfer != nil:
+		builder.AddData([]byte(DepositMemoActionIBCTransfer)).
+			AddData([]byte(m.IBCTransfer.Channel)).
+			AddData([]byte(m.IBCTransfer.Receiver))
+
+	case m.Swap != nil:
+		builder.AddData([]byte(DepositMemoActionSwap)).
+			AddData([]byte(m.Swap.PoolId)).
+			AddData([]byte(m.Swap.Denom)).
+			AddData([]byte(m.Swap.MinOut.String()))
+	}
+
+	return builder.Script()
+}
+
+// ParseDepositMemo parses the deposit memo from the given OP_RETURN script.
+// Nil is returned if the script is not a deposit memo.
+func ParseDepositMemo(pkScript []byte) (*DepositMemo, error) {
+	tokenizer := txscript.MakeScriptTokenizer(0, pkScript)
+	if !tokenizer.Next() || tokenizer.Opcode() != txscript.OP_RETURN {
+		return nil, nil
+	}
+
+	fields := make([]string, 0)
+	for tokenizer.Next() {
+		if tokenizer.Opcode() > txscript.OP_PUSHDATA4 {
+			return nil, nil
+		}
+
+		fields = append(fields, string(tokenizer.Data()))
+	}
+
+	if tokenizer.Err() != nil || len(fields) == 0 || fields[0] != DepositMemoTag {
+		return nil, nil
+	}
+
+	fields = fields[1:]
+	if len(fields) == 0 {
+		return nil, errorsmod.Wrap(ErrInvalidDepositMemo, "recipient missing")
+	}
+
+	memo := &DepositMemo{Recipient: fields[0]}
+
+	if len(fields) > 1 {
+		switch action, args := fields[1], fields[2:]; action {
+		case DepositMemoActionIBCTransfer:
+			if len(args) != 2 {
+				return nil, errorsmod.Wrap(ErrInvalidDepositMemo, "ibc transfer requires channel and receiver")
+			}
+
+			memo.IBCTransfer = &DepositIBCTransfer{Channel: args[0], Receiver: args[1]}
+
+		case DepositMemoActionSwap:
+			if len(args) != 3 {
+				return nil, errorsmod.Wrap(ErrInvalidDepositMemo, "swap requires pool id, denom and min out")
+			}
+
+			minOut, ok := sdkmath.NewIntFromString(args[2])
+			if !ok {
+				return nil, errorsmod.Wrapf(ErrInvalidDepositMemo, "invalid min out %s", args[2])
+			}
+
+			memo.Swap = &DepositSwap{PoolId: args[0], Denom: args[1], MinOut: minOut}
+
+		default:
+			return nil, errorsmod.Wrapf(ErrInvalidDepositMemo, "unknown action %s", action)
+		}
+	}
+
+	if err := memo.Validate(); err != nil {
+		return nil, err
+	}
+
+	return memo, nil
+}
+
+// ExtractDepositMemo extracts the deposit memo from the first OP_RETURN output carrying it.
+// Nil is returned if there is no deposit memo.
+func ExtractDepositMemo(tx *wire.MsgTx) (*DepositMemo, error) {
+	for _, out := range tx.TxOut {
+		if !IsOpReturnScript(out.PkScript) {
+			continue
+		}
+
+		memo, err := ParseDepositMemo(out.PkScript)
+		if err != nil || memo != nil {
+			return memo, err
+		}
+	}
+
+	return nil, nil
+}
+
+// IsOpReturnScript returns true if the given script starts with OP_RETURN.
+// Note that the deposit memo carries multiple data pushes, which is not the standard null data script.
+func IsOpReturnScript(pkScript []byte) bool {
+	return len(pkScript) > 0 && pkScript[0] == txscript.OP_RETURN
+}
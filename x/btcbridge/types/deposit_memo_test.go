@@ -0,0 +1,81 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sideprotocol/side/testutil/sample"
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+func TestParseDepositMemo(t *testing.T) {
+	recipient := sample.AccAddress()
+
+	memos := []*types.DepositMemo{
+		{Recipient: recipient},
+		{Recipient: recipient, IBCTransfer: &types.DepositIBCTransfer{Channel: "channel-1", Receiver: "osmo1receiver"}},
+		{Recipient: recipient, Swap: &types.DepositSwap{PoolId: "pool1", Denom: "uside", MinOut: sdk.NewInt(100)}},
+	}
+
+	for _, memo := range memos {
+		script, err := memo.Script()
+		require.NoError(t, err)
+
+		parsed, err := types.ParseDepositMemo(script)
+		require.NoError(t, err)
+		require.Equal(t, memo, parsed)
+
+		tx := wire.NewMsgTx(2)
+		tx.AddTxOut(wire.NewTxOut(1000, []byte{txscript.OP_TRUE}))
+		tx.AddTxOut(wire.NewTxOut(0, script))
+
+		extracted, err := types.ExtractDepositMemo(tx)
+		require.NoError(t, err)
+		require.Equal(t, memo, extracted)
+	}
+}
+
+func TestParseInvalidDepositMemo(t *testing.T) {
+	build := func(fields ...string) []byte {
+		builder := txscript.NewScriptBuilder().AddOp(txscript.OP_RETURN)
+		for _, field := range fields {
+			builder.AddData([]byte(field))
+		}
+
+		script, err := builder.Script()
+		require.NoError(t, err)
+
+		return script
+	}
+
+	recipient := sample.AccAddress()
+
+	// not a deposit memo
+	for _, script := range [][]byte{
+		build("other", recipient),
+		build(),
+		{txscript.OP_TRUE},
+	} {
+		memo, err := types.ParseDepositMemo(script)
+		require.NoError(t, err)
+		require.Nil(t, memo)
+	}
+
+	// invalid deposit memo
+	for _, script := range [][]byte{
+		build(types.DepositMemoTag),
+		build(types.DepositMemoTag, "invalid"),
+		build(types.DepositMemoTag, recipient, "unknown"),
+		build(types.DepositMemoTag, recipient, types.DepositMemoActionIBCTransfer, "invalid", "receiver"),
+		build(types.DepositMemoTag, recipient, types.DepositMemoActionIBCTransfer, "channel-1"),
+		build(types.DepositMemoTag, recipient, types.DepositMemoActionSwap, "pool1", "uside", "0"),
+		build(types.DepositMemoTag, recipient, types.DepositMemoActionSwap, "pool1", "uside", "abc"),
+	} {
+		_, err := types.ParseDepositMemo(script)
+		require.ErrorIs(t, err, types.ErrInvalidDepositMemo)
+	}
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 )
@@ -22,6 +23,11 @@ func ExtractRecipientAddr(tx *wire.MsgTx, prevTx *wire.MsgTx, vaults []*Vault, c
 
 	// extract from the tx out which is a non-vault address
 	for _, out := range tx.TxOut {
+		// skip the memo output
+		if IsOpReturnScript(out.PkScript) {
+			continue
+		}
+
 		pkScript, err := txscript.ParsePkScript(out.PkScript)
 		if err != nil {
 			return nil, err
@@ -49,10 +55,54 @@ func ExtractRecipientAddr(tx *wire.MsgTx, prevTx *wire.MsgTx, vaults []*Vault, c
 	}
 
 	// fallback to extract from the first input
-	pkScript, err := txscript.ParsePkScript(prevTx.TxOut[tx.TxIn[0].PreviousOutPoint.Index].PkScript)
+	return ExtractSenderAddr(tx, prevTx, chainCfg)
+}
+
+// ExtractSenderAddr extracts the sender address from the first input of the tx
+func ExtractSenderAddr(tx *wire.MsgTx, prevTx *wire.MsgTx, chainCfg *chaincfg.Params) (btcutil.Address, error) {
+	index := tx.TxIn[0].PreviousOutPoint.Index
+	if int(index) >= len(prevTx.TxOut) {
+		return nil, ErrInvalidBtcTransaction
+	}
+
+	pkScript, err := txscript.ParsePkScript(prevTx.TxOut[index].PkScript)
 	if err != nil {
 		return nil, err
 	}
 
 	return pkScript.Address(chainCfg)
 }
+
+// ExtractInputAddrs extracts the distinct addresses spent by the inputs of the tx, along with the number of the inputs
+// which can not be resolved as the txs spent by them are not given.
+// prevTxs are the txs spent by the inputs, keyed by the tx hash; the inputs spending the non-standard scripts are skipped.
+func ExtractInputAddrs(tx *wire.MsgTx, prevTxs map[chainhash.Hash]*wire.MsgTx, chainCfg *chaincfg.Params) ([]string, int) {
+	addresses := make([]string, 0, len(tx.TxIn))
+	seen := make(map[string]bool)
+
+	unresolved := 0
+	for _, in := range tx.TxIn {
+		prevTx, ok := prevTxs[in.PreviousOutPoint.Hash]
+		if !ok || int(in.PreviousOutPoint.Index) >= len(prevTx.TxOut) {
+			unresolved++
+			continue
+		}
+
+		pkScript, err := txscript.ParsePkScript(prevTx.TxOut[in.PreviousOutPoint.Index].PkScript)
+		if err != nil {
+			continue
+		}
+
+		addr, err := pkScript.Address(chainCfg)
+		if err != nil {
+			continue
+		}
+
+		if address := addr.EncodeAddress(); !seen[address] {
+			seen[address] = true
+			addresses = append(addresses, address)
+		}
+	}
+
+	return addresses, unresolved
+}
@@ -13,21 +13,34 @@ var (
 	ErrReorgFailed                = errorsmod.Register(ModuleName, 1101, "failed to reorg chain")
 	ErrForkedBlockHeader          = errorsmod.Register(ModuleName, 1102, "Invalid forked block header")
 
+	ErrAlreadyAttested = errorsmod.Register(ModuleName, 1200, "relayer already attested")
+
+	ErrBridgeDegraded = errorsmod.Register(ModuleName, 1300, "bridge degraded as the block headers lag behind")
+
 	ErrInvalidSenders = errorsmod.Register(ModuleName, 2100, "invalid allowed senders")
 
+	ErrInvalidBtcAddress       = errorsmod.Register(ModuleName, 2200, "invalid bitcoin address")
+	ErrInvalidAddressSignature = errorsmod.Register(ModuleName, 2201, "invalid address signature")
+
+	ErrUnknownChain = errorsmod.Register(ModuleName, 2300, "unknown bridged chain")
+
 	ErrInvalidBtcTransaction     = errorsmod.Register(ModuleName, 3100, "invalid bitcoin transaction")
 	ErrBlockNotFound             = errorsmod.Register(ModuleName, 3101, "block not found")
 	ErrTransactionNotIncluded    = errorsmod.Register(ModuleName, 3102, "transaction not included in block")
+	ErrInvalidTxOutProof         = errorsmod.Register(ModuleName, 3103, "invalid tx out proof")
 	ErrNotConfirmed              = errorsmod.Register(ModuleName, 3200, "transaction not confirmed")
 	ErrExceedMaxAcceptanceDepth  = errorsmod.Register(ModuleName, 3201, "exceed max acceptance block depth")
 	ErrUnsupportedScriptType     = errorsmod.Register(ModuleName, 3202, "unsupported script type")
 	ErrTransactionAlreadyMinted  = errorsmod.Register(ModuleName, 3203, "transaction already minted")
 	ErrInvalidDepositTransaction = errorsmod.Register(ModuleName, 3204, "invalid deposit transaction")
+	ErrInvalidDepositMemo        = errorsmod.Register(ModuleName, 3205, "invalid deposit memo")
+	ErrDepositAlreadyPending     = errorsmod.Register(ModuleName, 3206, "deposit already pending")
 
 	ErrInvalidSignatures      = errorsmod.Register(ModuleName, 4200, "invalid signatures")
 	ErrInsufficientBalance    = errorsmod.Register(ModuleName, 4201, "insufficient balance")
 	ErrSigningRequestNotExist = errorsmod.Register(ModuleName, 4202, "signing request does not exist")
 	ErrInvalidStatus          = errorsmod.Register(ModuleName, 4203, "invalid status")
+	ErrVaultNotRecoverable    = errorsmod.Register(ModuleName, 4204, "vault not recoverable")
 
 	ErrUTXODoesNotExist = errorsmod.Register(ModuleName, 5100, "utxo does not exist")
 	ErrUTXOLocked       = errorsmod.Register(ModuleName, 5101, "utxo locked")
@@ -38,4 +51,22 @@ var (
 	ErrDustOutput          = errorsmod.Register(ModuleName, 6102, "dust output value")
 	ErrInsufficientUTXOs   = errorsmod.Register(ModuleName, 6103, "insufficient utxos")
 	ErrFailToSerializePsbt = errorsmod.Register(ModuleName, 6104, "failed to serialize psbt")
+	ErrInvalidRecipients   = errorsmod.Register(ModuleName, 6105, "invalid withdrawal recipients")
+
+	ErrInvalidBridgeOperation = errorsmod.Register(ModuleName, 6200, "invalid bridge operation")
+
+	ErrInvalidHTLC        = errorsmod.Register(ModuleName, 7100, "invalid htlc")
+	ErrHTLCNotFound       = errorsmod.Register(ModuleName, 7101, "htlc not found")
+	ErrHTLCNotOpen        = errorsmod.Register(ModuleName, 7102, "htlc not open")
+	ErrHTLCExpired        = errorsmod.Register(ModuleName, 7103, "htlc expired")
+	ErrInvalidPreimage    = errorsmod.Register(ModuleName, 7104, "invalid preimage")
+	ErrHTLCOutputNotFound = errorsmod.Register(ModuleName, 7105, "htlc output not found")
+	ErrHTLCOutputClaimed  = errorsmod.Register(ModuleName, 7106, "htlc output already claimed")
+	ErrHTLCPaidTooEarly   = errorsmod.Register(ModuleName, 7107, "htlc paid before creation")
+
+	ErrAddressBlocked             = errorsmod.Register(ModuleName, 8100, "address blocked")
+	ErrInvalidBlocklist           = errorsmod.Register(ModuleName, 8101, "invalid blocklist")
+	ErrQuarantinedDepositNotFound = errorsmod.Register(ModuleName, 8102, "quarantined deposit not found")
+	ErrQuarantinedDepositNotHeld  = errorsmod.Register(ModuleName, 8103, "quarantined deposit not held")
+	ErrInputsNotScreened          = errorsmod.Register(ModuleName, 8104, "inputs not screened")
 )
@@ -0,0 +1,30 @@
+package types
+
+const (
+	EventTypeAttestationConflict = "attestation_conflict"
+	EventTypeAttestationExpired  = "attestation_expired"
+	EventTypeDepositAction       = "deposit_action"
+	EventTypeDepositActionFailed = "deposit_action_failed"
+	EventTypeLinkBitcoinAddress  = "link_bitcoin_address"
+	EventTypeHookFailed          = "hook_failed"
+)
+
+const (
+	EventValueActionAttest = "attest"
+)
+
+const (
+	AttributeKeySender             = "sender"
+	AttributeKeyAction             = "action"
+	AttributeKeyAttestationHash    = "attestation_hash"
+	AttributeKeyAttestationSubject = "attestation_subject"
+	AttributeKeyAttestations       = "attestations"
+	AttributeKeyConflictingHash    = "conflicting_hash"
+	AttributeKeyRecipient          = "recipient"
+	AttributeKeyDepositAction      = "deposit_action"
+	AttributeKeyAmount             = "amount"
+	AttributeKeyError              = "error"
+	AttributeKeyAddress            = "address"
+	AttributeKeyBtcAddress         = "btc_address"
+	AttributeKeyHook               = "hook"
+)
@@ -1,9 +1,14 @@
 package types
 
 import (
+	"context"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktype "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+
+	gmmtypes "github.com/sideprotocol/side/x/gmm/types"
 )
 
 // AccountKeeper defines the expected account keeper used for simulations (noalias)
@@ -28,5 +33,21 @@ type BankKeeper interface {
 	BurnCoins(ctx sdk.Context, moduleName string, amounts sdk.Coins) error
 
 	HasSupply(ctx sdk.Context, denom string) bool
+	GetSupply(ctx sdk.Context, denom string) sdk.Coin
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 }
+
+// DistrKeeper defines the expected distribution keeper used for funding the community pool with the bridge fees
+type DistrKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// TransferKeeper defines the expected ibc transfer keeper used for routing the deposits
+type TransferKeeper interface {
+	Transfer(goCtx context.Context, msg *ibctransfertypes.MsgTransfer) (*ibctransfertypes.MsgTransferResponse, error)
+}
+
+// SwapKeeper defines the expected gmm swap service used for routing the deposits
+type SwapKeeper interface {
+	Swap(goCtx context.Context, msg *gmmtypes.MsgSwap) (*gmmtypes.MsgSwapResponse, error)
+}
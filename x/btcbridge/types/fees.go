@@ -0,0 +1,98 @@
+package types
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+const (
+	// the denominator of the fees and fee shares in basis points
+	MaxBasisPoints = 10000
+
+	// default number of side blocks between the fee distributions, about one day
+	DefaultFeeEpoch = 14400
+)
+
+// DepositFee returns the fee deducted from the deposit of the given amount, which is capped at the amount
+func (s *FeeSchedule) DepositFee(amount sdkmath.Int) sdkmath.Int {
+	if s == nil {
+		return sdkmath.ZeroInt()
+	}
+
+	return sdkmath.MinInt(feeOf(amount, s.DepositFlatFee, s.DepositFeeBps), amount)
+}
+
+// WithdrawFee returns the fee charged on top of the withdrawal of the given amount
+func (s *FeeSchedule) WithdrawFee(amount sdkmath.Int) sdkmath.Int {
+	if s == nil {
+		return sdkmath.ZeroInt()
+	}
+
+	return feeOf(amount, s.WithdrawFlatFee, s.WithdrawFeeBps)
+}
+
+// Validate validates the fee schedule
+func (s *FeeSchedule) Validate() error {
+	if s.AssetType == AssetType_ASSET_TYPE_UNSPECIFIED {
+		return fmt.Errorf("asset type of the fee schedule must be specified")
+	}
+
+	if s.DepositFeeBps > MaxBasisPoints || s.WithdrawFeeBps > MaxBasisPoints {
+		return fmt.Errorf("fee of the asset type %s must not exceed %d basis points", s.AssetType, MaxBasisPoints)
+	}
+
+	return nil
+}
+
+// feeOf returns the flat fee plus the proportional fee of the given amount
+func feeOf(amount sdkmath.Int, flatFee uint64, bps uint32) sdkmath.Int {
+	return sdkmath.NewIntFromUint64(flatFee).Add(amount.MulRaw(int64(bps)).QuoRaw(MaxBasisPoints))
+}
+
+// FeeSchedule returns the fee schedule of the given asset type, nil if no fee is charged
+func (p Params) FeeSchedule(assetType AssetType) *FeeSchedule {
+	for _, schedule := range p.FeeSchedules {
+		if schedule.AssetType == assetType {
+			return schedule
+		}
+	}
+
+	return nil
+}
+
+// validateFees validates the fee schedules and the fee shares
+func (p Params) validateFees() error {
+	if err := validateFeeSchedules(p.FeeSchedules); err != nil {
+		return err
+	}
+
+	if len(p.FeeSchedules) != 0 && p.FeeEpoch <= 0 {
+		return fmt.Errorf("fee epoch must be greater than zero")
+	}
+
+	if p.RelayerFeeShare+p.SignerFeeShare > MaxBasisPoints {
+		return fmt.Errorf("fee shares of the relayers and signers must not exceed %d basis points", MaxBasisPoints)
+	}
+
+	return nil
+}
+
+// validateFeeSchedules validates the given fee schedules of a chain
+func validateFeeSchedules(schedules []*FeeSchedule) error {
+	assetTypes := make(map[AssetType]bool)
+
+	for _, schedule := range schedules {
+		if err := schedule.Validate(); err != nil {
+			return err
+		}
+
+		if assetTypes[schedule.AssetType] {
+			return fmt.Errorf("duplicate fee schedule of the asset type %s", schedule.AssetType)
+		}
+
+		assetTypes[schedule.AssetType] = true
+	}
+
+	return nil
+}
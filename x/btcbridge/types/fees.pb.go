@@ -0,0 +1,458 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: side/btcbridge/fees.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BridgeOperation defines the operation charged with the bridge fee
+type BridgeOperation int32
+
+const (
+	BridgeOperation_BRIDGE_OPERATION_UNSPECIFIED BridgeOperation = 0
+	BridgeOperation_BRIDGE_OPERATION_DEPOSIT     BridgeOperation = 1
+	BridgeOperation_BRIDGE_OPERATION_WITHDRAW    BridgeOperation = 2
+)
+
+var BridgeOperation_name = map[int32]string{
+	0: "BRIDGE_OPERATION_UNSPECIFIED",
+	1: "BRIDGE_OPERATION_DEPOSIT",
+	2: "BRIDGE_OPERATION_WITHDRAW",
+}
+
+var BridgeOperation_value = map[string]int32{
+	"BRIDGE_OPERATION_UNSPECIFIED": 0,
+	"BRIDGE_OPERATION_DEPOSIT":     1,
+	"BRIDGE_OPERATION_WITHDRAW":    2,
+}
+
+func (x BridgeOperation) String() string {
+	return proto.EnumName(BridgeOperation_name, int32(x))
+}
+
+func (BridgeOperation) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2e3b4f792a7963c2, []int{0}
+}
+
+// ContributorRole defines the role of the contributor rewarded by the collected fees
+type ContributorRole int32
+
+const (
+	ContributorRole_CONTRIBUTOR_ROLE_UNSPECIFIED ContributorRole = 0
+	// submits the block headers and deposits
+	ContributorRole_CONTRIBUTOR_ROLE_RELAYER ContributorRole = 1
+	// submits the signatures of the withdrawals
+	ContributorRole_CONTRIBUTOR_ROLE_SIGNER ContributorRole = 2
+)
+
+var ContributorRole_name = map[int32]string{
+	0: "CONTRIBUTOR_ROLE_UNSPECIFIED",
+	1: "CONTRIBUTOR_ROLE_RELAYER",
+	2: "CONTRIBUTOR_ROLE_SIGNER",
+}
+
+var ContributorRole_value = map[string]int32{
+	"CONTRIBUTOR_ROLE_UNSPECIFIED": 0,
+	"CONTRIBUTOR_ROLE_RELAYER":     1,
+	"CONTRIBUTOR_ROLE_SIGNER":      2,
+}
+
+func (x ContributorRole) String() string {
+	return proto.EnumName(ContributorRole_name, int32(x))
+}
+
+func (ContributorRole) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2e3b4f792a7963c2, []int{1}
+}
+
+// FeeContribution defines the number of contributions of a relayer or signer in the current fee epoch
+type FeeContribution struct {
+	Address string          `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Role    ContributorRole `protobuf:"varint,2,opt,name=role,proto3,enum=side.btcbridge.ContributorRole" json:"role,omitempty"`
+	Count   uint64          `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *FeeContribution) Reset()         { *m = FeeContribution{} }
+func (m *FeeContribution) String() string { return proto.CompactTextString(m) }
+func (*FeeContribution) ProtoMessage()    {}
+func (*FeeContribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e3b4f792a7963c2, []int{0}
+}
+func (m *FeeContribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeContribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeContribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeContribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeContribution.Merge(m, src)
+}
+func (m *FeeContribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeContribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeContribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeContribution proto.InternalMessageInfo
+
+func (m *FeeContribution) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *FeeContribution) GetRole() ContributorRole {
+	if m != nil {
+		return m.Role
+	}
+	return ContributorRole_CONTRIBUTOR_ROLE_UNSPECIFIED
+}
+
+func (m *FeeContribution) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("side.btcbridge.BridgeOperation", BridgeOperation_name, BridgeOperation_value)
+	proto.RegisterEnum("side.btcbridge.ContributorRole", ContributorRole_name, ContributorRole_value)
+	proto.RegisterType((*FeeContribution)(nil), "side.btcbridge.FeeContribution")
+}
+
+func init() { proto.RegisterFile("side/btcbridge/fees.proto", fileDescriptor_2e3b4f792a7963c2) }
+
+var fileDescriptor_2e3b4f792a7963c2 = []byte{
+	// 328 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0xc1, 0x4e, 0xc2, 0x30,
+	0x18, 0xc7, 0x57, 0x44, 0x8d, 0x3d, 0xc0, 0xd2, 0x98, 0x38, 0x22, 0xce, 0xc5, 0x13, 0xe1, 0xb0,
+	0x25, 0xf2, 0x04, 0x8c, 0x15, 0x68, 0x42, 0x56, 0x52, 0x46, 0x88, 0x5e, 0x08, 0xdb, 0x2a, 0x2e,
+	0xc1, 0x75, 0xe9, 0x8a, 0xd1, 0xb7, 0xf0, 0xb1, 0x3c, 0x72, 0xf4, 0x68, 0xe0, 0x45, 0x0c, 0x33,
+	0x12, 0x75, 0xc7, 0x7f, 0x7e, 0xbf, 0x7e, 0xff, 0xe6, 0xfb, 0x60, 0x23, 0x4f, 0x62, 0xee, 0x84,
+	0x2a, 0x0a, 0x65, 0x12, 0x2f, 0xb9, 0xf3, 0xc0, 0x79, 0x6e, 0x67, 0x52, 0x28, 0x81, 0x6a, 0x7b,
+	0x64, 0x1f, 0xd0, 0xcd, 0x33, 0xac, 0xf7, 0x39, 0xef, 0x89, 0x54, 0xc9, 0x24, 0x5c, 0xab, 0x44,
+	0xa4, 0xc8, 0x80, 0xa7, 0x8b, 0x38, 0x96, 0x3c, 0xcf, 0x0d, 0x60, 0x81, 0xd6, 0x19, 0xfb, 0x89,
+	0xa8, 0x03, 0xab, 0x52, 0xac, 0xb8, 0x51, 0xb1, 0x40, 0xab, 0x76, 0x7b, 0x6d, 0xff, 0x9d, 0x65,
+	0x1f, 0xa6, 0x08, 0xc9, 0xc4, 0x8a, 0xb3, 0x42, 0x46, 0xe7, 0xf0, 0x38, 0x12, 0xeb, 0x54, 0x19,
+	0x47, 0x16, 0x68, 0x55, 0xd9, 0x77, 0x68, 0x67, 0xb0, 0xee, 0x16, 0xaf, 0x68, 0xc6, 0xe5, 0xa2,
+	0xe8, 0xb5, 0x60, 0xd3, 0x65, 0xc4, 0x1b, 0xe0, 0x39, 0x1d, 0x63, 0xd6, 0x0d, 0x08, 0xf5, 0xe7,
+	0x53, 0x7f, 0x32, 0xc6, 0x3d, 0xd2, 0x27, 0xd8, 0xd3, 0x35, 0xd4, 0x84, 0x46, 0xc9, 0xf0, 0xf0,
+	0x98, 0x4e, 0x48, 0xa0, 0x03, 0x74, 0x05, 0x1b, 0x25, 0x3a, 0x23, 0xc1, 0xd0, 0x63, 0xdd, 0x99,
+	0x5e, 0x69, 0xa7, 0xb0, 0xfe, 0xef, 0x83, 0xfb, 0xc6, 0x1e, 0xf5, 0x03, 0x46, 0xdc, 0x69, 0x40,
+	0xd9, 0x9c, 0xd1, 0x11, 0x2e, 0x37, 0x96, 0x0c, 0x86, 0x47, 0xdd, 0x3b, 0xcc, 0x74, 0x80, 0x2e,
+	0xe1, 0x45, 0x89, 0x4e, 0xc8, 0xc0, 0xc7, 0x4c, 0xaf, 0xb8, 0xc3, 0xf7, 0xad, 0x09, 0x36, 0x5b,
+	0x13, 0x7c, 0x6e, 0x4d, 0xf0, 0xb6, 0x33, 0xb5, 0xcd, 0xce, 0xd4, 0x3e, 0x76, 0xa6, 0x76, 0x6f,
+	0x2f, 0x13, 0xf5, 0xb8, 0x0e, 0xed, 0x48, 0x3c, 0x39, 0xfb, 0x15, 0x16, 0x97, 0x89, 0xc4, 0xaa,
+	0x08, 0xce, 0xcb, 0xaf, 0xc3, 0xa9, 0xd7, 0x8c, 0xe7, 0xe1, 0x49, 0x21, 0x74, 0xbe, 0x06, 0x00,
+	0x24, 0xcd, 0xb6, 0x72, 0xd7, 0x01, 0x00, 0x00,
+}
+
+func (m *FeeContribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeContribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeContribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintFees(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Role != 0 {
+		i = encodeVarintFees(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintFees(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFees(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFees(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FeeContribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovFees(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovFees(uint64(m.Role))
+	}
+	if m.Count != 0 {
+		n += 1 + sovFees(uint64(m.Count))
+	}
+	return n
+}
+
+func sovFees(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFees(x uint64) (n int) {
+	return sovFees(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FeeContribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeContribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeContribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= ContributorRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFees(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFees
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFees
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFees
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFees
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFees
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFees
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFees        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFees          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFees = fmt.Errorf("proto: unexpected end of group")
+)
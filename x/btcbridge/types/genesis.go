@@ -1,23 +1,38 @@
 package types
 
 import (
+	"fmt"
+
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // this line is used by starport scaffolding # genesis/types/import
 
-func DefaultBestBlockHeader() *BlockHeader {
-	config := sdk.GetConfig().GetBtcChainCfg()
-	switch config.Name {
+// DefaultBestBlockHeader returns the default best block header of the given bitcoin network
+func DefaultBestBlockHeader(chainCfg *chaincfg.Params) *BlockHeader {
+	switch chainCfg.Name {
 	case chaincfg.MainNetParams.Name:
 		return DefaultMainNetBestBlockHeader()
 	case chaincfg.SigNetParams.Name:
 		return DefaultSignetBestBlockHeader()
+	case chaincfg.RegressionNetParams.Name:
+		return DefaultRegtestBestBlockHeader()
+	case LitecoinMainNetParams.Name, LitecoinTestNet4Params.Name, LitecoinRegressionNetParams.Name:
+		// litecoin genesis block
+		header := chainCfg.GenesisBlock.Header
+		return NewBlockHeader(&header, 0, 1)
 	}
 	return DefaultTestnetBestBlockHeader()
 }
 
+func DefaultRegtestBestBlockHeader() *BlockHeader {
+	// regtest genesis block
+	header := chaincfg.RegressionNetParams.GenesisBlock.Header
+	return NewBlockHeader(&header, 0, 1)
+}
+
 func DefaultSignetBestBlockHeader() *BlockHeader {
 	// testnet3 block 2815023
 	return &BlockHeader{
@@ -65,12 +80,15 @@ func DefaultMainNetBestBlockHeader() *BlockHeader {
 
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
+	params := DefaultParams()
+
 	return &GenesisState{
 		// this line is used by starport scaffolding # genesis/types/default
-		Params:          DefaultParams(),
-		BestBlockHeader: DefaultBestBlockHeader(),
+		Params:          params,
+		BestBlockHeader: DefaultBestBlockHeader(params.ChainCfg()),
 		BlockHeaders:    []*BlockHeader{},
 		Utxos:           []*UTXO{},
+		AddressLinks:    []*AddressLink{},
 	}
 }
 
@@ -79,8 +97,191 @@ func DefaultGenesis() *GenesisState {
 func (gs GenesisState) Validate() error {
 	// this line is used by starport scaffolding # genesis/types/validate
 	// need to be improved by checking the block headers & best block header
-	if gs.BestBlockHeader == nil || gs.BestBlockHeader.Hash == "" || gs.BestBlockHeader.PreviousBlockHash == "" || gs.BestBlockHeader.MerkleRoot == "" {
+	if !isValidBestBlockHeader(gs.BestBlockHeader) {
 		return ErrInvalidHeader
 	}
-	return gs.Params.Validate()
+
+	if err := validateAddressLinks(gs.AddressLinks); err != nil {
+		return err
+	}
+
+	if err := validatePendingDeposits(gs.PendingDeposits); err != nil {
+		return err
+	}
+
+	chainIDs := make(map[string]bool)
+	for _, chain := range gs.Chains {
+		if gs.Params.Chain(chain.ChainId) == nil || chainIDs[chain.ChainId] {
+			return ErrUnknownChain
+		}
+
+		if !isValidBestBlockHeader(chain.BestBlockHeader) {
+			return ErrInvalidHeader
+		}
+
+		if err := validateAddressLinks(chain.AddressLinks); err != nil {
+			return err
+		}
+
+		if err := validatePendingDeposits(chain.PendingDeposits); err != nil {
+			return err
+		}
+
+		chainIDs[chain.ChainId] = true
+	}
+
+	if err := gs.CollectedFees.Validate(); err != nil {
+		return err
+	}
+
+	if err := validateFeeContributions(gs.FeeContributions); err != nil {
+		return err
+	}
+
+	if err := validateHTLCs(gs.Htlcs); err != nil {
+		return err
+	}
+
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	if err := validateBlocklist(gs.Blocklist, gs.Params); err != nil {
+		return err
+	}
+
+	return validateQuarantinedDeposits(gs.QuarantinedDeposits, gs.Params)
+}
+
+// validateFeeContributions checks that each contributor is valid and counted once at most by role
+func validateFeeContributions(contributions []*FeeContribution) error {
+	contributors := make(map[string]bool)
+	for _, contribution := range contributions {
+		if _, err := sdk.AccAddressFromBech32(contribution.Address); err != nil {
+			return err
+		}
+
+		if contribution.Role == ContributorRole_CONTRIBUTOR_ROLE_UNSPECIFIED {
+			return fmt.Errorf("role of the contributor %s must be specified", contribution.Address)
+		}
+
+		key := contribution.Role.String() + "/" + contribution.Address
+		if contributors[key] {
+			return fmt.Errorf("duplicate contribution of the %s %s", contribution.Role, contribution.Address)
+		}
+
+		contributors[key] = true
+	}
+
+	return nil
+}
+
+// validateHTLCs checks that each HTLC is valid and its id is unique
+func validateHTLCs(htlcs []*HTLC) error {
+	ids := make(map[uint64]bool)
+	for _, htlc := range htlcs {
+		if err := htlc.Validate(); err != nil {
+			return err
+		}
+
+		if ids[htlc.Id] {
+			return fmt.Errorf("duplicate htlc %d", htlc.Id)
+		}
+
+		ids[htlc.Id] = true
+	}
+
+	return nil
+}
+
+// validateBlocklist checks that each blocked address is canonical and unique
+func validateBlocklist(blocklist []string, params Params) error {
+	entries := make(map[string]bool)
+	for _, entry := range blocklist {
+		normalized, err := params.NormalizeBlocklistEntry(entry)
+		if err != nil {
+			return err
+		}
+
+		if normalized != entry {
+			return fmt.Errorf("blocked address %s must be in the canonical form %s", entry, normalized)
+		}
+
+		if entries[entry] {
+			return fmt.Errorf("duplicate blocked address %s", entry)
+		}
+
+		entries[entry] = true
+	}
+
+	return nil
+}
+
+// validateQuarantinedDeposits checks that each quarantined deposit is valid and its id is unique
+func validateQuarantinedDeposits(deposits []*QuarantinedDeposit, params Params) error {
+	ids := make(map[uint64]bool)
+	for _, deposit := range deposits {
+		if err := deposit.Validate(); err != nil {
+			return err
+		}
+
+		if !params.HasChain(deposit.ChainId) {
+			return ErrUnknownChain
+		}
+
+		if ids[deposit.Id] {
+			return fmt.Errorf("duplicate quarantined deposit %d", deposit.Id)
+		}
+
+		ids[deposit.Id] = true
+	}
+
+	return nil
+}
+
+// validatePendingDeposits checks that each pending deposit is registered by a valid relayer and its txid is unique
+func validatePendingDeposits(deposits []*PendingDeposit) error {
+	txids := make(map[string]bool)
+	for _, deposit := range deposits {
+		if _, err := chainhash.NewHashFromStr(deposit.Txid); err != nil {
+			return err
+		}
+
+		if _, err := sdk.AccAddressFromBech32(deposit.Relayer); err != nil {
+			return err
+		}
+
+		if len(deposit.TxBytes) == 0 || len(deposit.Blockhash) == 0 {
+			return fmt.Errorf("incomplete pending deposit %s", deposit.Txid)
+		}
+
+		if txids[deposit.Txid] {
+			return fmt.Errorf("duplicate pending deposit %s", deposit.Txid)
+		}
+
+		txids[deposit.Txid] = true
+	}
+
+	return nil
+}
+
+// isValidBestBlockHeader returns true if the given best block header is populated
+func isValidBestBlockHeader(header *BlockHeader) bool {
+	return header != nil && header.Hash != "" && header.PreviousBlockHash != "" && header.MerkleRoot != ""
+}
+
+// validateAddressLinks checks that each address is linked once at most
+func validateAddressLinks(links []*AddressLink) error {
+	addresses := make(map[string]bool)
+	btcAddresses := make(map[string]bool)
+	for _, link := range links {
+		if addresses[link.Address] || btcAddresses[link.BtcAddress] {
+			return ErrInvalidBtcAddress
+		}
+
+		addresses[link.Address] = true
+		btcAddresses[link.BtcAddress] = true
+	}
+
+	return nil
 }
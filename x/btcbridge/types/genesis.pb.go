@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -30,6 +32,19 @@ type GenesisState struct {
 	BestBlockHeader *BlockHeader   `protobuf:"bytes,2,opt,name=best_block_header,json=bestBlockHeader,proto3" json:"best_block_header,omitempty"`
 	BlockHeaders    []*BlockHeader `protobuf:"bytes,3,rep,name=block_headers,json=blockHeaders,proto3" json:"block_headers,omitempty"`
 	Utxos           []*UTXO        `protobuf:"bytes,4,rep,name=utxos,proto3" json:"utxos,omitempty"`
+	AddressLinks    []*AddressLink `protobuf:"bytes,5,rep,name=address_links,json=addressLinks,proto3" json:"address_links,omitempty"`
+	// the state of the other bridged chains
+	Chains []*ChainGenesisState `protobuf:"bytes,6,rep,name=chains,proto3" json:"chains,omitempty"`
+	// the fees collected in the current fee epoch
+	CollectedFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=collected_fees,json=collectedFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"collected_fees"`
+	// the contributions of the relayers and signers in the current fee epoch
+	FeeContributions []*FeeContribution `protobuf:"bytes,8,rep,name=fee_contributions,json=feeContributions,proto3" json:"fee_contributions,omitempty"`
+	Htlcs            []*HTLC            `protobuf:"bytes,9,rep,name=htlcs,proto3" json:"htlcs,omitempty"`
+	// the blocked bitcoin addresses and side accounts
+	Blocklist           []string              `protobuf:"bytes,10,rep,name=blocklist,proto3" json:"blocklist,omitempty"`
+	QuarantinedDeposits []*QuarantinedDeposit `protobuf:"bytes,11,rep,name=quarantined_deposits,json=quarantinedDeposits,proto3" json:"quarantined_deposits,omitempty"`
+	// the deposits of bitcoin waiting for confirmations
+	PendingDeposits []*PendingDeposit `protobuf:"bytes,12,rep,name=pending_deposits,json=pendingDeposits,proto3" json:"pending_deposits,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -93,32 +108,214 @@ func (m *GenesisState) GetUtxos() []*UTXO {
 	return nil
 }
 
+func (m *GenesisState) GetAddressLinks() []*AddressLink {
+	if m != nil {
+		return m.AddressLinks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChains() []*ChainGenesisState {
+	if m != nil {
+		return m.Chains
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCollectedFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CollectedFees
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeContributions() []*FeeContribution {
+	if m != nil {
+		return m.FeeContributions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHtlcs() []*HTLC {
+	if m != nil {
+		return m.Htlcs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBlocklist() []string {
+	if m != nil {
+		return m.Blocklist
+	}
+	return nil
+}
+
+func (m *GenesisState) GetQuarantinedDeposits() []*QuarantinedDeposit {
+	if m != nil {
+		return m.QuarantinedDeposits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingDeposits() []*PendingDeposit {
+	if m != nil {
+		return m.PendingDeposits
+	}
+	return nil
+}
+
+// ChainGenesisState defines the state of a bridged chain other than bitcoin
+type ChainGenesisState struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// the chain tip from which the light client of the chain starts
+	BestBlockHeader *BlockHeader   `protobuf:"bytes,2,opt,name=best_block_header,json=bestBlockHeader,proto3" json:"best_block_header,omitempty"`
+	BlockHeaders    []*BlockHeader `protobuf:"bytes,3,rep,name=block_headers,json=blockHeaders,proto3" json:"block_headers,omitempty"`
+	Utxos           []*UTXO        `protobuf:"bytes,4,rep,name=utxos,proto3" json:"utxos,omitempty"`
+	AddressLinks    []*AddressLink `protobuf:"bytes,5,rep,name=address_links,json=addressLinks,proto3" json:"address_links,omitempty"`
+	// the deposits waiting for confirmations
+	PendingDeposits []*PendingDeposit `protobuf:"bytes,6,rep,name=pending_deposits,json=pendingDeposits,proto3" json:"pending_deposits,omitempty"`
+	// the fees collected in the current fee epoch
+	CollectedFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=collected_fees,json=collectedFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"collected_fees"`
+	// the contributions of the relayers and signers in the current fee epoch
+	FeeContributions []*FeeContribution `protobuf:"bytes,8,rep,name=fee_contributions,json=feeContributions,proto3" json:"fee_contributions,omitempty"`
+}
+
+func (m *ChainGenesisState) Reset()         { *m = ChainGenesisState{} }
+func (m *ChainGenesisState) String() string { return proto.CompactTextString(m) }
+func (*ChainGenesisState) ProtoMessage()    {}
+func (*ChainGenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37c22954cf4a954b, []int{1}
+}
+func (m *ChainGenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainGenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainGenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainGenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainGenesisState.Merge(m, src)
+}
+func (m *ChainGenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainGenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainGenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainGenesisState proto.InternalMessageInfo
+
+func (m *ChainGenesisState) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ChainGenesisState) GetBestBlockHeader() *BlockHeader {
+	if m != nil {
+		return m.BestBlockHeader
+	}
+	return nil
+}
+
+func (m *ChainGenesisState) GetBlockHeaders() []*BlockHeader {
+	if m != nil {
+		return m.BlockHeaders
+	}
+	return nil
+}
+
+func (m *ChainGenesisState) GetUtxos() []*UTXO {
+	if m != nil {
+		return m.Utxos
+	}
+	return nil
+}
+
+func (m *ChainGenesisState) GetAddressLinks() []*AddressLink {
+	if m != nil {
+		return m.AddressLinks
+	}
+	return nil
+}
+
+func (m *ChainGenesisState) GetPendingDeposits() []*PendingDeposit {
+	if m != nil {
+		return m.PendingDeposits
+	}
+	return nil
+}
+
+func (m *ChainGenesisState) GetCollectedFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CollectedFees
+	}
+	return nil
+}
+
+func (m *ChainGenesisState) GetFeeContributions() []*FeeContribution {
+	if m != nil {
+		return m.FeeContributions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "side.btcbridge.GenesisState")
+	proto.RegisterType((*ChainGenesisState)(nil), "side.btcbridge.ChainGenesisState")
 }
 
 func init() { proto.RegisterFile("side/btcbridge/genesis.proto", fileDescriptor_37c22954cf4a954b) }
 
 var fileDescriptor_37c22954cf4a954b = []byte{
-	// 287 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x29, 0xce, 0x4c, 0x49,
-	0xd5, 0x4f, 0x2a, 0x49, 0x4e, 0x2a, 0xca, 0x4c, 0x49, 0x4f, 0xd5, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d,
-	0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x03, 0xc9, 0xea, 0xc1, 0x65, 0xa5,
-	0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x52, 0xfa, 0x20, 0x16, 0x44, 0x95, 0x94, 0x34, 0x9a, 0x19,
-	0x05, 0x89, 0x45, 0x89, 0xb9, 0x50, 0x23, 0xa4, 0xd0, 0x2d, 0x48, 0xca, 0x2c, 0x49, 0xce, 0xcf,
-	0xcc, 0x83, 0xc8, 0x2a, 0xfd, 0x66, 0xe4, 0xe2, 0x71, 0x87, 0x58, 0x19, 0x5c, 0x92, 0x58, 0x92,
-	0x2a, 0x64, 0xc2, 0xc5, 0x06, 0xd1, 0x2e, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0xa6, 0x87,
-	0xea, 0x04, 0xbd, 0x00, 0xb0, 0xac, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41, 0x50, 0xb5, 0x42,
-	0xee, 0x5c, 0x82, 0x49, 0xa9, 0xc5, 0x25, 0xf1, 0x49, 0x39, 0xf9, 0xc9, 0xd9, 0xf1, 0x19, 0xa9,
-	0x89, 0x29, 0xa9, 0x45, 0x12, 0x4c, 0x60, 0x03, 0xa4, 0xd1, 0x0d, 0x70, 0x02, 0xa9, 0xf1, 0x00,
-	0x2b, 0x09, 0xe2, 0x07, 0xe9, 0x42, 0x12, 0x10, 0x72, 0xe0, 0xe2, 0x45, 0x36, 0xa3, 0x58, 0x82,
-	0x59, 0x81, 0x99, 0x90, 0x21, 0x3c, 0x49, 0x08, 0x4e, 0xb1, 0x90, 0x16, 0x17, 0x6b, 0x69, 0x49,
-	0x45, 0x7e, 0xb1, 0x04, 0x0b, 0x58, 0xa7, 0x08, 0xba, 0xce, 0xd0, 0x90, 0x08, 0xff, 0x20, 0x88,
-	0x12, 0x27, 0x8f, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71,
-	0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xd2, 0x4b, 0xcf,
-	0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x07, 0x19, 0x00, 0x0e, 0xad, 0xe4, 0xfc,
-	0x1c, 0x30, 0x47, 0xbf, 0x02, 0x29, 0x3c, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x0a,
-	0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x8f, 0x59, 0x66, 0x3a, 0xcf, 0x01, 0x00, 0x00,
+	// 606 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x54, 0x4f, 0x4f, 0xd4, 0x40,
+	0x14, 0xdf, 0x15, 0x28, 0xec, 0xb0, 0xfc, 0x1b, 0x89, 0x29, 0x7f, 0x52, 0x90, 0x13, 0x31, 0xb1,
+	0x15, 0xf5, 0xe2, 0x4d, 0x77, 0x0d, 0x7f, 0x12, 0x12, 0xb5, 0x42, 0x62, 0xbc, 0x34, 0xed, 0xcc,
+	0xa3, 0x3b, 0xd9, 0x32, 0x53, 0xfa, 0x66, 0x0d, 0x7e, 0x0b, 0x3f, 0x85, 0x07, 0xcf, 0x7e, 0x08,
+	0x8e, 0x1c, 0x3d, 0xa9, 0x81, 0x2f, 0x62, 0x3a, 0x2d, 0x6c, 0x29, 0x6b, 0x4c, 0xbc, 0x19, 0x4f,
+	0xbb, 0x6f, 0x7e, 0x7f, 0xde, 0xeb, 0x7b, 0x6f, 0x86, 0xac, 0xa2, 0xe0, 0xe0, 0x45, 0x9a, 0x45,
+	0x99, 0xe0, 0x31, 0x78, 0x31, 0x48, 0x40, 0x81, 0x6e, 0x9a, 0x29, 0xad, 0xe8, 0x6c, 0x8e, 0xba,
+	0xd7, 0xe8, 0xf2, 0x62, 0xac, 0x62, 0x65, 0x20, 0x2f, 0xff, 0x57, 0xb0, 0x96, 0x1d, 0xa6, 0xf0,
+	0x58, 0xa1, 0x17, 0x85, 0x08, 0xde, 0x87, 0xad, 0x08, 0x74, 0xb8, 0xe5, 0x31, 0x25, 0x64, 0x89,
+	0xaf, 0xd4, 0x72, 0xa4, 0x61, 0x16, 0x1e, 0x97, 0x29, 0x96, 0xeb, 0x05, 0x44, 0x42, 0x57, 0xa4,
+	0x4b, 0x35, 0xf4, 0x08, 0x00, 0x7f, 0x03, 0xf5, 0x74, 0xc2, 0xae, 0x0a, 0xaa, 0x41, 0xc8, 0x32,
+	0x00, 0x29, 0x64, 0x5c, 0xe0, 0x1b, 0x9f, 0x2d, 0xd2, 0xde, 0x29, 0x3e, 0xf4, 0xad, 0x0e, 0x35,
+	0xd0, 0xa7, 0xc4, 0x2a, 0x8a, 0xb2, 0x9b, 0xeb, 0xcd, 0xcd, 0xe9, 0xc7, 0xf7, 0xdc, 0x9b, 0x1f,
+	0xee, 0xbe, 0x36, 0x68, 0x67, 0xfc, 0xec, 0xfb, 0x5a, 0xc3, 0x2f, 0xb9, 0x74, 0x87, 0x2c, 0x44,
+	0x80, 0x3a, 0x88, 0x12, 0xc5, 0xfa, 0x41, 0x0f, 0x42, 0x0e, 0x99, 0x7d, 0xc7, 0x18, 0xac, 0xd4,
+	0x0d, 0x3a, 0x39, 0x67, 0xd7, 0x50, 0xfc, 0xb9, 0x5c, 0x55, 0x39, 0xa0, 0xcf, 0xc9, 0x4c, 0xd5,
+	0x03, 0xed, 0xb1, 0xf5, 0xb1, 0x3f, 0x99, 0xb4, 0xa3, 0x61, 0x80, 0xf4, 0x01, 0x99, 0x18, 0xe8,
+	0x53, 0x85, 0xf6, 0xb8, 0x51, 0x2e, 0xd6, 0x95, 0x87, 0x07, 0xef, 0x5e, 0xf9, 0x05, 0x25, 0xcf,
+	0x16, 0x72, 0x9e, 0x01, 0x62, 0x90, 0x08, 0xd9, 0x47, 0x7b, 0x62, 0x74, 0xb6, 0x17, 0x05, 0x69,
+	0x5f, 0xc8, 0xbe, 0xdf, 0x0e, 0x87, 0x01, 0xd2, 0x67, 0xc4, 0x62, 0xbd, 0x50, 0x48, 0xb4, 0x2d,
+	0x23, 0xbd, 0x5f, 0x97, 0x76, 0x73, 0xb4, 0xda, 0x61, 0xbf, 0x14, 0xd0, 0x8c, 0xcc, 0x32, 0x95,
+	0x24, 0xc0, 0x34, 0xf0, 0x20, 0x9f, 0xa6, 0x3d, 0x69, 0x2c, 0x96, 0xdc, 0x62, 0x89, 0xdc, 0x7c,
+	0x89, 0xdc, 0x72, 0x89, 0xdc, 0xae, 0x12, 0xb2, 0xf3, 0x28, 0x6f, 0xfa, 0x97, 0x1f, 0x6b, 0x9b,
+	0xb1, 0xd0, 0xbd, 0x41, 0xe4, 0x32, 0x75, 0xec, 0x95, 0x1b, 0x57, 0xfc, 0x3c, 0x44, 0xde, 0xf7,
+	0xf4, 0xc7, 0x14, 0xd0, 0x08, 0xd0, 0x9f, 0xb9, 0x4e, 0xb1, 0x0d, 0x80, 0x74, 0x9f, 0x2c, 0x1c,
+	0x01, 0x04, 0x4c, 0x49, 0x9d, 0x89, 0x68, 0xa0, 0x85, 0x92, 0x68, 0x4f, 0x99, 0xb4, 0x6b, 0xf5,
+	0xca, 0xb7, 0x01, 0xba, 0x15, 0x9e, 0x3f, 0x7f, 0x74, 0xf3, 0xc0, 0xb4, 0x3a, 0x5f, 0x35, 0xb4,
+	0x5b, 0xa3, 0x5b, 0xbd, 0x7b, 0xb0, 0xdf, 0xf5, 0x0b, 0x0a, 0x5d, 0x25, 0x2d, 0x33, 0xa6, 0x44,
+	0xa0, 0xb6, 0xc9, 0xfa, 0xd8, 0x66, 0xcb, 0x1f, 0x1e, 0xd0, 0x43, 0xb2, 0x78, 0x32, 0x08, 0xb3,
+	0x50, 0x6a, 0x21, 0x81, 0x07, 0x1c, 0x52, 0x85, 0x42, 0xa3, 0x3d, 0x6d, 0x8c, 0x37, 0xea, 0xc6,
+	0x6f, 0x86, 0xdc, 0x97, 0x05, 0xd5, 0xbf, 0x7b, 0x72, 0xeb, 0x0c, 0xe9, 0x1e, 0x99, 0x4f, 0x41,
+	0x72, 0x21, 0xe3, 0xa1, 0x65, 0xdb, 0x58, 0x3a, 0xb7, 0xd6, 0xba, 0xe0, 0x5d, 0xd9, 0xcd, 0xa5,
+	0x37, 0x62, 0xdc, 0xf8, 0x3a, 0x4e, 0x16, 0x6e, 0xcd, 0x92, 0x2e, 0x91, 0x29, 0x33, 0xcd, 0x40,
+	0x70, 0x73, 0x5f, 0x5a, 0xfe, 0xa4, 0x89, 0xf7, 0xf8, 0xff, 0x7b, 0x25, 0x46, 0x35, 0xdd, 0xfa,
+	0xab, 0xa6, 0xff, 0xfb, 0x57, 0xa4, 0xb3, 0x7b, 0x76, 0xe1, 0x34, 0xcf, 0x2f, 0x9c, 0xe6, 0xcf,
+	0x0b, 0xa7, 0xf9, 0xe9, 0xd2, 0x69, 0x9c, 0x5f, 0x3a, 0x8d, 0x6f, 0x97, 0x4e, 0xe3, 0xbd, 0x5b,
+	0x29, 0x30, 0xb7, 0x35, 0xef, 0x31, 0x53, 0x89, 0x09, 0xbc, 0xd3, 0xca, 0x9b, 0x6d, 0x8a, 0x8d,
+	0x2c, 0x43, 0x78, 0xf2, 0x6b, 0x00, 0xef, 0x16, 0x40, 0xc1, 0xa7, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -141,6 +338,113 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingDeposits) > 0 {
+		for iNdEx := len(m.PendingDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.QuarantinedDeposits) > 0 {
+		for iNdEx := len(m.QuarantinedDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QuarantinedDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Blocklist) > 0 {
+		for iNdEx := len(m.Blocklist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Blocklist[iNdEx])
+			copy(dAtA[i:], m.Blocklist[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Blocklist[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Htlcs) > 0 {
+		for iNdEx := len(m.Htlcs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Htlcs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.FeeContributions) > 0 {
+		for iNdEx := len(m.FeeContributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeContributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.CollectedFees) > 0 {
+		for iNdEx := len(m.CollectedFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CollectedFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Chains) > 0 {
+		for iNdEx := len(m.Chains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Chains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.AddressLinks) > 0 {
+		for iNdEx := len(m.AddressLinks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddressLinks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.Utxos) > 0 {
 		for iNdEx := len(m.Utxos) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -151,94 +455,778 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i -= size
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.BlockHeaders) > 0 {
-		for iNdEx := len(m.BlockHeaders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BlockHeaders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.BlockHeaders) > 0 {
+		for iNdEx := len(m.BlockHeaders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockHeaders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.BestBlockHeader != nil {
+		{
+			size, err := m.BestBlockHeader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ChainGenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainGenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainGenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeContributions) > 0 {
+		for iNdEx := len(m.FeeContributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeContributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.CollectedFees) > 0 {
+		for iNdEx := len(m.CollectedFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CollectedFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PendingDeposits) > 0 {
+		for iNdEx := len(m.PendingDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.AddressLinks) > 0 {
+		for iNdEx := len(m.AddressLinks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddressLinks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Utxos) > 0 {
+		for iNdEx := len(m.Utxos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Utxos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.BlockHeaders) > 0 {
+		for iNdEx := len(m.BlockHeaders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockHeaders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.BestBlockHeader != nil {
+		{
+			size, err := m.BestBlockHeader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.BestBlockHeader != nil {
+		l = m.BestBlockHeader.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.BlockHeaders) > 0 {
+		for _, e := range m.BlockHeaders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Utxos) > 0 {
+		for _, e := range m.Utxos {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AddressLinks) > 0 {
+		for _, e := range m.AddressLinks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Chains) > 0 {
+		for _, e := range m.Chains {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CollectedFees) > 0 {
+		for _, e := range m.CollectedFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeContributions) > 0 {
+		for _, e := range m.FeeContributions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Htlcs) > 0 {
+		for _, e := range m.Htlcs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Blocklist) > 0 {
+		for _, s := range m.Blocklist {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.QuarantinedDeposits) > 0 {
+		for _, e := range m.QuarantinedDeposits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingDeposits) > 0 {
+		for _, e := range m.PendingDeposits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChainGenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.BestBlockHeader != nil {
+		l = m.BestBlockHeader.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.BlockHeaders) > 0 {
+		for _, e := range m.BlockHeaders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Utxos) > 0 {
+		for _, e := range m.Utxos {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AddressLinks) > 0 {
+		for _, e := range m.AddressLinks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingDeposits) > 0 {
+		for _, e := range m.PendingDeposits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CollectedFees) > 0 {
+		for _, e := range m.CollectedFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeContributions) > 0 {
+		for _, e := range m.FeeContributions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestBlockHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BestBlockHeader == nil {
+				m.BestBlockHeader = &BlockHeader{}
+			}
+			if err := m.BestBlockHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeaders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHeaders = append(m.BlockHeaders, &BlockHeader{})
+			if err := m.BlockHeaders[len(m.BlockHeaders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Utxos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Utxos = append(m.Utxos, &UTXO{})
+			if err := m.Utxos[len(m.Utxos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressLinks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressLinks = append(m.AddressLinks, &AddressLink{})
+			if err := m.AddressLinks[len(m.AddressLinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chains = append(m.Chains, &ChainGenesisState{})
+			if err := m.Chains[len(m.Chains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectedFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollectedFees = append(m.CollectedFees, types.Coin{})
+			if err := m.CollectedFees[len(m.CollectedFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeContributions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeContributions = append(m.FeeContributions, &FeeContribution{})
+			if err := m.FeeContributions[len(m.FeeContributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Htlcs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Htlcs = append(m.Htlcs, &HTLC{})
+			if err := m.Htlcs[len(m.Htlcs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocklist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blocklist = append(m.Blocklist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuarantinedDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuarantinedDeposits = append(m.QuarantinedDeposits, &QuarantinedDeposit{})
+			if err := m.QuarantinedDeposits[len(m.QuarantinedDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.BestBlockHeader != nil {
-		{
-			size, err := m.BestBlockHeader.MarshalToSizedBuffer(dAtA[:i])
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingDeposits = append(m.PendingDeposits, &PendingDeposit{})
+			if err := m.PendingDeposits[len(m.PendingDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
 			if err != nil {
-				return 0, err
+				return err
 			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if m.BestBlockHeader != nil {
-		l = m.BestBlockHeader.Size()
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if len(m.BlockHeaders) > 0 {
-		for _, e := range m.BlockHeaders {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.Utxos) > 0 {
-		for _, e := range m.Utxos {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *ChainGenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -261,17 +1249,17 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChainGenesisState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChainGenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -281,24 +1269,23 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -404,6 +1391,142 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressLinks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressLinks = append(m.AddressLinks, &AddressLink{})
+			if err := m.AddressLinks[len(m.AddressLinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingDeposits = append(m.PendingDeposits, &PendingDeposit{})
+			if err := m.PendingDeposits[len(m.PendingDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectedFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollectedFees = append(m.CollectedFees, types.Coin{})
+			if err := m.CollectedFees[len(m.CollectedFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeContributions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeContributions = append(m.FeeContributions, &FeeContribution{})
+			if err := m.FeeContributions[len(m.FeeContributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,72 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BtcBridgeHooks defines the hooks by which other modules react to the bridge lifecycle.
+// The hooks are called in a cached context; the state changes of a failed hook are discarded
+// and the error is logged, so a hook can never revert the bridge operation.
+type BtcBridgeHooks interface {
+	// AfterDepositMinted is called after the voucher token of a deposit tx is minted to the recipient
+	// and the deposit action, if any, is executed
+	AfterDepositMinted(ctx sdk.Context, recipient sdk.AccAddress, txid string, amount sdk.Coin) error
+	// AfterWithdrawalRequested is called after the signing request of a withdrawal is created
+	// and the withdrawal amount is escrowed from the sender
+	AfterWithdrawalRequested(ctx sdk.Context, sender sdk.AccAddress, request *BitcoinSigningRequest, amount sdk.Coin) error
+	// AfterWithdrawalConfirmed is called after the withdrawal tx of a signing request is confirmed on bitcoin
+	AfterWithdrawalConfirmed(ctx sdk.Context, request *BitcoinSigningRequest) error
+	// AfterReorg is called after the light client switches to a fork from the given height
+	AfterReorg(ctx sdk.Context, forkHeight uint64, oldBestHeight uint64) error
+}
+
+var _ BtcBridgeHooks = MultiBtcBridgeHooks{}
+
+// MultiBtcBridgeHooks combines multiple bridge hooks, all hook functions are run in array sequence.
+// The first error aborts the remaining hooks.
+type MultiBtcBridgeHooks []BtcBridgeHooks
+
+// NewMultiBtcBridgeHooks creates a new MultiBtcBridgeHooks
+func NewMultiBtcBridgeHooks(hooks ...BtcBridgeHooks) MultiBtcBridgeHooks {
+	return hooks
+}
+
+func (h MultiBtcBridgeHooks) AfterDepositMinted(ctx sdk.Context, recipient sdk.AccAddress, txid string, amount sdk.Coin) error {
+	for i := range h {
+		if err := h[i].AfterDepositMinted(ctx, recipient, txid, amount); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h MultiBtcBridgeHooks) AfterWithdrawalRequested(ctx sdk.Context, sender sdk.AccAddress, request *BitcoinSigningRequest, amount sdk.Coin) error {
+	for i := range h {
+		if err := h[i].AfterWithdrawalRequested(ctx, sender, request, amount); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h MultiBtcBridgeHooks) AfterWithdrawalConfirmed(ctx sdk.Context, request *BitcoinSigningRequest) error {
+	for i := range h {
+		if err := h[i].AfterWithdrawalConfirmed(ctx, request); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h MultiBtcBridgeHooks) AfterReorg(ctx sdk.Context, forkHeight uint64, oldBestHeight uint64) error {
+	for i := range h {
+		if err := h[i].AfterReorg(ctx, forkHeight, oldBestHeight); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
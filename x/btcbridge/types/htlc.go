@@ -0,0 +1,69 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// HashLockSize is the size of the sha256 hash lock of the HTLC
+const HashLockSize = sha256.Size
+
+// ValidateHashLock validates the hex encoded sha256 hash lock
+func ValidateHashLock(hashLock string) error {
+	bz, err := hex.DecodeString(hashLock)
+	if err != nil || len(bz) != HashLockSize {
+		return fmt.Errorf("hash lock must be a hex encoded sha256 hash")
+	}
+
+	return nil
+}
+
+// VerifyPreimage returns true if the sha256 hash of the given hex encoded preimage matches the hash lock
+func (h *HTLC) VerifyPreimage(preimage string) bool {
+	bz, err := hex.DecodeString(preimage)
+	if err != nil {
+		return false
+	}
+
+	hash := sha256.Sum256(bz)
+
+	return hex.EncodeToString(hash[:]) == h.HashLock
+}
+
+// Validate validates the HTLC
+func (h *HTLC) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(h.Maker); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(h.Taker); err != nil {
+		return err
+	}
+
+	if err := h.Amount.Validate(); err != nil || !h.Amount.IsPositive() {
+		return fmt.Errorf("amount of the htlc %d must be positive", h.Id)
+	}
+
+	if err := ValidateHashLock(h.HashLock); err != nil {
+		return err
+	}
+
+	if len(h.PkScript) == 0 || h.BtcAmount == 0 {
+		return fmt.Errorf("expected output of the htlc %d must be specified", h.Id)
+	}
+
+	switch h.Status {
+	case HTLCStatus_HTLC_STATUS_OPEN, HTLCStatus_HTLC_STATUS_REFUNDED:
+	case HTLCStatus_HTLC_STATUS_CLAIMED:
+		if !h.VerifyPreimage(h.Preimage) || len(h.ClaimTxid) == 0 {
+			return fmt.Errorf("claim of the htlc %d is invalid", h.Id)
+		}
+	default:
+		return fmt.Errorf("status of the htlc %d must be specified", h.Id)
+	}
+
+	return nil
+}
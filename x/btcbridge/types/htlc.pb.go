@@ -0,0 +1,958 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: side/btcbridge/htlc.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// HTLCStatus defines the status of the HTLC
+type HTLCStatus int32
+
+const (
+	HTLCStatus_HTLC_STATUS_UNSPECIFIED HTLCStatus = 0
+	// the tokens are locked
+	HTLCStatus_HTLC_STATUS_OPEN HTLCStatus = 1
+	// the tokens are claimed by the taker
+	HTLCStatus_HTLC_STATUS_CLAIMED HTLCStatus = 2
+	// the tokens are refunded to the maker on timeout
+	HTLCStatus_HTLC_STATUS_REFUNDED HTLCStatus = 3
+)
+
+var HTLCStatus_name = map[int32]string{
+	0: "HTLC_STATUS_UNSPECIFIED",
+	1: "HTLC_STATUS_OPEN",
+	2: "HTLC_STATUS_CLAIMED",
+	3: "HTLC_STATUS_REFUNDED",
+}
+
+var HTLCStatus_value = map[string]int32{
+	"HTLC_STATUS_UNSPECIFIED": 0,
+	"HTLC_STATUS_OPEN":        1,
+	"HTLC_STATUS_CLAIMED":     2,
+	"HTLC_STATUS_REFUNDED":    3,
+}
+
+func (x HTLCStatus) String() string {
+	return proto.EnumName(HTLCStatus_name, int32(x))
+}
+
+func (HTLCStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d2043ea66c65ec94, []int{0}
+}
+
+// HTLC defines a hashed timelock contract swapping the side tokens locked by the maker for native btc.
+// The taker claims the tokens by revealing the preimage of the hash lock and proving by the light client
+// that a bitcoin tx paying the expected output is confirmed.
+type HTLC struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the account locking the tokens
+	Maker string `protobuf:"bytes,2,opt,name=maker,proto3" json:"maker,omitempty"`
+	// the account receiving the tokens on claim
+	Taker  string     `protobuf:"bytes,3,opt,name=taker,proto3" json:"taker,omitempty"`
+	Amount types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+	// the hex encoded sha256 hash of the preimage
+	HashLock string `protobuf:"bytes,5,opt,name=hash_lock,json=hashLock,proto3" json:"hash_lock,omitempty"`
+	// the side block height from which the tokens are refunded to the maker
+	TimeoutHeight int64 `protobuf:"varint,6,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height,omitempty"`
+	// the pk script of the bitcoin output expected to be paid, e.g. the maker's address or a bitcoin htlc script
+	PkScript []byte `protobuf:"bytes,7,opt,name=pk_script,json=pkScript,proto3" json:"pk_script,omitempty"`
+	// the minimum value of the bitcoin output expected to be paid
+	BtcAmount uint64 `protobuf:"varint,8,opt,name=btc_amount,json=btcAmount,proto3" json:"btc_amount,omitempty"`
+	// the bridged chain on which the output is paid, empty for bitcoin
+	ChainId string     `protobuf:"bytes,9,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Status  HTLCStatus `protobuf:"varint,10,opt,name=status,proto3,enum=side.btcbridge.HTLCStatus" json:"status,omitempty"`
+	// the hex encoded preimage revealed by the claim
+	Preimage string `protobuf:"bytes,11,opt,name=preimage,proto3" json:"preimage,omitempty"`
+	// the bitcoin output paying the HTLC
+	ClaimTxid string `protobuf:"bytes,12,opt,name=claim_txid,json=claimTxid,proto3" json:"claim_txid,omitempty"`
+	ClaimVout uint32 `protobuf:"varint,13,opt,name=claim_vout,json=claimVout,proto3" json:"claim_vout,omitempty"`
+	// the best block height of the light client on creation, above which the paying tx must be included
+	StartHeight uint64 `protobuf:"varint,14,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+}
+
+func (m *HTLC) Reset()         { *m = HTLC{} }
+func (m *HTLC) String() string { return proto.CompactTextString(m) }
+func (*HTLC) ProtoMessage()    {}
+func (*HTLC) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d2043ea66c65ec94, []int{0}
+}
+func (m *HTLC) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HTLC) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HTLC.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HTLC) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTLC.Merge(m, src)
+}
+func (m *HTLC) XXX_Size() int {
+	return m.Size()
+}
+func (m *HTLC) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTLC.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTLC proto.InternalMessageInfo
+
+func (m *HTLC) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *HTLC) GetMaker() string {
+	if m != nil {
+		return m.Maker
+	}
+	return ""
+}
+
+func (m *HTLC) GetTaker() string {
+	if m != nil {
+		return m.Taker
+	}
+	return ""
+}
+
+func (m *HTLC) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *HTLC) GetHashLock() string {
+	if m != nil {
+		return m.HashLock
+	}
+	return ""
+}
+
+func (m *HTLC) GetTimeoutHeight() int64 {
+	if m != nil {
+		return m.TimeoutHeight
+	}
+	return 0
+}
+
+func (m *HTLC) GetPkScript() []byte {
+	if m != nil {
+		return m.PkScript
+	}
+	return nil
+}
+
+func (m *HTLC) GetBtcAmount() uint64 {
+	if m != nil {
+		return m.BtcAmount
+	}
+	return 0
+}
+
+func (m *HTLC) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *HTLC) GetStatus() HTLCStatus {
+	if m != nil {
+		return m.Status
+	}
+	return HTLCStatus_HTLC_STATUS_UNSPECIFIED
+}
+
+func (m *HTLC) GetPreimage() string {
+	if m != nil {
+		return m.Preimage
+	}
+	return ""
+}
+
+func (m *HTLC) GetClaimTxid() string {
+	if m != nil {
+		return m.ClaimTxid
+	}
+	return ""
+}
+
+func (m *HTLC) GetClaimVout() uint32 {
+	if m != nil {
+		return m.ClaimVout
+	}
+	return 0
+}
+
+func (m *HTLC) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("side.btcbridge.HTLCStatus", HTLCStatus_name, HTLCStatus_value)
+	proto.RegisterType((*HTLC)(nil), "side.btcbridge.HTLC")
+}
+
+func init() { proto.RegisterFile("side/btcbridge/htlc.proto", fileDescriptor_d2043ea66c65ec94) }
+
+var fileDescriptor_d2043ea66c65ec94 = []byte{
+	// 510 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x52, 0x4d, 0x8b, 0xda, 0x40,
+	0x18, 0x76, 0xd4, 0x75, 0x75, 0xfc, 0x40, 0xa6, 0xc2, 0x8e, 0x2e, 0x4d, 0xd3, 0x42, 0x21, 0xf4,
+	0x90, 0xb0, 0xf6, 0xd0, 0xb3, 0xab, 0x59, 0x14, 0xac, 0x5d, 0xa2, 0xf6, 0xd0, 0x4b, 0x48, 0x26,
+	0x21, 0x19, 0x62, 0x9c, 0x90, 0x4c, 0x16, 0xfb, 0x2f, 0xfa, 0xaf, 0xba, 0xc7, 0x3d, 0xf6, 0x54,
+	0x8a, 0xfe, 0x91, 0x32, 0x93, 0x74, 0xd7, 0xde, 0xe6, 0xf9, 0x60, 0x9e, 0xf7, 0x7d, 0x78, 0xe1,
+	0x30, 0xa3, 0x9e, 0x6f, 0xb8, 0x9c, 0xb8, 0x29, 0xf5, 0x02, 0xdf, 0x08, 0xf9, 0x8e, 0xe8, 0x49,
+	0xca, 0x38, 0x43, 0x3d, 0x21, 0xe9, 0xcf, 0xd2, 0x68, 0x10, 0xb0, 0x80, 0x49, 0xc9, 0x10, 0xaf,
+	0xc2, 0x35, 0x52, 0x08, 0xcb, 0x62, 0x96, 0x19, 0xae, 0x93, 0xf9, 0xc6, 0xc3, 0x8d, 0xeb, 0x73,
+	0xe7, 0xc6, 0x20, 0x8c, 0xee, 0x0b, 0xfd, 0xdd, 0xcf, 0x1a, 0xac, 0xcf, 0x37, 0xcb, 0x29, 0xea,
+	0xc1, 0x2a, 0xf5, 0x30, 0x50, 0x81, 0x56, 0xb7, 0xaa, 0xd4, 0x43, 0x03, 0x78, 0x11, 0x3b, 0x91,
+	0x9f, 0xe2, 0xaa, 0x0a, 0xb4, 0x96, 0x55, 0x00, 0xc1, 0x72, 0xc9, 0xd6, 0x0a, 0x56, 0x02, 0xf4,
+	0x09, 0x36, 0x9c, 0x98, 0xe5, 0x7b, 0x8e, 0xeb, 0x2a, 0xd0, 0xda, 0xe3, 0xa1, 0x5e, 0xa4, 0xea,
+	0x22, 0x55, 0x2f, 0x53, 0xf5, 0x29, 0xa3, 0xfb, 0xdb, 0xfa, 0xe3, 0xef, 0x37, 0x15, 0xab, 0xb4,
+	0xa3, 0x6b, 0xd8, 0x0a, 0x9d, 0x2c, 0xb4, 0x77, 0x8c, 0x44, 0xf8, 0x42, 0x7e, 0xd9, 0x14, 0xc4,
+	0x92, 0x91, 0x08, 0xbd, 0x87, 0x3d, 0x4e, 0x63, 0x9f, 0xe5, 0xdc, 0x0e, 0x7d, 0x1a, 0x84, 0x1c,
+	0x37, 0x54, 0xa0, 0xd5, 0xac, 0x6e, 0xc9, 0xce, 0x25, 0x29, 0xfe, 0x48, 0x22, 0x3b, 0x23, 0x29,
+	0x4d, 0x38, 0xbe, 0x54, 0x81, 0xd6, 0xb1, 0x9a, 0x49, 0xb4, 0x96, 0x18, 0xbd, 0x86, 0xd0, 0xe5,
+	0xc4, 0x2e, 0xa7, 0x6b, 0xca, 0xed, 0x5a, 0x2e, 0x27, 0x93, 0x22, 0x7f, 0x08, 0x9b, 0x24, 0x74,
+	0xe8, 0xde, 0xa6, 0x1e, 0x6e, 0xc9, 0xf8, 0x4b, 0x89, 0x17, 0x1e, 0x1a, 0xc3, 0x46, 0xc6, 0x1d,
+	0x9e, 0x67, 0x18, 0xaa, 0x40, 0xeb, 0x8d, 0x47, 0xfa, 0xff, 0x7d, 0xeb, 0xa2, 0xb5, 0xb5, 0x74,
+	0x58, 0xa5, 0x13, 0x8d, 0x60, 0x33, 0x49, 0x7d, 0x1a, 0x3b, 0x81, 0x8f, 0xdb, 0xc5, 0x36, 0xff,
+	0xb0, 0x98, 0x84, 0xec, 0x1c, 0x1a, 0xdb, 0xfc, 0x40, 0x3d, 0xdc, 0x91, 0x6a, 0x4b, 0x32, 0x9b,
+	0x03, 0xf5, 0x5e, 0xe4, 0x07, 0x96, 0x73, 0xdc, 0x55, 0x81, 0xd6, 0x2d, 0xe5, 0xaf, 0x2c, 0xe7,
+	0xe8, 0x2d, 0xec, 0x64, 0xdc, 0x49, 0x9f, 0x9b, 0xe8, 0xc9, 0x4d, 0xda, 0x92, 0x2b, 0x7a, 0xf8,
+	0x90, 0x42, 0xf8, 0x32, 0x12, 0xba, 0x86, 0x57, 0x02, 0xd9, 0xeb, 0xcd, 0x64, 0xb3, 0x5d, 0xdb,
+	0xdb, 0xd5, 0xfa, 0xde, 0x9c, 0x2e, 0xee, 0x16, 0xe6, 0xac, 0x5f, 0x41, 0x03, 0xd8, 0x3f, 0x17,
+	0xbf, 0xdc, 0x9b, 0xab, 0x3e, 0x40, 0x57, 0xf0, 0xd5, 0x39, 0x3b, 0x5d, 0x4e, 0x16, 0x9f, 0xcd,
+	0x59, 0xbf, 0x8a, 0x30, 0x1c, 0x9c, 0x0b, 0x96, 0x79, 0xb7, 0x5d, 0xcd, 0xcc, 0x59, 0xbf, 0x76,
+	0x3b, 0x7f, 0x3c, 0x2a, 0xe0, 0xe9, 0xa8, 0x80, 0x3f, 0x47, 0x05, 0xfc, 0x38, 0x29, 0x95, 0xa7,
+	0x93, 0x52, 0xf9, 0x75, 0x52, 0x2a, 0xdf, 0xf4, 0x80, 0xf2, 0x30, 0x77, 0x75, 0xc2, 0x62, 0x43,
+	0x14, 0x27, 0xaf, 0x8d, 0xb0, 0x9d, 0x04, 0xc6, 0xe1, 0xec, 0xa4, 0xf9, 0xf7, 0xc4, 0xcf, 0xdc,
+	0x86, 0x34, 0x7c, 0xfc, 0x3b, 0x00, 0xa1, 0x26, 0xdd, 0xc3, 0xf1, 0x02, 0x00, 0x00,
+}
+
+func (m *HTLC) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HTLC) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HTLC) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		i = encodeVarintHtlc(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.ClaimVout != 0 {
+		i = encodeVarintHtlc(dAtA, i, uint64(m.ClaimVout))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.ClaimTxid) > 0 {
+		i -= len(m.ClaimTxid)
+		copy(dAtA[i:], m.ClaimTxid)
+		i = encodeVarintHtlc(dAtA, i, uint64(len(m.ClaimTxid)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Preimage) > 0 {
+		i -= len(m.Preimage)
+		copy(dAtA[i:], m.Preimage)
+		i = encodeVarintHtlc(dAtA, i, uint64(len(m.Preimage)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Status != 0 {
+		i = encodeVarintHtlc(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintHtlc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.BtcAmount != 0 {
+		i = encodeVarintHtlc(dAtA, i, uint64(m.BtcAmount))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.PkScript) > 0 {
+		i -= len(m.PkScript)
+		copy(dAtA[i:], m.PkScript)
+		i = encodeVarintHtlc(dAtA, i, uint64(len(m.PkScript)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.TimeoutHeight != 0 {
+		i = encodeVarintHtlc(dAtA, i, uint64(m.TimeoutHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.HashLock) > 0 {
+		i -= len(m.HashLock)
+		copy(dAtA[i:], m.HashLock)
+		i = encodeVarintHtlc(dAtA, i, uint64(len(m.HashLock)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintHtlc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Taker) > 0 {
+		i -= len(m.Taker)
+		copy(dAtA[i:], m.Taker)
+		i = encodeVarintHtlc(dAtA, i, uint64(len(m.Taker)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Maker) > 0 {
+		i -= len(m.Maker)
+		copy(dAtA[i:], m.Maker)
+		i = encodeVarintHtlc(dAtA, i, uint64(len(m.Maker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintHtlc(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintHtlc(dAtA []byte, offset int, v uint64) int {
+	offset -= sovHtlc(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *HTLC) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovHtlc(uint64(m.Id))
+	}
+	l = len(m.Maker)
+	if l > 0 {
+		n += 1 + l + sovHtlc(uint64(l))
+	}
+	l = len(m.Taker)
+	if l > 0 {
+		n += 1 + l + sovHtlc(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovHtlc(uint64(l))
+	l = len(m.HashLock)
+	if l > 0 {
+		n += 1 + l + sovHtlc(uint64(l))
+	}
+	if m.TimeoutHeight != 0 {
+		n += 1 + sovHtlc(uint64(m.TimeoutHeight))
+	}
+	l = len(m.PkScript)
+	if l > 0 {
+		n += 1 + l + sovHtlc(uint64(l))
+	}
+	if m.BtcAmount != 0 {
+		n += 1 + sovHtlc(uint64(m.BtcAmount))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovHtlc(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovHtlc(uint64(m.Status))
+	}
+	l = len(m.Preimage)
+	if l > 0 {
+		n += 1 + l + sovHtlc(uint64(l))
+	}
+	l = len(m.ClaimTxid)
+	if l > 0 {
+		n += 1 + l + sovHtlc(uint64(l))
+	}
+	if m.ClaimVout != 0 {
+		n += 1 + sovHtlc(uint64(m.ClaimVout))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovHtlc(uint64(m.StartHeight))
+	}
+	return n
+}
+
+func sovHtlc(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozHtlc(x uint64) (n int) {
+	return sovHtlc(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *HTLC) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHtlc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HTLC: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HTLC: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHtlc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Maker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHtlc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHtlc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHtlc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Maker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Taker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHtlc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHtlc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHtlc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Taker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHtlc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHtlc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHtlc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashLock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHtlc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHtlc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHtlc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HashLock = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+			}
+			m.TimeoutHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHtlc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PkScript", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHtlc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHtlc
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHtlc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PkScript = append(m.PkScript[:0], dAtA[iNdEx:postIndex]...)
+			if m.PkScript == nil {
+				m.PkScript = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcAmount", wireType)
+			}
+			m.BtcAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHtlc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHtlc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHtlc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHtlc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHtlc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= HTLCStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Preimage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHtlc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHtlc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHtlc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Preimage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimTxid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHtlc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHtlc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHtlc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimTxid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimVout", wireType)
+			}
+			m.ClaimVout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHtlc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimVout |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHtlc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHtlc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHtlc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipHtlc(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowHtlc
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHtlc
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHtlc
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthHtlc
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupHtlc
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthHtlc
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthHtlc        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowHtlc          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupHtlc = fmt.Errorf("proto: unexpected end of group")
+)
@@ -2,6 +2,8 @@ package types
 
 import (
 	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
@@ -32,10 +34,42 @@ var (
 	BtcUtxoKeyPrefix      = []byte{0x15} // prefix for each key to a utxo
 	BtcOwnerUtxoKeyPrefix = []byte{0x16} // prefix for each key to an owned utxo
 
-	BtcMintedTxHashKeyPrefix = []byte{0x17} // prefix for each key to a minted tx hash
+	BtcMintedTxHashKeyPrefix   = []byte{0x17} // prefix for each key to a minted tx hash
+	BtcMintedOutpointKeyPrefix = []byte{0x1A} // prefix for each key to a minted deposit outpoint
+	BtcAddressLinkKeyPrefix    = []byte{0x1B} // prefix for each key to the side address linked to a bitcoin address
+	AccountLinkKeyPrefix       = []byte{0x1C} // prefix for each key to the bitcoin address linked to a side address
+	BtcPendingDepositKeyPrefix = []byte{0x1D} // prefix for each key to a deposit waiting for confirmations, for a txid
+	BtcDegradationKey          = []byte{0x1E} // key for the degradation of the bridge as the best block header lags behind
+
+	BtcPendingDepositHeightKeyPrefix = []byte{0x2A} // prefix for each key to a pending deposit txid, for the height at which it is confirmed
+	BtcRecoveryDeficitKey            = []byte{0x2B} // key for the network fees of the recovery sweeps not covered by the collected fees
+
+	CollectedFeeKeyPrefix    = []byte{0x21} // prefix for each key to the collected fee, for a denom
+	FeeContributionKeyPrefix = []byte{0x22} // prefix for each key to the contribution count, for a role and an address
+
+	BtcAttestationKeyPrefix        = []byte{0x18} // prefix for each key to a pending attestation
+	BtcAttestationSubjectKeyPrefix = []byte{0x19} // prefix for each key to a pending attestation hash, for a subject
+	BtcAttestationExpiryKeyPrefix  = []byte{0x1F} // prefix for each key to a pending attestation hash, for an expiration height
+
+	ChainKeyPrefix = []byte{0x20} // prefix for the store of each bridged bitcoin-family chain other than bitcoin
 
+	// HTLC keys are shared by all bridged chains
+	HTLCSequenceKey         = []byte{0x23} // key for the id of the next HTLC
+	HTLCKeyPrefix           = []byte{0x24} // prefix for each key to an HTLC, for an id
+	HTLCTimeoutKeyPrefix    = []byte{0x25} // prefix for each key to an open HTLC, for a timeout height and an id
+	HTLCClaimedOutputPrefix = []byte{0x26} // prefix for each key to a bitcoin output claiming an HTLC, for a chain and an outpoint
+
+	// Screening keys are shared by all bridged chains
+	BlocklistKeyPrefix          = []byte{0x27} // prefix for each key to a blocked bitcoin address or side account
+	QuarantineSequenceKey       = []byte{0x28} // key for the id of the next quarantined deposit
+	QuarantinedDepositKeyPrefix = []byte{0x29} // prefix for each key to a quarantined deposit, for an id
 )
 
+// ChainKey returns the prefix of the store of the given bridged chain
+func ChainKey(chainID string) []byte {
+	return append(append(ChainKeyPrefix, []byte(chainID)...), '/')
+}
+
 func Int64ToBytes(number uint64) []byte {
 	big := new(big.Int)
 	big.SetUint64(number)
@@ -73,3 +107,64 @@ func BtcSigningRequestHashKey(txid string) []byte {
 func BtcMintedTxHashKey(hash string) []byte {
 	return append(BtcMintedTxHashKeyPrefix, []byte(hash)...)
 }
+
+func BtcMintedOutpointKey(hash string, vout uint64) []byte {
+	return append(append(BtcMintedOutpointKeyPrefix, []byte(hash)...), Int64ToBytes(vout)...)
+}
+
+func BtcPendingDepositKey(txid string) []byte {
+	return append(BtcPendingDepositKeyPrefix, []byte(txid)...)
+}
+
+func BtcPendingDepositHeightKey(height uint64, txid string) []byte {
+	return append(append(BtcPendingDepositHeightKeyPrefix, sdk.Uint64ToBigEndian(height)...), []byte(txid)...)
+}
+
+func BtcAddressLinkKey(btcAddress string) []byte {
+	return append(BtcAddressLinkKeyPrefix, []byte(btcAddress)...)
+}
+
+func AccountLinkKey(address string) []byte {
+	return append(AccountLinkKeyPrefix, []byte(address)...)
+}
+
+func BtcAttestationKey(hash string) []byte {
+	return append(BtcAttestationKeyPrefix, []byte(hash)...)
+}
+
+func BtcAttestationSubjectKey(subject string, hash string) []byte {
+	return append(append(BtcAttestationSubjectKeyPrefix, []byte(subject)...), []byte(hash)...)
+}
+
+func BtcAttestationExpiryKey(height int64, hash string) []byte {
+	return append(append(BtcAttestationExpiryKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...), []byte(hash)...)
+}
+
+func CollectedFeeKey(denom string) []byte {
+	return append(CollectedFeeKeyPrefix, []byte(denom)...)
+}
+
+func FeeContributionKey(role ContributorRole, address string) []byte {
+	return append(append(FeeContributionKeyPrefix, byte(role)), []byte(address)...)
+}
+
+func HTLCKey(id uint64) []byte {
+	return append(HTLCKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+func HTLCTimeoutKey(height int64, id uint64) []byte {
+	return append(append(HTLCTimeoutKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...), sdk.Uint64ToBigEndian(id)...)
+}
+
+func HTLCClaimedOutputKey(chainID string, txid string, vout uint32) []byte {
+	key := append(append(HTLCClaimedOutputPrefix, []byte(chainID)...), '/')
+	return append(append(key, []byte(txid)...), sdk.Uint64ToBigEndian(uint64(vout))...)
+}
+
+func BlocklistKey(address string) []byte {
+	return append(BlocklistKeyPrefix, []byte(address)...)
+}
+
+func QuarantinedDepositKey(id uint64) []byte {
+	return append(QuarantinedDepositKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
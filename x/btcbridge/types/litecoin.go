@@ -0,0 +1,159 @@
+package types
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+)
+
+var (
+	// litecoinPowLimit is the highest proof of work value a litecoin block can have
+	// for the main and test networks, i.e. 2^236 - 1
+	litecoinPowLimit = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 236), big.NewInt(1))
+
+	// litecoinRegTestPowLimit is the highest proof of work value a litecoin block can have
+	// for the regression test network, i.e. 2^255 - 1
+	litecoinRegTestPowLimit = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 255), big.NewInt(1))
+
+	// litecoinGenesisMerkleRoot is the merkle root of the litecoin genesis block, which is shared by all networks
+	litecoinGenesisMerkleRoot = newHashFromStr("97ddfbbae6be97fd6cdf3e7ca13232a3afff2353e29badfab7f73011edd4ced9")
+)
+
+// LitecoinMainNetParams defines the network parameters for the litecoin main network.
+// Only the header of the genesis block is populated as the light client does not need the genesis transaction.
+var LitecoinMainNetParams = chaincfg.Params{
+	Name:        "litecoin",
+	Net:         wire.BitcoinNet(0xdbb6c0fb),
+	DefaultPort: "9333",
+
+	GenesisBlock: &wire.MsgBlock{Header: wire.BlockHeader{
+		Version:    1,
+		MerkleRoot: *litecoinGenesisMerkleRoot,
+		Timestamp:  time.Unix(1317972665, 0),
+		Bits:       0x1e0ffff0,
+		Nonce:      2084524493,
+	}},
+	GenesisHash:              newHashFromStr("12a765e31ffd4059bada1e25190f6e98c99d9714d334efa41a195a7e7e04bfe2"),
+	PowLimit:                 litecoinPowLimit,
+	PowLimitBits:             0x1e0fffff,
+	CoinbaseMaturity:         100,
+	SubsidyReductionInterval: 840000,
+	TargetTimespan:           time.Hour * 84,      // 3.5 days
+	TargetTimePerBlock:       time.Minute * 5 / 2, // 2.5 minutes
+	RetargetAdjustmentFactor: 4,
+
+	Bech32HRPSegwit: "ltc",
+
+	PubKeyHashAddrID:        0x30, // starts with L
+	ScriptHashAddrID:        0x32, // starts with M
+	PrivateKeyID:            0xb0,
+	WitnessPubKeyHashAddrID: 0x06,
+	WitnessScriptHashAddrID: 0x0a,
+
+	HDPrivateKeyID: [4]byte{0x01, 0x9d, 0x9c, 0xfe}, // Ltpv
+	HDPublicKeyID:  [4]byte{0x01, 0x9d, 0xa4, 0x62}, // Ltub
+
+	HDCoinType: 2,
+}
+
+// LitecoinTestNet4Params defines the network parameters for the litecoin test network (version 4)
+var LitecoinTestNet4Params = chaincfg.Params{
+	Name:        "litecoin-testnet4",
+	Net:         wire.BitcoinNet(0xf1c8d2fd),
+	DefaultPort: "19335",
+
+	GenesisBlock: &wire.MsgBlock{Header: wire.BlockHeader{
+		Version:    1,
+		MerkleRoot: *litecoinGenesisMerkleRoot,
+		Timestamp:  time.Unix(1486949366, 0),
+		Bits:       0x1e0ffff0,
+		Nonce:      293345,
+	}},
+	GenesisHash:              newHashFromStr("4966625a4b2851d9fdee139e56211a0d88575f59ed816ff5e6a63deb4e3e29a0"),
+	PowLimit:                 litecoinPowLimit,
+	PowLimitBits:             0x1e0fffff,
+	CoinbaseMaturity:         100,
+	SubsidyReductionInterval: 840000,
+	TargetTimespan:           time.Hour * 84,      // 3.5 days
+	TargetTimePerBlock:       time.Minute * 5 / 2, // 2.5 minutes
+	RetargetAdjustmentFactor: 4,
+	ReduceMinDifficulty:      true,
+	MinDiffReductionTime:     time.Minute * 5, // TargetTimePerBlock * 2
+
+	Bech32HRPSegwit: "tltc",
+
+	PubKeyHashAddrID:        0x6f, // starts with m or n
+	ScriptHashAddrID:        0x3a, // starts with Q
+	PrivateKeyID:            0xef,
+	WitnessPubKeyHashAddrID: 0x52,
+	WitnessScriptHashAddrID: 0x31,
+
+	HDPrivateKeyID: [4]byte{0x04, 0x35, 0x83, 0x94}, // tprv
+	HDPublicKeyID:  [4]byte{0x04, 0x35, 0x87, 0xcf}, // tpub
+
+	HDCoinType: 1,
+}
+
+// LitecoinRegressionNetParams defines the network parameters for the litecoin regression test network
+var LitecoinRegressionNetParams = chaincfg.Params{
+	Name: "litecoin-regtest",
+	// The real magic of the litecoin regtest is the same as the bitcoin regtest,
+	// which could not be registered twice. The magic is never used on the wire by the light client.
+	Net:         wire.BitcoinNet(0xdab5bffb),
+	DefaultPort: "19444",
+
+	GenesisBlock: &wire.MsgBlock{Header: wire.BlockHeader{
+		Version:    1,
+		MerkleRoot: *litecoinGenesisMerkleRoot,
+		Timestamp:  time.Unix(1296688602, 0),
+		Bits:       0x207fffff,
+		Nonce:      0,
+	}},
+	GenesisHash:              newHashFromStr("530827f38f93b43ed12af0b3ad25a288dc02ed74d6d7857862df51fc56c416f9"),
+	PowLimit:                 litecoinRegTestPowLimit,
+	PowLimitBits:             0x207fffff,
+	PoWNoRetargeting:         true,
+	CoinbaseMaturity:         100,
+	SubsidyReductionInterval: 150,
+	TargetTimespan:           time.Hour * 84,      // 3.5 days
+	TargetTimePerBlock:       time.Minute * 5 / 2, // 2.5 minutes
+	RetargetAdjustmentFactor: 4,
+	ReduceMinDifficulty:      true,
+	MinDiffReductionTime:     time.Minute * 5, // TargetTimePerBlock * 2
+	GenerateSupported:        true,
+
+	Bech32HRPSegwit: "rltc",
+
+	PubKeyHashAddrID:        0x6f, // starts with m or n
+	ScriptHashAddrID:        0x3a, // starts with Q
+	PrivateKeyID:            0xef,
+	WitnessPubKeyHashAddrID: 0x52,
+	WitnessScriptHashAddrID: 0x31,
+
+	HDPrivateKeyID: [4]byte{0x04, 0x35, 0x83, 0x94}, // tprv
+	HDPublicKeyID:  [4]byte{0x04, 0x35, 0x87, 0xcf}, // tpub
+
+	HDCoinType: 1,
+}
+
+func init() {
+	// register the litecoin networks so that the litecoin addresses can be decoded
+	for _, params := range []*chaincfg.Params{&LitecoinMainNetParams, &LitecoinTestNet4Params, &LitecoinRegressionNetParams} {
+		if err := chaincfg.Register(params); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// newHashFromStr converts the given hex string to a hash and panics on error
+func newHashFromStr(hexStr string) *chainhash.Hash {
+	hash, err := chainhash.NewHashFromStr(hexStr)
+	if err != nil {
+		panic(err)
+	}
+
+	return hash
+}
@@ -0,0 +1,253 @@
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// ParseTxOutProof parses the hex encoded serialized merkle block as returned by bitcoind gettxoutproof
+func ParseTxOutProof(proof string) (*wire.MsgMerkleBlock, error) {
+	proofBytes, err := hex.DecodeString(proof)
+	if err != nil {
+		return nil, errorsmod.Wrap(ErrInvalidTxOutProof, err.Error())
+	}
+
+	var merkleBlock wire.MsgMerkleBlock
+	if err := merkleBlock.BtcDecode(bytes.NewReader(proofBytes), wire.ProtocolVersion, wire.BaseEncoding); err != nil {
+		return nil, errorsmod.Wrap(ErrInvalidTxOutProof, err.Error())
+	}
+
+	return &merkleBlock, nil
+}
+
+// ExtractMatchedTxHashes extracts the matched tx hashes from the partial merkle tree of the given merkle block.
+// The merkle root computed from the partial merkle tree must match the one in the block header.
+func ExtractMatchedTxHashes(merkleBlock *wire.MsgMerkleBlock) ([]chainhash.Hash, error) {
+	numTxs := merkleBlock.Transactions
+
+	// a tx takes at least 60 bytes
+	if numTxs == 0 || numTxs > blockchain.MaxBlockBaseSize/60 {
+		return nil, errorsmod.Wrap(ErrInvalidTxOutProof, "invalid number of transactions")
+	}
+
+	if uint32(len(merkleBlock.Hashes)) > numTxs {
+		return nil, errorsmod.Wrap(ErrInvalidTxOutProof, "more hashes than transactions")
+	}
+
+	if len(merkleBlock.Flags)*8 < len(merkleBlock.Hashes) {
+		return nil, errorsmod.Wrap(ErrInvalidTxOutProof, "not enough flag bits")
+	}
+
+	tree := &partialMerkleTree{
+		numTxs: numTxs,
+		hashes: merkleBlock.Hashes,
+		flags:  merkleBlock.Flags,
+	}
+
+	root, err := tree.traverseAndExtract(tree.height(), 0)
+	if err != nil {
+		return nil, err
+	}
+
+	// all hashes and flag bytes must be consumed
+	if tree.hashesUsed != len(tree.hashes) || (tree.bitsUsed+7)/8 != len(tree.flags) {
+		return nil, errorsmod.Wrap(ErrInvalidTxOutProof, "unused hashes or flag bits")
+	}
+
+	if !root.IsEqual(&merkleBlock.Header.MerkleRoot) {
+		return nil, errorsmod.Wrap(ErrInvalidTxOutProof, "merkle root mismatch")
+	}
+
+	return tree.matches, nil
+}
+
+// VerifyTxOutProof verifies that the given proof is built from the block of the given hash and matches the given tx
+func VerifyTxOutProof(proof string, txHash *chainhash.Hash, blockHash string) error {
+	merkleBlock, err := ParseTxOutProof(proof)
+	if err != nil {
+		return err
+	}
+
+	if merkleBlock.Header.BlockHash().String() != blockHash {
+		return errorsmod.Wrap(ErrInvalidTxOutProof, "block hash mismatch")
+	}
+
+	matches, err := ExtractMatchedTxHashes(merkleBlock)
+	if err != nil {
+		return err
+	}
+
+	for _, match := range matches {
+		if match.IsEqual(txHash) {
+			return nil
+		}
+	}
+
+	return ErrTransactionNotIncluded
+}
+
+// BuildTxOutProof builds the hex encoded serialized merkle block matching the given txs, in the format of bitcoind gettxoutproof
+func BuildTxOutProof(block *wire.MsgBlock, txHashes ...*chainhash.Hash) (string, error) {
+	tree := &partialMerkleTree{
+		numTxs: uint32(len(block.Transactions)),
+	}
+
+	leaves := make([]*chainhash.Hash, 0, len(block.Transactions))
+	matched := make([]bool, 0, len(block.Transactions))
+
+	for _, tx := range block.Transactions {
+		hash := tx.TxHash()
+		leaves = append(leaves, &hash)
+
+		isMatched := false
+		for _, txHash := range txHashes {
+			if txHash.IsEqual(&hash) {
+				isMatched = true
+				break
+			}
+		}
+
+		matched = append(matched, isMatched)
+	}
+
+	tree.traverseAndBuild(tree.height(), 0, leaves, matched)
+
+	merkleBlock := &wire.MsgMerkleBlock{
+		Header:       block.Header,
+		Transactions: tree.numTxs,
+		Hashes:       tree.hashes,
+		Flags:        tree.flags,
+	}
+
+	var buf bytes.Buffer
+	if err := merkleBlock.BtcEncode(&buf, wire.ProtocolVersion, wire.BaseEncoding); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(buf.Bytes()), nil
+}
+
+// partialMerkleTree is the partial merkle tree of a merkle block, see bitcoin core CPartialMerkleTree
+type partialMerkleTree struct {
+	numTxs uint32
+	hashes []*chainhash.Hash
+	flags  []byte
+
+	bitsUsed   int
+	hashesUsed int
+	matches    []chainhash.Hash
+}
+
+// width returns the number of nodes at the given height, leaves are at height 0
+func (t *partialMerkleTree) width(height uint) uint32 {
+	return (t.numTxs + (1 << height) - 1) >> height
+}
+
+// height returns the height of the tree root
+func (t *partialMerkleTree) height() uint {
+	height := uint(0)
+	for t.width(height) > 1 {
+		height++
+	}
+
+	return height
+}
+
+// traverseAndExtract consumes the flag bits and hashes in depth-first order and returns the hash of the given node
+func (t *partialMerkleTree) traverseAndExtract(height uint, pos uint32) (*chainhash.Hash, error) {
+	if t.bitsUsed >= len(t.flags)*8 {
+		return nil, errorsmod.Wrap(ErrInvalidTxOutProof, "flag bits overflow")
+	}
+
+	parentOfMatch := t.flags[t.bitsUsed/8]&(1<<(t.bitsUsed%8)) != 0
+	t.bitsUsed++
+
+	if height == 0 || !parentOfMatch {
+		if t.hashesUsed >= len(t.hashes) {
+			return nil, errorsmod.Wrap(ErrInvalidTxOutProof, "hashes overflow")
+		}
+
+		hash := t.hashes[t.hashesUsed]
+		t.hashesUsed++
+
+		if height == 0 && parentOfMatch {
+			t.matches = append(t.matches, *hash)
+		}
+
+		return hash, nil
+	}
+
+	left, err := t.traverseAndExtract(height-1, pos*2)
+	if err != nil {
+		return nil, err
+	}
+
+	right := left
+	if pos*2+1 < t.width(height-1) {
+		right, err = t.traverseAndExtract(height-1, pos*2+1)
+		if err != nil {
+			return nil, err
+		}
+
+		// identical siblings make the tree malleable (CVE-2012-2459)
+		if right.IsEqual(left) {
+			return nil, errorsmod.Wrap(ErrInvalidTxOutProof, "identical sibling hashes")
+		}
+	}
+
+	hash := blockchain.HashMerkleBranches(left, right)
+
+	return &hash, nil
+}
+
+// traverseAndBuild appends the flag bits and hashes of the given node in depth-first order
+func (t *partialMerkleTree) traverseAndBuild(height uint, pos uint32, leaves []*chainhash.Hash, matched []bool) {
+	parentOfMatch := false
+	for p := pos << height; p < (pos+1)<<height && p < t.numTxs; p++ {
+		parentOfMatch = parentOfMatch || matched[p]
+	}
+
+	if t.bitsUsed/8 >= len(t.flags) {
+		t.flags = append(t.flags, 0)
+	}
+
+	if parentOfMatch {
+		t.flags[t.bitsUsed/8] |= 1 << (t.bitsUsed % 8)
+	}
+
+	t.bitsUsed++
+
+	if height == 0 || !parentOfMatch {
+		t.hashes = append(t.hashes, t.calcHash(height, pos, leaves))
+		return
+	}
+
+	t.traverseAndBuild(height-1, pos*2, leaves, matched)
+	if pos*2+1 < t.width(height-1) {
+		t.traverseAndBuild(height-1, pos*2+1, leaves, matched)
+	}
+}
+
+// calcHash calculates the hash of the given node from the leaves
+func (t *partialMerkleTree) calcHash(height uint, pos uint32, leaves []*chainhash.Hash) *chainhash.Hash {
+	if height == 0 {
+		return leaves[pos]
+	}
+
+	left := t.calcHash(height-1, pos*2, leaves)
+
+	right := left
+	if pos*2+1 < t.width(height-1) {
+		right = t.calcHash(height-1, pos*2+1, leaves)
+	}
+
+	hash := blockchain.HashMerkleBranches(left, right)
+
+	return &hash
+}
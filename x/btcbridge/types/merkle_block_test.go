@@ -0,0 +1,94 @@
+package types_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sideprotocol/side/testutil/bitcoin"
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+func TestTxOutProof(t *testing.T) {
+	for _, n := range []int{1, 2, 3, 5, 8, 11} {
+		txs := make([]*wire.MsgTx, 0, n-1)
+		for i := 1; i < n; i++ {
+			txs = append(txs, bitcoin.NewTx(wire.NewOutPoint(&chainhash.Hash{byte(i)}, 0), nil, wire.NewTxOut(int64(i), nil)))
+		}
+
+		block := bitcoin.NewBlock(chaincfg.RegressionNetParams.GenesisBlock, 1, txs...)
+		blockHash := block.BlockHash().String()
+
+		for i, tx := range block.Transactions {
+			txHash := tx.TxHash()
+
+			proof, err := types.BuildTxOutProof(block, &txHash)
+			require.NoError(t, err)
+			require.NoError(t, types.VerifyTxOutProof(proof, &txHash, blockHash), "txs: %d, index: %d", n, i)
+
+			// the proof does not match other txs
+			otherHash := block.Transactions[(i+1)%n].TxHash()
+			if n > 1 {
+				require.ErrorIs(t, types.VerifyTxOutProof(proof, &otherHash, blockHash), types.ErrTransactionNotIncluded)
+			}
+
+			// the proof is bound to the block
+			require.ErrorIs(t, types.VerifyTxOutProof(proof, &txHash, chaincfg.RegressionNetParams.GenesisHash.String()), types.ErrInvalidTxOutProof)
+		}
+
+		// multiple matched txs
+		first := block.Transactions[0].TxHash()
+		last := block.Transactions[n-1].TxHash()
+
+		proof, err := types.BuildTxOutProof(block, &first, &last)
+		require.NoError(t, err)
+
+		merkleBlock, err := types.ParseTxOutProof(proof)
+		require.NoError(t, err)
+
+		matches, err := types.ExtractMatchedTxHashes(merkleBlock)
+		require.NoError(t, err)
+
+		if n == 1 {
+			require.Equal(t, []chainhash.Hash{first}, matches)
+		} else {
+			require.Equal(t, []chainhash.Hash{first, last}, matches)
+		}
+	}
+}
+
+func TestInvalidTxOutProof(t *testing.T) {
+	txs := make([]*wire.MsgTx, 0)
+	for i := 1; i < 5; i++ {
+		txs = append(txs, bitcoin.NewTx(wire.NewOutPoint(&chainhash.Hash{byte(i)}, 0), nil, wire.NewTxOut(int64(i), nil)))
+	}
+
+	block := bitcoin.NewBlock(chaincfg.RegressionNetParams.GenesisBlock, 1, txs...)
+	txHash := block.Transactions[2].TxHash()
+
+	proof, err := types.BuildTxOutProof(block, &txHash)
+	require.NoError(t, err)
+
+	merkleBlock, err := types.ParseTxOutProof(proof)
+	require.NoError(t, err)
+
+	// tampered hash
+	merkleBlock.Hashes[0] = &chainhash.Hash{1}
+	_, err = types.ExtractMatchedTxHashes(merkleBlock)
+	require.ErrorIs(t, err, types.ErrInvalidTxOutProof)
+
+	// not hex
+	_, err = types.ParseTxOutProof("invalid")
+	require.ErrorIs(t, err, types.ErrInvalidTxOutProof)
+
+	// truncated
+	proofBytes, err := hex.DecodeString(proof)
+	require.NoError(t, err)
+
+	_, err = types.ParseTxOutProof(hex.EncodeToString(proofBytes[:len(proofBytes)-1]))
+	require.ErrorIs(t, err, types.ErrInvalidTxOutProof)
+}
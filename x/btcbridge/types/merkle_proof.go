@@ -37,3 +37,41 @@ func VerifyMerkleProof(proofs []string, txHash, root *chainhash.Hash) bool {
 	return current.IsEqual(root)
 
 }
+
+// GetMerkleProof builds the Merkle proof of the tx at the given index from the tx hashes of the block.
+// Each proof item is the base64 encoded position byte followed by the sibling hash, as VerifyMerkleProof expects.
+// The sibling hash is omitted if the tx is the last odd one at the level and hashed with itself.
+func GetMerkleProof(txHashes []*chainhash.Hash, index int) []string {
+	proof := make([]string, 0)
+
+	level := txHashes
+	for len(level) > 1 {
+		var item []byte
+		if index%2 == 0 {
+			item = []byte{0}
+			if index+1 < len(level) {
+				item = append(item, level[index+1][:]...)
+			}
+		} else {
+			item = append([]byte{1}, level[index-1][:]...)
+		}
+
+		proof = append(proof, base64.StdEncoding.EncodeToString(item))
+
+		next := make([]*chainhash.Hash, 0, (len(level)+1)/2)
+		for i := 0; i < len(level); i += 2 {
+			right := level[i]
+			if i+1 < len(level) {
+				right = level[i+1]
+			}
+
+			hash := blockchain.HashMerkleBranches(level[i], right)
+			next = append(next, &hash)
+		}
+
+		level = next
+		index /= 2
+	}
+
+	return proof
+}
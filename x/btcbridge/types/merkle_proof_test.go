@@ -0,0 +1,36 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+func TestGetMerkleProof(t *testing.T) {
+	for _, n := range []int{1, 2, 3, 5, 8, 11} {
+		txs := make([]*btcutil.Tx, 0, n)
+		hashes := make([]*chainhash.Hash, 0, n)
+
+		for i := 0; i < n; i++ {
+			tx := wire.NewMsgTx(2)
+			tx.AddTxOut(wire.NewTxOut(int64(i), nil))
+
+			txs = append(txs, btcutil.NewTx(tx))
+			hashes = append(hashes, txs[i].Hash())
+		}
+
+		merkles := blockchain.BuildMerkleTreeStore(txs, false)
+		root := merkles[len(merkles)-1]
+
+		for i := 0; i < n; i++ {
+			proof := types.GetMerkleProof(hashes, i)
+			require.True(t, types.VerifyMerkleProof(proof, hashes[i], root), "txs: %d, index: %d", n, i)
+		}
+	}
+}
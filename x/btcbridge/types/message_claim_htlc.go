@@ -0,0 +1,71 @@
+package types
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgClaimHTLC = "claim_htlc"
+
+func NewMsgClaimHTLCRequest(
+	sender string,
+	id uint64,
+	preimage string,
+	blockhash string,
+	txBytes string,
+	proof []string,
+) *MsgClaimHTLCRequest {
+	return &MsgClaimHTLCRequest{
+		Sender:    sender,
+		Id:        id,
+		Preimage:  preimage,
+		Blockhash: blockhash,
+		TxBytes:   txBytes,
+		Proof:     proof,
+	}
+}
+
+func (msg *MsgClaimHTLCRequest) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgClaimHTLCRequest) Type() string {
+	return TypeMsgClaimHTLC
+}
+
+func (msg *MsgClaimHTLCRequest) GetSigners() []sdk.AccAddress {
+	Sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{Sender}
+}
+
+func (msg *MsgClaimHTLCRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgClaimHTLCRequest) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "invalid sender address (%s)", err)
+	}
+
+	if _, err := hex.DecodeString(msg.Preimage); err != nil || len(msg.Preimage) == 0 {
+		return sdkerrors.Wrap(ErrInvalidPreimage, "preimage must be hex encoded")
+	}
+
+	if len(msg.Blockhash) == 0 {
+		return sdkerrors.Wrap(ErrInvalidBtcTransaction, "blockhash cannot be empty")
+	}
+
+	if _, err := base64.StdEncoding.DecodeString(msg.TxBytes); err != nil || len(msg.TxBytes) == 0 {
+		return sdkerrors.Wrap(ErrInvalidBtcTransaction, "tx bytes must be base64 encoded")
+	}
+
+	return nil
+}
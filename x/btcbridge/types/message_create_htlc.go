@@ -0,0 +1,90 @@
+package types
+
+import (
+	"encoding/hex"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgCreateHTLC = "create_htlc"
+
+func NewMsgCreateHTLCRequest(
+	sender string,
+	taker string,
+	amount string,
+	hashLock string,
+	timeoutHeight int64,
+	pkScript string,
+	btcAmount uint64,
+) *MsgCreateHTLCRequest {
+	return &MsgCreateHTLCRequest{
+		Sender:        sender,
+		Taker:         taker,
+		Amount:        amount,
+		HashLock:      hashLock,
+		TimeoutHeight: timeoutHeight,
+		PkScript:      pkScript,
+		BtcAmount:     btcAmount,
+	}
+}
+
+func (msg *MsgCreateHTLCRequest) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateHTLCRequest) Type() string {
+	return TypeMsgCreateHTLC
+}
+
+func (msg *MsgCreateHTLCRequest) GetSigners() []sdk.AccAddress {
+	Sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{Sender}
+}
+
+func (msg *MsgCreateHTLCRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCreateHTLCRequest) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "invalid sender address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Taker)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "invalid taker address (%s)", err)
+	}
+
+	coin, err := sdk.ParseCoinNormalized(msg.Amount)
+	if err != nil || !coin.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidAmount, "invalid amount %s", msg.Amount)
+	}
+
+	if err := ValidateHashLock(msg.HashLock); err != nil {
+		return sdkerrors.Wrap(ErrInvalidHTLC, err.Error())
+	}
+
+	if msg.TimeoutHeight <= 0 {
+		return sdkerrors.Wrap(ErrInvalidHTLC, "timeout height must be greater than zero")
+	}
+
+	if pkScript, err := hex.DecodeString(msg.PkScript); err != nil || len(pkScript) == 0 {
+		return sdkerrors.Wrap(ErrInvalidHTLC, "pk script must be hex encoded")
+	}
+
+	if msg.BtcAmount == 0 {
+		return sdkerrors.Wrap(ErrInvalidAmount, "btc amount must be greater than zero")
+	}
+
+	if err := validateMsgChainID(msg.ChainId); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgLinkBitcoinAddress = "link_bitcoin_address"
+
+func NewMsgLinkBitcoinAddressRequest(
+	sender string,
+	btcAddress string,
+	signature string,
+) *MsgLinkBitcoinAddressRequest {
+	return &MsgLinkBitcoinAddressRequest{
+		Sender:     sender,
+		BtcAddress: btcAddress,
+		Signature:  signature,
+	}
+}
+
+func (msg *MsgLinkBitcoinAddressRequest) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgLinkBitcoinAddressRequest) Type() string {
+	return TypeMsgLinkBitcoinAddress
+}
+
+func (msg *MsgLinkBitcoinAddressRequest) GetSigners() []sdk.AccAddress {
+	Sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{Sender}
+}
+
+func (msg *MsgLinkBitcoinAddressRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgLinkBitcoinAddressRequest) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "invalid sender address (%s)", err)
+	}
+
+	// the bitcoin address is decoded against the network of the module params
+	if len(msg.BtcAddress) == 0 {
+		return sdkerrors.Wrap(ErrInvalidBtcAddress, "bitcoin address cannot be empty")
+	}
+
+	if len(msg.Signature) == 0 {
+		return sdkerrors.Wrap(ErrInvalidAddressSignature, "signature cannot be empty")
+	}
+
+	if err := validateMsgChainID(msg.ChainId); err != nil {
+		return err
+	}
+
+	return nil
+}
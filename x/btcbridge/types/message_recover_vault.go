@@ -0,0 +1,64 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgRecoverVault = "recover_vault"
+
+func NewMsgRecoverVaultRequest(
+	authority string,
+	vaultAddress string,
+	recipient string,
+	feeRate int64,
+) *MsgRecoverVaultRequest {
+	return &MsgRecoverVaultRequest{
+		Authority:    authority,
+		VaultAddress: vaultAddress,
+		Recipient:    recipient,
+		FeeRate:      feeRate,
+	}
+}
+
+func (msg *MsgRecoverVaultRequest) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRecoverVaultRequest) Type() string {
+	return TypeMsgRecoverVault
+}
+
+func (msg *MsgRecoverVaultRequest) GetSigners() []sdk.AccAddress {
+	Authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{Authority}
+}
+
+func (msg *MsgRecoverVaultRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRecoverVaultRequest) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "invalid authority address (%s)", err)
+	}
+
+	if len(msg.VaultAddress) == 0 || len(msg.Recipient) == 0 {
+		return sdkerrors.Wrap(ErrVaultNotRecoverable, "vault address and recipient cannot be empty")
+	}
+
+	if msg.FeeRate <= 0 {
+		return sdkerrors.Wrap(ErrInvalidFeeRate, "fee rate must be greater than zero")
+	}
+
+	if err := validateMsgChainID(msg.ChainId); err != nil {
+		return err
+	}
+
+	return nil
+}
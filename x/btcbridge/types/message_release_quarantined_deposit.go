@@ -0,0 +1,52 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgReleaseQuarantinedDeposit = "release_quarantined_deposit"
+
+func NewMsgReleaseQuarantinedDepositRequest(
+	sender string,
+	id uint64,
+) *MsgReleaseQuarantinedDepositRequest {
+	return &MsgReleaseQuarantinedDepositRequest{
+		Sender: sender,
+		Id:     id,
+	}
+}
+
+func (msg *MsgReleaseQuarantinedDepositRequest) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgReleaseQuarantinedDepositRequest) Type() string {
+	return TypeMsgReleaseQuarantinedDeposit
+}
+
+func (msg *MsgReleaseQuarantinedDepositRequest) GetSigners() []sdk.AccAddress {
+	Sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{Sender}
+}
+
+func (msg *MsgReleaseQuarantinedDepositRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgReleaseQuarantinedDepositRequest) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "invalid sender address (%s)", err)
+	}
+
+	if msg.Id == 0 {
+		return sdkerrors.Wrap(ErrQuarantinedDepositNotFound, "id must be greater than zero")
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgReturnQuarantinedDeposit = "return_quarantined_deposit"
+
+func NewMsgReturnQuarantinedDepositRequest(
+	sender string,
+	id uint64,
+	btcAddress string,
+	feeRate int64,
+) *MsgReturnQuarantinedDepositRequest {
+	return &MsgReturnQuarantinedDepositRequest{
+		Sender:     sender,
+		Id:         id,
+		BtcAddress: btcAddress,
+		FeeRate:    feeRate,
+	}
+}
+
+func (msg *MsgReturnQuarantinedDepositRequest) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgReturnQuarantinedDepositRequest) Type() string {
+	return TypeMsgReturnQuarantinedDeposit
+}
+
+func (msg *MsgReturnQuarantinedDepositRequest) GetSigners() []sdk.AccAddress {
+	Sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{Sender}
+}
+
+func (msg *MsgReturnQuarantinedDepositRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgReturnQuarantinedDepositRequest) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "invalid sender address (%s)", err)
+	}
+
+	if msg.Id == 0 {
+		return sdkerrors.Wrap(ErrQuarantinedDepositNotFound, "id must be greater than zero")
+	}
+
+	if msg.FeeRate <= 0 {
+		return ErrInvalidFeeRate
+	}
+
+	return nil
+}
@@ -7,17 +7,19 @@ import (
 
 const TypeMsgSubmitDepositTransaction = "submit_deposit_transaction"
 
-func NewMsgSubmitTransactionRequest(
+func NewMsgSubmitDepositTransactionRequest(
 	sender string,
 	blockhash string,
+	prevTransaction string,
 	transaction string,
 	proof []string,
 ) *MsgSubmitDepositTransactionRequest {
 	return &MsgSubmitDepositTransactionRequest{
-		Sender:    sender,
-		Blockhash: blockhash,
-		TxBytes:   transaction,
-		Proof:     proof,
+		Sender:      sender,
+		Blockhash:   blockhash,
+		PrevTxBytes: prevTransaction,
+		TxBytes:     transaction,
+		Proof:       proof,
 	}
 }
 
@@ -52,17 +54,19 @@ func (msg *MsgSubmitDepositTransactionRequest) ValidateBasic() error {
 		return sdkerrors.Wrap(ErrInvalidBtcTransaction, "blockhash cannot be empty")
 	}
 
-	if len(msg.PrevTxBytes) == 0 {
-		return sdkerrors.Wrap(ErrInvalidBtcTransaction, "transaction cannot be empty")
-	}
+	// the previous tx is empty for the coinbase deposit, which is checked on processing
 
 	if len(msg.TxBytes) == 0 {
 		return sdkerrors.Wrap(ErrInvalidBtcTransaction, "transaction cannot be empty")
 	}
 
-	if len(msg.Proof) == 0 {
+	if len(msg.Proof) == 0 && len(msg.TxOutProof) == 0 {
 		return sdkerrors.Wrap(ErrInvalidBtcTransaction, "proof cannot be empty")
 	}
 
+	if err := validateMsgChainID(msg.ChainId); err != nil {
+		return err
+	}
+
 	return nil
 }
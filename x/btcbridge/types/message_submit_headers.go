@@ -48,5 +48,9 @@ func (msg *MsgSubmitBlockHeaderRequest) ValidateBasic() error {
 		return sdkerrors.Wrap(ErrInvalidHeader, "block headers cannot be empty")
 	}
 
+	if err := validateMsgChainID(msg.ChainId); err != nil {
+		return err
+	}
+
 	return nil
 }
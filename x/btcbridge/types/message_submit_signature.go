@@ -54,5 +54,9 @@ func (msg *MsgSubmitWithdrawSignaturesRequest) ValidateBasic() error {
 		return sdkerrors.Wrap(ErrInvalidSignatures, "sigatures cannot be empty")
 	}
 
+	if err := validateMsgChainID(msg.ChainId); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -50,9 +50,13 @@ func (msg *MsgSubmitWithdrawStatusRequest) ValidateBasic() error {
 		return sdkerrors.Wrap(ErrSigningRequestNotExist, "txid cannot be empty")
 	}
 
-	if msg.Status != SigningStatus_SIGNING_STATUS_BROADCASTED {
+	if msg.Status != SigningStatus_SIGNING_STATUS_BROADCASTED && msg.Status != SigningStatus_SIGNING_STATUS_REJECTED {
 		return sdkerrors.Wrap(ErrInvalidStatus, "invalid status")
 	}
 
+	if err := validateMsgChainID(msg.ChainId); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -26,7 +26,7 @@ func (msg *MsgSubmitWithdrawTransactionRequest) Route() string {
 }
 
 func (msg *MsgSubmitWithdrawTransactionRequest) Type() string {
-	return TypeMsgSubmitDepositTransaction
+	return TypeMsgSubmitWithdrawTransaction
 }
 
 func (msg *MsgSubmitWithdrawTransactionRequest) GetSigners() []sdk.AccAddress {
@@ -56,9 +56,13 @@ func (msg *MsgSubmitWithdrawTransactionRequest) ValidateBasic() error {
 		return sdkerrors.Wrap(ErrInvalidBtcTransaction, "transaction cannot be empty")
 	}
 
-	if len(msg.Proof) == 0 {
+	if len(msg.Proof) == 0 && len(msg.TxOutProof) == 0 {
 		return sdkerrors.Wrap(ErrInvalidBtcTransaction, "proof cannot be empty")
 	}
 
+	if err := validateMsgChainID(msg.ChainId); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -0,0 +1,75 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgTagUTXO = "tag_utxo"
+
+func NewMsgTagUTXORequest(
+	authority string,
+	txid string,
+	vout uint64,
+	inscriptions uint32,
+	runes []*RuneBalance,
+) *MsgTagUTXORequest {
+	return &MsgTagUTXORequest{
+		Authority:    authority,
+		Txid:         txid,
+		Vout:         vout,
+		Inscriptions: inscriptions,
+		Runes:        runes,
+	}
+}
+
+func (msg *MsgTagUTXORequest) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgTagUTXORequest) Type() string {
+	return TypeMsgTagUTXO
+}
+
+func (msg *MsgTagUTXORequest) GetSigners() []sdk.AccAddress {
+	Authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{Authority}
+}
+
+func (msg *MsgTagUTXORequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgTagUTXORequest) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "invalid authority address (%s)", err)
+	}
+
+	if _, err := chainhash.NewHashFromStr(msg.Txid); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidBtcTransaction, "invalid txid %s", msg.Txid)
+	}
+
+	// the amount is empty if unknown
+	for _, balance := range msg.Runes {
+		if len(balance.Amount) == 0 {
+			continue
+		}
+
+		if amount, ok := sdkmath.NewIntFromString(balance.Amount); !ok || !amount.IsPositive() {
+			return sdkerrors.Wrapf(ErrInvalidAmount, "invalid amount %s of the rune %s", balance.Amount, balance.RuneId)
+		}
+	}
+
+	if err := validateMsgChainID(msg.ChainId); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgUpdateBlocklist = "update_blocklist"
+
+func NewMsgUpdateBlocklistRequest(
+	authority string,
+	add []string,
+	remove []string,
+) *MsgUpdateBlocklistRequest {
+	return &MsgUpdateBlocklistRequest{
+		Authority: authority,
+		Add:       add,
+		Remove:    remove,
+	}
+}
+
+func (msg *MsgUpdateBlocklistRequest) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateBlocklistRequest) Type() string {
+	return TypeMsgUpdateBlocklist
+}
+
+func (msg *MsgUpdateBlocklistRequest) GetSigners() []sdk.AccAddress {
+	Authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{Authority}
+}
+
+func (msg *MsgUpdateBlocklistRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateBlocklistRequest) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "invalid authority address (%s)", err)
+	}
+
+	if len(msg.Add) == 0 && len(msg.Remove) == 0 {
+		return sdkerrors.Wrap(ErrInvalidBlocklist, "addresses cannot be empty")
+	}
+
+	added := make(map[string]bool)
+	for _, address := range msg.Add {
+		if len(address) == 0 {
+			return sdkerrors.Wrap(ErrInvalidBlocklist, "address cannot be empty")
+		}
+
+		added[address] = true
+	}
+
+	for _, address := range msg.Remove {
+		if len(address) == 0 {
+			return sdkerrors.Wrap(ErrInvalidBlocklist, "address cannot be empty")
+		}
+
+		if added[address] {
+			return sdkerrors.Wrapf(ErrInvalidBlocklist, "address %s cannot be both added and removed", address)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgUpdateParams = "update_params"
+
+func NewMsgUpdateParamsRequest(
+	authority string,
+	params Params,
+) *MsgUpdateParamsRequest {
+	return &MsgUpdateParamsRequest{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+func (msg *MsgUpdateParamsRequest) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateParamsRequest) Type() string {
+	return TypeMsgUpdateParams
+}
+
+func (msg *MsgUpdateParamsRequest) GetSigners() []sdk.AccAddress {
+	Authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{Authority}
+}
+
+func (msg *MsgUpdateParamsRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateParamsRequest) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "invalid authority address (%s)", err)
+	}
+
+	return msg.Params.Validate()
+}
@@ -2,11 +2,17 @@ package types
 
 import (
 	sdkerrors "cosmossdk.io/errors"
+	"github.com/btcsuite/btcd/btcutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const TypeMsgWithdrawBitcoin = "withdraw_bitcoin"
 
+const (
+	// maximum allowed number of the recipients for a single withdrawal
+	MaxWithdrawRecipients = 100
+)
+
 func NewMsgWithdrawBitcoinRequest(
 	sender string,
 	amount string,
@@ -19,6 +25,19 @@ func NewMsgWithdrawBitcoinRequest(
 	}
 }
 
+// NewMsgWithdrawBitcoinToRecipientsRequest creates the withdrawal which pays the given recipients
+func NewMsgWithdrawBitcoinToRecipientsRequest(
+	sender string,
+	recipients []*WithdrawRecipient,
+	feeRate int64,
+) *MsgWithdrawBitcoinRequest {
+	return &MsgWithdrawBitcoinRequest{
+		Sender:     sender,
+		Recipients: recipients,
+		FeeRate:    feeRate,
+	}
+}
+
 func (msg *MsgWithdrawBitcoinRequest) Route() string {
 	return RouterKey
 }
@@ -46,14 +65,32 @@ func (msg *MsgWithdrawBitcoinRequest) ValidateBasic() error {
 		return sdkerrors.Wrapf(err, "invalid Sender address (%s)", err)
 	}
 
-	coin, err := sdk.ParseCoinNormalized(msg.Amount)
-	if err != nil {
-		return sdkerrors.Wrapf(ErrInvalidAmount, "invalid amount %s", msg.Amount)
-	}
+	if len(msg.Recipients) != 0 {
+		if len(msg.Amount) != 0 || len(msg.BtcAddress) != 0 {
+			return sdkerrors.Wrap(ErrInvalidRecipients, "amount and btc address must be empty along with the recipients")
+		}
 
-	err = CheckOutput(msg.Sender, coin.Amount.Int64())
-	if err != nil {
-		return err
+		if err := validateWithdrawRecipients(msg.Recipients); err != nil {
+			return err
+		}
+	} else {
+		coin, err := sdk.ParseCoinNormalized(msg.Amount)
+		if err != nil {
+			return sdkerrors.Wrapf(ErrInvalidAmount, "invalid amount %s", msg.Amount)
+		}
+
+		if !coin.IsPositive() {
+			return sdkerrors.Wrapf(ErrInvalidAmount, "invalid amount %s", msg.Amount)
+		}
+
+		// the sender which is not a bitcoin address withdraws to the linked bitcoin address
+		// bitcoin addresses as the account address always follow the network of the sdk config
+		btcChainCfg := sdk.GetConfig().GetBtcChainCfg()
+		if _, err := btcutil.DecodeAddress(msg.Sender, btcChainCfg); err == nil && len(msg.BtcAddress) == 0 {
+			if err := CheckOutput(msg.Sender, coin.Amount.Int64(), btcChainCfg); err != nil {
+				return err
+			}
+		}
 	}
 
 	if msg.FeeRate <= 0 {
@@ -62,3 +99,73 @@ func (msg *MsgWithdrawBitcoinRequest) ValidateBasic() error {
 
 	return nil
 }
+
+// WithdrawRecipients returns the destinations of the withdrawal.
+// The btc address of the single destination may be empty, which is resolved to the linked bitcoin address or the sender.
+func (msg *MsgWithdrawBitcoinRequest) WithdrawRecipients() []*WithdrawRecipient {
+	if len(msg.Recipients) != 0 {
+		return msg.Recipients
+	}
+
+	return []*WithdrawRecipient{{BtcAddress: msg.BtcAddress, Amount: msg.Amount}}
+}
+
+// TotalAmount returns the total amount paid to the recipients
+func (msg *MsgWithdrawBitcoinRequest) TotalAmount() (sdk.Coin, error) {
+	var total sdk.Coin
+
+	for i, recipient := range msg.WithdrawRecipients() {
+		coin, err := sdk.ParseCoinNormalized(recipient.Amount)
+		if err != nil {
+			return sdk.Coin{}, sdkerrors.Wrapf(ErrInvalidAmount, "invalid amount %s", recipient.Amount)
+		}
+
+		if i == 0 {
+			total = coin
+			continue
+		}
+
+		if coin.Denom != total.Denom {
+			return sdk.Coin{}, sdkerrors.Wrapf(ErrInvalidAmount, "mismatched denom %s", coin.Denom)
+		}
+
+		total = total.Add(coin)
+	}
+
+	return total, nil
+}
+
+// validateWithdrawRecipients validates the destinations of the multi-recipient withdrawal
+func validateWithdrawRecipients(recipients []*WithdrawRecipient) error {
+	if len(recipients) > MaxWithdrawRecipients {
+		return sdkerrors.Wrapf(ErrInvalidRecipients, "too many recipients; max: %d, got: %d", MaxWithdrawRecipients, len(recipients))
+	}
+
+	addresses := make(map[string]bool)
+
+	denom := ""
+	for _, recipient := range recipients {
+		if len(recipient.BtcAddress) == 0 {
+			return sdkerrors.Wrap(ErrInvalidRecipients, "btc address can not be empty")
+		}
+
+		if addresses[recipient.BtcAddress] {
+			return sdkerrors.Wrapf(ErrInvalidRecipients, "duplicate btc address %s", recipient.BtcAddress)
+		}
+
+		addresses[recipient.BtcAddress] = true
+
+		coin, err := sdk.ParseCoinNormalized(recipient.Amount)
+		if err != nil || !coin.IsPositive() {
+			return sdkerrors.Wrapf(ErrInvalidAmount, "invalid amount %s", recipient.Amount)
+		}
+
+		if len(denom) != 0 && coin.Denom != denom {
+			return sdkerrors.Wrapf(ErrInvalidRecipients, "mismatched denom %s", coin.Denom)
+		}
+
+		denom = coin.Denom
+	}
+
+	return nil
+}
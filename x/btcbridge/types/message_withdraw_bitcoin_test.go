@@ -0,0 +1,53 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sideprotocol/side/testutil/sample"
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+func TestWithdrawRecipients(t *testing.T) {
+	sender := sample.AccAddress()
+
+	msg := types.NewMsgWithdrawBitcoinRequest(sender, "10000sat", 10)
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, []*types.WithdrawRecipient{{Amount: "10000sat"}}, msg.WithdrawRecipients())
+
+	msg = types.NewMsgWithdrawBitcoinToRecipientsRequest(sender, []*types.WithdrawRecipient{
+		{BtcAddress: "addr1", Amount: "10000sat"},
+		{BtcAddress: "addr2", Amount: "20000sat"},
+	}, 10)
+	require.NoError(t, msg.ValidateBasic())
+
+	total, err := msg.TotalAmount()
+	require.NoError(t, err)
+	require.Equal(t, "30000sat", total.String())
+
+	invalid := []*types.MsgWithdrawBitcoinRequest{
+		// the amount along with the recipients
+		{Sender: sender, Amount: "10000sat", FeeRate: 10, Recipients: []*types.WithdrawRecipient{{BtcAddress: "addr1", Amount: "10000sat"}}},
+		// the empty btc address
+		{Sender: sender, FeeRate: 10, Recipients: []*types.WithdrawRecipient{{Amount: "10000sat"}}},
+		// the duplicate btc address
+		{Sender: sender, FeeRate: 10, Recipients: []*types.WithdrawRecipient{{BtcAddress: "addr1", Amount: "10000sat"}, {BtcAddress: "addr1", Amount: "10000sat"}}},
+		// the mismatched denoms
+		{Sender: sender, FeeRate: 10, Recipients: []*types.WithdrawRecipient{{BtcAddress: "addr1", Amount: "10000sat"}, {BtcAddress: "addr2", Amount: "10000ltcsat"}}},
+		// the zero amount
+		{Sender: sender, FeeRate: 10, Recipients: []*types.WithdrawRecipient{{BtcAddress: "addr1", Amount: "0sat"}}},
+	}
+
+	// the recipients beyond the max number
+	tooMany := make([]*types.WithdrawRecipient, types.MaxWithdrawRecipients+1)
+	for i := range tooMany {
+		tooMany[i] = &types.WithdrawRecipient{BtcAddress: fmt.Sprintf("addr%d", i), Amount: "10000sat"}
+	}
+	invalid = append(invalid, &types.MsgWithdrawBitcoinRequest{Sender: sender, FeeRate: 10, Recipients: tooMany})
+
+	for _, msg := range invalid {
+		require.Error(t, msg.ValidateBasic())
+	}
+}
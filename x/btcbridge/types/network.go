@@ -0,0 +1,84 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"golang.org/x/crypto/scrypt"
+)
+
+// BitcoinNetworkParams returns the bitcoin-family network params by the given network name
+func BitcoinNetworkParams(network string) (*chaincfg.Params, error) {
+	switch network {
+	case chaincfg.MainNetParams.Name:
+		return &chaincfg.MainNetParams, nil
+	case chaincfg.TestNet3Params.Name:
+		return &chaincfg.TestNet3Params, nil
+	case chaincfg.SigNetParams.Name:
+		return &chaincfg.SigNetParams, nil
+	case chaincfg.RegressionNetParams.Name:
+		return &chaincfg.RegressionNetParams, nil
+	case LitecoinMainNetParams.Name:
+		return &LitecoinMainNetParams, nil
+	case LitecoinTestNet4Params.Name:
+		return &LitecoinTestNet4Params, nil
+	case LitecoinRegressionNetParams.Name:
+		return &LitecoinRegressionNetParams, nil
+	}
+
+	return nil, fmt.Errorf("unknown bitcoin network: %s", network)
+}
+
+// PowHash returns the proof of work hash of the given block header on the given network,
+// i.e. the scrypt hash for litecoin and the block hash otherwise
+func PowHash(chainCfg *chaincfg.Params, header *wire.BlockHeader) chainhash.Hash {
+	switch chainCfg.Name {
+	case LitecoinMainNetParams.Name, LitecoinTestNet4Params.Name, LitecoinRegressionNetParams.Name:
+		return ScryptHash(header)
+	}
+
+	return header.BlockHash()
+}
+
+// ScryptHash returns the scrypt proof of work hash of the given block header
+func ScryptHash(header *wire.BlockHeader) chainhash.Hash {
+	var buf bytes.Buffer
+	_ = header.Serialize(&buf)
+
+	// scrypt with N=1024, r=1, p=1 and the serialized header as both the password and the salt
+	key, _ := scrypt.Key(buf.Bytes(), buf.Bytes(), 1024, 1, 1, chainhash.HashSize)
+
+	var hash chainhash.Hash
+	copy(hash[:], key)
+
+	return hash
+}
+
+// CheckBlockHeaderSanity checks the proof of work and the sanity of the given block header on the given network
+func CheckBlockHeaderSanity(chainCfg *chaincfg.Params, header *wire.BlockHeader) error {
+	if err := blockchain.CheckBlockHeaderSanity(header, chainCfg.PowLimit, blockchain.NewMedianTime(), blockchain.BFNoPoWCheck); err != nil {
+		return err
+	}
+
+	return CheckProofOfWork(chainCfg, header)
+}
+
+// CheckProofOfWork checks that the target of the given block header is in range
+// and the proof of work hash meets the target
+func CheckProofOfWork(chainCfg *chaincfg.Params, header *wire.BlockHeader) error {
+	target := blockchain.CompactToBig(header.Bits)
+	if target.Sign() <= 0 || target.Cmp(chainCfg.PowLimit) > 0 {
+		return fmt.Errorf("block target difficulty of %064x is out of range", target)
+	}
+
+	hash := PowHash(chainCfg, header)
+	if blockchain.HashToBig(&hash).Cmp(target) > 0 {
+		return fmt.Errorf("block proof of work hash %s is higher than the target %064x", hash, target)
+	}
+
+	return nil
+}
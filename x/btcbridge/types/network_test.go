@@ -0,0 +1,39 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+func TestLitecoinNetworks(t *testing.T) {
+	for _, params := range []*chaincfg.Params{&types.LitecoinMainNetParams, &types.LitecoinTestNet4Params, &types.LitecoinRegressionNetParams} {
+		chainCfg, err := types.BitcoinNetworkParams(params.Name)
+		require.NoError(t, err)
+		require.Equal(t, params, chainCfg)
+
+		// the genesis header hashes to the genesis hash and satisfies the scrypt proof of work
+		header := params.GenesisBlock.Header
+		require.Equal(t, *params.GenesisHash, header.BlockHash(), params.Name)
+		require.NoError(t, types.CheckProofOfWork(params, &header), params.Name)
+
+		// the segwit addresses of the network are decodable
+		addr, err := btcutil.NewAddressWitnessPubKeyHash(make([]byte, 20), params)
+		require.NoError(t, err)
+
+		decoded, err := btcutil.DecodeAddress(addr.EncodeAddress(), params)
+		require.NoError(t, err)
+		require.True(t, decoded.IsForNet(params))
+		require.False(t, decoded.IsForNet(&chaincfg.RegressionNetParams))
+	}
+
+	// the bitcoin proof of work is not valid on litecoin
+	header := chaincfg.MainNetParams.GenesisBlock.Header
+	require.NoError(t, types.CheckProofOfWork(&chaincfg.MainNetParams, &header))
+	header.Bits = types.LitecoinMainNetParams.PowLimitBits
+	require.Error(t, types.CheckProofOfWork(&types.LitecoinMainNetParams, &header))
+}
@@ -1,6 +1,24 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// default number of side blocks after which a pending attestation expires
+	DefaultAttestationExpiry = 600
+
+	// default lag in seconds of the best block header beyond which the bridge is degraded
+	DefaultMaxHeaderLag = 3 * 60 * 60
+
+	// maximum length of the id of a bridged chain
+	MaxChainIDLength = 32
+)
 
 // NewParams creates a new Params instance
 func NewParams(relayers []string) Params {
@@ -9,6 +27,11 @@ func NewParams(relayers []string) Params {
 		Confirmations:           2,
 		MaxAcceptableBlockDepth: 100,
 		BtcVoucherDenom:         "sat",
+		RelayerQuorum:           1,
+		AttestationExpiry:       DefaultAttestationExpiry,
+		FeeEpoch:                DefaultFeeEpoch,
+		MaxHeaderLag:            DefaultMaxHeaderLag,
+		Network:                 sdk.GetConfig().GetBtcChainCfg().Name,
 		Vaults: []*Vault{{
 			Address:   "",
 			PubKey:    "",
@@ -34,9 +57,252 @@ func (p Params) Validate() error {
 			return err
 		}
 	}
+
+	if p.RequiresQuorum() && p.AttestationExpiry <= 0 {
+		return fmt.Errorf("attestation expiry must be greater than zero")
+	}
+
+	if p.RequiresQuorum() && int(p.RelayerQuorum) > len(p.AuthorizedRelayers) {
+		return fmt.Errorf("relayer quorum %d must not exceed the number of the authorized relayers %d", p.RelayerQuorum, len(p.AuthorizedRelayers))
+	}
+
+	if len(p.Network) != 0 {
+		if _, err := BitcoinNetworkParams(p.Network); err != nil {
+			return err
+		}
+	}
+
+	// vault addresses must belong to the bitcoin network
+	if err := validateVaults(p.Vaults, p.ChainCfg()); err != nil {
+		return err
+	}
+
+	if err := p.validateFees(); err != nil {
+		return err
+	}
+
+	if err := validateConfirmationTiers(p.ConfirmationTiers); err != nil {
+		return err
+	}
+
+	if p.MaxHeaderLag < 0 {
+		return fmt.Errorf("max header lag must not be negative")
+	}
+
+	if len(p.ScreeningAdmin) != 0 {
+		if _, err := sdk.AccAddressFromBech32(p.ScreeningAdmin); err != nil {
+			return fmt.Errorf("invalid screening admin: %v", err)
+		}
+	}
+
+	return p.validateChains()
+}
+
+// validateChains validates the params of the bridged bitcoin-family chains
+func (p Params) validateChains() error {
+	chainIDs := make(map[string]bool)
+	denoms := map[string]bool{p.BtcVoucherDenom: true}
+
+	for _, chain := range p.Chains {
+		if err := ValidateChainID(chain.ChainId); err != nil {
+			return err
+		}
+
+		if chainIDs[chain.ChainId] {
+			return fmt.Errorf("duplicate chain %s", chain.ChainId)
+		}
+
+		chainCfg, err := BitcoinNetworkParams(chain.Network)
+		if err != nil {
+			return fmt.Errorf("invalid network of the chain %s: %v", chain.ChainId, err)
+		}
+
+		if err := sdk.ValidateDenom(chain.VoucherDenom); err != nil {
+			return fmt.Errorf("invalid voucher denom of the chain %s: %v", chain.ChainId, err)
+		}
+
+		if denoms[chain.VoucherDenom] {
+			return fmt.Errorf("duplicate voucher denom %s", chain.VoucherDenom)
+		}
+
+		if err := validateVaults(chain.Vaults, chainCfg); err != nil {
+			return err
+		}
+
+		if err := validateConfirmationTiers(chain.ConfirmationTiers); err != nil {
+			return err
+		}
+
+		if chain.MaxHeaderLag < 0 {
+			return fmt.Errorf("max header lag of the chain %s must not be negative", chain.ChainId)
+		}
+
+		if err := validateFeeSchedules(chain.FeeSchedules); err != nil {
+			return fmt.Errorf("invalid fee schedules of the chain %s: %v", chain.ChainId, err)
+		}
+
+		if len(chain.FeeSchedules) != 0 && p.FeeEpoch <= 0 {
+			return fmt.Errorf("fee epoch must be greater than zero")
+		}
+
+		chainIDs[chain.ChainId] = true
+		denoms[chain.VoucherDenom] = true
+	}
+
+	return nil
+}
+
+// validateVaults validates the given vaults against the given network
+func validateVaults(vaults []*Vault, chainCfg *chaincfg.Params) error {
+	for _, vault := range vaults {
+		if len(vault.Address) == 0 {
+			continue
+		}
+
+		addr, err := btcutil.DecodeAddress(vault.Address, chainCfg)
+		if err != nil || !addr.IsForNet(chainCfg) {
+			return fmt.Errorf("vault address %s is invalid for the bitcoin network %s", vault.Address, chainCfg.Name)
+		}
+
+		// taproot vaults must be derived from the descriptor
+		if vault.TapDescriptor != nil {
+			if err := vault.TapDescriptor.Validate(); err != nil {
+				return fmt.Errorf("invalid descriptor of the vault %s: %v", vault.Address, err)
+			}
+
+			derived, err := vault.TapDescriptor.Address(chainCfg)
+			if err != nil || derived != vault.Address {
+				return fmt.Errorf("vault address %s does not match the descriptor", vault.Address)
+			}
+		}
+
+		signers := make(map[string]bool)
+		for _, signer := range vault.Signers {
+			if _, err := sdk.AccAddressFromBech32(signer); err != nil {
+				return fmt.Errorf("invalid signer %s of the vault %s: %v", signer, vault.Address, err)
+			}
+
+			if signers[signer] {
+				return fmt.Errorf("duplicate signer %s of the vault %s", signer, vault.Address)
+			}
+
+			signers[signer] = true
+		}
+	}
+
 	return nil
 }
 
+// ForChain returns the params scoped to the given bridged chain, i.e. the network, confirmations,
+// max acceptable block depth, voucher denom, vaults and max header lag are replaced by the ones of the chain.
+// The params are returned as is for the empty chain id, which stands for bitcoin.
+func (p Params) ForChain(chainID string) Params {
+	chain := p.Chain(chainID)
+	if chain == nil {
+		return p
+	}
+
+	p.Network = chain.Network
+	p.Confirmations = chain.Confirmations
+	p.MaxAcceptableBlockDepth = chain.MaxAcceptableBlockDepth
+	p.BtcVoucherDenom = chain.VoucherDenom
+	p.Vaults = chain.Vaults
+	p.ConfirmationTiers = chain.ConfirmationTiers
+	p.MaxHeaderLag = chain.MaxHeaderLag
+	p.FeeSchedules = chain.FeeSchedules
+
+	return p
+}
+
+// Chain returns the params of the given bridged chain, nil if not found
+func (p Params) Chain(chainID string) *ChainParams {
+	if len(chainID) == 0 {
+		return nil
+	}
+
+	for _, chain := range p.Chains {
+		if chain.ChainId == chainID {
+			return chain
+		}
+	}
+
+	return nil
+}
+
+// HasChain returns true if the given chain is bridged, the empty chain id stands for bitcoin
+func (p Params) HasChain(chainID string) bool {
+	return len(chainID) == 0 || p.Chain(chainID) != nil
+}
+
+// ChainIDs returns the ids of all bridged chains including the empty one of bitcoin
+func (p Params) ChainIDs() []string {
+	chainIDs := []string{""}
+	for _, chain := range p.Chains {
+		chainIDs = append(chainIDs, chain.ChainId)
+	}
+
+	return chainIDs
+}
+
+// ChainIDByDenom returns the id of the bridged chain by the given voucher denom
+func (p Params) ChainIDByDenom(denom string) (string, bool) {
+	if denom == p.BtcVoucherDenom {
+		return "", true
+	}
+
+	for _, chain := range p.Chains {
+		if chain.VoucherDenom == denom {
+			return chain.ChainId, true
+		}
+	}
+
+	return "", false
+}
+
+// validateMsgChainID validates the chain id of the message, the empty chain id stands for bitcoin
+func validateMsgChainID(chainID string) error {
+	if len(chainID) == 0 {
+		return nil
+	}
+
+	if err := ValidateChainID(chainID); err != nil {
+		return errorsmod.Wrap(ErrUnknownChain, err.Error())
+	}
+
+	return nil
+}
+
+// ValidateChainID validates the id of the bridged chain, which consists of lowercase letters, digits and hyphens
+func ValidateChainID(chainID string) error {
+	if len(chainID) == 0 || len(chainID) > MaxChainIDLength {
+		return fmt.Errorf("chain id must be between 1 and %d characters", MaxChainIDLength)
+	}
+
+	for _, c := range chainID {
+		if (c < 'a' || c > 'z') && (c < '0' || c > '9') && c != '-' {
+			return fmt.Errorf("invalid chain id %s", chainID)
+		}
+	}
+
+	return nil
+}
+
+// ChainCfg returns the params of the bitcoin network.
+// Fallback to the network of the sdk config if not specified.
+func (p Params) ChainCfg() *chaincfg.Params {
+	chainCfg, err := BitcoinNetworkParams(p.Network)
+	if err != nil {
+		return sdk.GetConfig().GetBtcChainCfg()
+	}
+
+	return chainCfg
+}
+
+// RequiresQuorum returns true if headers and deposits must be attested by several relayers
+func (p Params) RequiresQuorum() bool {
+	return p.RelayerQuorum > 1
+}
+
 // checks if the given address is an authorized sender
 func (p Params) IsAuthorizedSender(sender string) bool {
 	for _, s := range p.AuthorizedRelayers {
@@ -58,6 +324,17 @@ func SelectVaultByBitcoinAddress(vaults []*Vault, address string) *Vault {
 	return nil
 }
 
+// IsSigner returns true if the given account is in the signer committee of the vault
+func (v *Vault) IsSigner(address string) bool {
+	for _, signer := range v.Signers {
+		if signer == address {
+			return true
+		}
+	}
+
+	return false
+}
+
 // SelectVaultByPubKey returns the vault if the public key is found
 // returns the vault if the public key is found
 func SelectVaultByPubKey(vaults []*Vault, pubKey string) *Vault {
@@ -70,6 +70,32 @@ type Params struct {
 	// the denomanation of the voucher
 	BtcVoucherDenom string   `protobuf:"bytes,4,opt,name=btc_voucher_denom,json=btcVoucherDenom,proto3" json:"btc_voucher_denom,omitempty"`
 	Vaults          []*Vault `protobuf:"bytes,5,rep,name=vaults,proto3" json:"vaults,omitempty"`
+	// The number of distinct authorized relayers which must submit matching headers or deposits
+	// before they take effect; 0 or 1 keeps the single-submit path
+	RelayerQuorum uint32 `protobuf:"varint,6,opt,name=relayer_quorum,json=relayerQuorum,proto3" json:"relayer_quorum,omitempty"`
+	// The number of side blocks after which a pending attestation expires
+	AttestationExpiry int64 `protobuf:"varint,7,opt,name=attestation_expiry,json=attestationExpiry,proto3" json:"attestation_expiry,omitempty"`
+	// The bitcoin network: mainnet, testnet3, signet or regtest
+	Network string `protobuf:"bytes,8,opt,name=network,proto3" json:"network,omitempty"`
+	// Other bitcoin-family chains bridged along with bitcoin
+	Chains []*ChainParams `protobuf:"bytes,9,rep,name=chains,proto3" json:"chains,omitempty"`
+	// The bridge fees by asset type, charged in the voucher denom of each bridged chain
+	FeeSchedules []*FeeSchedule `protobuf:"bytes,10,rep,name=fee_schedules,json=feeSchedules,proto3" json:"fee_schedules,omitempty"`
+	// The number of side blocks between the distributions of the collected fees
+	FeeEpoch int64 `protobuf:"varint,11,opt,name=fee_epoch,json=feeEpoch,proto3" json:"fee_epoch,omitempty"`
+	// The share of the collected fees distributed to the relayers, in basis points
+	RelayerFeeShare uint32 `protobuf:"varint,12,opt,name=relayer_fee_share,json=relayerFeeShare,proto3" json:"relayer_fee_share,omitempty"`
+	// The share of the collected fees distributed to the signers, in basis points;
+	// the rest goes to the community pool
+	SignerFeeShare uint32 `protobuf:"varint,13,opt,name=signer_fee_share,json=signerFeeShare,proto3" json:"signer_fee_share,omitempty"`
+	// The confirmations required by the amount of deposits and withdrawals of bitcoin,
+	// overriding the confirmations above for the amounts reaching the tiers
+	ConfirmationTiers []*ConfirmationTier `protobuf:"bytes,14,rep,name=confirmation_tiers,json=confirmationTiers,proto3" json:"confirmation_tiers,omitempty"`
+	// The account which may release or return the quarantined deposits along with the governance
+	ScreeningAdmin string `protobuf:"bytes,15,opt,name=screening_admin,json=screeningAdmin,proto3" json:"screening_admin,omitempty"`
+	// The maximum lag in seconds of the best block header behind the side block time,
+	// beyond which the bridge is degraded and the withdrawals are paused; 0 disables the check
+	MaxHeaderLag int64 `protobuf:"varint,16,opt,name=max_header_lag,json=maxHeaderLag,proto3" json:"max_header_lag,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -140,6 +166,282 @@ func (m *Params) GetVaults() []*Vault {
 	return nil
 }
 
+func (m *Params) GetRelayerQuorum() uint32 {
+	if m != nil {
+		return m.RelayerQuorum
+	}
+	return 0
+}
+
+func (m *Params) GetAttestationExpiry() int64 {
+	if m != nil {
+		return m.AttestationExpiry
+	}
+	return 0
+}
+
+func (m *Params) GetNetwork() string {
+	if m != nil {
+		return m.Network
+	}
+	return ""
+}
+
+func (m *Params) GetChains() []*ChainParams {
+	if m != nil {
+		return m.Chains
+	}
+	return nil
+}
+
+func (m *Params) GetFeeSchedules() []*FeeSchedule {
+	if m != nil {
+		return m.FeeSchedules
+	}
+	return nil
+}
+
+func (m *Params) GetFeeEpoch() int64 {
+	if m != nil {
+		return m.FeeEpoch
+	}
+	return 0
+}
+
+func (m *Params) GetRelayerFeeShare() uint32 {
+	if m != nil {
+		return m.RelayerFeeShare
+	}
+	return 0
+}
+
+func (m *Params) GetSignerFeeShare() uint32 {
+	if m != nil {
+		return m.SignerFeeShare
+	}
+	return 0
+}
+
+func (m *Params) GetConfirmationTiers() []*ConfirmationTier {
+	if m != nil {
+		return m.ConfirmationTiers
+	}
+	return nil
+}
+
+func (m *Params) GetScreeningAdmin() string {
+	if m != nil {
+		return m.ScreeningAdmin
+	}
+	return ""
+}
+
+func (m *Params) GetMaxHeaderLag() int64 {
+	if m != nil {
+		return m.MaxHeaderLag
+	}
+	return 0
+}
+
+// FeeSchedule defines the bridge fees of an asset type, i.e. a flat fee plus a proportional fee in basis points
+type FeeSchedule struct {
+	AssetType AssetType `protobuf:"varint,1,opt,name=asset_type,json=assetType,proto3,enum=side.btcbridge.AssetType" json:"asset_type,omitempty"`
+	// the flat fee deducted from each deposit
+	DepositFlatFee uint64 `protobuf:"varint,2,opt,name=deposit_flat_fee,json=depositFlatFee,proto3" json:"deposit_flat_fee,omitempty"`
+	// the proportional fee deducted from each deposit, in basis points
+	DepositFeeBps uint32 `protobuf:"varint,3,opt,name=deposit_fee_bps,json=depositFeeBps,proto3" json:"deposit_fee_bps,omitempty"`
+	// the flat fee charged on top of each withdrawal
+	WithdrawFlatFee uint64 `protobuf:"varint,4,opt,name=withdraw_flat_fee,json=withdrawFlatFee,proto3" json:"withdraw_flat_fee,omitempty"`
+	// the proportional fee charged on top of each withdrawal, in basis points
+	WithdrawFeeBps uint32 `protobuf:"varint,5,opt,name=withdraw_fee_bps,json=withdrawFeeBps,proto3" json:"withdraw_fee_bps,omitempty"`
+}
+
+func (m *FeeSchedule) Reset()         { *m = FeeSchedule{} }
+func (m *FeeSchedule) String() string { return proto.CompactTextString(m) }
+func (*FeeSchedule) ProtoMessage()    {}
+func (*FeeSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1d33573cda8a6d2, []int{1}
+}
+func (m *FeeSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeSchedule.Merge(m, src)
+}
+func (m *FeeSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeSchedule proto.InternalMessageInfo
+
+func (m *FeeSchedule) GetAssetType() AssetType {
+	if m != nil {
+		return m.AssetType
+	}
+	return AssetType_ASSET_TYPE_UNSPECIFIED
+}
+
+func (m *FeeSchedule) GetDepositFlatFee() uint64 {
+	if m != nil {
+		return m.DepositFlatFee
+	}
+	return 0
+}
+
+func (m *FeeSchedule) GetDepositFeeBps() uint32 {
+	if m != nil {
+		return m.DepositFeeBps
+	}
+	return 0
+}
+
+func (m *FeeSchedule) GetWithdrawFlatFee() uint64 {
+	if m != nil {
+		return m.WithdrawFlatFee
+	}
+	return 0
+}
+
+func (m *FeeSchedule) GetWithdrawFeeBps() uint32 {
+	if m != nil {
+		return m.WithdrawFeeBps
+	}
+	return 0
+}
+
+// ChainParams defines the params of a bitcoin-family chain bridged along with bitcoin.
+// The light client, utxos, vaults and address links of the chain are namespaced by the chain id.
+type ChainParams struct {
+	// the identifier of the chain, e.g. ltc
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// the network of the chain, e.g. litecoin
+	Network string `protobuf:"bytes,2,opt,name=network,proto3" json:"network,omitempty"`
+	// The minimum number of confirmations required for a block to be accepted
+	Confirmations int32 `protobuf:"varint,3,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	// The maximum depth from the latest block up to which transactions are considered for acceptance
+	MaxAcceptableBlockDepth uint64 `protobuf:"varint,4,opt,name=max_acceptable_block_depth,json=maxAcceptableBlockDepth,proto3" json:"max_acceptable_block_depth,omitempty"`
+	// the denomination of the voucher of the chain
+	VoucherDenom string   `protobuf:"bytes,5,opt,name=voucher_denom,json=voucherDenom,proto3" json:"voucher_denom,omitempty"`
+	Vaults       []*Vault `protobuf:"bytes,6,rep,name=vaults,proto3" json:"vaults,omitempty"`
+	// The confirmations required by the amount of deposits and withdrawals of the chain
+	ConfirmationTiers []*ConfirmationTier `protobuf:"bytes,7,rep,name=confirmation_tiers,json=confirmationTiers,proto3" json:"confirmation_tiers,omitempty"`
+	// The maximum lag in seconds of the best block header of the chain, 0 disables the check
+	MaxHeaderLag int64 `protobuf:"varint,8,opt,name=max_header_lag,json=maxHeaderLag,proto3" json:"max_header_lag,omitempty"`
+	// the bridge fees of the chain by asset type, charged in the voucher of the chain
+	FeeSchedules []*FeeSchedule `protobuf:"bytes,9,rep,name=fee_schedules,json=feeSchedules,proto3" json:"fee_schedules,omitempty"`
+}
+
+func (m *ChainParams) Reset()         { *m = ChainParams{} }
+func (m *ChainParams) String() string { return proto.CompactTextString(m) }
+func (*ChainParams) ProtoMessage()    {}
+func (*ChainParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1d33573cda8a6d2, []int{2}
+}
+func (m *ChainParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainParams.Merge(m, src)
+}
+func (m *ChainParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainParams proto.InternalMessageInfo
+
+func (m *ChainParams) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ChainParams) GetNetwork() string {
+	if m != nil {
+		return m.Network
+	}
+	return ""
+}
+
+func (m *ChainParams) GetConfirmations() int32 {
+	if m != nil {
+		return m.Confirmations
+	}
+	return 0
+}
+
+func (m *ChainParams) GetMaxAcceptableBlockDepth() uint64 {
+	if m != nil {
+		return m.MaxAcceptableBlockDepth
+	}
+	return 0
+}
+
+func (m *ChainParams) GetVoucherDenom() string {
+	if m != nil {
+		return m.VoucherDenom
+	}
+	return ""
+}
+
+func (m *ChainParams) GetVaults() []*Vault {
+	if m != nil {
+		return m.Vaults
+	}
+	return nil
+}
+
+func (m *ChainParams) GetConfirmationTiers() []*ConfirmationTier {
+	if m != nil {
+		return m.ConfirmationTiers
+	}
+	return nil
+}
+
+func (m *ChainParams) GetMaxHeaderLag() int64 {
+	if m != nil {
+		return m.MaxHeaderLag
+	}
+	return 0
+}
+
+func (m *ChainParams) GetFeeSchedules() []*FeeSchedule {
+	if m != nil {
+		return m.FeeSchedules
+	}
+	return nil
+}
+
 // Vault defines the parameters for the module.
 type Vault struct {
 	// the depositor should send their btc to this address
@@ -148,13 +450,17 @@ type Vault struct {
 	PubKey string `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
 	// the address to which the voucher is sent
 	AssetType AssetType `protobuf:"varint,4,opt,name=asset_type,json=assetType,proto3,enum=side.btcbridge.AssetType" json:"asset_type,omitempty"`
+	// the taproot script descriptor of the vault, if any; the address must be derived from it
+	TapDescriptor *VaultDescriptor `protobuf:"bytes,5,opt,name=tap_descriptor,json=tapDescriptor,proto3" json:"tap_descriptor,omitempty"`
+	// the side accounts of the signer committee, which are credited for the signatures of the vault
+	Signers []string `protobuf:"bytes,6,rep,name=signers,proto3" json:"signers,omitempty"`
 }
 
 func (m *Vault) Reset()         { *m = Vault{} }
 func (m *Vault) String() string { return proto.CompactTextString(m) }
 func (*Vault) ProtoMessage()    {}
 func (*Vault) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f1d33573cda8a6d2, []int{1}
+	return fileDescriptor_f1d33573cda8a6d2, []int{3}
 }
 func (m *Vault) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -204,98 +510,366 @@ func (m *Vault) GetAssetType() AssetType {
 	return AssetType_ASSET_TYPE_UNSPECIFIED
 }
 
-func init() {
-	proto.RegisterEnum("side.btcbridge.AssetType", AssetType_name, AssetType_value)
-	proto.RegisterType((*Params)(nil), "side.btcbridge.Params")
-	proto.RegisterType((*Vault)(nil), "side.btcbridge.Vault")
-}
-
-func init() { proto.RegisterFile("side/btcbridge/params.proto", fileDescriptor_f1d33573cda8a6d2) }
-
-var fileDescriptor_f1d33573cda8a6d2 = []byte{
-	// 444 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0xcf, 0x6b, 0xdb, 0x30,
-	0x14, 0xc7, 0xe3, 0xa6, 0x49, 0xb1, 0xc6, 0x52, 0x4f, 0xed, 0x56, 0x2f, 0x03, 0x63, 0xca, 0x0e,
-	0xa6, 0x30, 0x7b, 0x64, 0x97, 0xc1, 0x4e, 0xf9, 0xe1, 0xb1, 0x32, 0x28, 0x41, 0x49, 0x0b, 0xdb,
-	0x45, 0x48, 0xb2, 0x9a, 0x98, 0xda, 0x91, 0x91, 0xe4, 0x12, 0xef, 0xaf, 0xd8, 0x9f, 0xb5, 0x63,
-	0x8f, 0x3b, 0x8e, 0xe4, 0x8f, 0xd8, 0x75, 0xc8, 0x6d, 0xb3, 0xa4, 0xb7, 0xf7, 0x3e, 0x9f, 0xaf,
-	0xed, 0xf7, 0xcc, 0x03, 0x6f, 0x54, 0x9a, 0xf0, 0x88, 0x6a, 0x46, 0x65, 0x9a, 0xcc, 0x78, 0x54,
-	0x10, 0x49, 0x72, 0x15, 0x16, 0x52, 0x68, 0x01, 0x3b, 0x46, 0x86, 0x1b, 0xd9, 0x3d, 0x9e, 0x89,
-	0x99, 0xa8, 0x55, 0x64, 0xaa, 0xfb, 0xd4, 0xe9, 0x5f, 0x0b, 0xb4, 0xc7, 0xf5, 0x63, 0x30, 0x02,
-	0x47, 0xa4, 0xd4, 0x73, 0x21, 0xd3, 0x1f, 0x3c, 0xc1, 0x92, 0x67, 0xa4, 0xe2, 0x52, 0xb9, 0x96,
-	0xdf, 0x0c, 0x6c, 0x04, 0xff, 0x2b, 0xf4, 0x60, 0xe0, 0x5b, 0xf0, 0x9c, 0x89, 0xc5, 0x75, 0x2a,
-	0x73, 0xa2, 0x53, 0xb1, 0x50, 0xee, 0x9e, 0x6f, 0x05, 0x2d, 0xb4, 0x0b, 0xe1, 0x27, 0xd0, 0xcd,
-	0xc9, 0x12, 0x13, 0xc6, 0x78, 0xa1, 0x09, 0xcd, 0x38, 0xa6, 0x99, 0x60, 0x37, 0x38, 0xe1, 0x85,
-	0x9e, 0xbb, 0x4d, 0xdf, 0x0a, 0xf6, 0xd1, 0x49, 0x4e, 0x96, 0xfd, 0x4d, 0x60, 0x60, 0xfc, 0xc8,
-	0x68, 0x78, 0x06, 0x5e, 0x50, 0xcd, 0xf0, 0xad, 0x28, 0xd9, 0x9c, 0x4b, 0x9c, 0xf0, 0x85, 0xc8,
-	0xdd, 0x7d, 0xdf, 0x0a, 0x6c, 0x74, 0x48, 0x35, 0xbb, 0xba, 0xe7, 0x23, 0x83, 0xe1, 0x3b, 0xd0,
-	0xbe, 0x25, 0x65, 0xa6, 0x95, 0xdb, 0xf2, 0x9b, 0xc1, 0xb3, 0xde, 0xcb, 0x70, 0xf7, 0x0f, 0x84,
-	0x57, 0xc6, 0xa2, 0x87, 0xd0, 0xa9, 0x06, 0xad, 0x1a, 0x40, 0x17, 0x1c, 0x90, 0x24, 0x91, 0x5c,
-	0x99, 0x5d, 0xcd, 0x9b, 0x1f, 0x5b, 0x78, 0x02, 0x0e, 0x8a, 0x92, 0xe2, 0x1b, 0x5e, 0xd5, 0xab,
-	0xd9, 0xa8, 0x5d, 0x94, 0xf4, 0x2b, 0xaf, 0xe0, 0x47, 0x00, 0x88, 0x52, 0x5c, 0x63, 0x5d, 0x15,
-	0xbc, 0x9e, 0xa7, 0xd3, 0x7b, 0xfd, 0xf4, 0x73, 0x7d, 0x93, 0x98, 0x56, 0x05, 0x47, 0x36, 0x79,
-	0x2c, 0xcf, 0xae, 0x81, 0xbd, 0xe1, 0xb0, 0x0b, 0x5e, 0xf5, 0x27, 0x93, 0x78, 0x8a, 0xa7, 0xdf,
-	0xc6, 0x31, 0xbe, 0xbc, 0x98, 0x8c, 0xe3, 0xe1, 0xf9, 0xe7, 0xf3, 0x78, 0xe4, 0x34, 0x20, 0x04,
-	0x9d, 0x2d, 0x37, 0x98, 0x0e, 0x1d, 0x0b, 0x1e, 0x03, 0x67, 0x9b, 0xa1, 0x61, 0xef, 0xbd, 0xb3,
-	0x07, 0x8f, 0xc0, 0xe1, 0x16, 0x45, 0x97, 0x17, 0xb1, 0xd3, 0x1c, 0x7c, 0xf9, 0xb5, 0xf2, 0xac,
-	0xbb, 0x95, 0x67, 0xfd, 0x59, 0x79, 0xd6, 0xcf, 0xb5, 0xd7, 0xb8, 0x5b, 0x7b, 0x8d, 0xdf, 0x6b,
-	0xaf, 0xf1, 0x3d, 0x9c, 0xa5, 0x7a, 0x5e, 0xd2, 0x90, 0x89, 0x3c, 0x32, 0x13, 0xd7, 0x77, 0xc0,
-	0x44, 0x56, 0x37, 0xd1, 0x72, 0xeb, 0x9c, 0xcc, 0x72, 0x8a, 0xb6, 0xeb, 0xc0, 0x87, 0x7f, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x87, 0xf7, 0x68, 0xef, 0x6d, 0x02, 0x00, 0x00,
+func (m *Vault) GetTapDescriptor() *VaultDescriptor {
+	if m != nil {
+		return m.TapDescriptor
+	}
+	return nil
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Vault) GetSigners() []string {
+	if m != nil {
+		return m.Signers
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// VaultDescriptor describes a taproot vault whose key path is spent by the signer committee
+// and whose script path allows the recovery key to spend after a relative timelock
+type VaultDescriptor struct {
+	// the hex encoded x-only internal key of the signer committee
+	InternalKey string `protobuf:"bytes,1,opt,name=internal_key,json=internalKey,proto3" json:"internal_key,omitempty"`
+	// the hex encoded x-only governance recovery key
+	RecoveryKey string `protobuf:"bytes,2,opt,name=recovery_key,json=recoveryKey,proto3" json:"recovery_key,omitempty"`
+	// the number of bitcoin blocks (CSV) a vault utxo must age before the recovery key can spend it
+	RecoveryDelay uint32 `protobuf:"varint,3,opt,name=recovery_delay,json=recoveryDelay,proto3" json:"recovery_delay,omitempty"`
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Vaults) > 0 {
-		for iNdEx := len(m.Vaults) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Vaults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintParams(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
+func (m *VaultDescriptor) Reset()         { *m = VaultDescriptor{} }
+func (m *VaultDescriptor) String() string { return proto.CompactTextString(m) }
+func (*VaultDescriptor) ProtoMessage()    {}
+func (*VaultDescriptor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1d33573cda8a6d2, []int{4}
+}
+func (m *VaultDescriptor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VaultDescriptor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VaultDescriptor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.BtcVoucherDenom) > 0 {
-		i -= len(m.BtcVoucherDenom)
-		copy(dAtA[i:], m.BtcVoucherDenom)
-		i = encodeVarintParams(dAtA, i, uint64(len(m.BtcVoucherDenom)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *VaultDescriptor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VaultDescriptor.Merge(m, src)
+}
+func (m *VaultDescriptor) XXX_Size() int {
+	return m.Size()
+}
+func (m *VaultDescriptor) XXX_DiscardUnknown() {
+	xxx_messageInfo_VaultDescriptor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VaultDescriptor proto.InternalMessageInfo
+
+func (m *VaultDescriptor) GetInternalKey() string {
+	if m != nil {
+		return m.InternalKey
 	}
-	if m.MaxAcceptableBlockDepth != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.MaxAcceptableBlockDepth))
-		i--
-		dAtA[i] = 0x18
+	return ""
+}
+
+func (m *VaultDescriptor) GetRecoveryKey() string {
+	if m != nil {
+		return m.RecoveryKey
 	}
-	if m.Confirmations != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.Confirmations))
-		i--
-		dAtA[i] = 0x10
+	return ""
+}
+
+func (m *VaultDescriptor) GetRecoveryDelay() uint32 {
+	if m != nil {
+		return m.RecoveryDelay
 	}
-	if len(m.AuthorizedRelayers) > 0 {
+	return 0
+}
+
+// ConfirmationTier defines the confirmations required by the deposits and withdrawals
+// of the asset type reaching the amount, until the next tier
+type ConfirmationTier struct {
+	AssetType AssetType `protobuf:"varint,1,opt,name=asset_type,json=assetType,proto3,enum=side.btcbridge.AssetType" json:"asset_type,omitempty"`
+	// the minimum amount of the tier in sat
+	MinAmount uint64 `protobuf:"varint,2,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
+	// the number of blocks required on top of the block including the tx
+	Confirmations int32 `protobuf:"varint,3,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+}
+
+func (m *ConfirmationTier) Reset()         { *m = ConfirmationTier{} }
+func (m *ConfirmationTier) String() string { return proto.CompactTextString(m) }
+func (*ConfirmationTier) ProtoMessage()    {}
+func (*ConfirmationTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1d33573cda8a6d2, []int{5}
+}
+func (m *ConfirmationTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConfirmationTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConfirmationTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConfirmationTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfirmationTier.Merge(m, src)
+}
+func (m *ConfirmationTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConfirmationTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfirmationTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfirmationTier proto.InternalMessageInfo
+
+func (m *ConfirmationTier) GetAssetType() AssetType {
+	if m != nil {
+		return m.AssetType
+	}
+	return AssetType_ASSET_TYPE_UNSPECIFIED
+}
+
+func (m *ConfirmationTier) GetMinAmount() uint64 {
+	if m != nil {
+		return m.MinAmount
+	}
+	return 0
+}
+
+func (m *ConfirmationTier) GetConfirmations() int32 {
+	if m != nil {
+		return m.Confirmations
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("side.btcbridge.AssetType", AssetType_name, AssetType_value)
+	proto.RegisterType((*Params)(nil), "side.btcbridge.Params")
+	proto.RegisterType((*FeeSchedule)(nil), "side.btcbridge.FeeSchedule")
+	proto.RegisterType((*ChainParams)(nil), "side.btcbridge.ChainParams")
+	proto.RegisterType((*Vault)(nil), "side.btcbridge.Vault")
+	proto.RegisterType((*VaultDescriptor)(nil), "side.btcbridge.VaultDescriptor")
+	proto.RegisterType((*ConfirmationTier)(nil), "side.btcbridge.ConfirmationTier")
+}
+
+func init() { proto.RegisterFile("side/btcbridge/params.proto", fileDescriptor_f1d33573cda8a6d2) }
+
+var fileDescriptor_f1d33573cda8a6d2 = []byte{
+	// 967 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcb, 0x6e, 0x23, 0x45,
+	0x14, 0x4d, 0xc7, 0x8f, 0xc4, 0xd7, 0xcf, 0xd4, 0x0c, 0x4c, 0x4f, 0x22, 0x8c, 0xc7, 0x0c, 0x60,
+	0x8d, 0x34, 0x36, 0xca, 0x6c, 0x90, 0xd8, 0x90, 0x87, 0xa3, 0x89, 0x40, 0x43, 0xe8, 0x64, 0x46,
+	0x82, 0x4d, 0xa9, 0xba, 0xfb, 0xda, 0x2e, 0xc5, 0xfd, 0xa0, 0xaa, 0x3a, 0x89, 0x59, 0xf0, 0x0d,
+	0xf0, 0x2b, 0x7c, 0x05, 0xcb, 0x59, 0x21, 0x96, 0x28, 0xf9, 0x00, 0xc4, 0x1f, 0xa0, 0x2a, 0x77,
+	0x3b, 0x1d, 0x27, 0x42, 0x13, 0xd8, 0xf9, 0x9e, 0x73, 0xfa, 0xfa, 0x3e, 0xce, 0x6d, 0x35, 0x6c,
+	0x49, 0xee, 0xe3, 0xc0, 0x55, 0x9e, 0x2b, 0xb8, 0x3f, 0xc6, 0x41, 0xcc, 0x04, 0x0b, 0x64, 0x3f,
+	0x16, 0x91, 0x8a, 0x48, 0x43, 0x93, 0xfd, 0x05, 0xb9, 0xf9, 0x70, 0x1c, 0x8d, 0x23, 0x43, 0x0d,
+	0xf4, 0xaf, 0xb9, 0xaa, 0xfb, 0x77, 0x09, 0xca, 0x47, 0xe6, 0x31, 0x32, 0x80, 0x07, 0x2c, 0x51,
+	0x93, 0x48, 0xf0, 0x1f, 0xd1, 0xa7, 0x02, 0xa7, 0x6c, 0x86, 0x42, 0xda, 0x56, 0xa7, 0xd0, 0xab,
+	0x38, 0xe4, 0x9a, 0x72, 0x52, 0x86, 0x3c, 0x85, 0xba, 0x17, 0x85, 0x23, 0x2e, 0x02, 0xa6, 0x78,
+	0x14, 0x4a, 0x7b, 0xb5, 0x63, 0xf5, 0x4a, 0xce, 0x4d, 0x90, 0x7c, 0x01, 0x9b, 0x01, 0xbb, 0xa0,
+	0xcc, 0xf3, 0x30, 0x56, 0xcc, 0x9d, 0x22, 0x75, 0xa7, 0x91, 0x77, 0x4a, 0x7d, 0x8c, 0xd5, 0xc4,
+	0x2e, 0x74, 0xac, 0x5e, 0xd1, 0x79, 0x14, 0xb0, 0x8b, 0x9d, 0x85, 0x60, 0x57, 0xf3, 0xfb, 0x9a,
+	0x26, 0xcf, 0x60, 0xc3, 0x55, 0x1e, 0x3d, 0x8b, 0x12, 0x6f, 0x82, 0x82, 0xfa, 0x18, 0x46, 0x81,
+	0x5d, 0xec, 0x58, 0xbd, 0x8a, 0xd3, 0x74, 0x95, 0xf7, 0x66, 0x8e, 0xef, 0x6b, 0x98, 0x3c, 0x87,
+	0xf2, 0x19, 0x4b, 0xa6, 0x4a, 0xda, 0xa5, 0x4e, 0xa1, 0x57, 0xdd, 0x7e, 0xaf, 0x7f, 0x73, 0x02,
+	0xfd, 0x37, 0x9a, 0x75, 0x52, 0x11, 0xf9, 0x18, 0x1a, 0x69, 0x8f, 0xf4, 0x87, 0x24, 0x12, 0x49,
+	0x60, 0x97, 0x3b, 0x56, 0xaf, 0xee, 0xd4, 0x53, 0xf4, 0x5b, 0x03, 0x92, 0xe7, 0x40, 0x98, 0x52,
+	0x28, 0x95, 0x69, 0x87, 0xe2, 0x45, 0xcc, 0xc5, 0xcc, 0x5e, 0xeb, 0x58, 0xbd, 0x82, 0xb3, 0x91,
+	0x63, 0x86, 0x86, 0x20, 0x36, 0xac, 0x85, 0xa8, 0xce, 0x23, 0x71, 0x6a, 0xaf, 0x9b, 0x32, 0xb3,
+	0x90, 0xbc, 0x80, 0xb2, 0x37, 0x61, 0x3c, 0x94, 0x76, 0xc5, 0x94, 0xb7, 0xb5, 0x5c, 0xde, 0x9e,
+	0x66, 0xe7, 0xbb, 0x70, 0x52, 0x29, 0xf9, 0x12, 0xea, 0x23, 0x44, 0x2a, 0xbd, 0x09, 0xfa, 0xc9,
+	0x14, 0xa5, 0x0d, 0x77, 0x3f, 0x7b, 0x80, 0x78, 0x9c, 0x6a, 0x9c, 0xda, 0xe8, 0x3a, 0x90, 0x64,
+	0x0b, 0x2a, 0x3a, 0x03, 0xc6, 0x91, 0x37, 0xb1, 0xab, 0xa6, 0xec, 0xf5, 0x11, 0xe2, 0x50, 0xc7,
+	0x7a, 0xbc, 0xd9, 0x0c, 0xcc, 0xdf, 0x4c, 0x98, 0x40, 0xbb, 0x66, 0xc6, 0xd0, 0x4c, 0x09, 0x9d,
+	0x59, 0xc3, 0xa4, 0x07, 0x2d, 0xc9, 0xc7, 0xe1, 0x0d, 0x69, 0xdd, 0x48, 0x1b, 0x73, 0x7c, 0xa1,
+	0xfc, 0x06, 0x48, 0xde, 0x02, 0x54, 0x71, 0xed, 0xa3, 0x86, 0xa9, 0xbc, 0x73, 0xab, 0xeb, 0x9c,
+	0xf2, 0x84, 0xa3, 0x70, 0x36, 0xbc, 0x25, 0x44, 0x92, 0x4f, 0xa1, 0x29, 0x3d, 0x81, 0x18, 0xf2,
+	0x70, 0x4c, 0x99, 0x1f, 0xf0, 0xd0, 0x6e, 0x9a, 0xe1, 0x36, 0x16, 0xf0, 0x8e, 0x46, 0xc9, 0x53,
+	0x68, 0x68, 0xaf, 0x4d, 0x90, 0xf9, 0x28, 0xe8, 0x94, 0x8d, 0xed, 0x96, 0xe9, 0xb8, 0x16, 0xb0,
+	0x8b, 0x97, 0x06, 0xfc, 0x9a, 0x8d, 0xbb, 0x7f, 0x59, 0x50, 0xcd, 0x0d, 0x8c, 0x7c, 0x0e, 0xc0,
+	0xa4, 0x44, 0x45, 0xd5, 0x2c, 0x46, 0xdb, 0xea, 0x58, 0xbd, 0xc6, 0xf6, 0xe3, 0xe5, 0x3a, 0x77,
+	0xb4, 0xe2, 0x64, 0x16, 0xa3, 0x53, 0x61, 0xd9, 0x4f, 0x3d, 0x13, 0x1f, 0xe3, 0x48, 0x72, 0x45,
+	0x47, 0x53, 0xa6, 0xf4, 0x64, 0xcc, 0x11, 0x14, 0x9d, 0x46, 0x8a, 0x1f, 0x4c, 0x99, 0x3a, 0x40,
+	0x24, 0x9f, 0x40, 0x73, 0xa1, 0x44, 0xa4, 0x6e, 0x2c, 0x8d, 0xf5, 0xeb, 0x4e, 0x3d, 0x13, 0x22,
+	0xee, 0xc6, 0x52, 0x6f, 0xe4, 0x9c, 0xab, 0x89, 0x2f, 0xd8, 0xf9, 0x75, 0xca, 0xa2, 0x49, 0xd9,
+	0xcc, 0x88, 0x2c, 0x67, 0x0f, 0x5a, 0xd7, 0xda, 0x34, 0x69, 0x69, 0xbe, 0x91, 0x85, 0xd4, 0x64,
+	0xed, 0xfe, 0x5a, 0x80, 0x6a, 0xce, 0x5e, 0xe4, 0x31, 0xac, 0x1b, 0x83, 0x51, 0xee, 0x9b, 0x7e,
+	0x2b, 0xce, 0x9a, 0x89, 0x0f, 0xfd, 0xbc, 0x81, 0x57, 0x6f, 0x1a, 0xf8, 0xd6, 0xb9, 0x17, 0xee,
+	0x7f, 0xee, 0xc5, 0x7f, 0x3f, 0xf7, 0x8f, 0xa0, 0x7e, 0xf3, 0xd4, 0x4b, 0xa6, 0x84, 0xda, 0xd9,
+	0xdd, 0x77, 0x5e, 0x7e, 0x97, 0x3b, 0xbf, 0xdb, 0x8d, 0x6b, 0xff, 0xdd, 0x8d, 0xb7, 0x4d, 0xb6,
+	0x7e, 0xdb, 0x64, 0xb7, 0x2f, 0xb7, 0x72, 0xcf, 0xcb, 0xed, 0xfe, 0x6e, 0x41, 0xc9, 0xb4, 0xa2,
+	0x77, 0xc2, 0x7c, 0x5f, 0xa0, 0x94, 0xd9, 0xb6, 0xd2, 0x90, 0x3c, 0x82, 0xb5, 0x38, 0x71, 0xe9,
+	0x29, 0xce, 0xd2, 0x6d, 0x95, 0xe3, 0xc4, 0xfd, 0x0a, 0x67, 0x4b, 0x9e, 0x2e, 0xde, 0xc3, 0xd3,
+	0x07, 0xd0, 0x50, 0x2c, 0xa6, 0x3e, 0x4a, 0x4f, 0xf0, 0x58, 0x45, 0xc2, 0x2c, 0xa1, 0xba, 0xfd,
+	0xe1, 0x9d, 0x63, 0xde, 0x5f, 0xc8, 0x9c, 0xba, 0x62, 0xf1, 0x75, 0xa8, 0x8b, 0x9e, 0xbf, 0x17,
+	0xe6, 0x7b, 0xaa, 0x38, 0x59, 0xd8, 0xfd, 0x09, 0x9a, 0x4b, 0xcf, 0x92, 0x27, 0x50, 0xe3, 0xa1,
+	0x42, 0x11, 0xb2, 0xa9, 0x69, 0x66, 0xde, 0x66, 0x35, 0xc3, 0x74, 0x47, 0x4f, 0xa0, 0x26, 0xd0,
+	0x8b, 0xce, 0x50, 0xcc, 0x72, 0xfd, 0x56, 0x33, 0x4c, 0x4b, 0xcc, 0x2b, 0x3d, 0x95, 0xf8, 0xfa,
+	0xf5, 0x95, 0xdd, 0x58, 0x86, 0xee, 0x6b, 0xb0, 0xfb, 0x8b, 0x05, 0xad, 0xe5, 0x45, 0xff, 0x8f,
+	0x97, 0xc0, 0x07, 0x00, 0x01, 0x0f, 0x29, 0x0b, 0xa2, 0x24, 0x54, 0xe9, 0xf9, 0x57, 0x02, 0x1e,
+	0xee, 0x18, 0xe0, 0xdd, 0xce, 0xe6, 0xd9, 0x08, 0x2a, 0x8b, 0xe4, 0x64, 0x13, 0xde, 0xdf, 0x39,
+	0x3e, 0x1e, 0x9e, 0xd0, 0x93, 0xef, 0x8e, 0x86, 0xf4, 0xf5, 0xab, 0xe3, 0xa3, 0xe1, 0xde, 0xe1,
+	0xc1, 0xe1, 0x70, 0xbf, 0xb5, 0x42, 0x08, 0x34, 0x72, 0xdc, 0xee, 0xc9, 0x5e, 0xcb, 0x22, 0x0f,
+	0xa1, 0x95, 0xc7, 0x9c, 0xbd, 0xed, 0xcf, 0x5a, 0xab, 0xe4, 0x01, 0x34, 0x73, 0xa8, 0xf3, 0xfa,
+	0xd5, 0xb0, 0x55, 0xd8, 0x7d, 0xf9, 0xdb, 0x65, 0xdb, 0x7a, 0x7b, 0xd9, 0xb6, 0xfe, 0xbc, 0x6c,
+	0x5b, 0x3f, 0x5f, 0xb5, 0x57, 0xde, 0x5e, 0xb5, 0x57, 0xfe, 0xb8, 0x6a, 0xaf, 0x7c, 0xdf, 0x1f,
+	0x73, 0x35, 0x49, 0xdc, 0xbe, 0x17, 0x05, 0x03, 0xdd, 0xb6, 0xf9, 0x3e, 0xf0, 0xa2, 0xa9, 0x09,
+	0x06, 0x17, 0xb9, 0xcf, 0x0c, 0x3d, 0x21, 0xe9, 0x96, 0x8d, 0xe0, 0xc5, 0x3f, 0x03, 0x00, 0xd0,
+	0x72, 0x8f, 0x5d, 0x85, 0x08, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxHeaderLag != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxHeaderLag))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.ScreeningAdmin) > 0 {
+		i -= len(m.ScreeningAdmin)
+		copy(dAtA[i:], m.ScreeningAdmin)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ScreeningAdmin)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.ConfirmationTiers) > 0 {
+		for iNdEx := len(m.ConfirmationTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConfirmationTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.SignerFeeShare != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SignerFeeShare))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.RelayerFeeShare != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RelayerFeeShare))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.FeeEpoch != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FeeEpoch))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.FeeSchedules) > 0 {
+		for iNdEx := len(m.FeeSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Chains) > 0 {
+		for iNdEx := len(m.Chains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Chains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Network) > 0 {
+		i -= len(m.Network)
+		copy(dAtA[i:], m.Network)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Network)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.AttestationExpiry != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AttestationExpiry))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.RelayerQuorum != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RelayerQuorum))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Vaults) > 0 {
+		for iNdEx := len(m.Vaults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vaults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.BtcVoucherDenom) > 0 {
+		i -= len(m.BtcVoucherDenom)
+		copy(dAtA[i:], m.BtcVoucherDenom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.BtcVoucherDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MaxAcceptableBlockDepth != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxAcceptableBlockDepth))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Confirmations != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Confirmations))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AuthorizedRelayers) > 0 {
 		for iNdEx := len(m.AuthorizedRelayers) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AuthorizedRelayers[iNdEx])
 			copy(dAtA[i:], m.AuthorizedRelayers[iNdEx])
@@ -307,7 +881,7 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Vault) Marshal() (dAtA []byte, err error) {
+func (m *FeeSchedule) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -317,107 +891,1437 @@ func (m *Vault) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Vault) MarshalTo(dAtA []byte) (int, error) {
+func (m *FeeSchedule) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Vault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FeeSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.AssetType != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.AssetType))
+	if m.WithdrawFeeBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.WithdrawFeeBps))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.WithdrawFlatFee != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.WithdrawFlatFee))
 		i--
 		dAtA[i] = 0x20
 	}
-	if len(m.PubKey) > 0 {
-		i -= len(m.PubKey)
-		copy(dAtA[i:], m.PubKey)
-		i = encodeVarintParams(dAtA, i, uint64(len(m.PubKey)))
+	if m.DepositFeeBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DepositFeeBps))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x18
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintParams(dAtA, i, uint64(len(m.Address)))
+	if m.DepositFlatFee != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DepositFlatFee))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x10
+	}
+	if m.AssetType != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AssetType))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
-	offset -= sovParams(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ChainParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ChainParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.AuthorizedRelayers) > 0 {
-		for _, s := range m.AuthorizedRelayers {
-			l = len(s)
-			n += 1 + l + sovParams(uint64(l))
+	if len(m.FeeSchedules) > 0 {
+		for iNdEx := len(m.FeeSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
 		}
 	}
-	if m.Confirmations != 0 {
-		n += 1 + sovParams(uint64(m.Confirmations))
+	if m.MaxHeaderLag != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxHeaderLag))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ConfirmationTiers) > 0 {
+		for iNdEx := len(m.ConfirmationTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConfirmationTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Vaults) > 0 {
+		for iNdEx := len(m.Vaults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vaults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.VoucherDenom) > 0 {
+		i -= len(m.VoucherDenom)
+		copy(dAtA[i:], m.VoucherDenom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.VoucherDenom)))
+		i--
+		dAtA[i] = 0x2a
 	}
 	if m.MaxAcceptableBlockDepth != 0 {
-		n += 1 + sovParams(uint64(m.MaxAcceptableBlockDepth))
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxAcceptableBlockDepth))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.BtcVoucherDenom)
-	if l > 0 {
-		n += 1 + l + sovParams(uint64(l))
+	if m.Confirmations != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Confirmations))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.Vaults) > 0 {
-		for _, e := range m.Vaults {
-			l = e.Size()
-			n += 1 + l + sovParams(uint64(l))
+	if len(m.Network) > 0 {
+		i -= len(m.Network)
+		copy(dAtA[i:], m.Network)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Network)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Vault) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Vault) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Vault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.TapDescriptor != nil {
+		{
+			size, err := m.TapDescriptor.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.AssetType != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AssetType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VaultDescriptor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VaultDescriptor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VaultDescriptor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RecoveryDelay != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RecoveryDelay))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RecoveryKey) > 0 {
+		i -= len(m.RecoveryKey)
+		copy(dAtA[i:], m.RecoveryKey)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.RecoveryKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.InternalKey) > 0 {
+		i -= len(m.InternalKey)
+		copy(dAtA[i:], m.InternalKey)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.InternalKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConfirmationTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfirmationTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConfirmationTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Confirmations != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Confirmations))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MinAmount != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinAmount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AssetType != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AssetType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AuthorizedRelayers) > 0 {
+		for _, s := range m.AuthorizedRelayers {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.Confirmations != 0 {
+		n += 1 + sovParams(uint64(m.Confirmations))
+	}
+	if m.MaxAcceptableBlockDepth != 0 {
+		n += 1 + sovParams(uint64(m.MaxAcceptableBlockDepth))
+	}
+	l = len(m.BtcVoucherDenom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.Vaults) > 0 {
+		for _, e := range m.Vaults {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.RelayerQuorum != 0 {
+		n += 1 + sovParams(uint64(m.RelayerQuorum))
+	}
+	if m.AttestationExpiry != 0 {
+		n += 1 + sovParams(uint64(m.AttestationExpiry))
+	}
+	l = len(m.Network)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.Chains) > 0 {
+		for _, e := range m.Chains {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.FeeSchedules) > 0 {
+		for _, e := range m.FeeSchedules {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.FeeEpoch != 0 {
+		n += 1 + sovParams(uint64(m.FeeEpoch))
+	}
+	if m.RelayerFeeShare != 0 {
+		n += 1 + sovParams(uint64(m.RelayerFeeShare))
+	}
+	if m.SignerFeeShare != 0 {
+		n += 1 + sovParams(uint64(m.SignerFeeShare))
+	}
+	if len(m.ConfirmationTiers) > 0 {
+		for _, e := range m.ConfirmationTiers {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = len(m.ScreeningAdmin)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.MaxHeaderLag != 0 {
+		n += 2 + sovParams(uint64(m.MaxHeaderLag))
+	}
+	return n
+}
+
+func (m *FeeSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AssetType != 0 {
+		n += 1 + sovParams(uint64(m.AssetType))
+	}
+	if m.DepositFlatFee != 0 {
+		n += 1 + sovParams(uint64(m.DepositFlatFee))
+	}
+	if m.DepositFeeBps != 0 {
+		n += 1 + sovParams(uint64(m.DepositFeeBps))
+	}
+	if m.WithdrawFlatFee != 0 {
+		n += 1 + sovParams(uint64(m.WithdrawFlatFee))
+	}
+	if m.WithdrawFeeBps != 0 {
+		n += 1 + sovParams(uint64(m.WithdrawFeeBps))
+	}
+	return n
+}
+
+func (m *ChainParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.Network)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.Confirmations != 0 {
+		n += 1 + sovParams(uint64(m.Confirmations))
+	}
+	if m.MaxAcceptableBlockDepth != 0 {
+		n += 1 + sovParams(uint64(m.MaxAcceptableBlockDepth))
+	}
+	l = len(m.VoucherDenom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.Vaults) > 0 {
+		for _, e := range m.Vaults {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.ConfirmationTiers) > 0 {
+		for _, e := range m.ConfirmationTiers {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxHeaderLag != 0 {
+		n += 1 + sovParams(uint64(m.MaxHeaderLag))
+	}
+	if len(m.FeeSchedules) > 0 {
+		for _, e := range m.FeeSchedules {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Vault) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.AssetType != 0 {
+		n += 1 + sovParams(uint64(m.AssetType))
+	}
+	if m.TapDescriptor != nil {
+		l = m.TapDescriptor.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VaultDescriptor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InternalKey)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.RecoveryKey)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.RecoveryDelay != 0 {
+		n += 1 + sovParams(uint64(m.RecoveryDelay))
+	}
+	return n
+}
+
+func (m *ConfirmationTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AssetType != 0 {
+		n += 1 + sovParams(uint64(m.AssetType))
+	}
+	if m.MinAmount != 0 {
+		n += 1 + sovParams(uint64(m.MinAmount))
+	}
+	if m.Confirmations != 0 {
+		n += 1 + sovParams(uint64(m.Confirmations))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizedRelayers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthorizedRelayers = append(m.AuthorizedRelayers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmations", wireType)
+			}
+			m.Confirmations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Confirmations |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAcceptableBlockDepth", wireType)
+			}
+			m.MaxAcceptableBlockDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAcceptableBlockDepth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcVoucherDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcVoucherDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vaults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vaults = append(m.Vaults, &Vault{})
+			if err := m.Vaults[len(m.Vaults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerQuorum", wireType)
+			}
+			m.RelayerQuorum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RelayerQuorum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationExpiry", wireType)
+			}
+			m.AttestationExpiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttestationExpiry |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Network", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Network = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chains = append(m.Chains, &ChainParams{})
+			if err := m.Chains[len(m.Chains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeSchedules = append(m.FeeSchedules, &FeeSchedule{})
+			if err := m.FeeSchedules[len(m.FeeSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeEpoch", wireType)
+			}
+			m.FeeEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerFeeShare", wireType)
+			}
+			m.RelayerFeeShare = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RelayerFeeShare |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerFeeShare", wireType)
+			}
+			m.SignerFeeShare = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignerFeeShare |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmationTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfirmationTiers = append(m.ConfirmationTiers, &ConfirmationTier{})
+			if err := m.ConfirmationTiers[len(m.ConfirmationTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScreeningAdmin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScreeningAdmin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHeaderLag", wireType)
+			}
+			m.MaxHeaderLag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxHeaderLag |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetType", wireType)
+			}
+			m.AssetType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetType |= AssetType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositFlatFee", wireType)
+			}
+			m.DepositFlatFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositFlatFee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositFeeBps", wireType)
+			}
+			m.DepositFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositFeeBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawFlatFee", wireType)
+			}
+			m.WithdrawFlatFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawFlatFee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawFeeBps", wireType)
+			}
+			m.WithdrawFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawFeeBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Network", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Network = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmations", wireType)
+			}
+			m.Confirmations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Confirmations |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAcceptableBlockDepth", wireType)
+			}
+			m.MaxAcceptableBlockDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAcceptableBlockDepth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoucherDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoucherDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vaults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vaults = append(m.Vaults, &Vault{})
+			if err := m.Vaults[len(m.Vaults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmationTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfirmationTiers = append(m.ConfirmationTiers, &ConfirmationTier{})
+			if err := m.ConfirmationTiers[len(m.ConfirmationTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHeaderLag", wireType)
+			}
+			m.MaxHeaderLag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxHeaderLag |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeSchedules = append(m.FeeSchedules, &FeeSchedule{})
+			if err := m.FeeSchedules[len(m.FeeSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *Vault) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovParams(uint64(l))
-	}
-	l = len(m.PubKey)
-	if l > 0 {
-		n += 1 + l + sovParams(uint64(l))
-	}
-	if m.AssetType != 0 {
-		n += 1 + sovParams(uint64(m.AssetType))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovParams(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozParams(x uint64) (n int) {
-	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *Vault) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -440,15 +2344,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: Vault: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Vault: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizedRelayers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -476,13 +2380,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AuthorizedRelayers = append(m.AuthorizedRelayers, string(dAtA[iNdEx:postIndex]))
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Confirmations", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
 			}
-			m.Confirmations = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -492,16 +2396,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Confirmations |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxAcceptableBlockDepth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetType", wireType)
 			}
-			m.MaxAcceptableBlockDepth = 0
+			m.AssetType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -511,16 +2428,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxAcceptableBlockDepth |= uint64(b&0x7F) << shift
+				m.AssetType |= AssetType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcVoucherDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TapDescriptor", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -530,29 +2447,33 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthParams
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthParams
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BtcVoucherDenom = string(dAtA[iNdEx:postIndex])
+			if m.TapDescriptor == nil {
+				m.TapDescriptor = &VaultDescriptor{}
+			}
+			if err := m.TapDescriptor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vaults", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -562,25 +2483,23 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthParams
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthParams
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Vaults = append(m.Vaults, &Vault{})
-			if err := m.Vaults[len(m.Vaults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -603,7 +2522,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Vault) Unmarshal(dAtA []byte) error {
+func (m *VaultDescriptor) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -626,15 +2545,15 @@ func (m *Vault) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Vault: wiretype end group for non-group")
+			return fmt.Errorf("proto: VaultDescriptor: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Vault: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VaultDescriptor: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -662,11 +2581,11 @@ func (m *Vault) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.InternalKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -694,9 +2613,78 @@ func (m *Vault) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PubKey = string(dAtA[iNdEx:postIndex])
+			m.RecoveryKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryDelay", wireType)
+			}
+			m.RecoveryDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecoveryDelay |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfirmationTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfirmationTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfirmationTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AssetType", wireType)
 			}
@@ -715,6 +2703,44 @@ func (m *Vault) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAmount", wireType)
+			}
+			m.MinAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmations", wireType)
+			}
+			m.Confirmations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Confirmations |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
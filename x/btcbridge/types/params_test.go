@@ -0,0 +1,275 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sideprotocol/side/testutil/sample"
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+func TestParamsNetwork(t *testing.T) {
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	vaultAddr, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(key.PubKey().SerializeCompressed()), &chaincfg.RegressionNetParams)
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.Vaults = []*types.Vault{{Address: vaultAddr.EncodeAddress(), AssetType: types.AssetType_ASSET_TYPE_BTC}}
+
+	params.Network = chaincfg.RegressionNetParams.Name
+	require.NoError(t, params.Validate())
+	require.Equal(t, &chaincfg.RegressionNetParams, params.ChainCfg())
+
+	// the vault address does not belong to the network
+	params.Network = chaincfg.MainNetParams.Name
+	require.Error(t, params.Validate())
+
+	params.Network = "unknown"
+	require.Error(t, params.Validate())
+}
+
+func TestParamsRelayerQuorum(t *testing.T) {
+	params := types.DefaultParams()
+	params.AuthorizedRelayers = []string{sample.AccAddress(), sample.AccAddress()}
+
+	params.RelayerQuorum = 2
+	require.NoError(t, params.Validate())
+
+	// the quorum could never be reached
+	params.RelayerQuorum = 3
+	require.Error(t, params.Validate())
+}
+
+func TestParamsVaultSigners(t *testing.T) {
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	vaultAddr, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(key.PubKey().SerializeCompressed()), &chaincfg.RegressionNetParams)
+	require.NoError(t, err)
+
+	signer := sample.AccAddress()
+
+	params := types.DefaultParams()
+	params.Network = chaincfg.RegressionNetParams.Name
+	params.Vaults = []*types.Vault{{Address: vaultAddr.EncodeAddress(), AssetType: types.AssetType_ASSET_TYPE_BTC, Signers: []string{signer}}}
+	require.NoError(t, params.Validate())
+	require.True(t, params.Vaults[0].IsSigner(signer))
+	require.False(t, params.Vaults[0].IsSigner(sample.AccAddress()))
+
+	params.Vaults[0].Signers = []string{signer, signer}
+	require.Error(t, params.Validate())
+
+	params.Vaults[0].Signers = []string{"invalid"}
+	require.Error(t, params.Validate())
+}
+
+func TestParamsChains(t *testing.T) {
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	ltcVault, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(key.PubKey().SerializeCompressed()), &types.LitecoinRegressionNetParams)
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.Network = chaincfg.RegressionNetParams.Name
+	params.Chains = []*types.ChainParams{{
+		ChainId:                 "ltc",
+		Network:                 types.LitecoinRegressionNetParams.Name,
+		Confirmations:           6,
+		MaxAcceptableBlockDepth: 50,
+		VoucherDenom:            "ltcsat",
+		Vaults:                  []*types.Vault{{Address: ltcVault.EncodeAddress(), AssetType: types.AssetType_ASSET_TYPE_BTC}},
+	}}
+	require.NoError(t, params.Validate())
+
+	// the params are scoped to the chain
+	ltcParams := params.ForChain("ltc")
+	require.Equal(t, &types.LitecoinRegressionNetParams, ltcParams.ChainCfg())
+	require.Equal(t, int32(6), ltcParams.Confirmations)
+	require.Equal(t, uint64(50), ltcParams.MaxAcceptableBlockDepth)
+	require.Equal(t, "ltcsat", ltcParams.BtcVoucherDenom)
+	require.Equal(t, params.Chains[0].Vaults, ltcParams.Vaults)
+	require.Equal(t, params.AuthorizedRelayers, ltcParams.AuthorizedRelayers)
+	require.Equal(t, params, params.ForChain(""))
+
+	require.True(t, params.HasChain(""))
+	require.True(t, params.HasChain("ltc"))
+	require.False(t, params.HasChain("doge"))
+	require.Equal(t, []string{"", "ltc"}, params.ChainIDs())
+
+	chainID, found := params.ChainIDByDenom("ltcsat")
+	require.True(t, found)
+	require.Equal(t, "ltc", chainID)
+	chainID, found = params.ChainIDByDenom(params.BtcVoucherDenom)
+	require.True(t, found)
+	require.Empty(t, chainID)
+	_, found = params.ChainIDByDenom("unknown")
+	require.False(t, found)
+
+	for _, malleate := range []func(chain *types.ChainParams){
+		func(chain *types.ChainParams) { chain.ChainId = "" },
+		func(chain *types.ChainParams) { chain.ChainId = "LTC" },
+		func(chain *types.ChainParams) { chain.Network = "unknown" },
+		func(chain *types.ChainParams) { chain.VoucherDenom = params.BtcVoucherDenom },
+		// the vault address does not belong to the network of the chain
+		func(chain *types.ChainParams) { chain.Network = chaincfg.RegressionNetParams.Name },
+	} {
+		invalid := params
+		chain := *params.Chains[0]
+		malleate(&chain)
+		invalid.Chains = []*types.ChainParams{&chain}
+
+		require.Error(t, invalid.Validate())
+	}
+
+	// duplicate chains
+	duplicate := params
+	duplicate.Chains = []*types.ChainParams{params.Chains[0], params.Chains[0]}
+	require.Error(t, duplicate.Validate())
+}
+
+func TestDefaultBestBlockHeader(t *testing.T) {
+	for _, chainCfg := range []*chaincfg.Params{
+		&chaincfg.MainNetParams,
+		&chaincfg.TestNet3Params,
+		&chaincfg.SigNetParams,
+		&chaincfg.RegressionNetParams,
+	} {
+		genesis := types.DefaultGenesis()
+		genesis.Params.Network = chainCfg.Name
+		genesis.BestBlockHeader = types.DefaultBestBlockHeader(chainCfg)
+
+		require.NoError(t, genesis.Validate(), chainCfg.Name)
+	}
+
+	require.Equal(t, chaincfg.RegressionNetParams.GenesisHash.String(), types.DefaultBestBlockHeader(&chaincfg.RegressionNetParams).Hash)
+}
+
+func TestParamsFees(t *testing.T) {
+	params := types.DefaultParams()
+	params.FeeSchedules = []*types.FeeSchedule{{AssetType: types.AssetType_ASSET_TYPE_BTC, DepositFlatFee: 1000, DepositFeeBps: 10, WithdrawFeeBps: 20}}
+	params.RelayerFeeShare = 6000
+	params.SignerFeeShare = 4000
+	require.NoError(t, params.Validate())
+
+	schedule := params.FeeSchedule(types.AssetType_ASSET_TYPE_BTC)
+	require.Equal(t, int64(1100), schedule.DepositFee(sdkmath.NewInt(100000)).Int64())
+	require.Equal(t, int64(500), schedule.DepositFee(sdkmath.NewInt(500)).Int64())
+	require.Equal(t, int64(200), schedule.WithdrawFee(sdkmath.NewInt(100000)).Int64())
+
+	// no fee is charged without a schedule
+	require.Nil(t, params.FeeSchedule(types.AssetType_ASSET_TYPE_RUNE))
+	require.True(t, params.FeeSchedule(types.AssetType_ASSET_TYPE_RUNE).DepositFee(sdkmath.NewInt(100000)).IsZero())
+
+	// the bridged chains are charged by their own schedules
+	params.Chains = []*types.ChainParams{{ChainId: "ltc", Network: types.LitecoinRegressionNetParams.Name, VoucherDenom: "ltcsat"}}
+	require.NoError(t, params.Validate())
+	require.Nil(t, params.ForChain("ltc").FeeSchedule(types.AssetType_ASSET_TYPE_BTC))
+
+	params.Chains[0].FeeSchedules = []*types.FeeSchedule{{AssetType: types.AssetType_ASSET_TYPE_BTC, WithdrawFlatFee: 300}}
+	require.NoError(t, params.Validate())
+	require.Equal(t, int64(300), params.ForChain("ltc").FeeSchedule(types.AssetType_ASSET_TYPE_BTC).WithdrawFee(sdkmath.NewInt(100000)).Int64())
+	require.Equal(t, schedule, params.ForChain("").FeeSchedule(types.AssetType_ASSET_TYPE_BTC))
+
+	params.Chains[0].FeeSchedules[0].WithdrawFeeBps = types.MaxBasisPoints + 1
+	require.Error(t, params.Validate())
+	params.Chains = nil
+
+	for _, invalid := range []func(p *types.Params){
+		func(p *types.Params) { p.SignerFeeShare = 4001 },
+		func(p *types.Params) { p.FeeEpoch = 0 },
+		func(p *types.Params) { p.FeeSchedules[0].DepositFeeBps = types.MaxBasisPoints + 1 },
+		func(p *types.Params) { p.FeeSchedules[0].AssetType = types.AssetType_ASSET_TYPE_UNSPECIFIED },
+		func(p *types.Params) {
+			p.FeeSchedules = append(p.FeeSchedules, &types.FeeSchedule{AssetType: types.AssetType_ASSET_TYPE_BTC})
+		},
+	} {
+		p := types.DefaultParams()
+		p.FeeSchedules = []*types.FeeSchedule{{AssetType: types.AssetType_ASSET_TYPE_BTC}}
+		p.RelayerFeeShare = 6000
+		p.SignerFeeShare = 4000
+
+		invalid(&p)
+		require.Error(t, p.Validate())
+	}
+}
+
+func TestParamsConfirmationTiers(t *testing.T) {
+	params := types.DefaultParams()
+	params.Confirmations = 2
+	params.ConfirmationTiers = []*types.ConfirmationTier{
+		{AssetType: types.AssetType_ASSET_TYPE_BTC, MinAmount: 100000000, Confirmations: 6},
+		{AssetType: types.AssetType_ASSET_TYPE_BTC, MinAmount: 0, Confirmations: 1},
+		{AssetType: types.AssetType_ASSET_TYPE_BTC, MinAmount: 10000000, Confirmations: 3},
+	}
+	require.NoError(t, params.Validate())
+
+	// the highest tier reached by the amount applies
+	require.Equal(t, int32(1), params.RequiredConfirmations(types.AssetType_ASSET_TYPE_BTC, 100000))
+	require.Equal(t, int32(3), params.RequiredConfirmations(types.AssetType_ASSET_TYPE_BTC, 10000000))
+	require.Equal(t, int32(6), params.RequiredConfirmations(types.AssetType_ASSET_TYPE_BTC, 5000000000))
+
+	// the default confirmations apply without any tier of the asset type
+	require.Equal(t, int32(2), params.RequiredConfirmations(types.AssetType_ASSET_TYPE_RUNE, 5000000000))
+
+	for _, invalid := range []func(p *types.Params){
+		func(p *types.Params) { p.ConfirmationTiers[0].Confirmations = 2 },
+		func(p *types.Params) { p.ConfirmationTiers[1].Confirmations = -1 },
+		func(p *types.Params) { p.ConfirmationTiers[2].AssetType = types.AssetType_ASSET_TYPE_UNSPECIFIED },
+		func(p *types.Params) {
+			p.ConfirmationTiers = append(p.ConfirmationTiers, &types.ConfirmationTier{AssetType: types.AssetType_ASSET_TYPE_BTC, MinAmount: 10000000, Confirmations: 3})
+		},
+	} {
+		p := types.DefaultParams()
+		p.ConfirmationTiers = []*types.ConfirmationTier{
+			{AssetType: types.AssetType_ASSET_TYPE_BTC, MinAmount: 100000000, Confirmations: 6},
+			{AssetType: types.AssetType_ASSET_TYPE_BTC, MinAmount: 0, Confirmations: 1},
+			{AssetType: types.AssetType_ASSET_TYPE_BTC, MinAmount: 10000000, Confirmations: 3},
+		}
+
+		invalid(&p)
+		require.Error(t, p.Validate())
+	}
+}
+
+func TestParamsBlocklistEntry(t *testing.T) {
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	addr, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(key.PubKey().SerializeCompressed()), &chaincfg.RegressionNetParams)
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.Network = chaincfg.RegressionNetParams.Name
+
+	// bech32 bitcoin addresses are normalized to lower case
+	normalized, err := params.NormalizeBlocklistEntry(strings.ToUpper(addr.EncodeAddress()))
+	require.NoError(t, err)
+	require.Equal(t, addr.EncodeAddress(), normalized)
+
+	account := sample.AccAddress()
+	normalized, err = params.NormalizeBlocklistEntry(account)
+	require.NoError(t, err)
+	require.Equal(t, account, normalized)
+
+	// the address of another network is not bridged
+	testnetAddr, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(key.PubKey().SerializeCompressed()), &chaincfg.TestNet3Params)
+	require.NoError(t, err)
+	_, err = params.NormalizeBlocklistEntry(testnetAddr.EncodeAddress())
+	require.Error(t, err)
+
+	params.ScreeningAdmin = account
+	require.NoError(t, params.Validate())
+
+	params.ScreeningAdmin = addr.EncodeAddress()
+	require.Error(t, params.Validate())
+}
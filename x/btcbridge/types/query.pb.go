@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -34,6 +36,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type QuerySigningRequestRequest struct {
 	Status     SigningStatus       `protobuf:"varint,1,opt,name=status,proto3,enum=side.btcbridge.SigningStatus" json:"status,omitempty"`
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// the bridged chain, empty for bitcoin
+	ChainId string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
 func (m *QuerySigningRequestRequest) Reset()         { *m = QuerySigningRequestRequest{} }
@@ -83,6 +87,13 @@ func (m *QuerySigningRequestRequest) GetPagination() *query.PageResponse {
 	return nil
 }
 
+func (m *QuerySigningRequestRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
 // QuerySigningRequestResponse is response type for the Query/SigningRequest RPC method.
 type QuerySigningRequestResponse struct {
 	Requests   []*BitcoinSigningRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
@@ -221,6 +232,8 @@ func (m *QueryParamsResponse) GetParams() Params {
 
 // QueryChainTipRequest is request type for the Query/ChainTip RPC method.
 type QueryChainTipRequest struct {
+	// the bridged chain, empty for bitcoin
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
 func (m *QueryChainTipRequest) Reset()         { *m = QueryChainTipRequest{} }
@@ -256,6 +269,13 @@ func (m *QueryChainTipRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryChainTipRequest proto.InternalMessageInfo
 
+func (m *QueryChainTipRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
 // QueryChainTipResponse is response type for the Query/ChainTip RPC method.
 type QueryChainTipResponse struct {
 	Hash   string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
@@ -312,6 +332,8 @@ func (m *QueryChainTipResponse) GetHeight() uint64 {
 // QueryBlockHeaderByHeightRequest is the request type for the Query/BlockHeaderByHeight RPC method.
 type QueryBlockHeaderByHeightRequest struct {
 	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// the bridged chain, empty for bitcoin
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
 func (m *QueryBlockHeaderByHeightRequest) Reset()         { *m = QueryBlockHeaderByHeightRequest{} }
@@ -354,6 +376,13 @@ func (m *QueryBlockHeaderByHeightRequest) GetHeight() uint64 {
 	return 0
 }
 
+func (m *QueryBlockHeaderByHeightRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
 // QueryBlockHeaderByHeightResponse is the response type for the Query/BlockHeaderByHeight RPC method.
 type QueryBlockHeaderByHeightResponse struct {
 	BlockHeader *BlockHeader `protobuf:"bytes,1,opt,name=block_header,json=blockHeader,proto3" json:"block_header,omitempty"`
@@ -402,6 +431,8 @@ func (m *QueryBlockHeaderByHeightResponse) GetBlockHeader() *BlockHeader {
 // QueryBlockHeaderByHashRequest is the request type for the Query/BlockHeaderByHash RPC method.
 type QueryBlockHeaderByHashRequest struct {
 	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// the bridged chain, empty for bitcoin
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
 func (m *QueryBlockHeaderByHashRequest) Reset()         { *m = QueryBlockHeaderByHashRequest{} }
@@ -444,6 +475,13 @@ func (m *QueryBlockHeaderByHashRequest) GetHash() string {
 	return ""
 }
 
+func (m *QueryBlockHeaderByHashRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
 // QueryBlockHeaderByHashResponse is the response type for the Query/BlockHeaderByHash RPC method.
 type QueryBlockHeaderByHashResponse struct {
 	BlockHeader *BlockHeader `protobuf:"bytes,1,opt,name=block_header,json=blockHeader,proto3" json:"block_header,omitempty"`
@@ -491,6 +529,8 @@ func (m *QueryBlockHeaderByHashResponse) GetBlockHeader() *BlockHeader {
 
 // QueryUTXOsRequest is the request type for the Query/UTXOs RPC method.
 type QueryUTXOsRequest struct {
+	// the bridged chain, empty for bitcoin
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
 func (m *QueryUTXOsRequest) Reset()         { *m = QueryUTXOsRequest{} }
@@ -526,6 +566,13 @@ func (m *QueryUTXOsRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryUTXOsRequest proto.InternalMessageInfo
 
+func (m *QueryUTXOsRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
 // QueryUTXOsResponse is the response type for the Query/UTXOs RPC method.
 type QueryUTXOsResponse struct {
 	Utxos []*UTXO `protobuf:"bytes,1,rep,name=utxos,proto3" json:"utxos,omitempty"`
@@ -574,6 +621,8 @@ func (m *QueryUTXOsResponse) GetUtxos() []*UTXO {
 // QueryUTXOsByAddressRequest is the request type for the Query/UTXOsByAddress RPC method.
 type QueryUTXOsByAddressRequest struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// the bridged chain, empty for bitcoin
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
 func (m *QueryUTXOsByAddressRequest) Reset()         { *m = QueryUTXOsByAddressRequest{} }
@@ -616,6 +665,13 @@ func (m *QueryUTXOsByAddressRequest) GetAddress() string {
 	return ""
 }
 
+func (m *QueryUTXOsByAddressRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
 // QueryUTXOsByAddressResponse is the response type for the Query/UTXOsByAddress RPC method.
 type QueryUTXOsByAddressResponse struct {
 	Utxos []*UTXO `protobuf:"bytes,1,rep,name=utxos,proto3" json:"utxos,omitempty"`
@@ -661,1048 +717,7798 @@ func (m *QueryUTXOsByAddressResponse) GetUtxos() []*UTXO {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QuerySigningRequestRequest)(nil), "side.btcbridge.QuerySigningRequestRequest")
-	proto.RegisterType((*QuerySigningRequestResponse)(nil), "side.btcbridge.QuerySigningRequestResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "side.btcbridge.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "side.btcbridge.QueryParamsResponse")
-	proto.RegisterType((*QueryChainTipRequest)(nil), "side.btcbridge.QueryChainTipRequest")
-	proto.RegisterType((*QueryChainTipResponse)(nil), "side.btcbridge.QueryChainTipResponse")
-	proto.RegisterType((*QueryBlockHeaderByHeightRequest)(nil), "side.btcbridge.QueryBlockHeaderByHeightRequest")
-	proto.RegisterType((*QueryBlockHeaderByHeightResponse)(nil), "side.btcbridge.QueryBlockHeaderByHeightResponse")
-	proto.RegisterType((*QueryBlockHeaderByHashRequest)(nil), "side.btcbridge.QueryBlockHeaderByHashRequest")
-	proto.RegisterType((*QueryBlockHeaderByHashResponse)(nil), "side.btcbridge.QueryBlockHeaderByHashResponse")
-	proto.RegisterType((*QueryUTXOsRequest)(nil), "side.btcbridge.QueryUTXOsRequest")
-	proto.RegisterType((*QueryUTXOsResponse)(nil), "side.btcbridge.QueryUTXOsResponse")
-	proto.RegisterType((*QueryUTXOsByAddressRequest)(nil), "side.btcbridge.QueryUTXOsByAddressRequest")
-	proto.RegisterType((*QueryUTXOsByAddressResponse)(nil), "side.btcbridge.QueryUTXOsByAddressResponse")
+// QueryReservesRequest is the request type for the Query/Reserves RPC method.
+type QueryReservesRequest struct {
+	// the bridged chain, empty for bitcoin
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func init() { proto.RegisterFile("side/btcbridge/query.proto", fileDescriptor_fb547edb49d5502d) }
-
-var fileDescriptor_fb547edb49d5502d = []byte{
-	// 793 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x41, 0x4f, 0x13, 0x5b,
-	0x14, 0xc7, 0x3b, 0x3c, 0xe8, 0xe3, 0x9d, 0xbe, 0x47, 0xf2, 0x2e, 0xb5, 0x69, 0x06, 0x28, 0x75,
-	0x80, 0xd8, 0x14, 0x9d, 0x0b, 0x05, 0x4d, 0xdc, 0x18, 0x29, 0x0b, 0x31, 0x2e, 0xc4, 0x01, 0x13,
-	0xe3, 0x46, 0xef, 0xb4, 0x37, 0x33, 0x13, 0x61, 0xee, 0xd0, 0x3b, 0x35, 0x34, 0x84, 0x98, 0x68,
-	0xe2, 0xda, 0xc4, 0xad, 0x4b, 0x37, 0xac, 0xfd, 0x10, 0xb2, 0x24, 0x71, 0xe3, 0xca, 0x18, 0xf0,
-	0x83, 0x98, 0xb9, 0xf7, 0x96, 0xb6, 0xc3, 0xb4, 0x96, 0x84, 0x4d, 0xdb, 0xb9, 0xe7, 0x7f, 0xce,
-	0xf9, 0x9d, 0x33, 0xf7, 0x9c, 0x82, 0xce, 0xbd, 0x3a, 0xc5, 0x76, 0x58, 0xb3, 0x1b, 0x5e, 0xdd,
-	0xa1, 0x78, 0xaf, 0x49, 0x1b, 0x2d, 0x33, 0x68, 0xb0, 0x90, 0xa1, 0x89, 0xc8, 0x66, 0x9e, 0xdb,
-	0xf4, 0xac, 0xc3, 0x1c, 0x26, 0x4c, 0x38, 0xfa, 0x25, 0x55, 0xfa, 0xb4, 0xc3, 0x98, 0xb3, 0x43,
-	0x31, 0x09, 0x3c, 0x4c, 0x7c, 0x9f, 0x85, 0x24, 0xf4, 0x98, 0xcf, 0x95, 0xb5, 0x5c, 0x63, 0x7c,
-	0x97, 0x71, 0x6c, 0x13, 0xae, 0x82, 0xe3, 0xd7, 0xcb, 0x36, 0x0d, 0xc9, 0x32, 0x0e, 0x88, 0xe3,
-	0xf9, 0x42, 0xac, 0xb4, 0x53, 0x31, 0x96, 0x80, 0x34, 0xc8, 0x6e, 0x3b, 0xd0, 0x74, 0xcc, 0x68,
-	0x7b, 0x61, 0x8d, 0x79, 0xca, 0xd5, 0xf8, 0xa4, 0x81, 0xfe, 0x24, 0x8a, 0xbe, 0xe5, 0x39, 0xbe,
-	0xe7, 0x3b, 0x16, 0xdd, 0x6b, 0x52, 0x1e, 0xaa, 0x2f, 0x74, 0x1b, 0xd2, 0x3c, 0x24, 0x61, 0x93,
-	0xe7, 0xb5, 0xa2, 0x56, 0x9a, 0xa8, 0xcc, 0x98, 0xbd, 0xa5, 0x99, 0xca, 0x6d, 0x4b, 0x88, 0x2c,
-	0x25, 0x46, 0x0f, 0x00, 0x3a, 0x90, 0xf9, 0x91, 0xa2, 0x56, 0xca, 0x54, 0x6e, 0x98, 0xb2, 0x22,
-	0x33, 0xaa, 0xc8, 0x94, 0xed, 0x52, 0x15, 0x99, 0x9b, 0xc4, 0xa1, 0x16, 0xe5, 0x01, 0xf3, 0x39,
-	0xb5, 0xba, 0x5c, 0x8d, 0x23, 0x0d, 0xa6, 0x12, 0xf1, 0xa4, 0x16, 0xad, 0xc1, 0x78, 0x43, 0x1e,
-	0x45, 0x84, 0x7f, 0x95, 0x32, 0x95, 0x85, 0x38, 0x61, 0x55, 0xd6, 0x1b, 0x0b, 0x70, 0xee, 0x76,
-	0x75, 0xac, 0x59, 0x40, 0x02, 0x75, 0x53, 0x74, 0x5f, 0x25, 0x32, 0x1e, 0xc1, 0x64, 0xcf, 0xa9,
-	0x02, 0x5f, 0x85, 0xb4, 0x7c, 0x4b, 0xa2, 0xb1, 0x99, 0x4a, 0x2e, 0x8e, 0x2d, 0xf5, 0xd5, 0xd1,
-	0xe3, 0x1f, 0xb3, 0x29, 0x4b, 0x69, 0x8d, 0x1c, 0x64, 0x45, 0xb0, 0x75, 0x97, 0x78, 0xfe, 0xb6,
-	0x17, 0xb4, 0x93, 0xac, 0xc3, 0xb5, 0xd8, 0xb9, 0x4a, 0x83, 0x60, 0xd4, 0x25, 0xdc, 0x15, 0x49,
-	0xfe, 0xb1, 0xc4, 0x6f, 0x94, 0x83, 0xb4, 0x4b, 0x3d, 0xc7, 0x0d, 0x45, 0xb1, 0xa3, 0x96, 0x7a,
-	0x32, 0xee, 0xc2, 0xac, 0x08, 0x52, 0xdd, 0x61, 0xb5, 0x57, 0x1b, 0x94, 0xd4, 0x69, 0xa3, 0xda,
-	0xda, 0x10, 0xb6, 0xf6, 0x75, 0xe8, 0xb8, 0x6a, 0x3d, 0xae, 0x36, 0x14, 0xfb, 0xbb, 0x2a, 0x94,
-	0x7b, 0xf0, 0xaf, 0x1d, 0x99, 0x5f, 0xb8, 0xc2, 0xae, 0xea, 0x9e, 0xba, 0xf0, 0xba, 0x3a, 0x21,
-	0xac, 0x8c, 0xdd, 0x79, 0x30, 0x56, 0x60, 0x26, 0x21, 0x07, 0xe1, 0x6e, 0x1b, 0x2e, 0xa1, 0x56,
-	0xe3, 0x25, 0x14, 0xfa, 0x39, 0x5d, 0x11, 0xd6, 0x24, 0xfc, 0x2f, 0x32, 0x3c, 0xdd, 0x7e, 0xf6,
-	0xf8, 0xfc, 0xa5, 0xdf, 0x57, 0x57, 0x41, 0x1d, 0xaa, 0x54, 0x65, 0x18, 0x6b, 0x86, 0xfb, 0xac,
-	0x7d, 0x53, 0xb3, 0xf1, 0x1c, 0x91, 0xda, 0x92, 0x12, 0xe3, 0x8e, 0x1a, 0x4b, 0x11, 0xa1, 0xda,
-	0x5a, 0xab, 0xd7, 0x1b, 0x94, 0xb7, 0xe3, 0xa3, 0x3c, 0xfc, 0x4d, 0xe4, 0x89, 0xaa, 0xb6, 0xfd,
-	0x68, 0x3c, 0x54, 0xf3, 0x12, 0xf7, 0xbb, 0x3c, 0x42, 0xe5, 0xeb, 0x38, 0x8c, 0x89, 0x58, 0xe8,
-	0x9d, 0x06, 0x99, 0xae, 0x4b, 0x8c, 0x8c, 0xb8, 0xdb, 0xc5, 0x7b, 0xaf, 0xcf, 0x0d, 0xd4, 0x48,
-	0x1c, 0x63, 0xf1, 0xed, 0xb7, 0x5f, 0x1f, 0x47, 0x16, 0xd0, 0x1c, 0x8e, 0xc4, 0x62, 0x23, 0xd5,
-	0xd8, 0x0e, 0x4e, 0x5c, 0x67, 0xe8, 0xbd, 0x06, 0xff, 0xf5, 0xdc, 0x72, 0x34, 0x9f, 0x98, 0x23,
-	0x36, 0x1c, 0xfa, 0xc2, 0x1f, 0x54, 0x8a, 0xa5, 0x24, 0x58, 0x0c, 0x54, 0x1c, 0xc8, 0x12, 0x7a,
-	0x01, 0xfa, 0xa2, 0x41, 0xbe, 0xdf, 0x75, 0x47, 0x38, 0x31, 0x5b, 0xff, 0x99, 0xd2, 0x97, 0x86,
-	0x77, 0x50, 0xa4, 0xab, 0x82, 0xd4, 0x44, 0x37, 0x07, 0x92, 0xca, 0xd1, 0xc4, 0x07, 0xf2, 0xfb,
-	0x10, 0x1d, 0x69, 0x90, 0x4b, 0x9e, 0x05, 0x74, 0x6b, 0x08, 0x84, 0xce, 0xa0, 0xe9, 0xe6, 0xb0,
-	0x72, 0xc5, 0xbb, 0x24, 0x78, 0xcb, 0xa8, 0x34, 0x98, 0x97, 0x70, 0x17, 0x1f, 0x44, 0x9f, 0x87,
-	0xe8, 0xb3, 0xa6, 0xb6, 0x66, 0xef, 0xd6, 0x46, 0xe5, 0xc4, 0xcc, 0x89, 0x7f, 0x5d, 0xfa, 0xe2,
-	0x50, 0xda, 0x4b, 0xb5, 0x94, 0x4b, 0x67, 0xac, 0xfe, 0x3b, 0xd0, 0x1b, 0x80, 0xce, 0xb0, 0xa1,
-	0xeb, 0x89, 0x09, 0xbb, 0xf7, 0x82, 0x6e, 0x0c, 0x92, 0x28, 0x94, 0xb2, 0x40, 0x99, 0x47, 0xc6,
-	0x40, 0x14, 0x31, 0xa2, 0x9d, 0x3e, 0xf5, 0x8e, 0x7b, 0x9f, 0x3e, 0x25, 0xee, 0x92, 0x3e, 0x7d,
-	0x4a, 0xde, 0x1f, 0x43, 0xf6, 0x49, 0xc0, 0xe1, 0x03, 0xb5, 0x93, 0x0e, 0xab, 0x1b, 0xc7, 0xa7,
-	0x05, 0xed, 0xe4, 0xb4, 0xa0, 0xfd, 0x3c, 0x2d, 0x68, 0x1f, 0xce, 0x0a, 0xa9, 0x93, 0xb3, 0x42,
-	0xea, 0xfb, 0x59, 0x21, 0xf5, 0xdc, 0x74, 0xbc, 0xd0, 0x6d, 0xda, 0x66, 0x8d, 0xed, 0x26, 0x44,
-	0xdc, 0xef, 0x1e, 0xbc, 0x56, 0x40, 0xb9, 0x9d, 0x16, 0x82, 0x95, 0xdf, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0xeb, 0x78, 0x82, 0x82, 0x7e, 0x09, 0x00, 0x00,
+func (m *QueryReservesRequest) Reset()         { *m = QueryReservesRequest{} }
+func (m *QueryReservesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReservesRequest) ProtoMessage()    {}
+func (*QueryReservesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{14}
+}
+func (m *QueryReservesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReservesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReservesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReservesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReservesRequest.Merge(m, src)
+}
+func (m *QueryReservesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReservesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReservesRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryReservesRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	QueryParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// ChainTip queries the chain tip of the module.
-	QueryChainTip(ctx context.Context, in *QueryChainTipRequest, opts ...grpc.CallOption) (*QueryChainTipResponse, error)
-	// BlockHeaderByHeight queries the block header by height.
-	QueryBlockHeaderByHeight(ctx context.Context, in *QueryBlockHeaderByHeightRequest, opts ...grpc.CallOption) (*QueryBlockHeaderByHeightResponse, error)
-	// BlockHeaderByHash queries the block header by hash.
-	QueryBlockHeaderByHash(ctx context.Context, in *QueryBlockHeaderByHashRequest, opts ...grpc.CallOption) (*QueryBlockHeaderByHashResponse, error)
-	// QuerySigningRequest queries the request to sign.
-	QuerySigningRequest(ctx context.Context, in *QuerySigningRequestRequest, opts ...grpc.CallOption) (*QuerySigningRequestResponse, error)
-	// UTXOs queries all utxos.
-	QueryUTXOs(ctx context.Context, in *QueryUTXOsRequest, opts ...grpc.CallOption) (*QueryUTXOsResponse, error)
-	// UTXOsByAddress queries the utxos of the given address.
-	QueryUTXOsByAddress(ctx context.Context, in *QueryUTXOsByAddressRequest, opts ...grpc.CallOption) (*QueryUTXOsByAddressResponse, error)
+func (m *QueryReservesRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryReservesResponse is the response type for the Query/Reserves RPC method.
+type QueryReservesResponse struct {
+	// reserves of each vault
+	Vaults []*VaultReserve `protobuf:"bytes,1,rep,name=vaults,proto3" json:"vaults,omitempty"`
+	// total supply of the btc voucher token
+	VoucherSupply types.Coin `protobuf:"bytes,2,opt,name=voucher_supply,json=voucherSupply,proto3" json:"voucher_supply"`
+	// total amount of the in-flight withdrawals, including the network fees
+	PendingWithdrawals uint64 `protobuf:"varint,3,opt,name=pending_withdrawals,json=pendingWithdrawals,proto3" json:"pending_withdrawals,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryReservesResponse) Reset()         { *m = QueryReservesResponse{} }
+func (m *QueryReservesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReservesResponse) ProtoMessage()    {}
+func (*QueryReservesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{15}
+}
+func (m *QueryReservesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReservesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReservesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReservesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReservesResponse.Merge(m, src)
+}
+func (m *QueryReservesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReservesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReservesResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryReservesResponse proto.InternalMessageInfo
+
+func (m *QueryReservesResponse) GetVaults() []*VaultReserve {
+	if m != nil {
+		return m.Vaults
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) QueryChainTip(ctx context.Context, in *QueryChainTipRequest, opts ...grpc.CallOption) (*QueryChainTipResponse, error) {
-	out := new(QueryChainTipResponse)
-	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryChainTip", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryReservesResponse) GetVoucherSupply() types.Coin {
+	if m != nil {
+		return m.VoucherSupply
 	}
-	return out, nil
+	return types.Coin{}
 }
 
-func (c *queryClient) QueryBlockHeaderByHeight(ctx context.Context, in *QueryBlockHeaderByHeightRequest, opts ...grpc.CallOption) (*QueryBlockHeaderByHeightResponse, error) {
-	out := new(QueryBlockHeaderByHeightResponse)
-	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryBlockHeaderByHeight", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryReservesResponse) GetPendingWithdrawals() uint64 {
+	if m != nil {
+		return m.PendingWithdrawals
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) QueryBlockHeaderByHash(ctx context.Context, in *QueryBlockHeaderByHashRequest, opts ...grpc.CallOption) (*QueryBlockHeaderByHashResponse, error) {
-	out := new(QueryBlockHeaderByHashResponse)
-	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryBlockHeaderByHash", in, out, opts...)
-	if err != nil {
-		return nil, err
+// VaultReserve defines the reserve held by the vault
+type VaultReserve struct {
+	Address   string    `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	AssetType AssetType `protobuf:"varint,2,opt,name=asset_type,json=assetType,proto3,enum=side.btcbridge.AssetType" json:"asset_type,omitempty"`
+	// total amount of the unlocked utxos
+	Unspent uint64 `protobuf:"varint,3,opt,name=unspent,proto3" json:"unspent,omitempty"`
+	// total amount of the utxos locked by the signing requests
+	Locked uint64 `protobuf:"varint,4,opt,name=locked,proto3" json:"locked,omitempty"`
+	// total amount of the utxos created by the unconfirmed signing requests, e.g. the change,
+	// which are not counted as reserves until confirmed, as the spent utxos are still locked
+	Unconfirmed uint64 `protobuf:"varint,5,opt,name=unconfirmed,proto3" json:"unconfirmed,omitempty"`
+}
+
+func (m *VaultReserve) Reset()         { *m = VaultReserve{} }
+func (m *VaultReserve) String() string { return proto.CompactTextString(m) }
+func (*VaultReserve) ProtoMessage()    {}
+func (*VaultReserve) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{16}
+}
+func (m *VaultReserve) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VaultReserve) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VaultReserve.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *VaultReserve) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VaultReserve.Merge(m, src)
+}
+func (m *VaultReserve) XXX_Size() int {
+	return m.Size()
+}
+func (m *VaultReserve) XXX_DiscardUnknown() {
+	xxx_messageInfo_VaultReserve.DiscardUnknown(m)
 }
 
-func (c *queryClient) QuerySigningRequest(ctx context.Context, in *QuerySigningRequestRequest, opts ...grpc.CallOption) (*QuerySigningRequestResponse, error) {
-	out := new(QuerySigningRequestResponse)
-	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QuerySigningRequest", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_VaultReserve proto.InternalMessageInfo
+
+func (m *VaultReserve) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) QueryUTXOs(ctx context.Context, in *QueryUTXOsRequest, opts ...grpc.CallOption) (*QueryUTXOsResponse, error) {
-	out := new(QueryUTXOsResponse)
-	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryUTXOs", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *VaultReserve) GetAssetType() AssetType {
+	if m != nil {
+		return m.AssetType
 	}
-	return out, nil
+	return AssetType_ASSET_TYPE_UNSPECIFIED
 }
 
-func (c *queryClient) QueryUTXOsByAddress(ctx context.Context, in *QueryUTXOsByAddressRequest, opts ...grpc.CallOption) (*QueryUTXOsByAddressResponse, error) {
-	out := new(QueryUTXOsByAddressResponse)
-	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryUTXOsByAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *VaultReserve) GetUnspent() uint64 {
+	if m != nil {
+		return m.Unspent
 	}
-	return out, nil
+	return 0
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	QueryParams(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// ChainTip queries the chain tip of the module.
-	QueryChainTip(context.Context, *QueryChainTipRequest) (*QueryChainTipResponse, error)
-	// BlockHeaderByHeight queries the block header by height.
-	QueryBlockHeaderByHeight(context.Context, *QueryBlockHeaderByHeightRequest) (*QueryBlockHeaderByHeightResponse, error)
-	// BlockHeaderByHash queries the block header by hash.
-	QueryBlockHeaderByHash(context.Context, *QueryBlockHeaderByHashRequest) (*QueryBlockHeaderByHashResponse, error)
-	// QuerySigningRequest queries the request to sign.
-	QuerySigningRequest(context.Context, *QuerySigningRequestRequest) (*QuerySigningRequestResponse, error)
-	// UTXOs queries all utxos.
-	QueryUTXOs(context.Context, *QueryUTXOsRequest) (*QueryUTXOsResponse, error)
-	// UTXOsByAddress queries the utxos of the given address.
-	QueryUTXOsByAddress(context.Context, *QueryUTXOsByAddressRequest) (*QueryUTXOsByAddressResponse, error)
+func (m *VaultReserve) GetLocked() uint64 {
+	if m != nil {
+		return m.Locked
+	}
+	return 0
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *VaultReserve) GetUnconfirmed() uint64 {
+	if m != nil {
+		return m.Unconfirmed
+	}
+	return 0
 }
 
-func (*UnimplementedQueryServer) QueryParams(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryParams not implemented")
+// QueryAddressLinkRequest is the request type for the Query/AddressLink RPC method.
+type QueryAddressLinkRequest struct {
+	// the side address or the bitcoin address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// the bridged chain, empty for bitcoin
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
-func (*UnimplementedQueryServer) QueryChainTip(ctx context.Context, req *QueryChainTipRequest) (*QueryChainTipResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryChainTip not implemented")
+
+func (m *QueryAddressLinkRequest) Reset()         { *m = QueryAddressLinkRequest{} }
+func (m *QueryAddressLinkRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAddressLinkRequest) ProtoMessage()    {}
+func (*QueryAddressLinkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{17}
 }
-func (*UnimplementedQueryServer) QueryBlockHeaderByHeight(ctx context.Context, req *QueryBlockHeaderByHeightRequest) (*QueryBlockHeaderByHeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryBlockHeaderByHeight not implemented")
+func (m *QueryAddressLinkRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) QueryBlockHeaderByHash(ctx context.Context, req *QueryBlockHeaderByHashRequest) (*QueryBlockHeaderByHashResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryBlockHeaderByHash not implemented")
+func (m *QueryAddressLinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAddressLinkRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) QuerySigningRequest(ctx context.Context, req *QuerySigningRequestRequest) (*QuerySigningRequestResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QuerySigningRequest not implemented")
+func (m *QueryAddressLinkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAddressLinkRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) QueryUTXOs(ctx context.Context, req *QueryUTXOsRequest) (*QueryUTXOsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryUTXOs not implemented")
+func (m *QueryAddressLinkRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) QueryUTXOsByAddress(ctx context.Context, req *QueryUTXOsByAddressRequest) (*QueryUTXOsByAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryUTXOsByAddress not implemented")
+func (m *QueryAddressLinkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAddressLinkRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryAddressLinkRequest proto.InternalMessageInfo
 
-func _Query_QueryParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/side.btcbridge.Query/QueryParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryParams(ctx, req.(*QueryParamsRequest))
+func (m *QueryAddressLinkRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_QueryChainTip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryChainTipRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryChainTip(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/side.btcbridge.Query/QueryChainTip",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryChainTip(ctx, req.(*QueryChainTipRequest))
+func (m *QueryAddressLinkRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_QueryBlockHeaderByHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBlockHeaderByHeightRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryBlockHeaderByHeight(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/side.btcbridge.Query/QueryBlockHeaderByHeight",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryBlockHeaderByHeight(ctx, req.(*QueryBlockHeaderByHeightRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryAddressLinkResponse is the response type for the Query/AddressLink RPC method.
+type QueryAddressLinkResponse struct {
+	Link *AddressLink `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
 }
 
-func _Query_QueryBlockHeaderByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBlockHeaderByHashRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryBlockHeaderByHash(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/side.btcbridge.Query/QueryBlockHeaderByHash",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryBlockHeaderByHash(ctx, req.(*QueryBlockHeaderByHashRequest))
+func (m *QueryAddressLinkResponse) Reset()         { *m = QueryAddressLinkResponse{} }
+func (m *QueryAddressLinkResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAddressLinkResponse) ProtoMessage()    {}
+func (*QueryAddressLinkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{18}
+}
+func (m *QueryAddressLinkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAddressLinkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAddressLinkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryAddressLinkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAddressLinkResponse.Merge(m, src)
+}
+func (m *QueryAddressLinkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAddressLinkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAddressLinkResponse.DiscardUnknown(m)
 }
 
-func _Query_QuerySigningRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySigningRequestRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QuerySigningRequest(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/side.btcbridge.Query/QuerySigningRequest",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QuerySigningRequest(ctx, req.(*QuerySigningRequestRequest))
+var xxx_messageInfo_QueryAddressLinkResponse proto.InternalMessageInfo
+
+func (m *QueryAddressLinkResponse) GetLink() *AddressLink {
+	if m != nil {
+		return m.Link
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_QueryUTXOs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryUTXOsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryUTXOs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/side.btcbridge.Query/QueryUTXOs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryUTXOs(ctx, req.(*QueryUTXOsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryFeeQuoteRequest is the request type for the Query/FeeQuote RPC method.
+type QueryFeeQuoteRequest struct {
+	Operation BridgeOperation `protobuf:"varint,1,opt,name=operation,proto3,enum=side.btcbridge.BridgeOperation" json:"operation,omitempty"`
+	// the amount to deposit or withdraw
+	Amount uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// the asset type, btc by default
+	AssetType AssetType `protobuf:"varint,3,opt,name=asset_type,json=assetType,proto3,enum=side.btcbridge.AssetType" json:"asset_type,omitempty"`
+	// the bridged chain, empty for bitcoin
+	ChainId string `protobuf:"bytes,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func _Query_QueryUTXOsByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryUTXOsByAddressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryUTXOsByAddress(ctx, in)
+func (m *QueryFeeQuoteRequest) Reset()         { *m = QueryFeeQuoteRequest{} }
+func (m *QueryFeeQuoteRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeQuoteRequest) ProtoMessage()    {}
+func (*QueryFeeQuoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{19}
+}
+func (m *QueryFeeQuoteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeQuoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeQuoteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/side.btcbridge.Query/QueryUTXOsByAddress",
+}
+func (m *QueryFeeQuoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeQuoteRequest.Merge(m, src)
+}
+func (m *QueryFeeQuoteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeQuoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeQuoteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeQuoteRequest proto.InternalMessageInfo
+
+func (m *QueryFeeQuoteRequest) GetOperation() BridgeOperation {
+	if m != nil {
+		return m.Operation
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryUTXOsByAddress(ctx, req.(*QueryUTXOsByAddressRequest))
+	return BridgeOperation_BRIDGE_OPERATION_UNSPECIFIED
+}
+
+func (m *QueryFeeQuoteRequest) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "side.btcbridge.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "QueryParams",
-			Handler:    _Query_QueryParams_Handler,
-		},
-		{
-			MethodName: "QueryChainTip",
-			Handler:    _Query_QueryChainTip_Handler,
-		},
-		{
-			MethodName: "QueryBlockHeaderByHeight",
-			Handler:    _Query_QueryBlockHeaderByHeight_Handler,
-		},
-		{
-			MethodName: "QueryBlockHeaderByHash",
-			Handler:    _Query_QueryBlockHeaderByHash_Handler,
-		},
-		{
-			MethodName: "QuerySigningRequest",
-			Handler:    _Query_QuerySigningRequest_Handler,
-		},
-		{
-			MethodName: "QueryUTXOs",
-			Handler:    _Query_QueryUTXOs_Handler,
-		},
-		{
-			MethodName: "QueryUTXOsByAddress",
-			Handler:    _Query_QueryUTXOsByAddress_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "side/btcbridge/query.proto",
+func (m *QueryFeeQuoteRequest) GetAssetType() AssetType {
+	if m != nil {
+		return m.AssetType
+	}
+	return AssetType_ASSET_TYPE_UNSPECIFIED
 }
 
-func (m *QuerySigningRequestRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFeeQuoteRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QuerySigningRequestRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryFeeQuoteResponse is the response type for the Query/FeeQuote RPC method.
+type QueryFeeQuoteResponse struct {
+	// the bridge fee
+	Fee types.Coin `protobuf:"bytes,1,opt,name=fee,proto3" json:"fee"`
+	// the vouchers minted to the recipient of a deposit, or charged to the sender of a withdrawal
+	// excluding the bitcoin network fee
+	Total types.Coin `protobuf:"bytes,2,opt,name=total,proto3" json:"total"`
 }
 
-func (m *QuerySigningRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryFeeQuoteResponse) Reset()         { *m = QueryFeeQuoteResponse{} }
+func (m *QueryFeeQuoteResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeQuoteResponse) ProtoMessage()    {}
+func (*QueryFeeQuoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{20}
+}
+func (m *QueryFeeQuoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeQuoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeQuoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.Status != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *QueryFeeQuoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeQuoteResponse.Merge(m, src)
+}
+func (m *QueryFeeQuoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeQuoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeQuoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeQuoteResponse proto.InternalMessageInfo
+
+func (m *QueryFeeQuoteResponse) GetFee() types.Coin {
+	if m != nil {
+		return m.Fee
 	}
-	return len(dAtA) - i, nil
+	return types.Coin{}
 }
 
-func (m *QuerySigningRequestResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFeeQuoteResponse) GetTotal() types.Coin {
+	if m != nil {
+		return m.Total
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *QuerySigningRequestResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryFeePoolRequest is the request type for the Query/FeePool RPC method.
+type QueryFeePoolRequest struct {
+	// the bridged chain, empty for bitcoin
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *QuerySigningRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryFeePoolRequest) Reset()         { *m = QueryFeePoolRequest{} }
+func (m *QueryFeePoolRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeePoolRequest) ProtoMessage()    {}
+func (*QueryFeePoolRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{21}
+}
+func (m *QueryFeePoolRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeePoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeePoolRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Requests) > 0 {
-		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryFeePoolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeePoolRequest.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFeePoolRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryFeePoolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeePoolRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFeePoolRequest proto.InternalMessageInfo
+
+func (m *QueryFeePoolRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryFeePoolResponse is the response type for the Query/FeePool RPC method.
+type QueryFeePoolResponse struct {
+	CollectedFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=collected_fees,json=collectedFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"collected_fees"`
+	Contributions []*FeeContribution                       `protobuf:"bytes,2,rep,name=contributions,proto3" json:"contributions,omitempty"`
+	// the side block height at which the collected fees are distributed next
+	NextDistributionHeight int64 `protobuf:"varint,3,opt,name=next_distribution_height,json=nextDistributionHeight,proto3" json:"next_distribution_height,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryFeePoolResponse) Reset()         { *m = QueryFeePoolResponse{} }
+func (m *QueryFeePoolResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeePoolResponse) ProtoMessage()    {}
+func (*QueryFeePoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{22}
+}
+func (m *QueryFeePoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeePoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeePoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryChainTipRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryFeePoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeePoolResponse.Merge(m, src)
 }
-
-func (m *QueryChainTipRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFeePoolResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryChainTipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryFeePoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeePoolResponse.DiscardUnknown(m)
 }
 
-func (m *QueryChainTipResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFeePoolResponse proto.InternalMessageInfo
+
+func (m *QueryFeePoolResponse) GetCollectedFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CollectedFees
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryChainTipResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFeePoolResponse) GetContributions() []*FeeContribution {
+	if m != nil {
+		return m.Contributions
+	}
+	return nil
 }
 
-func (m *QueryChainTipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryFeePoolResponse) GetNextDistributionHeight() int64 {
+	if m != nil {
+		return m.NextDistributionHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryBlockHeaderByHeightRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// QueryVerifyTxInclusionRequest is the request type for the Query/VerifyTxInclusion RPC method.
+type QueryVerifyTxInclusionRequest struct {
+	// the base64 encoded transaction
+	TxBytes string `protobuf:"bytes,1,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	// the hash of the block including the transaction
+	Blockhash string `protobuf:"bytes,2,opt,name=blockhash,proto3" json:"blockhash,omitempty"`
+	// the merkle proof of the transaction in the block
+	Proof []string `protobuf:"bytes,3,rep,name=proof,proto3" json:"proof,omitempty"`
+	// the minimum number of blocks on top of the block
+	MinConfirmations uint64 `protobuf:"varint,4,opt,name=min_confirmations,json=minConfirmations,proto3" json:"min_confirmations,omitempty"`
+	// the bridged chain, empty for bitcoin
+	ChainId string `protobuf:"bytes,5,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *QueryBlockHeaderByHeightRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryVerifyTxInclusionRequest) Reset()         { *m = QueryVerifyTxInclusionRequest{} }
+func (m *QueryVerifyTxInclusionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyTxInclusionRequest) ProtoMessage()    {}
+func (*QueryVerifyTxInclusionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{23}
+}
+func (m *QueryVerifyTxInclusionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyTxInclusionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyTxInclusionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVerifyTxInclusionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyTxInclusionRequest.Merge(m, src)
+}
+func (m *QueryVerifyTxInclusionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyTxInclusionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyTxInclusionRequest.DiscardUnknown(m)
 }
 
-func (m *QueryBlockHeaderByHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QueryVerifyTxInclusionRequest proto.InternalMessageInfo
+
+func (m *QueryVerifyTxInclusionRequest) GetTxBytes() string {
+	if m != nil {
+		return m.TxBytes
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryBlockHeaderByHeightResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryVerifyTxInclusionRequest) GetBlockhash() string {
+	if m != nil {
+		return m.Blockhash
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryBlockHeaderByHeightResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryVerifyTxInclusionRequest) GetProof() []string {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
 }
 
-func (m *QueryBlockHeaderByHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockHeader != nil {
-		{
-			size, err := m.BlockHeader.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *QueryVerifyTxInclusionRequest) GetMinConfirmations() uint64 {
+	if m != nil {
+		return m.MinConfirmations
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryBlockHeaderByHashRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryVerifyTxInclusionRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryBlockHeaderByHashRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryVerifyTxInclusionResponse is the response type for the Query/VerifyTxInclusion RPC method.
+type QueryVerifyTxInclusionResponse struct {
+	Inclusion *TxInclusion `protobuf:"bytes,1,opt,name=inclusion,proto3" json:"inclusion,omitempty"`
 }
 
-func (m *QueryBlockHeaderByHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryVerifyTxInclusionResponse) Reset()         { *m = QueryVerifyTxInclusionResponse{} }
+func (m *QueryVerifyTxInclusionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyTxInclusionResponse) ProtoMessage()    {}
+func (*QueryVerifyTxInclusionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{24}
+}
+func (m *QueryVerifyTxInclusionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyTxInclusionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyTxInclusionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryVerifyTxInclusionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyTxInclusionResponse.Merge(m, src)
+}
+func (m *QueryVerifyTxInclusionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyTxInclusionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyTxInclusionResponse.DiscardUnknown(m)
 }
 
-func (m *QueryBlockHeaderByHashResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVerifyTxInclusionResponse proto.InternalMessageInfo
+
+func (m *QueryVerifyTxInclusionResponse) GetInclusion() *TxInclusion {
+	if m != nil {
+		return m.Inclusion
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryBlockHeaderByHashResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryHTLCRequest is the request type for the Query/HTLC RPC method.
+type QueryHTLCRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueryBlockHeaderByHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockHeader != nil {
-		{
-			size, err := m.BlockHeader.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryHTLCRequest) Reset()         { *m = QueryHTLCRequest{} }
+func (m *QueryHTLCRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHTLCRequest) ProtoMessage()    {}
+func (*QueryHTLCRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{25}
+}
+func (m *QueryHTLCRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHTLCRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHTLCRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryUTXOsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryHTLCRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHTLCRequest.Merge(m, src)
 }
-
-func (m *QueryUTXOsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryHTLCRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryUTXOsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryHTLCRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHTLCRequest.DiscardUnknown(m)
 }
 
-func (m *QueryUTXOsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryHTLCRequest proto.InternalMessageInfo
+
+func (m *QueryHTLCRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryUTXOsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryHTLCResponse is the response type for the Query/HTLC RPC method.
+type QueryHTLCResponse struct {
+	Htlc *HTLC `protobuf:"bytes,1,opt,name=htlc,proto3" json:"htlc,omitempty"`
 }
 
-func (m *QueryUTXOsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Utxos) > 0 {
-		for iNdEx := len(m.Utxos) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Utxos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryHTLCResponse) Reset()         { *m = QueryHTLCResponse{} }
+func (m *QueryHTLCResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHTLCResponse) ProtoMessage()    {}
+func (*QueryHTLCResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{26}
+}
+func (m *QueryHTLCResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHTLCResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHTLCResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryHTLCResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHTLCResponse.Merge(m, src)
+}
+func (m *QueryHTLCResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHTLCResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHTLCResponse.DiscardUnknown(m)
 }
 
-func (m *QueryUTXOsByAddressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryHTLCResponse proto.InternalMessageInfo
+
+func (m *QueryHTLCResponse) GetHtlc() *HTLC {
+	if m != nil {
+		return m.Htlc
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryUTXOsByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryHTLCsRequest is the request type for the Query/HTLCs RPC method.
+type QueryHTLCsRequest struct {
+	// all HTLCs if unspecified
+	Status HTLCStatus `protobuf:"varint,1,opt,name=status,proto3,enum=side.btcbridge.HTLCStatus" json:"status,omitempty"`
 }
 
-func (m *QueryUTXOsByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryHTLCsRequest) Reset()         { *m = QueryHTLCsRequest{} }
+func (m *QueryHTLCsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHTLCsRequest) ProtoMessage()    {}
+func (*QueryHTLCsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{27}
+}
+func (m *QueryHTLCsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHTLCsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHTLCsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryHTLCsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHTLCsRequest.Merge(m, src)
+}
+func (m *QueryHTLCsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHTLCsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHTLCsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryUTXOsByAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryHTLCsRequest proto.InternalMessageInfo
+
+func (m *QueryHTLCsRequest) GetStatus() HTLCStatus {
+	if m != nil {
+		return m.Status
 	}
-	return dAtA[:n], nil
+	return HTLCStatus_HTLC_STATUS_UNSPECIFIED
 }
 
-func (m *QueryUTXOsByAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryHTLCsResponse is the response type for the Query/HTLCs RPC method.
+type QueryHTLCsResponse struct {
+	Htlcs []*HTLC `protobuf:"bytes,1,rep,name=htlcs,proto3" json:"htlcs,omitempty"`
 }
 
-func (m *QueryUTXOsByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Utxos) > 0 {
-		for iNdEx := len(m.Utxos) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Utxos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryHTLCsResponse) Reset()         { *m = QueryHTLCsResponse{} }
+func (m *QueryHTLCsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHTLCsResponse) ProtoMessage()    {}
+func (*QueryHTLCsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{28}
+}
+func (m *QueryHTLCsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHTLCsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHTLCsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryHTLCsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHTLCsResponse.Merge(m, src)
+}
+func (m *QueryHTLCsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHTLCsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHTLCsResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryHTLCsResponse proto.InternalMessageInfo
+
+func (m *QueryHTLCsResponse) GetHtlcs() []*HTLC {
+	if m != nil {
+		return m.Htlcs
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QuerySigningRequestRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovQuery(uint64(m.Status))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+// QueryRequiredConfirmationsRequest is the request type for the Query/RequiredConfirmations RPC method.
+type QueryRequiredConfirmationsRequest struct {
+	// the amount in sat
+	Amount uint64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	// btc by default
+	AssetType AssetType `protobuf:"varint,2,opt,name=asset_type,json=assetType,proto3,enum=side.btcbridge.AssetType" json:"asset_type,omitempty"`
+	ChainId   string    `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *QuerySigningRequestResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Requests) > 0 {
-		for _, e := range m.Requests {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryRequiredConfirmationsRequest) Reset()         { *m = QueryRequiredConfirmationsRequest{} }
+func (m *QueryRequiredConfirmationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRequiredConfirmationsRequest) ProtoMessage()    {}
+func (*QueryRequiredConfirmationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{29}
+}
+func (m *QueryRequiredConfirmationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRequiredConfirmationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRequiredConfirmationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryRequiredConfirmationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRequiredConfirmationsRequest.Merge(m, src)
+}
+func (m *QueryRequiredConfirmationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRequiredConfirmationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRequiredConfirmationsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryRequiredConfirmationsRequest proto.InternalMessageInfo
+
+func (m *QueryRequiredConfirmationsRequest) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
 	}
-	var l int
-	_ = l
-	return n
+	return 0
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRequiredConfirmationsRequest) GetAssetType() AssetType {
+	if m != nil {
+		return m.AssetType
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return AssetType_ASSET_TYPE_UNSPECIFIED
 }
 
-func (m *QueryChainTipRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRequiredConfirmationsRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *QueryChainTipResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+// QueryRequiredConfirmationsResponse is the response type for the Query/RequiredConfirmations RPC method.
+type QueryRequiredConfirmationsResponse struct {
+	Confirmations int32 `protobuf:"varint,1,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+}
+
+func (m *QueryRequiredConfirmationsResponse) Reset()         { *m = QueryRequiredConfirmationsResponse{} }
+func (m *QueryRequiredConfirmationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRequiredConfirmationsResponse) ProtoMessage()    {}
+func (*QueryRequiredConfirmationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{30}
+}
+func (m *QueryRequiredConfirmationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRequiredConfirmationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRequiredConfirmationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+}
+func (m *QueryRequiredConfirmationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRequiredConfirmationsResponse.Merge(m, src)
+}
+func (m *QueryRequiredConfirmationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRequiredConfirmationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRequiredConfirmationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRequiredConfirmationsResponse proto.InternalMessageInfo
+
+func (m *QueryRequiredConfirmationsResponse) GetConfirmations() int32 {
+	if m != nil {
+		return m.Confirmations
 	}
-	return n
+	return 0
 }
 
-func (m *QueryBlockHeaderByHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryBlocklistRequest is the request type for the Query/Blocklist RPC method.
+type QueryBlocklistRequest struct {
+}
+
+func (m *QueryBlocklistRequest) Reset()         { *m = QueryBlocklistRequest{} }
+func (m *QueryBlocklistRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlocklistRequest) ProtoMessage()    {}
+func (*QueryBlocklistRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{31}
+}
+func (m *QueryBlocklistRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlocklistRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlocklistRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+}
+func (m *QueryBlocklistRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlocklistRequest.Merge(m, src)
+}
+func (m *QueryBlocklistRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlocklistRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlocklistRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlocklistRequest proto.InternalMessageInfo
+
+// QueryBlocklistResponse is the response type for the Query/Blocklist RPC method.
+type QueryBlocklistResponse struct {
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (m *QueryBlocklistResponse) Reset()         { *m = QueryBlocklistResponse{} }
+func (m *QueryBlocklistResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlocklistResponse) ProtoMessage()    {}
+func (*QueryBlocklistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{32}
+}
+func (m *QueryBlocklistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlocklistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlocklistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryBlocklistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlocklistResponse.Merge(m, src)
+}
+func (m *QueryBlocklistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlocklistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlocklistResponse.DiscardUnknown(m)
 }
 
-func (m *QueryBlockHeaderByHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryBlocklistResponse proto.InternalMessageInfo
+
+func (m *QueryBlocklistResponse) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
 	}
-	var l int
-	_ = l
-	if m.BlockHeader != nil {
-		l = m.BlockHeader.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// QueryQuarantinedDepositsRequest is the request type for the Query/QuarantinedDeposits RPC method.
+type QueryQuarantinedDepositsRequest struct {
+	// all quarantined deposits if unspecified
+	Status QuarantineStatus `protobuf:"varint,1,opt,name=status,proto3,enum=side.btcbridge.QuarantineStatus" json:"status,omitempty"`
+}
+
+func (m *QueryQuarantinedDepositsRequest) Reset()         { *m = QueryQuarantinedDepositsRequest{} }
+func (m *QueryQuarantinedDepositsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryQuarantinedDepositsRequest) ProtoMessage()    {}
+func (*QueryQuarantinedDepositsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{33}
+}
+func (m *QueryQuarantinedDepositsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQuarantinedDepositsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQuarantinedDepositsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryQuarantinedDepositsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuarantinedDepositsRequest.Merge(m, src)
+}
+func (m *QueryQuarantinedDepositsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQuarantinedDepositsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuarantinedDepositsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryBlockHeaderByHashRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryQuarantinedDepositsRequest proto.InternalMessageInfo
+
+func (m *QueryQuarantinedDepositsRequest) GetStatus() QuarantineStatus {
+	if m != nil {
+		return m.Status
 	}
-	var l int
-	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return QuarantineStatus_QUARANTINE_STATUS_UNSPECIFIED
+}
+
+// QueryQuarantinedDepositsResponse is the response type for the Query/QuarantinedDeposits RPC method.
+type QueryQuarantinedDepositsResponse struct {
+	Deposits []*QuarantinedDeposit `protobuf:"bytes,1,rep,name=deposits,proto3" json:"deposits,omitempty"`
+}
+
+func (m *QueryQuarantinedDepositsResponse) Reset()         { *m = QueryQuarantinedDepositsResponse{} }
+func (m *QueryQuarantinedDepositsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQuarantinedDepositsResponse) ProtoMessage()    {}
+func (*QueryQuarantinedDepositsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{34}
+}
+func (m *QueryQuarantinedDepositsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQuarantinedDepositsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQuarantinedDepositsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryQuarantinedDepositsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuarantinedDepositsResponse.Merge(m, src)
+}
+func (m *QueryQuarantinedDepositsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQuarantinedDepositsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuarantinedDepositsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryBlockHeaderByHashResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryQuarantinedDepositsResponse proto.InternalMessageInfo
+
+func (m *QueryQuarantinedDepositsResponse) GetDeposits() []*QuarantinedDeposit {
+	if m != nil {
+		return m.Deposits
 	}
-	var l int
-	_ = l
-	if m.BlockHeader != nil {
-		l = m.BlockHeader.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// QueryPendingDepositRequest is the request type for the Query/PendingDeposit RPC method.
+type QueryPendingDepositRequest struct {
+	Txid string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	// the bridged chain, empty for bitcoin
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *QueryPendingDepositRequest) Reset()         { *m = QueryPendingDepositRequest{} }
+func (m *QueryPendingDepositRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingDepositRequest) ProtoMessage()    {}
+func (*QueryPendingDepositRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{35}
+}
+func (m *QueryPendingDepositRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingDepositRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingDepositRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryPendingDepositRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingDepositRequest.Merge(m, src)
+}
+func (m *QueryPendingDepositRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingDepositRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingDepositRequest.DiscardUnknown(m)
 }
 
-func (m *QueryUTXOsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryPendingDepositRequest proto.InternalMessageInfo
+
+func (m *QueryPendingDepositRequest) GetTxid() string {
+	if m != nil {
+		return m.Txid
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *QueryUTXOsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPendingDepositRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	var l int
-	_ = l
-	if len(m.Utxos) > 0 {
-		for _, e := range m.Utxos {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+// QueryPendingDepositResponse is the response type for the Query/PendingDeposit RPC method.
+type QueryPendingDepositResponse struct {
+	Deposit *PendingDeposit `protobuf:"bytes,1,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	// the current confirmations of the deposit
+	Confirmations uint64 `protobuf:"varint,2,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	// whether the deposit is waiting for confirmations or for the coinbase maturity
+	State PendingDepositState `protobuf:"varint,3,opt,name=state,proto3,enum=side.btcbridge.PendingDepositState" json:"state,omitempty"`
+}
+
+func (m *QueryPendingDepositResponse) Reset()         { *m = QueryPendingDepositResponse{} }
+func (m *QueryPendingDepositResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingDepositResponse) ProtoMessage()    {}
+func (*QueryPendingDepositResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{36}
+}
+func (m *QueryPendingDepositResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingDepositResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryPendingDepositResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingDepositResponse.Merge(m, src)
+}
+func (m *QueryPendingDepositResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingDepositResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingDepositResponse.DiscardUnknown(m)
 }
 
-func (m *QueryUTXOsByAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryPendingDepositResponse proto.InternalMessageInfo
+
+func (m *QueryPendingDepositResponse) GetDeposit() *PendingDeposit {
+	if m != nil {
+		return m.Deposit
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+func (m *QueryPendingDepositResponse) GetConfirmations() uint64 {
+	if m != nil {
+		return m.Confirmations
 	}
-	return n
+	return 0
 }
 
-func (m *QueryUTXOsByAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPendingDepositResponse) GetState() PendingDepositState {
+	if m != nil {
+		return m.State
 	}
-	var l int
-	_ = l
-	if len(m.Utxos) > 0 {
-		for _, e := range m.Utxos {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return PendingDepositState_PENDING_DEPOSIT_STATE_UNSPECIFIED
+}
+
+// QueryPendingDepositsRequest is the request type for the Query/PendingDeposits RPC method.
+type QueryPendingDepositsRequest struct {
+	// the bridged chain, empty for bitcoin
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// filter by the state if specified
+	State PendingDepositState `protobuf:"varint,2,opt,name=state,proto3,enum=side.btcbridge.PendingDepositState" json:"state,omitempty"`
+}
+
+func (m *QueryPendingDepositsRequest) Reset()         { *m = QueryPendingDepositsRequest{} }
+func (m *QueryPendingDepositsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingDepositsRequest) ProtoMessage()    {}
+func (*QueryPendingDepositsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{37}
+}
+func (m *QueryPendingDepositsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingDepositsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingDepositsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
 }
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryPendingDepositsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingDepositsRequest.Merge(m, src)
 }
-func sozQuery(x uint64) (n int) {
+func (m *QueryPendingDepositsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingDepositsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingDepositsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingDepositsRequest proto.InternalMessageInfo
+
+func (m *QueryPendingDepositsRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *QueryPendingDepositsRequest) GetState() PendingDepositState {
+	if m != nil {
+		return m.State
+	}
+	return PendingDepositState_PENDING_DEPOSIT_STATE_UNSPECIFIED
+}
+
+// QueryPendingDepositsResponse is the response type for the Query/PendingDeposits RPC method.
+type QueryPendingDepositsResponse struct {
+	Deposits []*PendingDeposit `protobuf:"bytes,1,rep,name=deposits,proto3" json:"deposits,omitempty"`
+	// the height of the best block, from which the confirmations of the deposits are counted
+	BestHeight uint64 `protobuf:"varint,2,opt,name=best_height,json=bestHeight,proto3" json:"best_height,omitempty"`
+}
+
+func (m *QueryPendingDepositsResponse) Reset()         { *m = QueryPendingDepositsResponse{} }
+func (m *QueryPendingDepositsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingDepositsResponse) ProtoMessage()    {}
+func (*QueryPendingDepositsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{38}
+}
+func (m *QueryPendingDepositsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingDepositsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingDepositsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingDepositsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingDepositsResponse.Merge(m, src)
+}
+func (m *QueryPendingDepositsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingDepositsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingDepositsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingDepositsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingDepositsResponse) GetDeposits() []*PendingDeposit {
+	if m != nil {
+		return m.Deposits
+	}
+	return nil
+}
+
+func (m *QueryPendingDepositsResponse) GetBestHeight() uint64 {
+	if m != nil {
+		return m.BestHeight
+	}
+	return 0
+}
+
+// QueryBridgeStatusRequest is the request type for the Query/BridgeStatus RPC method.
+type QueryBridgeStatusRequest struct {
+	// the bridged chain, empty for bitcoin
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *QueryBridgeStatusRequest) Reset()         { *m = QueryBridgeStatusRequest{} }
+func (m *QueryBridgeStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBridgeStatusRequest) ProtoMessage()    {}
+func (*QueryBridgeStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{39}
+}
+func (m *QueryBridgeStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBridgeStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBridgeStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBridgeStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBridgeStatusRequest.Merge(m, src)
+}
+func (m *QueryBridgeStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBridgeStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBridgeStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBridgeStatusRequest proto.InternalMessageInfo
+
+func (m *QueryBridgeStatusRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+// QueryBridgeStatusResponse is the response type for the Query/BridgeStatus RPC method.
+type QueryBridgeStatusResponse struct {
+	// the degradation of the bridge, nil if the bridge operates normally
+	Degradation *BridgeDegradation `protobuf:"bytes,1,opt,name=degradation,proto3" json:"degradation,omitempty"`
+	BestHeight  uint64             `protobuf:"varint,2,opt,name=best_height,json=bestHeight,proto3" json:"best_height,omitempty"`
+	// the time of the best block header in unix seconds
+	BestTime uint64 `protobuf:"varint,3,opt,name=best_time,json=bestTime,proto3" json:"best_time,omitempty"`
+	// the lag of the best block header behind the side block time in seconds
+	HeaderLag int64 `protobuf:"varint,4,opt,name=header_lag,json=headerLag,proto3" json:"header_lag,omitempty"`
+	// the max header lag beyond which the bridge is degraded, 0 if disabled
+	MaxHeaderLag int64 `protobuf:"varint,5,opt,name=max_header_lag,json=maxHeaderLag,proto3" json:"max_header_lag,omitempty"`
+}
+
+func (m *QueryBridgeStatusResponse) Reset()         { *m = QueryBridgeStatusResponse{} }
+func (m *QueryBridgeStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBridgeStatusResponse) ProtoMessage()    {}
+func (*QueryBridgeStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{40}
+}
+func (m *QueryBridgeStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBridgeStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBridgeStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBridgeStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBridgeStatusResponse.Merge(m, src)
+}
+func (m *QueryBridgeStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBridgeStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBridgeStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBridgeStatusResponse proto.InternalMessageInfo
+
+func (m *QueryBridgeStatusResponse) GetDegradation() *BridgeDegradation {
+	if m != nil {
+		return m.Degradation
+	}
+	return nil
+}
+
+func (m *QueryBridgeStatusResponse) GetBestHeight() uint64 {
+	if m != nil {
+		return m.BestHeight
+	}
+	return 0
+}
+
+func (m *QueryBridgeStatusResponse) GetBestTime() uint64 {
+	if m != nil {
+		return m.BestTime
+	}
+	return 0
+}
+
+func (m *QueryBridgeStatusResponse) GetHeaderLag() int64 {
+	if m != nil {
+		return m.HeaderLag
+	}
+	return 0
+}
+
+func (m *QueryBridgeStatusResponse) GetMaxHeaderLag() int64 {
+	if m != nil {
+		return m.MaxHeaderLag
+	}
+	return 0
+}
+
+// QueryTaggedUTXOsRequest is the request type for the Query/TaggedUTXOs RPC method.
+type QueryTaggedUTXOsRequest struct {
+	// the vault address, empty for all vaults
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// the bridged chain, empty for bitcoin
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *QueryTaggedUTXOsRequest) Reset()         { *m = QueryTaggedUTXOsRequest{} }
+func (m *QueryTaggedUTXOsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTaggedUTXOsRequest) ProtoMessage()    {}
+func (*QueryTaggedUTXOsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{41}
+}
+func (m *QueryTaggedUTXOsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTaggedUTXOsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTaggedUTXOsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTaggedUTXOsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTaggedUTXOsRequest.Merge(m, src)
+}
+func (m *QueryTaggedUTXOsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTaggedUTXOsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTaggedUTXOsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTaggedUTXOsRequest proto.InternalMessageInfo
+
+func (m *QueryTaggedUTXOsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryTaggedUTXOsRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+// QueryTaggedUTXOsResponse is the response type for the Query/TaggedUTXOs RPC method.
+type QueryTaggedUTXOsResponse struct {
+	Utxos []*UTXO `protobuf:"bytes,1,rep,name=utxos,proto3" json:"utxos,omitempty"`
+}
+
+func (m *QueryTaggedUTXOsResponse) Reset()         { *m = QueryTaggedUTXOsResponse{} }
+func (m *QueryTaggedUTXOsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTaggedUTXOsResponse) ProtoMessage()    {}
+func (*QueryTaggedUTXOsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb547edb49d5502d, []int{42}
+}
+func (m *QueryTaggedUTXOsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTaggedUTXOsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTaggedUTXOsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTaggedUTXOsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTaggedUTXOsResponse.Merge(m, src)
+}
+func (m *QueryTaggedUTXOsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTaggedUTXOsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTaggedUTXOsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTaggedUTXOsResponse proto.InternalMessageInfo
+
+func (m *QueryTaggedUTXOsResponse) GetUtxos() []*UTXO {
+	if m != nil {
+		return m.Utxos
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QuerySigningRequestRequest)(nil), "side.btcbridge.QuerySigningRequestRequest")
+	proto.RegisterType((*QuerySigningRequestResponse)(nil), "side.btcbridge.QuerySigningRequestResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "side.btcbridge.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "side.btcbridge.QueryParamsResponse")
+	proto.RegisterType((*QueryChainTipRequest)(nil), "side.btcbridge.QueryChainTipRequest")
+	proto.RegisterType((*QueryChainTipResponse)(nil), "side.btcbridge.QueryChainTipResponse")
+	proto.RegisterType((*QueryBlockHeaderByHeightRequest)(nil), "side.btcbridge.QueryBlockHeaderByHeightRequest")
+	proto.RegisterType((*QueryBlockHeaderByHeightResponse)(nil), "side.btcbridge.QueryBlockHeaderByHeightResponse")
+	proto.RegisterType((*QueryBlockHeaderByHashRequest)(nil), "side.btcbridge.QueryBlockHeaderByHashRequest")
+	proto.RegisterType((*QueryBlockHeaderByHashResponse)(nil), "side.btcbridge.QueryBlockHeaderByHashResponse")
+	proto.RegisterType((*QueryUTXOsRequest)(nil), "side.btcbridge.QueryUTXOsRequest")
+	proto.RegisterType((*QueryUTXOsResponse)(nil), "side.btcbridge.QueryUTXOsResponse")
+	proto.RegisterType((*QueryUTXOsByAddressRequest)(nil), "side.btcbridge.QueryUTXOsByAddressRequest")
+	proto.RegisterType((*QueryUTXOsByAddressResponse)(nil), "side.btcbridge.QueryUTXOsByAddressResponse")
+	proto.RegisterType((*QueryReservesRequest)(nil), "side.btcbridge.QueryReservesRequest")
+	proto.RegisterType((*QueryReservesResponse)(nil), "side.btcbridge.QueryReservesResponse")
+	proto.RegisterType((*VaultReserve)(nil), "side.btcbridge.VaultReserve")
+	proto.RegisterType((*QueryAddressLinkRequest)(nil), "side.btcbridge.QueryAddressLinkRequest")
+	proto.RegisterType((*QueryAddressLinkResponse)(nil), "side.btcbridge.QueryAddressLinkResponse")
+	proto.RegisterType((*QueryFeeQuoteRequest)(nil), "side.btcbridge.QueryFeeQuoteRequest")
+	proto.RegisterType((*QueryFeeQuoteResponse)(nil), "side.btcbridge.QueryFeeQuoteResponse")
+	proto.RegisterType((*QueryFeePoolRequest)(nil), "side.btcbridge.QueryFeePoolRequest")
+	proto.RegisterType((*QueryFeePoolResponse)(nil), "side.btcbridge.QueryFeePoolResponse")
+	proto.RegisterType((*QueryVerifyTxInclusionRequest)(nil), "side.btcbridge.QueryVerifyTxInclusionRequest")
+	proto.RegisterType((*QueryVerifyTxInclusionResponse)(nil), "side.btcbridge.QueryVerifyTxInclusionResponse")
+	proto.RegisterType((*QueryHTLCRequest)(nil), "side.btcbridge.QueryHTLCRequest")
+	proto.RegisterType((*QueryHTLCResponse)(nil), "side.btcbridge.QueryHTLCResponse")
+	proto.RegisterType((*QueryHTLCsRequest)(nil), "side.btcbridge.QueryHTLCsRequest")
+	proto.RegisterType((*QueryHTLCsResponse)(nil), "side.btcbridge.QueryHTLCsResponse")
+	proto.RegisterType((*QueryRequiredConfirmationsRequest)(nil), "side.btcbridge.QueryRequiredConfirmationsRequest")
+	proto.RegisterType((*QueryRequiredConfirmationsResponse)(nil), "side.btcbridge.QueryRequiredConfirmationsResponse")
+	proto.RegisterType((*QueryBlocklistRequest)(nil), "side.btcbridge.QueryBlocklistRequest")
+	proto.RegisterType((*QueryBlocklistResponse)(nil), "side.btcbridge.QueryBlocklistResponse")
+	proto.RegisterType((*QueryQuarantinedDepositsRequest)(nil), "side.btcbridge.QueryQuarantinedDepositsRequest")
+	proto.RegisterType((*QueryQuarantinedDepositsResponse)(nil), "side.btcbridge.QueryQuarantinedDepositsResponse")
+	proto.RegisterType((*QueryPendingDepositRequest)(nil), "side.btcbridge.QueryPendingDepositRequest")
+	proto.RegisterType((*QueryPendingDepositResponse)(nil), "side.btcbridge.QueryPendingDepositResponse")
+	proto.RegisterType((*QueryPendingDepositsRequest)(nil), "side.btcbridge.QueryPendingDepositsRequest")
+	proto.RegisterType((*QueryPendingDepositsResponse)(nil), "side.btcbridge.QueryPendingDepositsResponse")
+	proto.RegisterType((*QueryBridgeStatusRequest)(nil), "side.btcbridge.QueryBridgeStatusRequest")
+	proto.RegisterType((*QueryBridgeStatusResponse)(nil), "side.btcbridge.QueryBridgeStatusResponse")
+	proto.RegisterType((*QueryTaggedUTXOsRequest)(nil), "side.btcbridge.QueryTaggedUTXOsRequest")
+	proto.RegisterType((*QueryTaggedUTXOsResponse)(nil), "side.btcbridge.QueryTaggedUTXOsResponse")
+}
+
+func init() { proto.RegisterFile("side/btcbridge/query.proto", fileDescriptor_fb547edb49d5502d) }
+
+var fileDescriptor_fb547edb49d5502d = []byte{
+	// 2210 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0xdf, 0x6f, 0x1b, 0x59,
+	0xf5, 0xef, 0xe4, 0x47, 0x5b, 0x9f, 0xb4, 0xd1, 0xf6, 0x6e, 0xb6, 0xeb, 0x4c, 0x53, 0x27, 0x9d,
+	0x26, 0x5b, 0x37, 0xd9, 0x78, 0x12, 0xb7, 0x59, 0x65, 0xbf, 0x5f, 0x16, 0xd1, 0xa4, 0x64, 0x53,
+	0x5a, 0xed, 0xb6, 0xd3, 0xb0, 0x20, 0xf6, 0xc1, 0x8c, 0x3d, 0x37, 0xf6, 0xa8, 0xf6, 0x8c, 0x33,
+	0x33, 0xce, 0x3a, 0x8a, 0x22, 0xb4, 0x20, 0x21, 0xa1, 0x15, 0x62, 0x25, 0x84, 0x84, 0x78, 0xe6,
+	0x81, 0x45, 0x08, 0x09, 0x21, 0x01, 0x8f, 0x88, 0x07, 0xb4, 0x8f, 0x2b, 0xf1, 0x82, 0x78, 0x00,
+	0xd4, 0xf2, 0x87, 0xa0, 0x7b, 0xe7, 0xdc, 0xf9, 0xe5, 0x99, 0xb1, 0x5d, 0xf6, 0x25, 0xf6, 0xdc,
+	0xfb, 0x39, 0xe7, 0x7c, 0x7c, 0xee, 0x9c, 0x5f, 0x37, 0x20, 0xbb, 0xa6, 0x41, 0xd5, 0xba, 0xd7,
+	0xa8, 0x3b, 0xa6, 0xd1, 0xa4, 0xea, 0x51, 0x8f, 0x3a, 0x27, 0x95, 0xae, 0x63, 0x7b, 0x36, 0x99,
+	0x65, 0x7b, 0x95, 0x60, 0x4f, 0x9e, 0x6b, 0xda, 0x4d, 0x9b, 0x6f, 0xa9, 0xec, 0x9b, 0x8f, 0x92,
+	0x17, 0x9a, 0xb6, 0xdd, 0x6c, 0x53, 0x55, 0xef, 0x9a, 0xaa, 0x6e, 0x59, 0xb6, 0xa7, 0x7b, 0xa6,
+	0x6d, 0xb9, 0xb8, 0xbb, 0xda, 0xb0, 0xdd, 0x8e, 0xed, 0xaa, 0x75, 0xdd, 0x45, 0xe5, 0xea, 0xf1,
+	0x66, 0x9d, 0x7a, 0xfa, 0xa6, 0xda, 0xd5, 0x9b, 0xa6, 0xc5, 0xc1, 0x88, 0x2d, 0x45, 0xb1, 0x02,
+	0xd5, 0xb0, 0x4d, 0xb1, 0x7f, 0x2d, 0xc1, 0xb5, 0xab, 0x3b, 0x7a, 0x47, 0x18, 0x5a, 0x48, 0x6c,
+	0xd6, 0x4d, 0x2f, 0x22, 0x3a, 0x9f, 0xd8, 0x3d, 0xa4, 0xd4, 0xcd, 0xd8, 0x6a, 0x79, 0xed, 0x86,
+	0x20, 0x94, 0xd8, 0x72, 0x1b, 0x0e, 0xa5, 0x96, 0x69, 0x35, 0xfd, 0x7d, 0xe5, 0x8f, 0x12, 0xc8,
+	0x4f, 0xd8, 0x6f, 0x7a, 0x6a, 0x36, 0xd9, 0xb2, 0x46, 0x8f, 0x7a, 0xd4, 0xf5, 0xf0, 0x83, 0x6c,
+	0xc1, 0x79, 0xd7, 0xd3, 0xbd, 0x9e, 0x5b, 0x94, 0x96, 0xa4, 0xf2, 0x6c, 0xf5, 0x7a, 0x25, 0xee,
+	0xd0, 0x0a, 0x8a, 0x3d, 0xe5, 0x20, 0x0d, 0xc1, 0xe4, 0x5d, 0x80, 0xd0, 0x35, 0xc5, 0x89, 0x25,
+	0xa9, 0x3c, 0x53, 0xbd, 0x55, 0xf1, 0x7d, 0x53, 0x61, 0xbe, 0xa9, 0xf8, 0x87, 0x84, 0x1e, 0xaa,
+	0x3c, 0xd6, 0x9b, 0x54, 0xa3, 0x6e, 0xd7, 0xb6, 0x5c, 0xaa, 0x45, 0x44, 0xc9, 0x3c, 0x5c, 0x6c,
+	0xb4, 0x74, 0xd3, 0xaa, 0x99, 0x46, 0x71, 0x72, 0x49, 0x2a, 0x17, 0xb4, 0x0b, 0xfc, 0xf9, 0x81,
+	0xa1, 0x7c, 0x26, 0xc1, 0xb5, 0x54, 0xe6, 0xbe, 0x1a, 0x72, 0x0f, 0x2e, 0x3a, 0xfe, 0x12, 0x23,
+	0x3f, 0x59, 0x9e, 0xa9, 0xae, 0x24, 0xc9, 0xef, 0xf8, 0x0e, 0x4e, 0x28, 0x08, 0xc4, 0xbe, 0xb4,
+	0x9f, 0xa1, 0xcc, 0x01, 0xe1, 0x54, 0x1f, 0xf3, 0xe3, 0x46, 0x43, 0xca, 0x43, 0x78, 0x35, 0xb6,
+	0x8a, 0xc4, 0xef, 0xc2, 0x79, 0xff, 0xb5, 0xe0, 0x3e, 0x9f, 0xa9, 0x5e, 0x4d, 0xd2, 0xf6, 0xf1,
+	0x3b, 0x53, 0x9f, 0xff, 0x73, 0xf1, 0x9c, 0x86, 0x58, 0x65, 0x13, 0xe6, 0xb8, 0xb2, 0x5d, 0xe6,
+	0x9e, 0x03, 0xb3, 0x2b, 0x4e, 0x30, 0xea, 0x41, 0x29, 0xee, 0xc1, 0x5d, 0x78, 0x2d, 0x21, 0x82,
+	0x0c, 0x08, 0x4c, 0xb5, 0x74, 0xb7, 0x85, 0x78, 0xfe, 0x9d, 0x5c, 0x85, 0xf3, 0x2d, 0x6a, 0x36,
+	0x5b, 0x1e, 0xf7, 0xc3, 0x94, 0x86, 0x4f, 0xca, 0x01, 0x2c, 0x72, 0x25, 0x3b, 0x6d, 0xbb, 0xf1,
+	0x6c, 0x9f, 0xea, 0x06, 0x75, 0x76, 0x4e, 0xf6, 0xf9, 0x9e, 0xa0, 0x10, 0x8a, 0x4a, 0x51, 0xd1,
+	0x18, 0xb5, 0x89, 0x38, 0xb5, 0x3a, 0x2c, 0x65, 0x6b, 0x45, 0x96, 0x5f, 0x85, 0x4b, 0x75, 0xb6,
+	0x5d, 0x6b, 0xf1, 0x7d, 0xf4, 0xd6, 0xb5, 0x81, 0x43, 0x0e, 0x55, 0x68, 0x33, 0xf5, 0xf0, 0x41,
+	0x79, 0x0f, 0xae, 0xa7, 0xd8, 0xd0, 0xdd, 0x96, 0xe0, 0x9d, 0xe6, 0x86, 0x1c, 0xce, 0xdf, 0x85,
+	0x52, 0x96, 0xbe, 0x2f, 0x89, 0x71, 0x05, 0xae, 0x70, 0x0b, 0xdf, 0x3c, 0xf8, 0xf6, 0xfb, 0xee,
+	0x08, 0x07, 0xfc, 0x35, 0x20, 0x51, 0x3c, 0xb2, 0x58, 0x85, 0xe9, 0x9e, 0xd7, 0xb7, 0x45, 0x54,
+	0xcc, 0x25, 0xcd, 0x33, 0xb4, 0xe6, 0x43, 0x94, 0x27, 0x98, 0x1d, 0xb8, 0x86, 0x9d, 0x93, 0x7b,
+	0x86, 0xe1, 0x50, 0x37, 0x30, 0x5d, 0x84, 0x0b, 0xba, 0xbf, 0x22, 0x2c, 0xe3, 0x63, 0x9e, 0x9b,
+	0x1e, 0xc0, 0xb5, 0x54, 0x95, 0x2f, 0xc1, 0x4e, 0xbc, 0xf3, 0x1a, 0x75, 0xa9, 0x73, 0x4c, 0x47,
+	0x71, 0xc9, 0x5f, 0x25, 0x78, 0x2d, 0x21, 0x13, 0x86, 0xdd, 0xb1, 0xde, 0x6b, 0x07, 0xd9, 0x62,
+	0x21, 0x69, 0xf9, 0x03, 0xb6, 0x8b, 0x62, 0x1a, 0x62, 0xc9, 0x1e, 0xcc, 0x1e, 0xdb, 0xbd, 0x46,
+	0x8b, 0x3a, 0x35, 0xb7, 0xd7, 0xed, 0xb6, 0x4f, 0x30, 0x4d, 0xcc, 0xc7, 0xd2, 0x84, 0x48, 0x10,
+	0xbb, 0xb6, 0x69, 0x61, 0xdc, 0x5e, 0x46, 0xb1, 0xa7, 0x5c, 0x8a, 0xa8, 0xf0, 0x6a, 0x97, 0x5a,
+	0x86, 0x69, 0x35, 0x6b, 0x1f, 0x99, 0x5e, 0xcb, 0x70, 0xf4, 0x8f, 0xf4, 0xb6, 0xcb, 0x73, 0xde,
+	0x94, 0x46, 0x70, 0xeb, 0x5b, 0xe1, 0x8e, 0xf2, 0x07, 0x09, 0x2e, 0x45, 0x19, 0xe5, 0x1c, 0xc6,
+	0x36, 0x80, 0xee, 0xba, 0xd4, 0xab, 0x79, 0x27, 0x5d, 0xca, 0xf9, 0xcd, 0x56, 0xe7, 0x93, 0xbf,
+	0xee, 0x1e, 0x43, 0x1c, 0x9c, 0x74, 0xa9, 0x56, 0xd0, 0xc5, 0x57, 0xa6, 0xb3, 0x67, 0xb9, 0x5d,
+	0x6a, 0x79, 0xc8, 0x44, 0x3c, 0xb2, 0x98, 0x66, 0x2f, 0x26, 0x35, 0x8a, 0x53, 0x7e, 0x4c, 0xfb,
+	0x4f, 0x64, 0x09, 0x66, 0x7a, 0x56, 0xc3, 0xb6, 0x0e, 0x4d, 0xa7, 0x43, 0x8d, 0xe2, 0x34, 0xdf,
+	0x8c, 0x2e, 0x29, 0xef, 0xc1, 0xeb, 0xfc, 0x00, 0xf0, 0xe0, 0x1f, 0x99, 0xd6, 0xb3, 0xff, 0xe9,
+	0x7d, 0x7a, 0x08, 0xc5, 0x41, 0x7d, 0x78, 0xa6, 0x2a, 0x4c, 0xb5, 0x4d, 0xeb, 0x59, 0x56, 0xa0,
+	0x45, 0x45, 0x38, 0x50, 0xf9, 0x8b, 0x84, 0xaf, 0xd4, 0x1e, 0xa5, 0x4f, 0x7a, 0xb6, 0x47, 0x05,
+	0xb5, 0x77, 0xa0, 0x60, 0x77, 0xa9, 0xe3, 0x57, 0x02, 0xbf, 0x16, 0x2e, 0x0e, 0xc4, 0x2d, 0xff,
+	0x78, 0x5f, 0xc0, 0xb4, 0x50, 0x82, 0xb9, 0x4b, 0xef, 0xd8, 0x3d, 0x2b, 0xc8, 0x9e, 0xfe, 0x53,
+	0xe2, 0x68, 0x26, 0xc7, 0x38, 0x9a, 0xa8, 0x47, 0xa6, 0xe2, 0x1e, 0xf9, 0x58, 0xbc, 0xe3, 0xe1,
+	0x8f, 0x40, 0x7f, 0x6c, 0xc2, 0xe4, 0x21, 0xa5, 0x45, 0x69, 0xb4, 0x57, 0x94, 0x61, 0xc9, 0x16,
+	0x4c, 0x7b, 0xb6, 0xa7, 0xb7, 0x47, 0x7d, 0xaf, 0x7d, 0xb4, 0xb2, 0x81, 0xb5, 0x6d, 0x8f, 0xd2,
+	0xc7, 0xb6, 0xdd, 0x1e, 0x21, 0x32, 0x3f, 0x99, 0x80, 0xb9, 0xb8, 0x08, 0x92, 0x76, 0x60, 0xb6,
+	0x61, 0xb7, 0xdb, 0xb4, 0xe1, 0x51, 0xa3, 0xc6, 0xba, 0x1e, 0x0c, 0xd0, 0x1c, 0x2a, 0x1b, 0x8c,
+	0xca, 0xaf, 0xff, 0xb5, 0x58, 0x6e, 0x9a, 0x5e, 0xab, 0x57, 0xaf, 0x34, 0xec, 0x8e, 0xea, 0x83,
+	0xf1, 0x63, 0xdd, 0x35, 0x9e, 0xa9, 0xcc, 0xe7, 0x2e, 0x17, 0x70, 0xb5, 0xcb, 0x81, 0x89, 0x3d,
+	0x4a, 0x5d, 0xf2, 0x75, 0xb8, 0xdc, 0xb0, 0x2d, 0xcf, 0x31, 0xeb, 0x3d, 0xde, 0x0a, 0x16, 0x27,
+	0xb8, 0xc9, 0x81, 0x23, 0xdf, 0xa3, 0x74, 0x37, 0x82, 0xd3, 0xe2, 0x52, 0x64, 0x1b, 0x8a, 0x16,
+	0xed, 0x7b, 0x35, 0xc3, 0x74, 0x83, 0xd5, 0x1a, 0xd6, 0x42, 0x76, 0xd8, 0x93, 0xda, 0x55, 0xb6,
+	0x7f, 0x3f, 0xb2, 0xed, 0x17, 0x39, 0x16, 0xde, 0x7e, 0x75, 0xfa, 0x80, 0x3a, 0xe6, 0xe1, 0xc9,
+	0x41, 0xff, 0x81, 0xd5, 0x68, 0xf7, 0x5c, 0x66, 0x23, 0x74, 0xa5, 0xd7, 0xaf, 0xd5, 0x4f, 0x3c,
+	0x1a, 0x44, 0x8b, 0xd7, 0xdf, 0x61, 0x8f, 0x64, 0x01, 0x0a, 0xbc, 0x6c, 0xf0, 0xea, 0xe5, 0x87,
+	0x4b, 0xb8, 0x40, 0xe6, 0x60, 0xba, 0xeb, 0xd8, 0xf6, 0x61, 0x71, 0x72, 0x69, 0xb2, 0x5c, 0xd0,
+	0xfc, 0x07, 0xb2, 0x06, 0x57, 0x3a, 0xa6, 0x55, 0xc3, 0x38, 0xf5, 0x1b, 0x60, 0x8c, 0xed, 0x57,
+	0x3a, 0xa6, 0xb5, 0x1b, 0x5d, 0x8f, 0x1d, 0xe3, 0x74, 0xfc, 0x18, 0x3f, 0x84, 0x52, 0x16, 0x6f,
+	0x3c, 0xcf, 0xb7, 0xa1, 0x60, 0x8a, 0xc5, 0xac, 0xc8, 0x8c, 0xca, 0x85, 0x68, 0x45, 0x81, 0x57,
+	0xb8, 0xf2, 0xfd, 0x83, 0x47, 0xbb, 0xc2, 0x0f, 0xb3, 0x30, 0x81, 0x2f, 0xd3, 0x94, 0x36, 0x61,
+	0x1a, 0xca, 0x3b, 0x70, 0x25, 0x82, 0x41, 0x9b, 0x65, 0x98, 0x62, 0x4d, 0x31, 0x9a, 0x1b, 0x28,
+	0x2a, 0x1c, 0xcb, 0x11, 0xca, 0xbb, 0x11, 0xf1, 0xa0, 0xa0, 0x54, 0x13, 0x6d, 0xb0, 0x9c, 0xa6,
+	0x20, 0xde, 0x03, 0x07, 0xc5, 0x17, 0x15, 0x85, 0xe5, 0x8d, 0x99, 0xc9, 0x2c, 0x6f, 0x9c, 0x89,
+	0x0f, 0x51, 0x3e, 0x95, 0xe0, 0x06, 0xd6, 0xaa, 0xa3, 0x9e, 0xe9, 0x50, 0x23, 0x76, 0x08, 0x91,
+	0xee, 0x0a, 0x53, 0x8b, 0x94, 0x93, 0x5a, 0x26, 0x5e, 0x32, 0xb5, 0x24, 0x9a, 0xee, 0x6f, 0x80,
+	0x92, 0xc7, 0x08, 0x7f, 0xe4, 0x32, 0x8f, 0x9e, 0xc8, 0x7b, 0xc4, 0x98, 0x4d, 0x6b, 0xf1, 0x45,
+	0xe5, 0x75, 0xcc, 0x52, 0xbc, 0xdd, 0x69, 0x9b, 0xc1, 0xd0, 0xa1, 0xbc, 0x05, 0x57, 0x93, 0x1b,
+	0xa8, 0x78, 0x01, 0x0a, 0x58, 0x11, 0x30, 0x0b, 0x14, 0xb4, 0x70, 0x41, 0xf9, 0x10, 0x5b, 0xd1,
+	0x27, 0x3d, 0xdd, 0xd1, 0x2d, 0xcf, 0xb4, 0xa8, 0x71, 0x9f, 0x76, 0x6d, 0xd7, 0xf4, 0x02, 0x67,
+	0x6d, 0x27, 0x0e, 0x72, 0x29, 0xe9, 0x90, 0x50, 0x36, 0x71, 0x9c, 0xa2, 0x23, 0x4d, 0x55, 0x1e,
+	0xf4, 0x77, 0x17, 0x0d, 0x5c, 0xc3, 0xf3, 0x55, 0xb2, 0xf5, 0x0b, 0x71, 0x2d, 0x90, 0x51, 0x1e,
+	0x62, 0xb7, 0xf5, 0xd8, 0x2f, 0xf7, 0x02, 0x10, 0xb6, 0xa3, 0x5e, 0x3f, 0xc8, 0x9b, 0xfc, 0x7b,
+	0x5e, 0x5d, 0xfc, 0x93, 0x98, 0x8f, 0x92, 0xda, 0x90, 0xec, 0x36, 0x5c, 0x40, 0xc3, 0x18, 0x15,
+	0xa5, 0x81, 0x39, 0x23, 0x2e, 0x28, 0xe0, 0x83, 0xc7, 0xeb, 0xd7, 0xb4, 0xf8, 0x22, 0x79, 0x1b,
+	0xa6, 0x99, 0xeb, 0x44, 0x55, 0xbb, 0x99, 0xaf, 0x9d, 0x79, 0x9b, 0x6a, 0xbe, 0x84, 0xe2, 0xa6,
+	0x32, 0x1f, 0xa1, 0xbd, 0x0b, 0x8d, 0x4e, 0x8c, 0x6d, 0xf4, 0x14, 0x16, 0xd2, 0x8d, 0xa2, 0xbf,
+	0xfe, 0x6f, 0xe0, 0x70, 0x87, 0x39, 0x2c, 0xc0, 0x93, 0x45, 0x98, 0xa9, 0x53, 0xd7, 0xab, 0xc5,
+	0x26, 0x28, 0x60, 0x4b, 0x98, 0xee, 0xb7, 0xb0, 0x89, 0xf1, 0x5b, 0x08, 0x7c, 0xf5, 0x86, 0xd7,
+	0xcc, 0x17, 0x12, 0xcc, 0xa7, 0xc8, 0x21, 0xe3, 0x5d, 0x98, 0x31, 0x68, 0xd3, 0xd1, 0x8d, 0xb0,
+	0x6b, 0x99, 0xa9, 0xde, 0x48, 0xef, 0x5a, 0xee, 0x87, 0x40, 0x2d, 0x2a, 0x35, 0x94, 0x3a, 0xb9,
+	0x06, 0x05, 0x0e, 0xf0, 0xcc, 0x0e, 0xc5, 0x2e, 0xf1, 0x22, 0x5b, 0x38, 0x30, 0x3b, 0x94, 0x5c,
+	0x07, 0xf0, 0x67, 0x9d, 0x5a, 0x5b, 0x6f, 0xf2, 0x72, 0x32, 0xa9, 0x15, 0xfc, 0x95, 0x47, 0x7a,
+	0x93, 0x2c, 0xc3, 0x6c, 0x47, 0xef, 0xd7, 0x22, 0x90, 0x69, 0x0e, 0xb9, 0xd4, 0xd1, 0xfb, 0xfb,
+	0x02, 0x15, 0x74, 0x8c, 0x07, 0x7a, 0xb3, 0x49, 0x8d, 0xd8, 0xf0, 0xf3, 0x52, 0x1d, 0xe3, 0x1e,
+	0x14, 0x07, 0xf5, 0x8d, 0x3f, 0x7e, 0x54, 0xff, 0x31, 0x0f, 0xd3, 0x5c, 0x11, 0xf9, 0x81, 0x04,
+	0x33, 0x91, 0x51, 0x9e, 0xa4, 0x84, 0x7d, 0x72, 0xfa, 0x97, 0x6f, 0xe6, 0x62, 0x7c, 0x3a, 0xca,
+	0xda, 0xf7, 0xff, 0xf6, 0x9f, 0x9f, 0x4e, 0xac, 0x90, 0x9b, 0x2a, 0x03, 0xf3, 0x2b, 0x9b, 0x86,
+	0xdd, 0x56, 0x53, 0x6f, 0x91, 0xc8, 0x0f, 0x25, 0xb8, 0x1c, 0x1b, 0xe8, 0xc9, 0x72, 0xaa, 0x8d,
+	0xc4, 0x15, 0x81, 0xbc, 0x32, 0x04, 0x85, 0x5c, 0xca, 0x9c, 0x8b, 0x42, 0x96, 0x72, 0xb9, 0x78,
+	0x66, 0x97, 0xfc, 0x5e, 0x82, 0x62, 0x98, 0xc1, 0xe3, 0xe3, 0x3b, 0x51, 0x53, 0xad, 0x65, 0x5f,
+	0x1f, 0xc8, 0x1b, 0xa3, 0x0b, 0x20, 0xd3, 0xbb, 0x9c, 0x69, 0x85, 0xbc, 0x99, 0xcb, 0xd4, 0x7f,
+	0xa3, 0xd5, 0x53, 0xff, 0xf3, 0x8c, 0x7c, 0x26, 0xc1, 0xd5, 0x14, 0xd5, 0xac, 0x65, 0x5a, 0x1f,
+	0x81, 0x42, 0x78, 0x71, 0x20, 0x57, 0x46, 0x85, 0x23, 0xdf, 0x0d, 0xce, 0x77, 0x95, 0x94, 0xf3,
+	0xf9, 0xea, 0x6e, 0x4b, 0x3d, 0x65, 0x7f, 0xcf, 0xc8, 0x2f, 0x25, 0xec, 0xaf, 0xe3, 0x77, 0x57,
+	0x64, 0x35, 0xd5, 0x72, 0xea, 0xdd, 0x9e, 0xbc, 0x36, 0x12, 0x76, 0x2c, 0x97, 0xba, 0xbe, 0xb0,
+	0x8a, 0x37, 0x68, 0xe4, 0x7b, 0x00, 0xe1, 0xac, 0x4f, 0x6e, 0xa4, 0x1a, 0x8c, 0xc6, 0xb3, 0xac,
+	0xe4, 0x41, 0x90, 0xca, 0x2a, 0xa7, 0xb2, 0x4c, 0x94, 0x5c, 0x2a, 0x3c, 0x44, 0x43, 0x3f, 0xc5,
+	0x6f, 0x1b, 0x32, 0xfc, 0x94, 0x7a, 0xcb, 0x21, 0xaf, 0x8d, 0x84, 0x1d, 0xcb, 0x4f, 0x9c, 0x9c,
+	0x7a, 0x8a, 0xb9, 0xea, 0x8c, 0x7c, 0x22, 0x22, 0x57, 0xdc, 0x4a, 0x64, 0x44, 0x6e, 0xe2, 0xa2,
+	0x43, 0x5e, 0x19, 0x82, 0x42, 0x52, 0xeb, 0x9c, 0xd4, 0x2d, 0xb2, 0x92, 0x4b, 0xca, 0x11, 0xb6,
+	0x7f, 0x21, 0x61, 0x9b, 0x1d, 0x99, 0x8f, 0xc9, 0xad, 0x54, 0x53, 0x83, 0x43, 0xbc, 0x5c, 0x1e,
+	0x0e, 0x44, 0x5a, 0x77, 0x38, 0xad, 0x75, 0xb2, 0x96, 0x4b, 0x8b, 0xcd, 0xe5, 0x11, 0x57, 0xfd,
+	0x58, 0xb8, 0x4a, 0x0c, 0xb7, 0x19, 0xae, 0x4a, 0x0c, 0xf0, 0xf2, 0xca, 0x10, 0x14, 0x72, 0x52,
+	0x39, 0xa7, 0xdb, 0xe4, 0x56, 0x2e, 0x27, 0x36, 0x85, 0xaa, 0x47, 0xdc, 0xfa, 0x8f, 0x24, 0xb8,
+	0x14, 0x1d, 0x5b, 0xc9, 0xcd, 0x2c, 0x43, 0x91, 0x39, 0x58, 0x5e, 0xce, 0x07, 0x21, 0x99, 0x0a,
+	0x27, 0x53, 0x26, 0x6f, 0x0c, 0x27, 0xd3, 0x65, 0xa6, 0x7f, 0x27, 0x32, 0xd8, 0xc0, 0xf0, 0x95,
+	0x91, 0xc1, 0xb2, 0x86, 0x4b, 0xb9, 0x32, 0x2a, 0x1c, 0x99, 0x6e, 0x73, 0xa6, 0x55, 0xb2, 0x91,
+	0xcb, 0xf4, 0x98, 0xcb, 0xd7, 0xbc, 0x7e, 0x2d, 0x18, 0xe9, 0xc8, 0x9f, 0xc5, 0x3f, 0x20, 0x52,
+	0x47, 0x0a, 0xb2, 0x99, 0xf1, 0x86, 0x67, 0x0f, 0x44, 0x72, 0x75, 0x1c, 0x11, 0xe4, 0xff, 0xff,
+	0x9c, 0xff, 0x16, 0xb9, 0x93, 0xcb, 0x3f, 0xd6, 0xe0, 0xaa, 0xa7, 0xfe, 0xa0, 0x75, 0x46, 0x7e,
+	0x23, 0x92, 0x4c, 0xbc, 0xff, 0xcb, 0x48, 0x32, 0xa9, 0xcd, 0xbd, 0xbc, 0x36, 0x12, 0x16, 0xd9,
+	0x7e, 0x85, 0xb3, 0x7d, 0x8b, 0xdc, 0xcd, 0xef, 0x0a, 0xf0, 0x3e, 0x51, 0x74, 0xa1, 0xea, 0x29,
+	0x1b, 0x19, 0xce, 0xc8, 0xaf, 0xc4, 0x1d, 0x57, 0x5c, 0xbb, 0x4b, 0x46, 0xe1, 0x10, 0x78, 0xf9,
+	0xcd, 0xd1, 0xc0, 0xc8, 0x78, 0x8b, 0x33, 0x56, 0xc9, 0xfa, 0x58, 0x8c, 0xc9, 0xcf, 0x45, 0x26,
+	0x8a, 0xb4, 0x6a, 0x19, 0x99, 0x68, 0xb0, 0x39, 0x94, 0xcb, 0xc3, 0x81, 0x48, 0x6f, 0x93, 0xd3,
+	0x5b, 0x23, 0xb7, 0x73, 0xe9, 0x79, 0x5c, 0xb2, 0xe6, 0x57, 0x96, 0x9f, 0x49, 0x78, 0x51, 0x10,
+	0x6d, 0xbd, 0x49, 0xba, 0xc9, 0x94, 0xae, 0x5e, 0xbe, 0x3d, 0x02, 0x72, 0xac, 0x26, 0x10, 0xff,
+	0xf5, 0xf6, 0x13, 0x09, 0x66, 0xe3, 0xd3, 0x33, 0x59, 0xc9, 0x6e, 0x47, 0x22, 0x63, 0xb7, 0xfc,
+	0xc6, 0x30, 0xd8, 0x58, 0x59, 0xa9, 0x1e, 0x98, 0xff, 0xad, 0xe8, 0x06, 0x53, 0x46, 0xe7, 0x8c,
+	0x6e, 0x30, 0x7b, 0x82, 0x97, 0x37, 0x46, 0x17, 0x18, 0x2b, 0xa5, 0x1f, 0x05, 0x1a, 0xc8, 0xc7,
+	0x12, 0x14, 0x82, 0xab, 0x1b, 0xb2, 0x94, 0x6a, 0x30, 0x72, 0x03, 0x25, 0xdf, 0xc8, 0x41, 0x8c,
+	0xc5, 0x81, 0x5f, 0xfb, 0xa8, 0xa7, 0x2c, 0x48, 0x45, 0xe7, 0xc4, 0xb4, 0x64, 0x75, 0x4e, 0xd1,
+	0x2b, 0x2a, 0x59, 0xc9, 0x83, 0x8c, 0xd5, 0x39, 0x71, 0x16, 0x3b, 0xfb, 0x9f, 0x3f, 0x2f, 0x49,
+	0x5f, 0x3c, 0x2f, 0x49, 0xff, 0x7e, 0x5e, 0x92, 0x3e, 0x7d, 0x51, 0x3a, 0xf7, 0xc5, 0x8b, 0xd2,
+	0xb9, 0xbf, 0xbf, 0x28, 0x9d, 0xfb, 0x4e, 0x25, 0x72, 0xa9, 0x3a, 0xa8, 0xa7, 0x1f, 0x0d, 0x18,
+	0x76, 0xc1, 0x5a, 0x3f, 0xcf, 0x01, 0x77, 0xfe, 0x3b, 0x00, 0x21, 0x32, 0xbc, 0x9a, 0xa8, 0x1f,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	QueryParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ChainTip queries the chain tip of the module.
+	QueryChainTip(ctx context.Context, in *QueryChainTipRequest, opts ...grpc.CallOption) (*QueryChainTipResponse, error)
+	// BlockHeaderByHeight queries the block header by height.
+	QueryBlockHeaderByHeight(ctx context.Context, in *QueryBlockHeaderByHeightRequest, opts ...grpc.CallOption) (*QueryBlockHeaderByHeightResponse, error)
+	// BlockHeaderByHash queries the block header by hash.
+	QueryBlockHeaderByHash(ctx context.Context, in *QueryBlockHeaderByHashRequest, opts ...grpc.CallOption) (*QueryBlockHeaderByHashResponse, error)
+	// QuerySigningRequest queries the request to sign.
+	QuerySigningRequest(ctx context.Context, in *QuerySigningRequestRequest, opts ...grpc.CallOption) (*QuerySigningRequestResponse, error)
+	// UTXOs queries all utxos.
+	QueryUTXOs(ctx context.Context, in *QueryUTXOsRequest, opts ...grpc.CallOption) (*QueryUTXOsResponse, error)
+	// UTXOsByAddress queries the utxos of the given address.
+	QueryUTXOsByAddress(ctx context.Context, in *QueryUTXOsByAddressRequest, opts ...grpc.CallOption) (*QueryUTXOsByAddressResponse, error)
+	// Reserves queries the reserves of the vaults and the supply of the voucher token.
+	QueryReserves(ctx context.Context, in *QueryReservesRequest, opts ...grpc.CallOption) (*QueryReservesResponse, error)
+	// AddressLink queries the address link by the side address or the bitcoin address.
+	QueryAddressLink(ctx context.Context, in *QueryAddressLinkRequest, opts ...grpc.CallOption) (*QueryAddressLinkResponse, error)
+	// FeeQuote queries the bridge fee of a deposit or withdrawal of the given amount.
+	QueryFeeQuote(ctx context.Context, in *QueryFeeQuoteRequest, opts ...grpc.CallOption) (*QueryFeeQuoteResponse, error)
+	// FeePool queries the fees collected and the contributions in the current fee epoch.
+	QueryFeePool(ctx context.Context, in *QueryFeePoolRequest, opts ...grpc.CallOption) (*QueryFeePoolResponse, error)
+	// VerifyTxInclusion verifies that the bitcoin transaction is included in a confirmed block
+	// and returns its parsed outputs.
+	QueryVerifyTxInclusion(ctx context.Context, in *QueryVerifyTxInclusionRequest, opts ...grpc.CallOption) (*QueryVerifyTxInclusionResponse, error)
+	// RequiredConfirmations queries the confirmations required by a deposit or withdrawal of the given amount.
+	QueryRequiredConfirmations(ctx context.Context, in *QueryRequiredConfirmationsRequest, opts ...grpc.CallOption) (*QueryRequiredConfirmationsResponse, error)
+	// PendingDeposit queries the deposit waiting for confirmations by txid.
+	QueryPendingDeposit(ctx context.Context, in *QueryPendingDepositRequest, opts ...grpc.CallOption) (*QueryPendingDepositResponse, error)
+	// PendingDeposits queries all deposits waiting for confirmations.
+	QueryPendingDeposits(ctx context.Context, in *QueryPendingDepositsRequest, opts ...grpc.CallOption) (*QueryPendingDepositsResponse, error)
+	// TaggedUTXOs queries the utxos carrying inscriptions or runes, optionally of the given vault.
+	QueryTaggedUTXOs(ctx context.Context, in *QueryTaggedUTXOsRequest, opts ...grpc.CallOption) (*QueryTaggedUTXOsResponse, error)
+	// BridgeStatus queries the liveness of the light client and whether the bridge is degraded.
+	QueryBridgeStatus(ctx context.Context, in *QueryBridgeStatusRequest, opts ...grpc.CallOption) (*QueryBridgeStatusResponse, error)
+	// Blocklist queries the blocked bitcoin addresses and side accounts.
+	QueryBlocklist(ctx context.Context, in *QueryBlocklistRequest, opts ...grpc.CallOption) (*QueryBlocklistResponse, error)
+	// QuarantinedDeposits queries the quarantined deposits by status.
+	QueryQuarantinedDeposits(ctx context.Context, in *QueryQuarantinedDepositsRequest, opts ...grpc.CallOption) (*QueryQuarantinedDepositsResponse, error)
+	// HTLC queries the HTLC by id.
+	QueryHTLC(ctx context.Context, in *QueryHTLCRequest, opts ...grpc.CallOption) (*QueryHTLCResponse, error)
+	// HTLCs queries the HTLCs by status.
+	QueryHTLCs(ctx context.Context, in *QueryHTLCsRequest, opts ...grpc.CallOption) (*QueryHTLCsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) QueryParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryChainTip(ctx context.Context, in *QueryChainTipRequest, opts ...grpc.CallOption) (*QueryChainTipResponse, error) {
+	out := new(QueryChainTipResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryChainTip", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryBlockHeaderByHeight(ctx context.Context, in *QueryBlockHeaderByHeightRequest, opts ...grpc.CallOption) (*QueryBlockHeaderByHeightResponse, error) {
+	out := new(QueryBlockHeaderByHeightResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryBlockHeaderByHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryBlockHeaderByHash(ctx context.Context, in *QueryBlockHeaderByHashRequest, opts ...grpc.CallOption) (*QueryBlockHeaderByHashResponse, error) {
+	out := new(QueryBlockHeaderByHashResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryBlockHeaderByHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QuerySigningRequest(ctx context.Context, in *QuerySigningRequestRequest, opts ...grpc.CallOption) (*QuerySigningRequestResponse, error) {
+	out := new(QuerySigningRequestResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QuerySigningRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryUTXOs(ctx context.Context, in *QueryUTXOsRequest, opts ...grpc.CallOption) (*QueryUTXOsResponse, error) {
+	out := new(QueryUTXOsResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryUTXOs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryUTXOsByAddress(ctx context.Context, in *QueryUTXOsByAddressRequest, opts ...grpc.CallOption) (*QueryUTXOsByAddressResponse, error) {
+	out := new(QueryUTXOsByAddressResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryUTXOsByAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryReserves(ctx context.Context, in *QueryReservesRequest, opts ...grpc.CallOption) (*QueryReservesResponse, error) {
+	out := new(QueryReservesResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryReserves", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryAddressLink(ctx context.Context, in *QueryAddressLinkRequest, opts ...grpc.CallOption) (*QueryAddressLinkResponse, error) {
+	out := new(QueryAddressLinkResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryAddressLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryFeeQuote(ctx context.Context, in *QueryFeeQuoteRequest, opts ...grpc.CallOption) (*QueryFeeQuoteResponse, error) {
+	out := new(QueryFeeQuoteResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryFeeQuote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryFeePool(ctx context.Context, in *QueryFeePoolRequest, opts ...grpc.CallOption) (*QueryFeePoolResponse, error) {
+	out := new(QueryFeePoolResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryFeePool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryVerifyTxInclusion(ctx context.Context, in *QueryVerifyTxInclusionRequest, opts ...grpc.CallOption) (*QueryVerifyTxInclusionResponse, error) {
+	out := new(QueryVerifyTxInclusionResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryVerifyTxInclusion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryRequiredConfirmations(ctx context.Context, in *QueryRequiredConfirmationsRequest, opts ...grpc.CallOption) (*QueryRequiredConfirmationsResponse, error) {
+	out := new(QueryRequiredConfirmationsResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryRequiredConfirmations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryPendingDeposit(ctx context.Context, in *QueryPendingDepositRequest, opts ...grpc.CallOption) (*QueryPendingDepositResponse, error) {
+	out := new(QueryPendingDepositResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryPendingDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryPendingDeposits(ctx context.Context, in *QueryPendingDepositsRequest, opts ...grpc.CallOption) (*QueryPendingDepositsResponse, error) {
+	out := new(QueryPendingDepositsResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryPendingDeposits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryTaggedUTXOs(ctx context.Context, in *QueryTaggedUTXOsRequest, opts ...grpc.CallOption) (*QueryTaggedUTXOsResponse, error) {
+	out := new(QueryTaggedUTXOsResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryTaggedUTXOs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryBridgeStatus(ctx context.Context, in *QueryBridgeStatusRequest, opts ...grpc.CallOption) (*QueryBridgeStatusResponse, error) {
+	out := new(QueryBridgeStatusResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryBridgeStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryBlocklist(ctx context.Context, in *QueryBlocklistRequest, opts ...grpc.CallOption) (*QueryBlocklistResponse, error) {
+	out := new(QueryBlocklistResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryBlocklist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryQuarantinedDeposits(ctx context.Context, in *QueryQuarantinedDepositsRequest, opts ...grpc.CallOption) (*QueryQuarantinedDepositsResponse, error) {
+	out := new(QueryQuarantinedDepositsResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryQuarantinedDeposits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryHTLC(ctx context.Context, in *QueryHTLCRequest, opts ...grpc.CallOption) (*QueryHTLCResponse, error) {
+	out := new(QueryHTLCResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryHTLC", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryHTLCs(ctx context.Context, in *QueryHTLCsRequest, opts ...grpc.CallOption) (*QueryHTLCsResponse, error) {
+	out := new(QueryHTLCsResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Query/QueryHTLCs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	QueryParams(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ChainTip queries the chain tip of the module.
+	QueryChainTip(context.Context, *QueryChainTipRequest) (*QueryChainTipResponse, error)
+	// BlockHeaderByHeight queries the block header by height.
+	QueryBlockHeaderByHeight(context.Context, *QueryBlockHeaderByHeightRequest) (*QueryBlockHeaderByHeightResponse, error)
+	// BlockHeaderByHash queries the block header by hash.
+	QueryBlockHeaderByHash(context.Context, *QueryBlockHeaderByHashRequest) (*QueryBlockHeaderByHashResponse, error)
+	// QuerySigningRequest queries the request to sign.
+	QuerySigningRequest(context.Context, *QuerySigningRequestRequest) (*QuerySigningRequestResponse, error)
+	// UTXOs queries all utxos.
+	QueryUTXOs(context.Context, *QueryUTXOsRequest) (*QueryUTXOsResponse, error)
+	// UTXOsByAddress queries the utxos of the given address.
+	QueryUTXOsByAddress(context.Context, *QueryUTXOsByAddressRequest) (*QueryUTXOsByAddressResponse, error)
+	// Reserves queries the reserves of the vaults and the supply of the voucher token.
+	QueryReserves(context.Context, *QueryReservesRequest) (*QueryReservesResponse, error)
+	// AddressLink queries the address link by the side address or the bitcoin address.
+	QueryAddressLink(context.Context, *QueryAddressLinkRequest) (*QueryAddressLinkResponse, error)
+	// FeeQuote queries the bridge fee of a deposit or withdrawal of the given amount.
+	QueryFeeQuote(context.Context, *QueryFeeQuoteRequest) (*QueryFeeQuoteResponse, error)
+	// FeePool queries the fees collected and the contributions in the current fee epoch.
+	QueryFeePool(context.Context, *QueryFeePoolRequest) (*QueryFeePoolResponse, error)
+	// VerifyTxInclusion verifies that the bitcoin transaction is included in a confirmed block
+	// and returns its parsed outputs.
+	QueryVerifyTxInclusion(context.Context, *QueryVerifyTxInclusionRequest) (*QueryVerifyTxInclusionResponse, error)
+	// RequiredConfirmations queries the confirmations required by a deposit or withdrawal of the given amount.
+	QueryRequiredConfirmations(context.Context, *QueryRequiredConfirmationsRequest) (*QueryRequiredConfirmationsResponse, error)
+	// PendingDeposit queries the deposit waiting for confirmations by txid.
+	QueryPendingDeposit(context.Context, *QueryPendingDepositRequest) (*QueryPendingDepositResponse, error)
+	// PendingDeposits queries all deposits waiting for confirmations.
+	QueryPendingDeposits(context.Context, *QueryPendingDepositsRequest) (*QueryPendingDepositsResponse, error)
+	// TaggedUTXOs queries the utxos carrying inscriptions or runes, optionally of the given vault.
+	QueryTaggedUTXOs(context.Context, *QueryTaggedUTXOsRequest) (*QueryTaggedUTXOsResponse, error)
+	// BridgeStatus queries the liveness of the light client and whether the bridge is degraded.
+	QueryBridgeStatus(context.Context, *QueryBridgeStatusRequest) (*QueryBridgeStatusResponse, error)
+	// Blocklist queries the blocked bitcoin addresses and side accounts.
+	QueryBlocklist(context.Context, *QueryBlocklistRequest) (*QueryBlocklistResponse, error)
+	// QuarantinedDeposits queries the quarantined deposits by status.
+	QueryQuarantinedDeposits(context.Context, *QueryQuarantinedDepositsRequest) (*QueryQuarantinedDepositsResponse, error)
+	// HTLC queries the HTLC by id.
+	QueryHTLC(context.Context, *QueryHTLCRequest) (*QueryHTLCResponse, error)
+	// HTLCs queries the HTLCs by status.
+	QueryHTLCs(context.Context, *QueryHTLCsRequest) (*QueryHTLCsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) QueryParams(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryParams not implemented")
+}
+func (*UnimplementedQueryServer) QueryChainTip(ctx context.Context, req *QueryChainTipRequest) (*QueryChainTipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryChainTip not implemented")
+}
+func (*UnimplementedQueryServer) QueryBlockHeaderByHeight(ctx context.Context, req *QueryBlockHeaderByHeightRequest) (*QueryBlockHeaderByHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryBlockHeaderByHeight not implemented")
+}
+func (*UnimplementedQueryServer) QueryBlockHeaderByHash(ctx context.Context, req *QueryBlockHeaderByHashRequest) (*QueryBlockHeaderByHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryBlockHeaderByHash not implemented")
+}
+func (*UnimplementedQueryServer) QuerySigningRequest(ctx context.Context, req *QuerySigningRequestRequest) (*QuerySigningRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuerySigningRequest not implemented")
+}
+func (*UnimplementedQueryServer) QueryUTXOs(ctx context.Context, req *QueryUTXOsRequest) (*QueryUTXOsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryUTXOs not implemented")
+}
+func (*UnimplementedQueryServer) QueryUTXOsByAddress(ctx context.Context, req *QueryUTXOsByAddressRequest) (*QueryUTXOsByAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryUTXOsByAddress not implemented")
+}
+func (*UnimplementedQueryServer) QueryReserves(ctx context.Context, req *QueryReservesRequest) (*QueryReservesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryReserves not implemented")
+}
+func (*UnimplementedQueryServer) QueryAddressLink(ctx context.Context, req *QueryAddressLinkRequest) (*QueryAddressLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAddressLink not implemented")
+}
+func (*UnimplementedQueryServer) QueryFeeQuote(ctx context.Context, req *QueryFeeQuoteRequest) (*QueryFeeQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryFeeQuote not implemented")
+}
+func (*UnimplementedQueryServer) QueryFeePool(ctx context.Context, req *QueryFeePoolRequest) (*QueryFeePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryFeePool not implemented")
+}
+func (*UnimplementedQueryServer) QueryVerifyTxInclusion(ctx context.Context, req *QueryVerifyTxInclusionRequest) (*QueryVerifyTxInclusionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryVerifyTxInclusion not implemented")
+}
+func (*UnimplementedQueryServer) QueryRequiredConfirmations(ctx context.Context, req *QueryRequiredConfirmationsRequest) (*QueryRequiredConfirmationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryRequiredConfirmations not implemented")
+}
+func (*UnimplementedQueryServer) QueryPendingDeposit(ctx context.Context, req *QueryPendingDepositRequest) (*QueryPendingDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPendingDeposit not implemented")
+}
+func (*UnimplementedQueryServer) QueryPendingDeposits(ctx context.Context, req *QueryPendingDepositsRequest) (*QueryPendingDepositsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPendingDeposits not implemented")
+}
+func (*UnimplementedQueryServer) QueryTaggedUTXOs(ctx context.Context, req *QueryTaggedUTXOsRequest) (*QueryTaggedUTXOsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryTaggedUTXOs not implemented")
+}
+func (*UnimplementedQueryServer) QueryBridgeStatus(ctx context.Context, req *QueryBridgeStatusRequest) (*QueryBridgeStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryBridgeStatus not implemented")
+}
+func (*UnimplementedQueryServer) QueryBlocklist(ctx context.Context, req *QueryBlocklistRequest) (*QueryBlocklistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryBlocklist not implemented")
+}
+func (*UnimplementedQueryServer) QueryQuarantinedDeposits(ctx context.Context, req *QueryQuarantinedDepositsRequest) (*QueryQuarantinedDepositsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryQuarantinedDeposits not implemented")
+}
+func (*UnimplementedQueryServer) QueryHTLC(ctx context.Context, req *QueryHTLCRequest) (*QueryHTLCResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryHTLC not implemented")
+}
+func (*UnimplementedQueryServer) QueryHTLCs(ctx context.Context, req *QueryHTLCsRequest) (*QueryHTLCsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryHTLCs not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_QueryParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QueryParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryParams(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryChainTip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChainTipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryChainTip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QueryChainTip",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryChainTip(ctx, req.(*QueryChainTipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryBlockHeaderByHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockHeaderByHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryBlockHeaderByHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QueryBlockHeaderByHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryBlockHeaderByHeight(ctx, req.(*QueryBlockHeaderByHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryBlockHeaderByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockHeaderByHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryBlockHeaderByHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QueryBlockHeaderByHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryBlockHeaderByHash(ctx, req.(*QueryBlockHeaderByHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QuerySigningRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySigningRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuerySigningRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QuerySigningRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuerySigningRequest(ctx, req.(*QuerySigningRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryUTXOs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUTXOsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryUTXOs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QueryUTXOs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryUTXOs(ctx, req.(*QueryUTXOsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryUTXOsByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUTXOsByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryUTXOsByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QueryUTXOsByAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryUTXOsByAddress(ctx, req.(*QueryUTXOsByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryReserves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReservesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryReserves(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QueryReserves",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryReserves(ctx, req.(*QueryReservesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryAddressLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAddressLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryAddressLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QueryAddressLink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryAddressLink(ctx, req.(*QueryAddressLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryFeeQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryFeeQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QueryFeeQuote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryFeeQuote(ctx, req.(*QueryFeeQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryFeePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeePoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryFeePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QueryFeePool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryFeePool(ctx, req.(*QueryFeePoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryVerifyTxInclusion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifyTxInclusionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryVerifyTxInclusion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QueryVerifyTxInclusion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryVerifyTxInclusion(ctx, req.(*QueryVerifyTxInclusionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryRequiredConfirmations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRequiredConfirmationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryRequiredConfirmations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QueryRequiredConfirmations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryRequiredConfirmations(ctx, req.(*QueryRequiredConfirmationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryPendingDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingDepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPendingDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QueryPendingDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPendingDeposit(ctx, req.(*QueryPendingDepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryPendingDeposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingDepositsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPendingDeposits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QueryPendingDeposits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPendingDeposits(ctx, req.(*QueryPendingDepositsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryTaggedUTXOs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTaggedUTXOsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryTaggedUTXOs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QueryTaggedUTXOs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryTaggedUTXOs(ctx, req.(*QueryTaggedUTXOsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryBridgeStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBridgeStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryBridgeStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QueryBridgeStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryBridgeStatus(ctx, req.(*QueryBridgeStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryBlocklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlocklistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryBlocklist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QueryBlocklist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryBlocklist(ctx, req.(*QueryBlocklistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryQuarantinedDeposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQuarantinedDepositsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryQuarantinedDeposits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QueryQuarantinedDeposits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryQuarantinedDeposits(ctx, req.(*QueryQuarantinedDepositsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryHTLC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHTLCRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryHTLC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QueryHTLC",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryHTLC(ctx, req.(*QueryHTLCRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryHTLCs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHTLCsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryHTLCs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Query/QueryHTLCs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryHTLCs(ctx, req.(*QueryHTLCsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "side.btcbridge.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "QueryParams",
+			Handler:    _Query_QueryParams_Handler,
+		},
+		{
+			MethodName: "QueryChainTip",
+			Handler:    _Query_QueryChainTip_Handler,
+		},
+		{
+			MethodName: "QueryBlockHeaderByHeight",
+			Handler:    _Query_QueryBlockHeaderByHeight_Handler,
+		},
+		{
+			MethodName: "QueryBlockHeaderByHash",
+			Handler:    _Query_QueryBlockHeaderByHash_Handler,
+		},
+		{
+			MethodName: "QuerySigningRequest",
+			Handler:    _Query_QuerySigningRequest_Handler,
+		},
+		{
+			MethodName: "QueryUTXOs",
+			Handler:    _Query_QueryUTXOs_Handler,
+		},
+		{
+			MethodName: "QueryUTXOsByAddress",
+			Handler:    _Query_QueryUTXOsByAddress_Handler,
+		},
+		{
+			MethodName: "QueryReserves",
+			Handler:    _Query_QueryReserves_Handler,
+		},
+		{
+			MethodName: "QueryAddressLink",
+			Handler:    _Query_QueryAddressLink_Handler,
+		},
+		{
+			MethodName: "QueryFeeQuote",
+			Handler:    _Query_QueryFeeQuote_Handler,
+		},
+		{
+			MethodName: "QueryFeePool",
+			Handler:    _Query_QueryFeePool_Handler,
+		},
+		{
+			MethodName: "QueryVerifyTxInclusion",
+			Handler:    _Query_QueryVerifyTxInclusion_Handler,
+		},
+		{
+			MethodName: "QueryRequiredConfirmations",
+			Handler:    _Query_QueryRequiredConfirmations_Handler,
+		},
+		{
+			MethodName: "QueryPendingDeposit",
+			Handler:    _Query_QueryPendingDeposit_Handler,
+		},
+		{
+			MethodName: "QueryPendingDeposits",
+			Handler:    _Query_QueryPendingDeposits_Handler,
+		},
+		{
+			MethodName: "QueryTaggedUTXOs",
+			Handler:    _Query_QueryTaggedUTXOs_Handler,
+		},
+		{
+			MethodName: "QueryBridgeStatus",
+			Handler:    _Query_QueryBridgeStatus_Handler,
+		},
+		{
+			MethodName: "QueryBlocklist",
+			Handler:    _Query_QueryBlocklist_Handler,
+		},
+		{
+			MethodName: "QueryQuarantinedDeposits",
+			Handler:    _Query_QueryQuarantinedDeposits_Handler,
+		},
+		{
+			MethodName: "QueryHTLC",
+			Handler:    _Query_QueryHTLC_Handler,
+		},
+		{
+			MethodName: "QueryHTLCs",
+			Handler:    _Query_QueryHTLCs_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "side/btcbridge/query.proto",
+}
+
+func (m *QuerySigningRequestRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningRequestRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChainTipRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChainTipRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChainTipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChainTipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChainTipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChainTipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockHeaderByHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockHeaderByHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockHeaderByHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockHeaderByHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockHeaderByHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockHeaderByHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeader != nil {
+		{
+			size, err := m.BlockHeader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockHeaderByHashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockHeaderByHashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockHeaderByHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockHeaderByHashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockHeaderByHashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockHeaderByHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeader != nil {
+		{
+			size, err := m.BlockHeader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUTXOsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUTXOsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUTXOsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUTXOsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUTXOsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUTXOsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Utxos) > 0 {
+		for iNdEx := len(m.Utxos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Utxos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUTXOsByAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUTXOsByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUTXOsByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUTXOsByAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUTXOsByAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUTXOsByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Utxos) > 0 {
+		for iNdEx := len(m.Utxos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Utxos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReservesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReservesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReservesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReservesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReservesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReservesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PendingWithdrawals != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PendingWithdrawals))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.VoucherSupply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Vaults) > 0 {
+		for iNdEx := len(m.Vaults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vaults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VaultReserve) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VaultReserve) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VaultReserve) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Unconfirmed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Unconfirmed))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Locked != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Locked))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Unspent != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Unspent))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AssetType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AssetType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAddressLinkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAddressLinkRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAddressLinkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAddressLinkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAddressLinkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAddressLinkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Link != nil {
+		{
+			size, err := m.Link.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeQuoteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeQuoteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeQuoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.AssetType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AssetType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Amount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Operation != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Operation))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeQuoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeQuoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeQuoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Total.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeePoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeePoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeePoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeePoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeePoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextDistributionHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextDistributionHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Contributions) > 0 {
+		for iNdEx := len(m.Contributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Contributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.CollectedFees) > 0 {
+		for iNdEx := len(m.CollectedFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CollectedFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyTxInclusionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyTxInclusionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyTxInclusionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MinConfirmations != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinConfirmations))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Blockhash) > 0 {
+		i -= len(m.Blockhash)
+		copy(dAtA[i:], m.Blockhash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Blockhash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyTxInclusionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyTxInclusionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyTxInclusionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Inclusion != nil {
+		{
+			size, err := m.Inclusion.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHTLCRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHTLCRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHTLCRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHTLCResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHTLCResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHTLCResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Htlc != nil {
+		{
+			size, err := m.Htlc.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHTLCsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHTLCsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHTLCsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHTLCsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHTLCsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHTLCsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Htlcs) > 0 {
+		for iNdEx := len(m.Htlcs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Htlcs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRequiredConfirmationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRequiredConfirmationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRequiredConfirmationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AssetType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AssetType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Amount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRequiredConfirmationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRequiredConfirmationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRequiredConfirmationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Confirmations != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Confirmations))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlocklistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlocklistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlocklistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlocklistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlocklistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlocklistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuarantinedDepositsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuarantinedDepositsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuarantinedDepositsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuarantinedDepositsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuarantinedDepositsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuarantinedDepositsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposits) > 0 {
+		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingDepositRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingDepositRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingDepositRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Txid) > 0 {
+		i -= len(m.Txid)
+		copy(dAtA[i:], m.Txid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Txid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.State != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Confirmations != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Confirmations))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Deposit != nil {
+		{
+			size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingDepositsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingDepositsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingDepositsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.State != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingDepositsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingDepositsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingDepositsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BestHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BestHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Deposits) > 0 {
+		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBridgeStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBridgeStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBridgeStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBridgeStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBridgeStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBridgeStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxHeaderLag != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxHeaderLag))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.HeaderLag != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.HeaderLag))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BestTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BestTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BestHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BestHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Degradation != nil {
+		{
+			size, err := m.Degradation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaggedUTXOsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaggedUTXOsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaggedUTXOsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaggedUTXOsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaggedUTXOsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaggedUTXOsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Utxos) > 0 {
+		for iNdEx := len(m.Utxos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Utxos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QuerySigningRequestRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryChainTipRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChainTipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryBlockHeaderByHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlockHeaderByHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeader != nil {
+		l = m.BlockHeader.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlockHeaderByHashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlockHeaderByHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeader != nil {
+		l = m.BlockHeader.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUTXOsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUTXOsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Utxos) > 0 {
+		for _, e := range m.Utxos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryUTXOsByAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUTXOsByAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Utxos) > 0 {
+		for _, e := range m.Utxos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryReservesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReservesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Vaults) > 0 {
+		for _, e := range m.Vaults {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.VoucherSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.PendingWithdrawals != 0 {
+		n += 1 + sovQuery(uint64(m.PendingWithdrawals))
+	}
+	return n
+}
+
+func (m *VaultReserve) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AssetType != 0 {
+		n += 1 + sovQuery(uint64(m.AssetType))
+	}
+	if m.Unspent != 0 {
+		n += 1 + sovQuery(uint64(m.Unspent))
+	}
+	if m.Locked != 0 {
+		n += 1 + sovQuery(uint64(m.Locked))
+	}
+	if m.Unconfirmed != 0 {
+		n += 1 + sovQuery(uint64(m.Unconfirmed))
+	}
+	return n
+}
+
+func (m *QueryAddressLinkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAddressLinkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Link != nil {
+		l = m.Link.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeQuoteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Operation != 0 {
+		n += 1 + sovQuery(uint64(m.Operation))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovQuery(uint64(m.Amount))
+	}
+	if m.AssetType != 0 {
+		n += 1 + sovQuery(uint64(m.AssetType))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeQuoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Fee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Total.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFeePoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeePoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CollectedFees) > 0 {
+		for _, e := range m.CollectedFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Contributions) > 0 {
+		for _, e := range m.Contributions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.NextDistributionHeight != 0 {
+		n += 1 + sovQuery(uint64(m.NextDistributionHeight))
+	}
+	return n
+}
+
+func (m *QueryVerifyTxInclusionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Blockhash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Proof) > 0 {
+		for _, s := range m.Proof {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.MinConfirmations != 0 {
+		n += 1 + sovQuery(uint64(m.MinConfirmations))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifyTxInclusionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Inclusion != nil {
+		l = m.Inclusion.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHTLCRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryHTLCResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Htlc != nil {
+		l = m.Htlc.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHTLCsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *QueryHTLCsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Htlcs) > 0 {
+		for _, e := range m.Htlcs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRequiredConfirmationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		n += 1 + sovQuery(uint64(m.Amount))
+	}
+	if m.AssetType != 0 {
+		n += 1 + sovQuery(uint64(m.AssetType))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRequiredConfirmationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Confirmations != 0 {
+		n += 1 + sovQuery(uint64(m.Confirmations))
+	}
+	return n
+}
+
+func (m *QueryBlocklistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBlocklistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryQuarantinedDepositsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *QueryQuarantinedDepositsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deposits) > 0 {
+		for _, e := range m.Deposits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPendingDepositRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Txid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Deposit != nil {
+		l = m.Deposit.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Confirmations != 0 {
+		n += 1 + sovQuery(uint64(m.Confirmations))
+	}
+	if m.State != 0 {
+		n += 1 + sovQuery(uint64(m.State))
+	}
+	return n
+}
+
+func (m *QueryPendingDepositsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovQuery(uint64(m.State))
+	}
+	return n
+}
+
+func (m *QueryPendingDepositsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deposits) > 0 {
+		for _, e := range m.Deposits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.BestHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BestHeight))
+	}
+	return n
+}
+
+func (m *QueryBridgeStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBridgeStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Degradation != nil {
+		l = m.Degradation.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BestHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BestHeight))
+	}
+	if m.BestTime != 0 {
+		n += 1 + sovQuery(uint64(m.BestTime))
+	}
+	if m.HeaderLag != 0 {
+		n += 1 + sovQuery(uint64(m.HeaderLag))
+	}
+	if m.MaxHeaderLag != 0 {
+		n += 1 + sovQuery(uint64(m.MaxHeaderLag))
+	}
+	return n
+}
+
+func (m *QueryTaggedUTXOsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTaggedUTXOsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Utxos) > 0 {
+		for _, e := range m.Utxos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *QuerySigningRequestRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySigningRequestRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningRequestRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SigningStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningRequestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningRequestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, &BitcoinSigningRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChainTipRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChainTipRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChainTipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChainTipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChainTipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChainTipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockHeaderByHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockHeaderByHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockHeaderByHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockHeaderByHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockHeaderByHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockHeaderByHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockHeader == nil {
+				m.BlockHeader = &BlockHeader{}
+			}
+			if err := m.BlockHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockHeaderByHashRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockHeaderByHashRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockHeaderByHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockHeaderByHashResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockHeaderByHashResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockHeaderByHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockHeader == nil {
+				m.BlockHeader = &BlockHeader{}
+			}
+			if err := m.BlockHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUTXOsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUTXOsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUTXOsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUTXOsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUTXOsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUTXOsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Utxos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Utxos = append(m.Utxos, &UTXO{})
+			if err := m.Utxos[len(m.Utxos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUTXOsByAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUTXOsByAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUTXOsByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUTXOsByAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUTXOsByAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUTXOsByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Utxos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Utxos = append(m.Utxos, &UTXO{})
+			if err := m.Utxos[len(m.Utxos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReservesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReservesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReservesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReservesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReservesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReservesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vaults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vaults = append(m.Vaults, &VaultReserve{})
+			if err := m.Vaults[len(m.Vaults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoucherSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VoucherSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingWithdrawals", wireType)
+			}
+			m.PendingWithdrawals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingWithdrawals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VaultReserve) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VaultReserve: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VaultReserve: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetType", wireType)
+			}
+			m.AssetType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetType |= AssetType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unspent", wireType)
+			}
+			m.Unspent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Unspent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+			m.Locked = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Locked |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unconfirmed", wireType)
+			}
+			m.Unconfirmed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Unconfirmed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAddressLinkRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAddressLinkRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAddressLinkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAddressLinkResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAddressLinkResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAddressLinkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Link", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Link == nil {
+				m.Link = &AddressLink{}
+			}
+			if err := m.Link.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeQuoteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeQuoteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeQuoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			m.Operation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Operation |= BridgeOperation(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetType", wireType)
+			}
+			m.AssetType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetType |= AssetType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeQuoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeQuoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeQuoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeePoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeePoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeePoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeePoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeePoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectedFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollectedFees = append(m.CollectedFees, types.Coin{})
+			if err := m.CollectedFees[len(m.CollectedFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contributions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contributions = append(m.Contributions, &FeeContribution{})
+			if err := m.Contributions[len(m.Contributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextDistributionHeight", wireType)
+			}
+			m.NextDistributionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextDistributionHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVerifyTxInclusionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifyTxInclusionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifyTxInclusionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blockhash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blockhash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinConfirmations", wireType)
+			}
+			m.MinConfirmations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinConfirmations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVerifyTxInclusionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifyTxInclusionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifyTxInclusionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inclusion", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Inclusion == nil {
+				m.Inclusion = &TxInclusion{}
+			}
+			if err := m.Inclusion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHTLCRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHTLCRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHTLCRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHTLCResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHTLCResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHTLCResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Htlc", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Htlc == nil {
+				m.Htlc = &HTLC{}
+			}
+			if err := m.Htlc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHTLCsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHTLCsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHTLCsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= HTLCStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHTLCsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1725,34 +8531,15 @@ func (m *QuerySigningRequestRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningRequestRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHTLCsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHTLCsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= SigningStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Htlcs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1779,10 +8566,8 @@ func (m *QuerySigningRequestRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Htlcs = append(m.Htlcs, &HTLC{})
+			if err := m.Htlcs[len(m.Htlcs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1807,7 +8592,7 @@ func (m *QuerySigningRequestRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningRequestResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRequiredConfirmationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1830,17 +8615,17 @@ func (m *QuerySigningRequestResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningRequestResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRequiredConfirmationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRequiredConfirmationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1850,31 +8635,35 @@ func (m *QuerySigningRequestResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Amount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetType", wireType)
 			}
-			m.Requests = append(m.Requests, &BitcoinSigningRequest{})
-			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.AssetType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetType |= AssetType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1884,28 +8673,93 @@ func (m *QuerySigningRequestResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRequiredConfirmationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRequiredConfirmationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRequiredConfirmationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmations", wireType)
+			}
+			m.Confirmations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Confirmations |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1927,7 +8781,7 @@ func (m *QuerySigningRequestResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBlocklistRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1950,10 +8804,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlocklistRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlocklistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1977,7 +8831,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBlocklistResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2000,17 +8854,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlocklistResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlocklistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2020,24 +8874,23 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2060,7 +8913,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryChainTipRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryQuarantinedDepositsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2083,12 +8936,31 @@ func (m *QueryChainTipRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryChainTipRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryQuarantinedDepositsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryChainTipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryQuarantinedDepositsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= QuarantineStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2110,7 +8982,7 @@ func (m *QueryChainTipRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryChainTipResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryQuarantinedDepositsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2133,17 +9005,17 @@ func (m *QueryChainTipResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryChainTipResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryQuarantinedDepositsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryChainTipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryQuarantinedDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2153,43 +9025,26 @@ func (m *QueryChainTipResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Deposits = append(m.Deposits, &QuarantinedDeposit{})
+			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2211,7 +9066,7 @@ func (m *QueryChainTipResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlockHeaderByHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingDepositRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2230,21 +9085,53 @@ func (m *QueryBlockHeaderByHeightRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlockHeaderByHeightRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlockHeaderByHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingDepositRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingDepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2254,11 +9141,24 @@ func (m *QueryBlockHeaderByHeightRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2280,7 +9180,7 @@ func (m *QueryBlockHeaderByHeightRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlockHeaderByHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingDepositResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2303,15 +9203,15 @@ func (m *QueryBlockHeaderByHeightResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlockHeaderByHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingDepositResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlockHeaderByHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2338,13 +9238,51 @@ func (m *QueryBlockHeaderByHeightResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BlockHeader == nil {
-				m.BlockHeader = &BlockHeader{}
+			if m.Deposit == nil {
+				m.Deposit = &PendingDeposit{}
 			}
-			if err := m.BlockHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmations", wireType)
+			}
+			m.Confirmations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Confirmations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= PendingDepositState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2366,7 +9304,7 @@ func (m *QueryBlockHeaderByHeightResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlockHeaderByHashRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingDepositsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2389,15 +9327,15 @@ func (m *QueryBlockHeaderByHashRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlockHeaderByHashRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingDepositsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlockHeaderByHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingDepositsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2425,8 +9363,27 @@ func (m *QueryBlockHeaderByHashRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= PendingDepositState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2448,7 +9405,7 @@ func (m *QueryBlockHeaderByHashRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlockHeaderByHashResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingDepositsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2471,15 +9428,15 @@ func (m *QueryBlockHeaderByHashResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlockHeaderByHashResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingDepositsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlockHeaderByHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2506,13 +9463,30 @@ func (m *QueryBlockHeaderByHashResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BlockHeader == nil {
-				m.BlockHeader = &BlockHeader{}
-			}
-			if err := m.BlockHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Deposits = append(m.Deposits, &PendingDeposit{})
+			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestHeight", wireType)
+			}
+			m.BestHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BestHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2534,7 +9508,7 @@ func (m *QueryBlockHeaderByHashResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUTXOsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBridgeStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2557,12 +9531,44 @@ func (m *QueryUTXOsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUTXOsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBridgeStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUTXOsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBridgeStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2584,7 +9590,7 @@ func (m *QueryUTXOsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUTXOsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBridgeStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2607,15 +9613,15 @@ func (m *QueryUTXOsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUTXOsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBridgeStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUTXOsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBridgeStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Utxos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Degradation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2642,11 +9648,89 @@ func (m *QueryUTXOsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Utxos = append(m.Utxos, &UTXO{})
-			if err := m.Utxos[len(m.Utxos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Degradation == nil {
+				m.Degradation = &BridgeDegradation{}
+			}
+			if err := m.Degradation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestHeight", wireType)
+			}
+			m.BestHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BestHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestTime", wireType)
+			}
+			m.BestTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BestTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderLag", wireType)
+			}
+			m.HeaderLag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeaderLag |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHeaderLag", wireType)
+			}
+			m.MaxHeaderLag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxHeaderLag |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2668,7 +9752,7 @@ func (m *QueryUTXOsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUTXOsByAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTaggedUTXOsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2691,10 +9775,10 @@ func (m *QueryUTXOsByAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUTXOsByAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTaggedUTXOsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUTXOsByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTaggedUTXOsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2729,6 +9813,38 @@ func (m *QueryUTXOsByAddressRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2750,7 +9866,7 @@ func (m *QueryUTXOsByAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUTXOsByAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTaggedUTXOsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2773,10 +9889,10 @@ func (m *QueryUTXOsByAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUTXOsByAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTaggedUTXOsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUTXOsByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTaggedUTXOsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AddressScreener defines the screening of the parties of the bridge operations.
+// The screener returns a non-nil error to reject the operation, the message of which is recorded as the reason.
+// The keeper screens against the governance blocklist by default; the app may replace it with its own screener.
+type AddressScreener interface {
+	// ScreenDeposit screens the deposit tx of the given bridged chain, spending the given bitcoin addresses to the side recipient.
+	// The rejected deposit is quarantined instead of being minted.
+	ScreenDeposit(ctx sdk.Context, chainID string, txid string, btcSenders []string, recipient string) error
+	// ScreenWithdrawal screens the withdrawal of the given side account to the bitcoin address on the given bridged chain.
+	// The rejected withdrawal fails.
+	ScreenWithdrawal(ctx sdk.Context, chainID string, sender string, btcAddress string) error
+}
+
+// NormalizeBlocklistEntry returns the canonical form of the given side account or bitcoin address of any bridged chain
+func (p Params) NormalizeBlocklistEntry(entry string) (string, error) {
+	if addr, err := sdk.AccAddressFromBech32(entry); err == nil {
+		return addr.String(), nil
+	}
+
+	for _, chainID := range p.ChainIDs() {
+		chainCfg := p.ForChain(chainID).ChainCfg()
+		if addr, err := btcutil.DecodeAddress(entry, chainCfg); err == nil && addr.IsForNet(chainCfg) {
+			return addr.EncodeAddress(), nil
+		}
+	}
+
+	return "", fmt.Errorf("%s is neither a side account nor a bitcoin address", entry)
+}
+
+// Validate validates the quarantined deposit
+func (d *QuarantinedDeposit) Validate() error {
+	if d.Id == 0 {
+		return fmt.Errorf("id of the quarantined deposit must be greater than zero")
+	}
+
+	if len(d.Txid) == 0 || len(d.Vouts) == 0 {
+		return fmt.Errorf("outputs of the quarantined deposit %d must be specified", d.Id)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(d.Recipient); err != nil {
+		return fmt.Errorf("invalid recipient of the quarantined deposit %d: %v", d.Id, err)
+	}
+
+	switch d.Status {
+	case QuarantineStatus_QUARANTINE_STATUS_HELD, QuarantineStatus_QUARANTINE_STATUS_RELEASED:
+	case QuarantineStatus_QUARANTINE_STATUS_RETURNED:
+		if len(d.ReturnTxid) == 0 {
+			return fmt.Errorf("return tx of the quarantined deposit %d must be specified", d.Id)
+		}
+	default:
+		return fmt.Errorf("invalid status of the quarantined deposit %d", d.Id)
+	}
+
+	return nil
+}
@@ -0,0 +1,844 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: side/btcbridge/screening.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QuarantineStatus defines the status of the quarantined deposit
+type QuarantineStatus int32
+
+const (
+	QuarantineStatus_QUARANTINE_STATUS_UNSPECIFIED QuarantineStatus = 0
+	// the deposit is held by the bridge
+	QuarantineStatus_QUARANTINE_STATUS_HELD QuarantineStatus = 1
+	// the deposit is credited to the recipient
+	QuarantineStatus_QUARANTINE_STATUS_RELEASED QuarantineStatus = 2
+	// the deposit is sent back on the bridged chain
+	QuarantineStatus_QUARANTINE_STATUS_RETURNED QuarantineStatus = 3
+)
+
+var QuarantineStatus_name = map[int32]string{
+	0: "QUARANTINE_STATUS_UNSPECIFIED",
+	1: "QUARANTINE_STATUS_HELD",
+	2: "QUARANTINE_STATUS_RELEASED",
+	3: "QUARANTINE_STATUS_RETURNED",
+}
+
+var QuarantineStatus_value = map[string]int32{
+	"QUARANTINE_STATUS_UNSPECIFIED": 0,
+	"QUARANTINE_STATUS_HELD":        1,
+	"QUARANTINE_STATUS_RELEASED":    2,
+	"QUARANTINE_STATUS_RETURNED":    3,
+}
+
+func (x QuarantineStatus) String() string {
+	return proto.EnumName(QuarantineStatus_name, int32(x))
+}
+
+func (QuarantineStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd2a150e1802fb, []int{0}
+}
+
+// QuarantinedDeposit defines a deposit held by the bridge instead of being minted, as the address screening rejected it.
+// The vault outputs of the deposit are locked until the deposit is released or returned.
+type QuarantinedDeposit struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the bridged chain, empty for bitcoin
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Txid    string `protobuf:"bytes,3,opt,name=txid,proto3" json:"txid,omitempty"`
+	// the vault outputs of the deposit
+	Vouts []uint64 `protobuf:"varint,4,rep,packed,name=vouts,proto3" json:"vouts,omitempty"`
+	// the total amount of the vault outputs in sat
+	Amount uint64 `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	// the side account credited on release
+	Recipient string `protobuf:"bytes,6,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// the bitcoin address spent by the deposit, to which the deposit is returned by default
+	BtcSender string `protobuf:"bytes,7,opt,name=btc_sender,json=btcSender,proto3" json:"btc_sender,omitempty"`
+	// the reason given by the screening
+	Reason string           `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+	Status QuarantineStatus `protobuf:"varint,9,opt,name=status,proto3,enum=side.btcbridge.QuarantineStatus" json:"status,omitempty"`
+	// the tx returning the deposit
+	ReturnTxid string `protobuf:"bytes,10,opt,name=return_txid,json=returnTxid,proto3" json:"return_txid,omitempty"`
+}
+
+func (m *QuarantinedDeposit) Reset()         { *m = QuarantinedDeposit{} }
+func (m *QuarantinedDeposit) String() string { return proto.CompactTextString(m) }
+func (*QuarantinedDeposit) ProtoMessage()    {}
+func (*QuarantinedDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd2a150e1802fb, []int{0}
+}
+func (m *QuarantinedDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuarantinedDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuarantinedDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuarantinedDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuarantinedDeposit.Merge(m, src)
+}
+func (m *QuarantinedDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuarantinedDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuarantinedDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuarantinedDeposit proto.InternalMessageInfo
+
+func (m *QuarantinedDeposit) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *QuarantinedDeposit) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *QuarantinedDeposit) GetTxid() string {
+	if m != nil {
+		return m.Txid
+	}
+	return ""
+}
+
+func (m *QuarantinedDeposit) GetVouts() []uint64 {
+	if m != nil {
+		return m.Vouts
+	}
+	return nil
+}
+
+func (m *QuarantinedDeposit) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *QuarantinedDeposit) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *QuarantinedDeposit) GetBtcSender() string {
+	if m != nil {
+		return m.BtcSender
+	}
+	return ""
+}
+
+func (m *QuarantinedDeposit) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *QuarantinedDeposit) GetStatus() QuarantineStatus {
+	if m != nil {
+		return m.Status
+	}
+	return QuarantineStatus_QUARANTINE_STATUS_UNSPECIFIED
+}
+
+func (m *QuarantinedDeposit) GetReturnTxid() string {
+	if m != nil {
+		return m.ReturnTxid
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("side.btcbridge.QuarantineStatus", QuarantineStatus_name, QuarantineStatus_value)
+	proto.RegisterType((*QuarantinedDeposit)(nil), "side.btcbridge.QuarantinedDeposit")
+}
+
+func init() { proto.RegisterFile("side/btcbridge/screening.proto", fileDescriptor_1fbd2a150e1802fb) }
+
+var fileDescriptor_1fbd2a150e1802fb = []byte{
+	// 401 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x41, 0x6f, 0xd3, 0x30,
+	0x1c, 0xc5, 0xeb, 0xb4, 0xeb, 0xd6, 0x3f, 0x52, 0x15, 0x59, 0x68, 0x32, 0x13, 0x33, 0x81, 0x53,
+	0xc5, 0x21, 0x95, 0xe0, 0xc2, 0xb5, 0x10, 0xa3, 0x55, 0x9a, 0x2a, 0x96, 0xa4, 0x17, 0x2e, 0x51,
+	0x62, 0x5b, 0x9d, 0x25, 0x66, 0x47, 0xb6, 0x83, 0xca, 0xb7, 0x80, 0x8f, 0xc2, 0xb7, 0xe0, 0xb8,
+	0x23, 0x47, 0xd4, 0x7e, 0x11, 0x54, 0xb7, 0x62, 0xc0, 0xb4, 0x5b, 0xde, 0xfb, 0xbd, 0x3c, 0xe9,
+	0x59, 0x7f, 0xa0, 0x4e, 0x09, 0x39, 0x6d, 0x3c, 0x6f, 0xac, 0x12, 0x2b, 0x39, 0x75, 0xdc, 0x4a,
+	0xa9, 0x95, 0x5e, 0xa5, 0xad, 0x35, 0xde, 0xe0, 0xf1, 0x8e, 0xa7, 0x7f, 0xf8, 0x8b, 0xef, 0x11,
+	0xe0, 0xab, 0xae, 0xb6, 0xb5, 0xf6, 0x4a, 0x4b, 0x91, 0xc9, 0xd6, 0x38, 0xe5, 0xf1, 0x18, 0x22,
+	0x25, 0x08, 0x4a, 0xd0, 0x64, 0x90, 0x47, 0x4a, 0xe0, 0x27, 0x70, 0xc2, 0xaf, 0x6b, 0xa5, 0x2b,
+	0x25, 0x48, 0x94, 0xa0, 0xc9, 0x28, 0x3f, 0x0e, 0x7a, 0x2e, 0x30, 0x86, 0x81, 0x5f, 0x2b, 0x41,
+	0xfa, 0xc1, 0x0e, 0xdf, 0xf8, 0x31, 0x1c, 0x7d, 0x36, 0x9d, 0x77, 0x64, 0x90, 0xf4, 0x27, 0x83,
+	0x7c, 0x2f, 0xf0, 0x29, 0x0c, 0xeb, 0x1b, 0xd3, 0x69, 0x4f, 0x8e, 0x42, 0xf1, 0x41, 0xe1, 0xa7,
+	0x30, 0xb2, 0x92, 0xab, 0x56, 0x49, 0xed, 0xc9, 0x30, 0xd4, 0xdc, 0x19, 0xf8, 0x1c, 0xa0, 0xf1,
+	0xbc, 0x72, 0x52, 0x0b, 0x69, 0xc9, 0xf1, 0x1e, 0x37, 0x9e, 0x17, 0xc1, 0xd8, 0x95, 0x5a, 0x59,
+	0x3b, 0xa3, 0xc9, 0x49, 0x40, 0x07, 0x85, 0xdf, 0xc0, 0xd0, 0xf9, 0xda, 0x77, 0x8e, 0x8c, 0x12,
+	0x34, 0x19, 0xbf, 0x4a, 0xd2, 0x7f, 0x97, 0xa7, 0x77, 0xab, 0x8b, 0x90, 0xcb, 0x0f, 0x79, 0xfc,
+	0x0c, 0x1e, 0x59, 0xe9, 0x3b, 0xab, 0xab, 0xb0, 0x0b, 0x42, 0x2d, 0xec, 0xad, 0x72, 0xad, 0xc4,
+	0xcb, 0x6f, 0x08, 0xe2, 0xff, 0xff, 0xc6, 0xcf, 0xe1, 0xfc, 0x6a, 0x39, 0xcb, 0x67, 0x8b, 0x72,
+	0xbe, 0x60, 0x55, 0x51, 0xce, 0xca, 0x65, 0x51, 0x2d, 0x17, 0xc5, 0x07, 0xf6, 0x6e, 0xfe, 0x7e,
+	0xce, 0xb2, 0xb8, 0x87, 0xcf, 0xe0, 0xf4, 0x7e, 0xe4, 0x82, 0x5d, 0x66, 0x31, 0xc2, 0x14, 0xce,
+	0xee, 0xb3, 0x9c, 0x5d, 0xb2, 0x59, 0xc1, 0xb2, 0x38, 0x7a, 0x88, 0x97, 0xcb, 0x7c, 0xc1, 0xb2,
+	0xb8, 0xff, 0xf6, 0xe2, 0xc7, 0x86, 0xa2, 0xdb, 0x0d, 0x45, 0xbf, 0x36, 0x14, 0x7d, 0xdd, 0xd2,
+	0xde, 0xed, 0x96, 0xf6, 0x7e, 0x6e, 0x69, 0xef, 0x63, 0xba, 0x52, 0xfe, 0xba, 0x6b, 0x52, 0x6e,
+	0x6e, 0xa6, 0xbb, 0x27, 0x08, 0x77, 0xc0, 0xcd, 0xa7, 0x20, 0xa6, 0xeb, 0xbf, 0x6e, 0xc5, 0x7f,
+	0x69, 0xa5, 0x6b, 0x86, 0x21, 0xf0, 0xfa, 0xf7, 0x00, 0xc8, 0xe5, 0x11, 0xdf, 0x4a, 0x02, 0x00,
+	0x00,
+}
+
+func (m *QuarantinedDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuarantinedDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuarantinedDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReturnTxid) > 0 {
+		i -= len(m.ReturnTxid)
+		copy(dAtA[i:], m.ReturnTxid)
+		i = encodeVarintScreening(dAtA, i, uint64(len(m.ReturnTxid)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Status != 0 {
+		i = encodeVarintScreening(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintScreening(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.BtcSender) > 0 {
+		i -= len(m.BtcSender)
+		copy(dAtA[i:], m.BtcSender)
+		i = encodeVarintScreening(dAtA, i, uint64(len(m.BtcSender)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintScreening(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Amount != 0 {
+		i = encodeVarintScreening(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Vouts) > 0 {
+		dAtA2 := make([]byte, len(m.Vouts)*10)
+		var j1 int
+		for _, num := range m.Vouts {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintScreening(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Txid) > 0 {
+		i -= len(m.Txid)
+		copy(dAtA[i:], m.Txid)
+		i = encodeVarintScreening(dAtA, i, uint64(len(m.Txid)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintScreening(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintScreening(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintScreening(dAtA []byte, offset int, v uint64) int {
+	offset -= sovScreening(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QuarantinedDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovScreening(uint64(m.Id))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovScreening(uint64(l))
+	}
+	l = len(m.Txid)
+	if l > 0 {
+		n += 1 + l + sovScreening(uint64(l))
+	}
+	if len(m.Vouts) > 0 {
+		l = 0
+		for _, e := range m.Vouts {
+			l += sovScreening(uint64(e))
+		}
+		n += 1 + sovScreening(uint64(l)) + l
+	}
+	if m.Amount != 0 {
+		n += 1 + sovScreening(uint64(m.Amount))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovScreening(uint64(l))
+	}
+	l = len(m.BtcSender)
+	if l > 0 {
+		n += 1 + l + sovScreening(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovScreening(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovScreening(uint64(m.Status))
+	}
+	l = len(m.ReturnTxid)
+	if l > 0 {
+		n += 1 + l + sovScreening(uint64(l))
+	}
+	return n
+}
+
+func sovScreening(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozScreening(x uint64) (n int) {
+	return sovScreening(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QuarantinedDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScreening
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuarantinedDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuarantinedDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScreening
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScreening
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScreening
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScreening
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScreening
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScreening
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScreening
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowScreening
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Vouts = append(m.Vouts, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowScreening
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthScreening
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthScreening
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Vouts) == 0 {
+					m.Vouts = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowScreening
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Vouts = append(m.Vouts, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vouts", wireType)
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScreening
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScreening
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScreening
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScreening
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScreening
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScreening
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScreening
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScreening
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScreening
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScreening
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScreening
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= QuarantineStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnTxid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScreening
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScreening
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScreening
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReturnTxid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScreening(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScreening
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipScreening(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowScreening
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowScreening
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowScreening
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthScreening
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupScreening
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthScreening
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthScreening        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowScreening          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupScreening = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,14 +1,19 @@
 package types
 
 import (
+	"bytes"
+
 	secp256k1 "github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
 )
 
 // VerifyPsbtSignatures verifies the signatures of the given psbt
-// Note: assume that the psbt is valid and all inputs are native segwit
+// Note: assume that the psbt is valid and all inputs are native segwit or taproot
 func VerifyPsbtSignatures(p *psbt.Packet) bool {
 	// build previous output fetcher
 	prevOutputFetcher := txscript.NewMultiPrevOutFetcher(nil)
@@ -25,17 +30,33 @@ func VerifyPsbtSignatures(p *psbt.Packet) bool {
 	// verify signatures
 	for i := range p.Inputs {
 		output := p.Inputs[i].WitnessUtxo
-		hashType := p.Inputs[i].SighashType
 
-		witness := p.Inputs[i].FinalScriptWitness
-		if len(witness) < 72+33 {
+		witness, err := DeserializeWitness(p.Inputs[i].FinalScriptWitness)
+		if err != nil {
+			return false
+		}
+
+		if txscript.IsPayToTaproot(output.PkScript) {
+			if !verifyTaprootInput(p, i, witness, prevOutputFetcher) {
+				return false
+			}
+
+			continue
+		}
+
+		if len(witness) != 2 {
 			return false
 		}
 
-		sigBytes := witness[0 : len(witness)-33]
-		pkBytes := witness[len(witness)-33:]
+		sigBytes := witness[0]
+		pkBytes := witness[1]
+		if len(sigBytes) == 0 {
+			return false
+		}
 
-		if sigBytes[len(sigBytes)-1] != byte(hashType) {
+		// the sighash type is cleared from the input once finalized
+		hashType := txscript.SigHashType(sigBytes[len(sigBytes)-1])
+		if p.Inputs[i].SighashType != 0 && p.Inputs[i].SighashType != hashType {
 			return false
 		}
 
@@ -44,6 +65,11 @@ func VerifyPsbtSignatures(p *psbt.Packet) bool {
 			return false
 		}
 
+		// the pub key must be the one committed to by the p2wpkh output
+		if !txscript.IsPayToWitnessPubKeyHash(output.PkScript) || !bytes.Equal(output.PkScript[2:], btcutil.Hash160(pkBytes)) {
+			return false
+		}
+
 		pk, err := secp256k1.ParsePubKey(pkBytes)
 		if err != nil {
 			return false
@@ -62,3 +88,99 @@ func VerifyPsbtSignatures(p *psbt.Packet) bool {
 
 	return true
 }
+
+// MatchPsbtInputs returns true if the witness utxos of the given psbt are identical to the ones of the expected psbt,
+// as the signatures are verified against the amounts and scripts of the witness utxos which the txid does not commit to
+func MatchPsbtInputs(p *psbt.Packet, expected *psbt.Packet) bool {
+	if len(p.Inputs) != len(expected.Inputs) {
+		return false
+	}
+
+	for i, input := range p.Inputs {
+		output, expectedOutput := input.WitnessUtxo, expected.Inputs[i].WitnessUtxo
+		if output == nil || expectedOutput == nil {
+			return false
+		}
+
+		if output.Value != expectedOutput.Value || !bytes.Equal(output.PkScript, expectedOutput.PkScript) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// verifyTaprootInput verifies the given witness of the taproot input by the script engine,
+// which covers both the key path and the script path spends.
+// The signature must commit to all inputs and outputs, i.e. SIGHASH_DEFAULT or SIGHASH_ALL.
+func verifyTaprootInput(p *psbt.Packet, index int, witness wire.TxWitness, prevOutputFetcher txscript.PrevOutputFetcher) bool {
+	if len(witness) == 0 {
+		return false
+	}
+
+	sigBytes := witness[0]
+	switch len(sigBytes) {
+	case schnorr.SignatureSize:
+	case schnorr.SignatureSize + 1:
+		if txscript.SigHashType(sigBytes[schnorr.SignatureSize]) != txscript.SigHashAll {
+			return false
+		}
+	default:
+		return false
+	}
+
+	output := p.Inputs[index].WitnessUtxo
+
+	tx := p.UnsignedTx.Copy()
+	tx.TxIn[index].Witness = witness
+
+	vm, err := txscript.NewEngine(output.PkScript, tx, index, txscript.StandardVerifyFlags, nil,
+		txscript.NewTxSigHashes(tx, prevOutputFetcher), output.Value, prevOutputFetcher)
+	if err != nil {
+		return false
+	}
+
+	return vm.Execute() == nil
+}
+
+// DeserializeWitness deserializes the witness stack in the psbt final script witness format
+func DeserializeWitness(witnessBytes []byte) (wire.TxWitness, error) {
+	r := bytes.NewReader(witnessBytes)
+
+	count, err := wire.ReadVarInt(r, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	// each witness item takes at least one byte
+	if count > uint64(len(witnessBytes)) {
+		return nil, ErrInvalidSignatures
+	}
+
+	witness := make(wire.TxWitness, count)
+	for i := range witness {
+		witness[i], err = wire.ReadVarBytes(r, 0, uint32(len(witnessBytes)), "witness")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return witness, nil
+}
+
+// SerializeWitness serializes the witness stack in the psbt final script witness format
+func SerializeWitness(witness wire.TxWitness) ([]byte, error) {
+	var buf bytes.Buffer
+
+	if err := wire.WriteVarInt(&buf, 0, uint64(len(witness))); err != nil {
+		return nil, err
+	}
+
+	for _, item := range witness {
+		if err := wire.WriteVarBytes(&buf, 0, item); err != nil {
+			return nil, err
+		}
+	}
+
+	return buf.Bytes(), nil
+}
@@ -33,6 +33,8 @@ type MsgSubmitWithdrawStatusRequest struct {
 	Sender string        `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	Txid   string        `protobuf:"bytes,2,opt,name=txid,proto3" json:"txid,omitempty"`
 	Status SigningStatus `protobuf:"varint,3,opt,name=status,proto3,enum=side.btcbridge.SigningStatus" json:"status,omitempty"`
+	// the bridged chain, empty for bitcoin
+	ChainId string `protobuf:"bytes,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
 func (m *MsgSubmitWithdrawStatusRequest) Reset()         { *m = MsgSubmitWithdrawStatusRequest{} }
@@ -89,6 +91,13 @@ func (m *MsgSubmitWithdrawStatusRequest) GetStatus() SigningStatus {
 	return SigningStatus_SIGNING_STATUS_UNSPECIFIED
 }
 
+func (m *MsgSubmitWithdrawStatusRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
 // MsgSubmitWithdrawStatusResponse defines the Msg/SubmitWithdrawStatus response type.
 type MsgSubmitWithdrawStatusResponse struct {
 }
@@ -130,6 +139,8 @@ var xxx_messageInfo_MsgSubmitWithdrawStatusResponse proto.InternalMessageInfo
 type MsgSubmitBlockHeaderRequest struct {
 	Sender       string         `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	BlockHeaders []*BlockHeader `protobuf:"bytes,2,rep,name=block_headers,json=blockHeaders,proto3" json:"block_headers,omitempty"`
+	// the bridged chain, empty for bitcoin
+	ChainId string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
 func (m *MsgSubmitBlockHeaderRequest) Reset()         { *m = MsgSubmitBlockHeaderRequest{} }
@@ -179,6 +190,13 @@ func (m *MsgSubmitBlockHeaderRequest) GetBlockHeaders() []*BlockHeader {
 	return nil
 }
 
+func (m *MsgSubmitBlockHeaderRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
 // MsgSubmitBlockHeadersResponse defines the Msg/SubmitBlockHeaders response type.
 type MsgSubmitBlockHeadersResponse struct {
 }
@@ -227,6 +245,14 @@ type MsgSubmitDepositTransactionRequest struct {
 	// the tx bytes in base64 format
 	TxBytes string   `protobuf:"bytes,4,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
 	Proof   []string `protobuf:"bytes,5,rep,name=proof,proto3" json:"proof,omitempty"`
+	// the serialized merkle block in hex format as returned by bitcoind gettxoutproof
+	// used instead of proof if not empty
+	TxOutProof string `protobuf:"bytes,6,opt,name=tx_out_proof,json=txOutProof,proto3" json:"tx_out_proof,omitempty"`
+	// the bridged chain, empty for bitcoin
+	ChainId string `protobuf:"bytes,7,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// the txs spent by the inputs other than the first one, in base64 format
+	// used for screening the senders of all inputs
+	InputPrevTxs []string `protobuf:"bytes,8,rep,name=input_prev_txs,json=inputPrevTxs,proto3" json:"input_prev_txs,omitempty"`
 }
 
 func (m *MsgSubmitDepositTransactionRequest) Reset()         { *m = MsgSubmitDepositTransactionRequest{} }
@@ -297,6 +323,27 @@ func (m *MsgSubmitDepositTransactionRequest) GetProof() []string {
 	return nil
 }
 
+func (m *MsgSubmitDepositTransactionRequest) GetTxOutProof() string {
+	if m != nil {
+		return m.TxOutProof
+	}
+	return ""
+}
+
+func (m *MsgSubmitDepositTransactionRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *MsgSubmitDepositTransactionRequest) GetInputPrevTxs() []string {
+	if m != nil {
+		return m.InputPrevTxs
+	}
+	return nil
+}
+
 // MsgSubmitTransactionResponse defines the Msg/SubmitTransaction response type.
 type MsgSubmitDepositTransactionResponse struct {
 }
@@ -342,6 +389,11 @@ type MsgSubmitWithdrawTransactionRequest struct {
 	// the tx bytes in base64 format
 	TxBytes string   `protobuf:"bytes,4,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
 	Proof   []string `protobuf:"bytes,5,rep,name=proof,proto3" json:"proof,omitempty"`
+	// the serialized merkle block in hex format as returned by bitcoind gettxoutproof
+	// used instead of proof if not empty
+	TxOutProof string `protobuf:"bytes,6,opt,name=tx_out_proof,json=txOutProof,proto3" json:"tx_out_proof,omitempty"`
+	// the bridged chain, empty for bitcoin
+	ChainId string `protobuf:"bytes,7,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
 func (m *MsgSubmitWithdrawTransactionRequest) Reset()         { *m = MsgSubmitWithdrawTransactionRequest{} }
@@ -405,6 +457,20 @@ func (m *MsgSubmitWithdrawTransactionRequest) GetProof() []string {
 	return nil
 }
 
+func (m *MsgSubmitWithdrawTransactionRequest) GetTxOutProof() string {
+	if m != nil {
+		return m.TxOutProof
+	}
+	return ""
+}
+
+func (m *MsgSubmitWithdrawTransactionRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
 // MsgSubmitTransactionResponse defines the Msg/SubmitTransaction response type.
 type MsgSubmitWithdrawTransactionResponse struct {
 }
@@ -444,6 +510,7 @@ var xxx_messageInfo_MsgSubmitWithdrawTransactionResponse proto.InternalMessageIn
 
 // Msg defines the MsgUpdateSender service.
 type MsgUpdateQualifiedRelayersRequest struct {
+	// the gov authority, i.e. the relayers are only updated by gov proposals
 	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	// update senders who can send block headers to the side chain
 	Relayers []string `protobuf:"bytes,2,rep,name=relayers,proto3" json:"relayers,omitempty"`
@@ -540,6 +607,10 @@ type MsgWithdrawBitcoinRequest struct {
 	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
 	// fee rate in sats/vB
 	FeeRate int64 `protobuf:"varint,3,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
+	// the bitcoin address receiving the amount, the linked bitcoin address or the sender by default
+	BtcAddress string `protobuf:"bytes,4,opt,name=btc_address,json=btcAddress,proto3" json:"btc_address,omitempty"`
+	// the destinations paid by the single withdrawal, exclusive of the amount and the btc address
+	Recipients []*WithdrawRecipient `protobuf:"bytes,5,rep,name=recipients,proto3" json:"recipients,omitempty"`
 }
 
 func (m *MsgWithdrawBitcoinRequest) Reset()         { *m = MsgWithdrawBitcoinRequest{} }
@@ -596,6 +667,74 @@ func (m *MsgWithdrawBitcoinRequest) GetFeeRate() int64 {
 	return 0
 }
 
+func (m *MsgWithdrawBitcoinRequest) GetBtcAddress() string {
+	if m != nil {
+		return m.BtcAddress
+	}
+	return ""
+}
+
+func (m *MsgWithdrawBitcoinRequest) GetRecipients() []*WithdrawRecipient {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
+
+// WithdrawRecipient defines a destination of the multi-recipient withdrawal
+type WithdrawRecipient struct {
+	BtcAddress string `protobuf:"bytes,1,opt,name=btc_address,json=btcAddress,proto3" json:"btc_address,omitempty"`
+	// withdraw amount in satoshi, etc: 100000000sat = 1btc
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *WithdrawRecipient) Reset()         { *m = WithdrawRecipient{} }
+func (m *WithdrawRecipient) String() string { return proto.CompactTextString(m) }
+func (*WithdrawRecipient) ProtoMessage()    {}
+func (*WithdrawRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_785ca8e1e4227068, []int{11}
+}
+func (m *WithdrawRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WithdrawRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WithdrawRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WithdrawRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawRecipient.Merge(m, src)
+}
+func (m *WithdrawRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *WithdrawRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawRecipient proto.InternalMessageInfo
+
+func (m *WithdrawRecipient) GetBtcAddress() string {
+	if m != nil {
+		return m.BtcAddress
+	}
+	return ""
+}
+
+func (m *WithdrawRecipient) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
 // MsgWithdrawBitcoinResponse defines the Msg/WithdrawBitcoin response type.
 type MsgWithdrawBitcoinResponse struct {
 }
@@ -604,7 +743,7 @@ func (m *MsgWithdrawBitcoinResponse) Reset()         { *m = MsgWithdrawBitcoinRe
 func (m *MsgWithdrawBitcoinResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawBitcoinResponse) ProtoMessage()    {}
 func (*MsgWithdrawBitcoinResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_785ca8e1e4227068, []int{11}
+	return fileDescriptor_785ca8e1e4227068, []int{12}
 }
 func (m *MsgWithdrawBitcoinResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -638,13 +777,15 @@ type MsgSubmitWithdrawSignaturesRequest struct {
 	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	Txid   string `protobuf:"bytes,2,opt,name=txid,proto3" json:"txid,omitempty"`
 	Psbt   string `protobuf:"bytes,3,opt,name=psbt,proto3" json:"psbt,omitempty"`
+	// the bridged chain, empty for bitcoin
+	ChainId string `protobuf:"bytes,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
 func (m *MsgSubmitWithdrawSignaturesRequest) Reset()         { *m = MsgSubmitWithdrawSignaturesRequest{} }
 func (m *MsgSubmitWithdrawSignaturesRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitWithdrawSignaturesRequest) ProtoMessage()    {}
 func (*MsgSubmitWithdrawSignaturesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_785ca8e1e4227068, []int{12}
+	return fileDescriptor_785ca8e1e4227068, []int{13}
 }
 func (m *MsgSubmitWithdrawSignaturesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -694,6 +835,13 @@ func (m *MsgSubmitWithdrawSignaturesRequest) GetPsbt() string {
 	return ""
 }
 
+func (m *MsgSubmitWithdrawSignaturesRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
 // MsgSubmitWithdrawSignaturesResponse defines the Msg/SubmitWithdrawSignatures response type.
 type MsgSubmitWithdrawSignaturesResponse struct {
 }
@@ -702,7 +850,7 @@ func (m *MsgSubmitWithdrawSignaturesResponse) Reset()         { *m = MsgSubmitWi
 func (m *MsgSubmitWithdrawSignaturesResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitWithdrawSignaturesResponse) ProtoMessage()    {}
 func (*MsgSubmitWithdrawSignaturesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_785ca8e1e4227068, []int{13}
+	return fileDescriptor_785ca8e1e4227068, []int{14}
 }
 func (m *MsgSubmitWithdrawSignaturesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -731,1137 +879,6265 @@ func (m *MsgSubmitWithdrawSignaturesResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSubmitWithdrawSignaturesResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgSubmitWithdrawStatusRequest)(nil), "side.btcbridge.MsgSubmitWithdrawStatusRequest")
-	proto.RegisterType((*MsgSubmitWithdrawStatusResponse)(nil), "side.btcbridge.MsgSubmitWithdrawStatusResponse")
-	proto.RegisterType((*MsgSubmitBlockHeaderRequest)(nil), "side.btcbridge.MsgSubmitBlockHeaderRequest")
-	proto.RegisterType((*MsgSubmitBlockHeadersResponse)(nil), "side.btcbridge.MsgSubmitBlockHeadersResponse")
-	proto.RegisterType((*MsgSubmitDepositTransactionRequest)(nil), "side.btcbridge.MsgSubmitDepositTransactionRequest")
-	proto.RegisterType((*MsgSubmitDepositTransactionResponse)(nil), "side.btcbridge.MsgSubmitDepositTransactionResponse")
-	proto.RegisterType((*MsgSubmitWithdrawTransactionRequest)(nil), "side.btcbridge.MsgSubmitWithdrawTransactionRequest")
-	proto.RegisterType((*MsgSubmitWithdrawTransactionResponse)(nil), "side.btcbridge.MsgSubmitWithdrawTransactionResponse")
-	proto.RegisterType((*MsgUpdateQualifiedRelayersRequest)(nil), "side.btcbridge.MsgUpdateQualifiedRelayersRequest")
-	proto.RegisterType((*MsgUpdateQualifiedRelayersResponse)(nil), "side.btcbridge.MsgUpdateQualifiedRelayersResponse")
-	proto.RegisterType((*MsgWithdrawBitcoinRequest)(nil), "side.btcbridge.MsgWithdrawBitcoinRequest")
-	proto.RegisterType((*MsgWithdrawBitcoinResponse)(nil), "side.btcbridge.MsgWithdrawBitcoinResponse")
-	proto.RegisterType((*MsgSubmitWithdrawSignaturesRequest)(nil), "side.btcbridge.MsgSubmitWithdrawSignaturesRequest")
-	proto.RegisterType((*MsgSubmitWithdrawSignaturesResponse)(nil), "side.btcbridge.MsgSubmitWithdrawSignaturesResponse")
+// MsgLinkBitcoinAddressRequest defines the Msg/LinkBitcoinAddress request type.
+type MsgLinkBitcoinAddressRequest struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// the bitcoin address to be linked
+	BtcAddress string `protobuf:"bytes,2,opt,name=btc_address,json=btcAddress,proto3" json:"btc_address,omitempty"`
+	// base64 encoded BIP-322 simple signature or legacy signmessage signature
+	// of the bitcoin address over the link message "side-btcbridge-link/<chain-id>/<sender>"
+	Signature string `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	// the bridged chain, empty for bitcoin
+	ChainId string `protobuf:"bytes,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *MsgLinkBitcoinAddressRequest) Reset()         { *m = MsgLinkBitcoinAddressRequest{} }
+func (m *MsgLinkBitcoinAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgLinkBitcoinAddressRequest) ProtoMessage()    {}
+func (*MsgLinkBitcoinAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_785ca8e1e4227068, []int{15}
+}
+func (m *MsgLinkBitcoinAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLinkBitcoinAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLinkBitcoinAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgLinkBitcoinAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLinkBitcoinAddressRequest.Merge(m, src)
+}
+func (m *MsgLinkBitcoinAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLinkBitcoinAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLinkBitcoinAddressRequest.DiscardUnknown(m)
 }
 
-func init() { proto.RegisterFile("side/btcbridge/tx.proto", fileDescriptor_785ca8e1e4227068) }
+var xxx_messageInfo_MsgLinkBitcoinAddressRequest proto.InternalMessageInfo
 
-var fileDescriptor_785ca8e1e4227068 = []byte{
-	// 692 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x4e, 0xdb, 0x40,
-	0x10, 0xc6, 0x04, 0x02, 0x19, 0x0a, 0x95, 0x56, 0x08, 0x82, 0x01, 0x13, 0x5c, 0x5a, 0xa5, 0xad,
-	0xea, 0xa8, 0xa1, 0xbd, 0x57, 0x51, 0x0f, 0x5c, 0x72, 0xa8, 0xa1, 0x42, 0xea, 0x25, 0x5a, 0xc7,
-	0x1b, 0x67, 0xd5, 0xc4, 0xeb, 0x7a, 0xd7, 0x25, 0xdc, 0x2a, 0x21, 0xf5, 0xd4, 0x43, 0xdf, 0xa4,
-	0xaf, 0xd1, 0x23, 0xc7, 0x1e, 0x2b, 0x78, 0x83, 0x3e, 0x41, 0xe5, 0xf5, 0x62, 0x42, 0xb0, 0xf3,
-	0x53, 0xf5, 0xb6, 0xb3, 0xf3, 0xcd, 0xcc, 0xb7, 0x33, 0xf3, 0xc9, 0x86, 0x4d, 0x4e, 0x5d, 0x52,
-	0x73, 0x44, 0xdb, 0x09, 0xa9, 0xeb, 0x91, 0x9a, 0x18, 0x58, 0x41, 0xc8, 0x04, 0x43, 0x6b, 0xb1,
-	0xc3, 0x4a, 0x1d, 0xfa, 0xba, 0xc7, 0x3c, 0x26, 0x5d, 0xb5, 0xf8, 0x94, 0xa0, 0xf4, 0xed, 0x91,
-	0xf0, 0x00, 0x87, 0xb8, 0xcf, 0x95, 0x73, 0x67, 0xc4, 0xe9, 0x50, 0xd1, 0x66, 0xd4, 0x4f, 0xbc,
-	0xe6, 0x85, 0x06, 0x46, 0x93, 0x7b, 0xc7, 0x91, 0xd3, 0xa7, 0xe2, 0x94, 0x8a, 0xae, 0x1b, 0xe2,
-	0xb3, 0x63, 0x81, 0x45, 0xc4, 0x6d, 0xf2, 0x29, 0x22, 0x5c, 0xa0, 0x0d, 0x28, 0x72, 0xe2, 0xbb,
-	0x24, 0x2c, 0x6b, 0x15, 0xad, 0x5a, 0xb2, 0x95, 0x85, 0x10, 0x2c, 0x88, 0x01, 0x75, 0xcb, 0xf3,
-	0xf2, 0x56, 0x9e, 0xd1, 0x6b, 0x28, 0x72, 0x19, 0x5c, 0x2e, 0x54, 0xb4, 0xea, 0x5a, 0x7d, 0xd7,
-	0xba, 0xfb, 0x00, 0xeb, 0x98, 0x7a, 0x3e, 0xf5, 0x3d, 0x55, 0x41, 0x81, 0xcd, 0x7d, 0xd8, 0xcb,
-	0x25, 0xc1, 0x03, 0xe6, 0x73, 0x62, 0x9e, 0xc1, 0x76, 0x0a, 0x69, 0xf4, 0x58, 0xfb, 0xe3, 0x11,
-	0xc1, 0x2e, 0x09, 0x27, 0x91, 0x7c, 0x03, 0xab, 0x4e, 0x8c, 0x6e, 0x75, 0x25, 0x9c, 0x97, 0xe7,
-	0x2b, 0x85, 0xea, 0x4a, 0x7d, 0x7b, 0x94, 0xd7, 0x70, 0xca, 0x07, 0xce, 0xad, 0xc1, 0xcd, 0x3d,
-	0xd8, 0xcd, 0x2a, 0x7c, 0xcb, 0xec, 0x87, 0x06, 0x66, 0x8a, 0x78, 0x4b, 0x02, 0xc6, 0xa9, 0x38,
-	0x09, 0xb1, 0xcf, 0x71, 0x5b, 0x50, 0xe6, 0x4f, 0x62, 0xb8, 0x03, 0x25, 0x59, 0xaf, 0x8b, 0x79,
-	0x57, 0xf5, 0xf2, 0xf6, 0x02, 0x99, 0xb0, 0x1a, 0x84, 0xe4, 0x73, 0x4b, 0x0c, 0x5a, 0xce, 0xb9,
-	0x20, 0x49, 0x5f, 0x4b, 0xf6, 0x4a, 0x7c, 0x79, 0x32, 0x68, 0xc4, 0x57, 0x68, 0x0b, 0x96, 0x53,
-	0xf7, 0x82, 0x74, 0x2f, 0x09, 0xe5, 0x5a, 0x87, 0xc5, 0x20, 0x64, 0xac, 0x53, 0x5e, 0xac, 0x14,
-	0xaa, 0x25, 0x3b, 0x31, 0xcc, 0xc7, 0xf0, 0x68, 0x2c, 0x61, 0xf5, 0xb0, 0x6f, 0xda, 0x10, 0xee,
-	0x66, 0x2c, 0xff, 0xed, 0x65, 0x33, 0xb3, 0x7e, 0x02, 0x07, 0xe3, 0xd9, 0x28, 0xda, 0xa7, 0xb0,
-	0xdf, 0xe4, 0xde, 0xfb, 0xc0, 0xc5, 0x82, 0xbc, 0x8b, 0x70, 0x8f, 0x76, 0x28, 0x71, 0x6d, 0xd2,
-	0xc3, 0xe7, 0x72, 0x6a, 0xe3, 0x39, 0xeb, 0xb0, 0x1c, 0x2a, 0xa8, 0x5c, 0x95, 0x92, 0x9d, 0xda,
-	0xe6, 0x81, 0x9c, 0x73, 0x6e, 0x62, 0x55, 0xbe, 0x03, 0x5b, 0x4d, 0xee, 0xdd, 0x10, 0x6c, 0x24,
-	0x6a, 0x9b, 0x54, 0x76, 0x03, 0x8a, 0xb8, 0xcf, 0x22, 0x5f, 0xa8, 0x3e, 0x29, 0x2b, 0x6e, 0x52,
-	0x87, 0x90, 0x56, 0x88, 0x05, 0x91, 0x93, 0x2f, 0xd8, 0x4b, 0x1d, 0x42, 0x6c, 0x2c, 0x88, 0xb9,
-	0x03, 0x7a, 0x56, 0x1d, 0xc5, 0xc2, 0x1d, 0xda, 0xc9, 0x54, 0x51, 0xd4, 0xf3, 0xb1, 0x88, 0x42,
-	0xf2, 0x4f, 0xd2, 0x46, 0xb0, 0x10, 0x70, 0x47, 0xa8, 0x05, 0x94, 0xe7, 0x3b, 0x8b, 0x94, 0x55,
-	0x25, 0x21, 0x53, 0xff, 0x53, 0x84, 0x42, 0x93, 0x7b, 0x28, 0x00, 0x74, 0x5f, 0x47, 0xe8, 0xf9,
-	0xa8, 0x16, 0xc7, 0xe8, 0x5c, 0x7f, 0x31, 0x0d, 0x38, 0xad, 0x8c, 0x2e, 0x34, 0x28, 0xe7, 0xed,
-	0x39, 0xaa, 0xe7, 0xe6, 0xca, 0x55, 0xb1, 0x7e, 0x38, 0x53, 0x8c, 0x62, 0xf1, 0x55, 0x83, 0xad,
-	0xdc, 0xbd, 0x45, 0xf9, 0x29, 0xf3, 0x35, 0xa7, 0xbf, 0x9a, 0x2d, 0x48, 0x11, 0xf9, 0xa2, 0xc1,
-	0x66, 0xce, 0xfe, 0xa2, 0x97, 0x19, 0x19, 0xc7, 0x8b, 0x48, 0xaf, 0xcf, 0x12, 0xa2, 0x28, 0x74,
-	0xe1, 0xe1, 0xc8, 0xce, 0xa2, 0xa7, 0x19, 0x69, 0xb2, 0xf5, 0xa3, 0x3f, 0x9b, 0x06, 0x7a, 0x6f,
-	0xf6, 0xf7, 0x57, 0x73, 0xcc, 0xec, 0x73, 0xd5, 0xa2, 0x1f, 0xce, 0x14, 0xa3, 0x58, 0x9c, 0xc1,
-	0x7a, 0xd6, 0x77, 0x0d, 0x59, 0x93, 0x93, 0x0d, 0x7f, 0x85, 0xf5, 0xda, 0xd4, 0xf8, 0xa4, 0x70,
-	0xe3, 0xe8, 0xe7, 0x95, 0xa1, 0x5d, 0x5e, 0x19, 0xda, 0xef, 0x2b, 0x43, 0xfb, 0x7e, 0x6d, 0xcc,
-	0x5d, 0x5e, 0x1b, 0x73, 0xbf, 0xae, 0x8d, 0xb9, 0x0f, 0x96, 0x47, 0x45, 0x37, 0x72, 0xac, 0x36,
-	0xeb, 0xd7, 0xe2, 0xa4, 0xf2, 0x4f, 0xa0, 0xcd, 0x7a, 0xd2, 0xa8, 0x0d, 0x86, 0xff, 0x43, 0xce,
-	0x03, 0xc2, 0x9d, 0xa2, 0x04, 0x1c, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xa5, 0x14, 0x51, 0xb1,
-	0xa6, 0x08, 0x00, 0x00,
+func (m *MsgLinkBitcoinAddressRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *MsgLinkBitcoinAddressRequest) GetBtcAddress() string {
+	if m != nil {
+		return m.BtcAddress
+	}
+	return ""
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgLinkBitcoinAddressRequest) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// SubmitBlockHeaders submits bitcoin block headers to the side chain.
-	SubmitBlockHeaders(ctx context.Context, in *MsgSubmitBlockHeaderRequest, opts ...grpc.CallOption) (*MsgSubmitBlockHeadersResponse, error)
-	// SubmitDepositTransaction submits bitcoin transaction to the side chain.
-	SubmitDepositTransaction(ctx context.Context, in *MsgSubmitDepositTransactionRequest, opts ...grpc.CallOption) (*MsgSubmitDepositTransactionResponse, error)
-	// SubmitWithdrawalTransaction submits bitcoin transaction to the side chain.
-	SubmitWithdrawTransaction(ctx context.Context, in *MsgSubmitWithdrawTransactionRequest, opts ...grpc.CallOption) (*MsgSubmitWithdrawTransactionResponse, error)
-	// UpdateSenders updates the senders of the side chain.
-	UpdateQualifiedRelayers(ctx context.Context, in *MsgUpdateQualifiedRelayersRequest, opts ...grpc.CallOption) (*MsgUpdateQualifiedRelayersResponse, error)
-	// WithdrawBitcoin withdraws the bitcoin from the side chain.
-	WithdrawBitcoin(ctx context.Context, in *MsgWithdrawBitcoinRequest, opts ...grpc.CallOption) (*MsgWithdrawBitcoinResponse, error)
-	// SubmitWithdrawSignatures submits the signatures of the withdraw transaction.
-	SubmitWithdrawSignatures(ctx context.Context, in *MsgSubmitWithdrawSignaturesRequest, opts ...grpc.CallOption) (*MsgSubmitWithdrawSignaturesResponse, error)
-	// SubmitWithdrawStatus submits the status of the withdraw transaction.
-	SubmitWithdrawStatus(ctx context.Context, in *MsgSubmitWithdrawStatusRequest, opts ...grpc.CallOption) (*MsgSubmitWithdrawStatusResponse, error)
+func (m *MsgLinkBitcoinAddressRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+// MsgLinkBitcoinAddressResponse defines the Msg/LinkBitcoinAddress response type.
+type MsgLinkBitcoinAddressResponse struct {
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgLinkBitcoinAddressResponse) Reset()         { *m = MsgLinkBitcoinAddressResponse{} }
+func (m *MsgLinkBitcoinAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgLinkBitcoinAddressResponse) ProtoMessage()    {}
+func (*MsgLinkBitcoinAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_785ca8e1e4227068, []int{16}
+}
+func (m *MsgLinkBitcoinAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLinkBitcoinAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLinkBitcoinAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgLinkBitcoinAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLinkBitcoinAddressResponse.Merge(m, src)
+}
+func (m *MsgLinkBitcoinAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLinkBitcoinAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLinkBitcoinAddressResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) SubmitBlockHeaders(ctx context.Context, in *MsgSubmitBlockHeaderRequest, opts ...grpc.CallOption) (*MsgSubmitBlockHeadersResponse, error) {
-	out := new(MsgSubmitBlockHeadersResponse)
-	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/SubmitBlockHeaders", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgLinkBitcoinAddressResponse proto.InternalMessageInfo
+
+// MsgCreateHTLCRequest defines the Msg/CreateHTLC request type.
+type MsgCreateHTLCRequest struct {
+	// the maker locking the tokens
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// the account receiving the tokens on claim
+	Taker string `protobuf:"bytes,2,opt,name=taker,proto3" json:"taker,omitempty"`
+	// the tokens to lock
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// the hex encoded sha256 hash of the preimage
+	HashLock string `protobuf:"bytes,4,opt,name=hash_lock,json=hashLock,proto3" json:"hash_lock,omitempty"`
+	// the side block height from which the tokens are refunded to the maker
+	TimeoutHeight int64 `protobuf:"varint,5,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height,omitempty"`
+	// the hex encoded pk script of the bitcoin output expected to be paid
+	PkScript string `protobuf:"bytes,6,opt,name=pk_script,json=pkScript,proto3" json:"pk_script,omitempty"`
+	// the minimum value of the bitcoin output expected to be paid
+	BtcAmount uint64 `protobuf:"varint,7,opt,name=btc_amount,json=btcAmount,proto3" json:"btc_amount,omitempty"`
+	// the bridged chain, empty for bitcoin
+	ChainId string `protobuf:"bytes,8,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *MsgCreateHTLCRequest) Reset()         { *m = MsgCreateHTLCRequest{} }
+func (m *MsgCreateHTLCRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateHTLCRequest) ProtoMessage()    {}
+func (*MsgCreateHTLCRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_785ca8e1e4227068, []int{17}
+}
+func (m *MsgCreateHTLCRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateHTLCRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateHTLCRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgCreateHTLCRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateHTLCRequest.Merge(m, src)
+}
+func (m *MsgCreateHTLCRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateHTLCRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateHTLCRequest.DiscardUnknown(m)
 }
 
-func (c *msgClient) SubmitDepositTransaction(ctx context.Context, in *MsgSubmitDepositTransactionRequest, opts ...grpc.CallOption) (*MsgSubmitDepositTransactionResponse, error) {
-	out := new(MsgSubmitDepositTransactionResponse)
-	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/SubmitDepositTransaction", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCreateHTLCRequest proto.InternalMessageInfo
+
+func (m *MsgCreateHTLCRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SubmitWithdrawTransaction(ctx context.Context, in *MsgSubmitWithdrawTransactionRequest, opts ...grpc.CallOption) (*MsgSubmitWithdrawTransactionResponse, error) {
-	out := new(MsgSubmitWithdrawTransactionResponse)
-	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/SubmitWithdrawTransaction", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateHTLCRequest) GetTaker() string {
+	if m != nil {
+		return m.Taker
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateQualifiedRelayers(ctx context.Context, in *MsgUpdateQualifiedRelayersRequest, opts ...grpc.CallOption) (*MsgUpdateQualifiedRelayersResponse, error) {
-	out := new(MsgUpdateQualifiedRelayersResponse)
-	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/UpdateQualifiedRelayers", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateHTLCRequest) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) WithdrawBitcoin(ctx context.Context, in *MsgWithdrawBitcoinRequest, opts ...grpc.CallOption) (*MsgWithdrawBitcoinResponse, error) {
-	out := new(MsgWithdrawBitcoinResponse)
-	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/WithdrawBitcoin", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateHTLCRequest) GetHashLock() string {
+	if m != nil {
+		return m.HashLock
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SubmitWithdrawSignatures(ctx context.Context, in *MsgSubmitWithdrawSignaturesRequest, opts ...grpc.CallOption) (*MsgSubmitWithdrawSignaturesResponse, error) {
-	out := new(MsgSubmitWithdrawSignaturesResponse)
-	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/SubmitWithdrawSignatures", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateHTLCRequest) GetTimeoutHeight() int64 {
+	if m != nil {
+		return m.TimeoutHeight
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) SubmitWithdrawStatus(ctx context.Context, in *MsgSubmitWithdrawStatusRequest, opts ...grpc.CallOption) (*MsgSubmitWithdrawStatusResponse, error) {
-	out := new(MsgSubmitWithdrawStatusResponse)
-	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/SubmitWithdrawStatus", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateHTLCRequest) GetPkScript() string {
+	if m != nil {
+		return m.PkScript
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// SubmitBlockHeaders submits bitcoin block headers to the side chain.
-	SubmitBlockHeaders(context.Context, *MsgSubmitBlockHeaderRequest) (*MsgSubmitBlockHeadersResponse, error)
-	// SubmitDepositTransaction submits bitcoin transaction to the side chain.
-	SubmitDepositTransaction(context.Context, *MsgSubmitDepositTransactionRequest) (*MsgSubmitDepositTransactionResponse, error)
-	// SubmitWithdrawalTransaction submits bitcoin transaction to the side chain.
-	SubmitWithdrawTransaction(context.Context, *MsgSubmitWithdrawTransactionRequest) (*MsgSubmitWithdrawTransactionResponse, error)
-	// UpdateSenders updates the senders of the side chain.
-	UpdateQualifiedRelayers(context.Context, *MsgUpdateQualifiedRelayersRequest) (*MsgUpdateQualifiedRelayersResponse, error)
-	// WithdrawBitcoin withdraws the bitcoin from the side chain.
-	WithdrawBitcoin(context.Context, *MsgWithdrawBitcoinRequest) (*MsgWithdrawBitcoinResponse, error)
-	// SubmitWithdrawSignatures submits the signatures of the withdraw transaction.
-	SubmitWithdrawSignatures(context.Context, *MsgSubmitWithdrawSignaturesRequest) (*MsgSubmitWithdrawSignaturesResponse, error)
-	// SubmitWithdrawStatus submits the status of the withdraw transaction.
-	SubmitWithdrawStatus(context.Context, *MsgSubmitWithdrawStatusRequest) (*MsgSubmitWithdrawStatusResponse, error)
+func (m *MsgCreateHTLCRequest) GetBtcAmount() uint64 {
+	if m != nil {
+		return m.BtcAmount
+	}
+	return 0
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgCreateHTLCRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
 }
 
-func (*UnimplementedMsgServer) SubmitBlockHeaders(ctx context.Context, req *MsgSubmitBlockHeaderRequest) (*MsgSubmitBlockHeadersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitBlockHeaders not implemented")
+// MsgCreateHTLCResponse defines the Msg/CreateHTLC response type.
+type MsgCreateHTLCResponse struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
-func (*UnimplementedMsgServer) SubmitDepositTransaction(ctx context.Context, req *MsgSubmitDepositTransactionRequest) (*MsgSubmitDepositTransactionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitDepositTransaction not implemented")
+
+func (m *MsgCreateHTLCResponse) Reset()         { *m = MsgCreateHTLCResponse{} }
+func (m *MsgCreateHTLCResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateHTLCResponse) ProtoMessage()    {}
+func (*MsgCreateHTLCResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_785ca8e1e4227068, []int{18}
 }
-func (*UnimplementedMsgServer) SubmitWithdrawTransaction(ctx context.Context, req *MsgSubmitWithdrawTransactionRequest) (*MsgSubmitWithdrawTransactionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitWithdrawTransaction not implemented")
+func (m *MsgCreateHTLCResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) UpdateQualifiedRelayers(ctx context.Context, req *MsgUpdateQualifiedRelayersRequest) (*MsgUpdateQualifiedRelayersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateQualifiedRelayers not implemented")
+func (m *MsgCreateHTLCResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateHTLCResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) WithdrawBitcoin(ctx context.Context, req *MsgWithdrawBitcoinRequest) (*MsgWithdrawBitcoinResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawBitcoin not implemented")
+func (m *MsgCreateHTLCResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateHTLCResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) SubmitWithdrawSignatures(ctx context.Context, req *MsgSubmitWithdrawSignaturesRequest) (*MsgSubmitWithdrawSignaturesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitWithdrawSignatures not implemented")
+func (m *MsgCreateHTLCResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) SubmitWithdrawStatus(ctx context.Context, req *MsgSubmitWithdrawStatusRequest) (*MsgSubmitWithdrawStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitWithdrawStatus not implemented")
+func (m *MsgCreateHTLCResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateHTLCResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
-}
+var xxx_messageInfo_MsgCreateHTLCResponse proto.InternalMessageInfo
 
-func _Msg_SubmitBlockHeaders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitBlockHeaderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitBlockHeaders(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/side.btcbridge.Msg/SubmitBlockHeaders",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitBlockHeaders(ctx, req.(*MsgSubmitBlockHeaderRequest))
+func (m *MsgCreateHTLCResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_SubmitDepositTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitDepositTransactionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitDepositTransaction(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/side.btcbridge.Msg/SubmitDepositTransaction",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitDepositTransaction(ctx, req.(*MsgSubmitDepositTransactionRequest))
+// MsgClaimHTLCRequest defines the Msg/ClaimHTLC request type.
+// Anyone may submit the claim, the tokens always go to the taker of the HTLC.
+type MsgClaimHTLCRequest struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Id     uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// the hex encoded preimage of the hash lock
+	Preimage string `protobuf:"bytes,3,opt,name=preimage,proto3" json:"preimage,omitempty"`
+	// the hash of the bitcoin block including the payment
+	Blockhash string `protobuf:"bytes,4,opt,name=blockhash,proto3" json:"blockhash,omitempty"`
+	// the base64 encoded bitcoin tx paying the expected output
+	TxBytes string `protobuf:"bytes,5,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	// the merkle proof of the tx in the block
+	Proof []string `protobuf:"bytes,6,rep,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *MsgClaimHTLCRequest) Reset()         { *m = MsgClaimHTLCRequest{} }
+func (m *MsgClaimHTLCRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimHTLCRequest) ProtoMessage()    {}
+func (*MsgClaimHTLCRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_785ca8e1e4227068, []int{19}
+}
+func (m *MsgClaimHTLCRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimHTLCRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimHTLCRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgClaimHTLCRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimHTLCRequest.Merge(m, src)
+}
+func (m *MsgClaimHTLCRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimHTLCRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimHTLCRequest.DiscardUnknown(m)
 }
 
-func _Msg_SubmitWithdrawTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitWithdrawTransactionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitWithdrawTransaction(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/side.btcbridge.Msg/SubmitWithdrawTransaction",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitWithdrawTransaction(ctx, req.(*MsgSubmitWithdrawTransactionRequest))
+var xxx_messageInfo_MsgClaimHTLCRequest proto.InternalMessageInfo
+
+func (m *MsgClaimHTLCRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateQualifiedRelayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateQualifiedRelayersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateQualifiedRelayers(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/side.btcbridge.Msg/UpdateQualifiedRelayers",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateQualifiedRelayers(ctx, req.(*MsgUpdateQualifiedRelayersRequest))
+func (m *MsgClaimHTLCRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_WithdrawBitcoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdrawBitcoinRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WithdrawBitcoin(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/side.btcbridge.Msg/WithdrawBitcoin",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WithdrawBitcoin(ctx, req.(*MsgWithdrawBitcoinRequest))
+func (m *MsgClaimHTLCRequest) GetPreimage() string {
+	if m != nil {
+		return m.Preimage
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_SubmitWithdrawSignatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitWithdrawSignaturesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitWithdrawSignatures(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/side.btcbridge.Msg/SubmitWithdrawSignatures",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitWithdrawSignatures(ctx, req.(*MsgSubmitWithdrawSignaturesRequest))
+func (m *MsgClaimHTLCRequest) GetBlockhash() string {
+	if m != nil {
+		return m.Blockhash
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_SubmitWithdrawStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitWithdrawStatusRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitWithdrawStatus(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/side.btcbridge.Msg/SubmitWithdrawStatus",
+func (m *MsgClaimHTLCRequest) GetTxBytes() string {
+	if m != nil {
+		return m.TxBytes
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitWithdrawStatus(ctx, req.(*MsgSubmitWithdrawStatusRequest))
+	return ""
+}
+
+func (m *MsgClaimHTLCRequest) GetProof() []string {
+	if m != nil {
+		return m.Proof
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "side.btcbridge.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SubmitBlockHeaders",
-			Handler:    _Msg_SubmitBlockHeaders_Handler,
-		},
-		{
-			MethodName: "SubmitDepositTransaction",
-			Handler:    _Msg_SubmitDepositTransaction_Handler,
-		},
-		{
-			MethodName: "SubmitWithdrawTransaction",
-			Handler:    _Msg_SubmitWithdrawTransaction_Handler,
-		},
-		{
-			MethodName: "UpdateQualifiedRelayers",
-			Handler:    _Msg_UpdateQualifiedRelayers_Handler,
-		},
-		{
-			MethodName: "WithdrawBitcoin",
-			Handler:    _Msg_WithdrawBitcoin_Handler,
-		},
-		{
-			MethodName: "SubmitWithdrawSignatures",
-			Handler:    _Msg_SubmitWithdrawSignatures_Handler,
-		},
-		{
-			MethodName: "SubmitWithdrawStatus",
-			Handler:    _Msg_SubmitWithdrawStatus_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "side/btcbridge/tx.proto",
+// MsgClaimHTLCResponse defines the Msg/ClaimHTLC response type.
+type MsgClaimHTLCResponse struct {
 }
 
-func (m *MsgSubmitWithdrawStatusRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgClaimHTLCResponse) Reset()         { *m = MsgClaimHTLCResponse{} }
+func (m *MsgClaimHTLCResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimHTLCResponse) ProtoMessage()    {}
+func (*MsgClaimHTLCResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_785ca8e1e4227068, []int{20}
+}
+func (m *MsgClaimHTLCResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimHTLCResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimHTLCResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgClaimHTLCResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimHTLCResponse.Merge(m, src)
+}
+func (m *MsgClaimHTLCResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimHTLCResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimHTLCResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSubmitWithdrawStatusRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgClaimHTLCResponse proto.InternalMessageInfo
+
+// MsgUpdateParamsRequest defines the Msg/UpdateParams request type.
+type MsgUpdateParamsRequest struct {
+	// the governance account
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Params    Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 }
 
-func (m *MsgSubmitWithdrawStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Status != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Txid) > 0 {
-		i -= len(m.Txid)
-		copy(dAtA[i:], m.Txid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Txid)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgUpdateParamsRequest) Reset()         { *m = MsgUpdateParamsRequest{} }
+func (m *MsgUpdateParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsRequest) ProtoMessage()    {}
+func (*MsgUpdateParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_785ca8e1e4227068, []int{21}
+}
+func (m *MsgUpdateParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgUpdateParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsRequest.Merge(m, src)
+}
+func (m *MsgUpdateParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsRequest.DiscardUnknown(m)
 }
 
-func (m *MsgSubmitWithdrawStatusResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateParamsRequest proto.InternalMessageInfo
+
+func (m *MsgUpdateParamsRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgSubmitWithdrawStatusResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateParamsRequest) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
 }
 
-func (m *MsgSubmitWithdrawStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgUpdateParamsResponse defines the Msg/UpdateParams response type.
+type MsgUpdateParamsResponse struct {
 }
 
-func (m *MsgSubmitBlockHeaderRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_785ca8e1e4227068, []int{22}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSubmitBlockHeaderRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+// MsgUpdateBlocklistRequest defines the Msg/UpdateBlocklist request type.
+type MsgUpdateBlocklistRequest struct {
+	// the governance account
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// the bitcoin addresses or side accounts to block
+	Add []string `protobuf:"bytes,2,rep,name=add,proto3" json:"add,omitempty"`
+	// the bitcoin addresses or side accounts to unblock
+	Remove []string `protobuf:"bytes,3,rep,name=remove,proto3" json:"remove,omitempty"`
 }
 
-func (m *MsgSubmitBlockHeaderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BlockHeaders) > 0 {
-		for iNdEx := len(m.BlockHeaders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BlockHeaders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *MsgUpdateBlocklistRequest) Reset()         { *m = MsgUpdateBlocklistRequest{} }
+func (m *MsgUpdateBlocklistRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateBlocklistRequest) ProtoMessage()    {}
+func (*MsgUpdateBlocklistRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_785ca8e1e4227068, []int{23}
+}
+func (m *MsgUpdateBlocklistRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateBlocklistRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateBlocklistRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgUpdateBlocklistRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateBlocklistRequest.Merge(m, src)
+}
+func (m *MsgUpdateBlocklistRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateBlocklistRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateBlocklistRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateBlocklistRequest proto.InternalMessageInfo
+
+func (m *MsgUpdateBlocklistRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgSubmitBlockHeadersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateBlocklistRequest) GetAdd() []string {
+	if m != nil {
+		return m.Add
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgSubmitBlockHeadersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateBlocklistRequest) GetRemove() []string {
+	if m != nil {
+		return m.Remove
+	}
+	return nil
 }
 
-func (m *MsgSubmitBlockHeadersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgUpdateBlocklistResponse defines the Msg/UpdateBlocklist response type.
+type MsgUpdateBlocklistResponse struct {
 }
 
-func (m *MsgSubmitDepositTransactionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateBlocklistResponse) Reset()         { *m = MsgUpdateBlocklistResponse{} }
+func (m *MsgUpdateBlocklistResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateBlocklistResponse) ProtoMessage()    {}
+func (*MsgUpdateBlocklistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_785ca8e1e4227068, []int{24}
+}
+func (m *MsgUpdateBlocklistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateBlocklistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateBlocklistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUpdateBlocklistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateBlocklistResponse.Merge(m, src)
+}
+func (m *MsgUpdateBlocklistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateBlocklistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateBlocklistResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSubmitDepositTransactionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateBlocklistResponse proto.InternalMessageInfo
+
+// MsgReleaseQuarantinedDepositRequest defines the Msg/ReleaseQuarantinedDeposit request type.
+type MsgReleaseQuarantinedDepositRequest struct {
+	// the governance account or the screening admin
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Id     uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *MsgSubmitDepositTransactionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Proof) > 0 {
-		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Proof[iNdEx])
-			copy(dAtA[i:], m.Proof[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Proof[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
+func (m *MsgReleaseQuarantinedDepositRequest) Reset()         { *m = MsgReleaseQuarantinedDepositRequest{} }
+func (m *MsgReleaseQuarantinedDepositRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgReleaseQuarantinedDepositRequest) ProtoMessage()    {}
+func (*MsgReleaseQuarantinedDepositRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_785ca8e1e4227068, []int{25}
+}
+func (m *MsgReleaseQuarantinedDepositRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReleaseQuarantinedDepositRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReleaseQuarantinedDepositRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.TxBytes) > 0 {
-		i -= len(m.TxBytes)
-		copy(dAtA[i:], m.TxBytes)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TxBytes)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.PrevTxBytes) > 0 {
-		i -= len(m.PrevTxBytes)
-		copy(dAtA[i:], m.PrevTxBytes)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PrevTxBytes)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Blockhash) > 0 {
-		i -= len(m.Blockhash)
-		copy(dAtA[i:], m.Blockhash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Blockhash)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgReleaseQuarantinedDepositRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReleaseQuarantinedDepositRequest.Merge(m, src)
+}
+func (m *MsgReleaseQuarantinedDepositRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReleaseQuarantinedDepositRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReleaseQuarantinedDepositRequest.DiscardUnknown(m)
 }
 
-func (m *MsgSubmitDepositTransactionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgReleaseQuarantinedDepositRequest proto.InternalMessageInfo
+
+func (m *MsgReleaseQuarantinedDepositRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgSubmitDepositTransactionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgReleaseQuarantinedDepositRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *MsgSubmitDepositTransactionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgReleaseQuarantinedDepositResponse defines the Msg/ReleaseQuarantinedDeposit response type.
+type MsgReleaseQuarantinedDepositResponse struct {
 }
 
-func (m *MsgSubmitWithdrawTransactionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgReleaseQuarantinedDepositResponse) Reset()         { *m = MsgReleaseQuarantinedDepositResponse{} }
+func (m *MsgReleaseQuarantinedDepositResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReleaseQuarantinedDepositResponse) ProtoMessage()    {}
+func (*MsgReleaseQuarantinedDepositResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_785ca8e1e4227068, []int{26}
+}
+func (m *MsgReleaseQuarantinedDepositResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReleaseQuarantinedDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReleaseQuarantinedDepositResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgReleaseQuarantinedDepositResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReleaseQuarantinedDepositResponse.Merge(m, src)
+}
+func (m *MsgReleaseQuarantinedDepositResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReleaseQuarantinedDepositResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReleaseQuarantinedDepositResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSubmitWithdrawTransactionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgReleaseQuarantinedDepositResponse proto.InternalMessageInfo
+
+// MsgReturnQuarantinedDepositRequest defines the Msg/ReturnQuarantinedDeposit request type.
+type MsgReturnQuarantinedDepositRequest struct {
+	// the governance account or the screening admin
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Id     uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// the address to return the deposit to, the sender of the deposit by default
+	BtcAddress string `protobuf:"bytes,3,opt,name=btc_address,json=btcAddress,proto3" json:"btc_address,omitempty"`
+	// the network fee rate in sat/vbyte, deducted from the returned amount
+	FeeRate int64 `protobuf:"varint,4,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
 }
 
-func (m *MsgSubmitWithdrawTransactionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Proof) > 0 {
-		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Proof[iNdEx])
-			copy(dAtA[i:], m.Proof[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Proof[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
+func (m *MsgReturnQuarantinedDepositRequest) Reset()         { *m = MsgReturnQuarantinedDepositRequest{} }
+func (m *MsgReturnQuarantinedDepositRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgReturnQuarantinedDepositRequest) ProtoMessage()    {}
+func (*MsgReturnQuarantinedDepositRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_785ca8e1e4227068, []int{27}
+}
+func (m *MsgReturnQuarantinedDepositRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReturnQuarantinedDepositRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReturnQuarantinedDepositRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.TxBytes) > 0 {
-		i -= len(m.TxBytes)
-		copy(dAtA[i:], m.TxBytes)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TxBytes)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Blockhash) > 0 {
-		i -= len(m.Blockhash)
-		copy(dAtA[i:], m.Blockhash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Blockhash)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgReturnQuarantinedDepositRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReturnQuarantinedDepositRequest.Merge(m, src)
+}
+func (m *MsgReturnQuarantinedDepositRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReturnQuarantinedDepositRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReturnQuarantinedDepositRequest.DiscardUnknown(m)
 }
 
-func (m *MsgSubmitWithdrawTransactionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgReturnQuarantinedDepositRequest proto.InternalMessageInfo
+
+func (m *MsgReturnQuarantinedDepositRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgSubmitWithdrawTransactionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgReturnQuarantinedDepositRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *MsgSubmitWithdrawTransactionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgReturnQuarantinedDepositRequest) GetBtcAddress() string {
+	if m != nil {
+		return m.BtcAddress
+	}
+	return ""
 }
 
-func (m *MsgUpdateQualifiedRelayersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgReturnQuarantinedDepositRequest) GetFeeRate() int64 {
+	if m != nil {
+		return m.FeeRate
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgUpdateQualifiedRelayersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgReturnQuarantinedDepositResponse defines the Msg/ReturnQuarantinedDeposit response type.
+type MsgReturnQuarantinedDepositResponse struct {
 }
 
-func (m *MsgUpdateQualifiedRelayersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Relayers) > 0 {
-		for iNdEx := len(m.Relayers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Relayers[iNdEx])
-			copy(dAtA[i:], m.Relayers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Relayers[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+func (m *MsgReturnQuarantinedDepositResponse) Reset()         { *m = MsgReturnQuarantinedDepositResponse{} }
+func (m *MsgReturnQuarantinedDepositResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReturnQuarantinedDepositResponse) ProtoMessage()    {}
+func (*MsgReturnQuarantinedDepositResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_785ca8e1e4227068, []int{28}
+}
+func (m *MsgReturnQuarantinedDepositResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReturnQuarantinedDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReturnQuarantinedDepositResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgUpdateQualifiedRelayersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgReturnQuarantinedDepositResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReturnQuarantinedDepositResponse.Merge(m, src)
 }
-
-func (m *MsgUpdateQualifiedRelayersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgReturnQuarantinedDepositResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReturnQuarantinedDepositResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReturnQuarantinedDepositResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateQualifiedRelayersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgReturnQuarantinedDepositResponse proto.InternalMessageInfo
+
+// MsgRecoverVaultRequest defines the Msg/RecoverVault request type.
+type MsgRecoverVaultRequest struct {
+	// the governance account
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// the bridged chain, empty for bitcoin
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// the taproot vault of which the signer committee is lost
+	VaultAddress string `protobuf:"bytes,3,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
+	// another vault of the same asset type to which the utxos are swept
+	Recipient string `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	FeeRate   int64  `protobuf:"varint,5,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
 }
 
-func (m *MsgWithdrawBitcoinRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRecoverVaultRequest) Reset()         { *m = MsgRecoverVaultRequest{} }
+func (m *MsgRecoverVaultRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgRecoverVaultRequest) ProtoMessage()    {}
+func (*MsgRecoverVaultRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_785ca8e1e4227068, []int{29}
+}
+func (m *MsgRecoverVaultRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecoverVaultRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecoverVaultRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgWithdrawBitcoinRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRecoverVaultRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecoverVaultRequest.Merge(m, src)
+}
+func (m *MsgRecoverVaultRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecoverVaultRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecoverVaultRequest.DiscardUnknown(m)
 }
 
-func (m *MsgWithdrawBitcoinRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FeeRate != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.FeeRate))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgRecoverVaultRequest proto.InternalMessageInfo
+
+func (m *MsgRecoverVaultRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgWithdrawBitcoinResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRecoverVaultRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgWithdrawBitcoinResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRecoverVaultRequest) GetVaultAddress() string {
+	if m != nil {
+		return m.VaultAddress
+	}
+	return ""
 }
 
-func (m *MsgWithdrawBitcoinResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRecoverVaultRequest) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
 }
 
-func (m *MsgSubmitWithdrawSignaturesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRecoverVaultRequest) GetFeeRate() int64 {
+	if m != nil {
+		return m.FeeRate
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgSubmitWithdrawSignaturesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRecoverVaultResponse defines the Msg/RecoverVault response type.
+type MsgRecoverVaultResponse struct {
+	// the txid of the recovery signing request
+	Txid string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
 }
 
-func (m *MsgSubmitWithdrawSignaturesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Psbt) > 0 {
-		i -= len(m.Psbt)
-		copy(dAtA[i:], m.Psbt)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Psbt)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Txid) > 0 {
-		i -= len(m.Txid)
-		copy(dAtA[i:], m.Txid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Txid)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgRecoverVaultResponse) Reset()         { *m = MsgRecoverVaultResponse{} }
+func (m *MsgRecoverVaultResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRecoverVaultResponse) ProtoMessage()    {}
+func (*MsgRecoverVaultResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_785ca8e1e4227068, []int{30}
+}
+func (m *MsgRecoverVaultResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecoverVaultResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecoverVaultResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgRecoverVaultResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecoverVaultResponse.Merge(m, src)
+}
+func (m *MsgRecoverVaultResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecoverVaultResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecoverVaultResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSubmitWithdrawSignaturesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRecoverVaultResponse proto.InternalMessageInfo
+
+func (m *MsgRecoverVaultResponse) GetTxid() string {
+	if m != nil {
+		return m.Txid
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgSubmitWithdrawSignaturesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgTagUTXORequest defines the Msg/TagUTXO request type.
+type MsgTagUTXORequest struct {
+	// the governance account
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// the bridged chain, empty for bitcoin
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Txid    string `protobuf:"bytes,3,opt,name=txid,proto3" json:"txid,omitempty"`
+	Vout    uint64 `protobuf:"varint,4,opt,name=vout,proto3" json:"vout,omitempty"`
+	// the number of the inscriptions carried by the utxo, replacing the detected ones
+	Inscriptions uint32 `protobuf:"varint,5,opt,name=inscriptions,proto3" json:"inscriptions,omitempty"`
+	// the runes carried by the utxo, replacing the detected ones
+	Runes []*RuneBalance `protobuf:"bytes,6,rep,name=runes,proto3" json:"runes,omitempty"`
 }
 
-func (m *MsgSubmitWithdrawSignaturesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgTagUTXORequest) Reset()         { *m = MsgTagUTXORequest{} }
+func (m *MsgTagUTXORequest) String() string { return proto.CompactTextString(m) }
+func (*MsgTagUTXORequest) ProtoMessage()    {}
+func (*MsgTagUTXORequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_785ca8e1e4227068, []int{31}
 }
-
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgTagUTXORequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (m *MsgSubmitWithdrawStatusRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Txid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Status != 0 {
-		n += 1 + sovTx(uint64(m.Status))
+func (m *MsgTagUTXORequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTagUTXORequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgTagUTXORequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTagUTXORequest.Merge(m, src)
+}
+func (m *MsgTagUTXORequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTagUTXORequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTagUTXORequest.DiscardUnknown(m)
 }
 
-func (m *MsgSubmitWithdrawStatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgTagUTXORequest proto.InternalMessageInfo
+
+func (m *MsgTagUTXORequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgSubmitBlockHeaderRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.BlockHeaders) > 0 {
-		for _, e := range m.BlockHeaders {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+func (m *MsgTagUTXORequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return n
+	return ""
 }
 
-func (m *MsgSubmitBlockHeadersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgTagUTXORequest) GetTxid() string {
+	if m != nil {
+		return m.Txid
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgSubmitDepositTransactionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Blockhash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.PrevTxBytes)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.TxBytes)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Proof) > 0 {
-		for _, s := range m.Proof {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+func (m *MsgTagUTXORequest) GetVout() uint64 {
+	if m != nil {
+		return m.Vout
 	}
-	return n
+	return 0
 }
 
-func (m *MsgSubmitDepositTransactionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgTagUTXORequest) GetInscriptions() uint32 {
+	if m != nil {
+		return m.Inscriptions
 	}
-	var l int
-	_ = l
-	return n
+	return 0
 }
 
-func (m *MsgSubmitWithdrawTransactionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Blockhash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.TxBytes)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Proof) > 0 {
-		for _, s := range m.Proof {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+func (m *MsgTagUTXORequest) GetRunes() []*RuneBalance {
+	if m != nil {
+		return m.Runes
 	}
-	return n
+	return nil
 }
 
-func (m *MsgSubmitWithdrawTransactionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+// MsgTagUTXOResponse defines the Msg/TagUTXO response type.
+type MsgTagUTXOResponse struct {
 }
 
-func (m *MsgUpdateQualifiedRelayersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Relayers) > 0 {
-		for _, s := range m.Relayers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+func (m *MsgTagUTXOResponse) Reset()         { *m = MsgTagUTXOResponse{} }
+func (m *MsgTagUTXOResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTagUTXOResponse) ProtoMessage()    {}
+func (*MsgTagUTXOResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_785ca8e1e4227068, []int{32}
+}
+func (m *MsgTagUTXOResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTagUTXOResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTagUTXOResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgTagUTXOResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTagUTXOResponse.Merge(m, src)
+}
+func (m *MsgTagUTXOResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTagUTXOResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTagUTXOResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateQualifiedRelayersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var xxx_messageInfo_MsgTagUTXOResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgSubmitWithdrawStatusRequest)(nil), "side.btcbridge.MsgSubmitWithdrawStatusRequest")
+	proto.RegisterType((*MsgSubmitWithdrawStatusResponse)(nil), "side.btcbridge.MsgSubmitWithdrawStatusResponse")
+	proto.RegisterType((*MsgSubmitBlockHeaderRequest)(nil), "side.btcbridge.MsgSubmitBlockHeaderRequest")
+	proto.RegisterType((*MsgSubmitBlockHeadersResponse)(nil), "side.btcbridge.MsgSubmitBlockHeadersResponse")
+	proto.RegisterType((*MsgSubmitDepositTransactionRequest)(nil), "side.btcbridge.MsgSubmitDepositTransactionRequest")
+	proto.RegisterType((*MsgSubmitDepositTransactionResponse)(nil), "side.btcbridge.MsgSubmitDepositTransactionResponse")
+	proto.RegisterType((*MsgSubmitWithdrawTransactionRequest)(nil), "side.btcbridge.MsgSubmitWithdrawTransactionRequest")
+	proto.RegisterType((*MsgSubmitWithdrawTransactionResponse)(nil), "side.btcbridge.MsgSubmitWithdrawTransactionResponse")
+	proto.RegisterType((*MsgUpdateQualifiedRelayersRequest)(nil), "side.btcbridge.MsgUpdateQualifiedRelayersRequest")
+	proto.RegisterType((*MsgUpdateQualifiedRelayersResponse)(nil), "side.btcbridge.MsgUpdateQualifiedRelayersResponse")
+	proto.RegisterType((*MsgWithdrawBitcoinRequest)(nil), "side.btcbridge.MsgWithdrawBitcoinRequest")
+	proto.RegisterType((*WithdrawRecipient)(nil), "side.btcbridge.WithdrawRecipient")
+	proto.RegisterType((*MsgWithdrawBitcoinResponse)(nil), "side.btcbridge.MsgWithdrawBitcoinResponse")
+	proto.RegisterType((*MsgSubmitWithdrawSignaturesRequest)(nil), "side.btcbridge.MsgSubmitWithdrawSignaturesRequest")
+	proto.RegisterType((*MsgSubmitWithdrawSignaturesResponse)(nil), "side.btcbridge.MsgSubmitWithdrawSignaturesResponse")
+	proto.RegisterType((*MsgLinkBitcoinAddressRequest)(nil), "side.btcbridge.MsgLinkBitcoinAddressRequest")
+	proto.RegisterType((*MsgLinkBitcoinAddressResponse)(nil), "side.btcbridge.MsgLinkBitcoinAddressResponse")
+	proto.RegisterType((*MsgCreateHTLCRequest)(nil), "side.btcbridge.MsgCreateHTLCRequest")
+	proto.RegisterType((*MsgCreateHTLCResponse)(nil), "side.btcbridge.MsgCreateHTLCResponse")
+	proto.RegisterType((*MsgClaimHTLCRequest)(nil), "side.btcbridge.MsgClaimHTLCRequest")
+	proto.RegisterType((*MsgClaimHTLCResponse)(nil), "side.btcbridge.MsgClaimHTLCResponse")
+	proto.RegisterType((*MsgUpdateParamsRequest)(nil), "side.btcbridge.MsgUpdateParamsRequest")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "side.btcbridge.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgUpdateBlocklistRequest)(nil), "side.btcbridge.MsgUpdateBlocklistRequest")
+	proto.RegisterType((*MsgUpdateBlocklistResponse)(nil), "side.btcbridge.MsgUpdateBlocklistResponse")
+	proto.RegisterType((*MsgReleaseQuarantinedDepositRequest)(nil), "side.btcbridge.MsgReleaseQuarantinedDepositRequest")
+	proto.RegisterType((*MsgReleaseQuarantinedDepositResponse)(nil), "side.btcbridge.MsgReleaseQuarantinedDepositResponse")
+	proto.RegisterType((*MsgReturnQuarantinedDepositRequest)(nil), "side.btcbridge.MsgReturnQuarantinedDepositRequest")
+	proto.RegisterType((*MsgReturnQuarantinedDepositResponse)(nil), "side.btcbridge.MsgReturnQuarantinedDepositResponse")
+	proto.RegisterType((*MsgRecoverVaultRequest)(nil), "side.btcbridge.MsgRecoverVaultRequest")
+	proto.RegisterType((*MsgRecoverVaultResponse)(nil), "side.btcbridge.MsgRecoverVaultResponse")
+	proto.RegisterType((*MsgTagUTXORequest)(nil), "side.btcbridge.MsgTagUTXORequest")
+	proto.RegisterType((*MsgTagUTXOResponse)(nil), "side.btcbridge.MsgTagUTXOResponse")
 }
 
-func (m *MsgWithdrawBitcoinRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func init() { proto.RegisterFile("side/btcbridge/tx.proto", fileDescriptor_785ca8e1e4227068) }
+
+var fileDescriptor_785ca8e1e4227068 = []byte{
+	// 1471 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x73, 0xdb, 0x44,
+	0x14, 0x8f, 0x6c, 0xe7, 0xc3, 0x2f, 0x1f, 0xd0, 0x25, 0x24, 0x8e, 0x92, 0xe6, 0x43, 0x49, 0xda,
+	0x50, 0x68, 0x32, 0x4d, 0xca, 0x9d, 0xa6, 0x1c, 0xc2, 0x4c, 0x3c, 0x0d, 0x4a, 0xda, 0x02, 0x17,
+	0xcf, 0x5a, 0xda, 0xc8, 0x3b, 0xb1, 0x25, 0x55, 0xbb, 0x4a, 0x93, 0x13, 0xcc, 0x30, 0xc3, 0x70,
+	0xec, 0xf0, 0x07, 0x70, 0x63, 0x86, 0x1b, 0xff, 0x46, 0x81, 0x4b, 0x8f, 0x9c, 0x18, 0xa6, 0xfd,
+	0x23, 0xb8, 0x32, 0x5a, 0xad, 0x65, 0x49, 0x96, 0x64, 0x9b, 0x32, 0xdc, 0xb4, 0x6f, 0xdf, 0xc7,
+	0xef, 0xbd, 0xdd, 0xf7, 0xf6, 0x67, 0xc3, 0x22, 0xa3, 0x26, 0xd9, 0x6b, 0x72, 0xa3, 0xe9, 0x51,
+	0xd3, 0x22, 0x7b, 0xfc, 0x6a, 0xd7, 0xf5, 0x1c, 0xee, 0xa0, 0xb9, 0x60, 0x63, 0x37, 0xda, 0x50,
+	0xe7, 0x2d, 0xc7, 0x72, 0xc4, 0xd6, 0x5e, 0xf0, 0x15, 0x6a, 0xa9, 0xcb, 0x29, 0x73, 0x17, 0x7b,
+	0xb8, 0xc3, 0xe4, 0xe6, 0x4a, 0x6a, 0xb3, 0x49, 0xb9, 0xe1, 0x50, 0x3b, 0xdc, 0xd5, 0x7e, 0x54,
+	0x60, 0xb5, 0xce, 0xac, 0x53, 0xbf, 0xd9, 0xa1, 0xfc, 0x29, 0xe5, 0x2d, 0xd3, 0xc3, 0xcf, 0x4f,
+	0x39, 0xe6, 0x3e, 0xd3, 0xc9, 0x33, 0x9f, 0x30, 0x8e, 0x16, 0x60, 0x82, 0x11, 0xdb, 0x24, 0x5e,
+	0x4d, 0x59, 0x57, 0x76, 0xaa, 0xba, 0x5c, 0x21, 0x04, 0x15, 0x7e, 0x45, 0xcd, 0x5a, 0x49, 0x48,
+	0xc5, 0x37, 0xfa, 0x18, 0x26, 0x98, 0x30, 0xae, 0x95, 0xd7, 0x95, 0x9d, 0xb9, 0xfd, 0x9b, 0xbb,
+	0xc9, 0x04, 0x76, 0x4f, 0xa9, 0x65, 0x53, 0xdb, 0x92, 0x11, 0xa4, 0x32, 0x5a, 0x82, 0x29, 0xa3,
+	0x85, 0xa9, 0xdd, 0xa0, 0x66, 0xad, 0x22, 0xdc, 0x4d, 0x8a, 0xf5, 0x67, 0xa6, 0xb6, 0x01, 0x6b,
+	0xb9, 0xf8, 0x98, 0xeb, 0xd8, 0x8c, 0x68, 0x3f, 0x28, 0xb0, 0x1c, 0xe9, 0x1c, 0xb6, 0x1d, 0xe3,
+	0xe2, 0x88, 0x60, 0x93, 0x78, 0x83, 0x12, 0xf8, 0x04, 0x66, 0x9b, 0x81, 0x76, 0xa3, 0x25, 0xd4,
+	0x59, 0xad, 0xb4, 0x5e, 0xde, 0x99, 0xde, 0x5f, 0x4e, 0x63, 0x8e, 0xbb, 0x9c, 0x69, 0xf6, 0x16,
+	0x49, 0xdc, 0xe5, 0x24, 0xee, 0x35, 0xb8, 0x99, 0x85, 0x29, 0x86, 0xba, 0x04, 0x5a, 0xa4, 0xf1,
+	0x29, 0x71, 0x1d, 0x46, 0xf9, 0x99, 0x87, 0x6d, 0x86, 0x0d, 0x4e, 0x1d, 0x7b, 0x10, 0xf8, 0x15,
+	0xa8, 0x0a, 0x28, 0x2d, 0xcc, 0x5a, 0xf2, 0x08, 0x7a, 0x02, 0xa4, 0xc1, 0xac, 0xeb, 0x91, 0xcb,
+	0x06, 0xbf, 0x6a, 0x34, 0xaf, 0x39, 0x61, 0x12, 0xdd, 0x74, 0x20, 0x3c, 0xbb, 0x3a, 0x0c, 0x44,
+	0x01, 0xf8, 0x68, 0x5b, 0x16, 0x9d, 0xcb, 0xad, 0x79, 0x18, 0x77, 0x3d, 0xc7, 0x39, 0xaf, 0x8d,
+	0xaf, 0x97, 0x77, 0xaa, 0x7a, 0xb8, 0x40, 0xeb, 0x30, 0xc3, 0xaf, 0x1a, 0x8e, 0xcf, 0x1b, 0xe1,
+	0xe6, 0x84, 0x30, 0x02, 0x7e, 0xf5, 0xc8, 0xe7, 0x27, 0x42, 0x23, 0x5e, 0x8f, 0xc9, 0x44, 0x3d,
+	0xd0, 0x16, 0xcc, 0x51, 0xdb, 0x15, 0xb6, 0x02, 0x17, 0xab, 0x4d, 0x09, 0xdf, 0x33, 0x42, 0x7a,
+	0x22, 0x70, 0x31, 0x6d, 0x1b, 0x36, 0x0b, 0x6b, 0x22, 0x6b, 0xf7, 0xab, 0x02, 0x9b, 0x7d, 0xb7,
+	0xe2, 0x3f, 0x2b, 0xde, 0xff, 0x5a, 0x18, 0xed, 0x16, 0x6c, 0x15, 0xa7, 0x22, 0x73, 0x7e, 0x0a,
+	0x1b, 0x75, 0x66, 0x3d, 0x76, 0x4d, 0xcc, 0xc9, 0xe7, 0x3e, 0x6e, 0xd3, 0x73, 0x4a, 0x4c, 0x9d,
+	0xb4, 0xf1, 0xb5, 0xb8, 0x55, 0xc5, 0x09, 0xab, 0x30, 0xe5, 0x49, 0x55, 0x71, 0xcb, 0xab, 0x7a,
+	0xb4, 0xd6, 0xb6, 0x40, 0x2b, 0x72, 0x2c, 0xc3, 0xff, 0xa6, 0xc0, 0x52, 0x9d, 0x59, 0x5d, 0x84,
+	0x87, 0xe1, 0x14, 0x19, 0x14, 0x77, 0x01, 0x26, 0x70, 0xc7, 0xf1, 0x6d, 0x2e, 0xab, 0x2c, 0x57,
+	0x41, 0x3d, 0xce, 0x09, 0x69, 0x78, 0x98, 0x13, 0x71, 0x35, 0xcb, 0xfa, 0xe4, 0x39, 0x21, 0x3a,
+	0xe6, 0x04, 0xad, 0xc1, 0x74, 0x93, 0x1b, 0x0d, 0x6c, 0x9a, 0x1e, 0x61, 0xdd, 0x03, 0x80, 0x26,
+	0x37, 0x1e, 0x84, 0x12, 0xf4, 0x00, 0xc0, 0x23, 0x06, 0x75, 0x29, 0xb1, 0x39, 0x13, 0x07, 0x31,
+	0xbd, 0xbf, 0x91, 0xee, 0xd9, 0x2e, 0x4e, 0xbd, 0xab, 0xa9, 0xc7, 0x8c, 0xb4, 0x63, 0xb8, 0xd1,
+	0xa7, 0x90, 0x0e, 0xac, 0xf4, 0x05, 0xce, 0x49, 0x46, 0x5b, 0x01, 0x35, 0xab, 0x32, 0xb2, 0x70,
+	0x5f, 0xc7, 0xda, 0x3c, 0x1a, 0x60, 0xd4, 0xb2, 0x31, 0xf7, 0x3d, 0xf2, 0xaf, 0x86, 0x2c, 0x82,
+	0x8a, 0xcb, 0x9a, 0x5c, 0xf6, 0xb4, 0xf8, 0x2e, 0x9a, 0xa0, 0xdb, 0xb0, 0x59, 0x08, 0x40, 0xe2,
+	0x7c, 0xa1, 0xc0, 0x4a, 0x9d, 0x59, 0xc7, 0xd4, 0xbe, 0x90, 0x29, 0xc8, 0xbc, 0x07, 0x41, 0x4c,
+	0xd5, 0xad, 0xd4, 0x57, 0xb7, 0x15, 0xa8, 0xb2, 0x6e, 0x3c, 0x09, 0xba, 0x27, 0x28, 0x42, 0x1e,
+	0xce, 0xd0, 0x2c, 0x44, 0x12, 0xf3, 0xdf, 0x0a, 0xcc, 0xd7, 0x99, 0xf5, 0xd0, 0x23, 0x98, 0x93,
+	0xa3, 0xb3, 0xe3, 0x87, 0x83, 0xb0, 0xce, 0xc3, 0x38, 0xc7, 0x17, 0xc4, 0x93, 0x28, 0xc3, 0x45,
+	0xec, 0x60, 0xcb, 0x89, 0x5b, 0xba, 0x0c, 0xd5, 0x60, 0x20, 0x34, 0x82, 0xc9, 0x20, 0xb1, 0x4d,
+	0x05, 0x82, 0x63, 0xc7, 0xb8, 0x40, 0xdb, 0x30, 0xc7, 0x69, 0x87, 0x04, 0x5d, 0xdf, 0x22, 0xd4,
+	0x6a, 0xf1, 0xda, 0xb8, 0xb8, 0xc8, 0xb3, 0x52, 0x7a, 0x24, 0x84, 0x81, 0x0f, 0xf7, 0xa2, 0xc1,
+	0x0c, 0x8f, 0xba, 0x5c, 0x0e, 0x86, 0x29, 0xf7, 0xe2, 0x54, 0xac, 0xd1, 0x4d, 0x00, 0x51, 0xba,
+	0x30, 0x78, 0x30, 0x18, 0x2a, 0x7a, 0x35, 0xa8, 0x5c, 0xd4, 0x25, 0x51, 0x69, 0xa6, 0x92, 0xa5,
+	0xb9, 0x0d, 0xef, 0xa7, 0x12, 0x0f, 0x4b, 0x82, 0xe6, 0xa0, 0x44, 0x4d, 0x91, 0x75, 0x45, 0x2f,
+	0x51, 0x53, 0xfb, 0x59, 0x81, 0xf7, 0x02, 0xcd, 0x36, 0xa6, 0x9d, 0x61, 0x2a, 0x14, 0xda, 0x97,
+	0xba, 0xf6, 0xc1, 0xe4, 0x70, 0x3d, 0x42, 0x3b, 0xd8, 0xea, 0x9e, 0x5d, 0xb4, 0x4e, 0x8e, 0xd1,
+	0x4a, 0xd1, 0x18, 0x1d, 0xcf, 0x19, 0xa3, 0x13, 0xb1, 0x31, 0xaa, 0x2d, 0xc0, 0x7c, 0x12, 0xa9,
+	0x3c, 0xe5, 0x36, 0x2c, 0x44, 0x03, 0xea, 0x44, 0x50, 0x9b, 0x6e, 0x12, 0x2b, 0x50, 0xc5, 0x3e,
+	0x6f, 0x39, 0x1e, 0xe5, 0xd7, 0x32, 0x8f, 0x9e, 0x00, 0xdd, 0x87, 0x89, 0x90, 0x09, 0x89, 0x74,
+	0xa6, 0xf7, 0x17, 0xd2, 0x43, 0x22, 0x74, 0x76, 0x58, 0x79, 0xf9, 0xe7, 0xda, 0x98, 0x2e, 0x75,
+	0xb5, 0x25, 0x58, 0xec, 0x8b, 0x26, 0x81, 0x18, 0xb0, 0x14, 0x6d, 0x89, 0x37, 0xbd, 0x4d, 0x19,
+	0x1f, 0x0e, 0xcb, 0xbb, 0x50, 0xc6, 0xa6, 0x29, 0x67, 0x6f, 0xf0, 0x19, 0x1c, 0x80, 0x47, 0x3a,
+	0xce, 0x65, 0x50, 0xd6, 0x40, 0x28, 0x57, 0x72, 0x9a, 0xf4, 0x05, 0x91, 0x10, 0xea, 0xa2, 0x99,
+	0x75, 0xd2, 0x26, 0x98, 0x05, 0xd3, 0xda, 0xc3, 0x36, 0xa7, 0x36, 0x31, 0xe5, 0x63, 0x39, 0xe2,
+	0xe9, 0xca, 0xc7, 0xa7, 0xc0, 0x9d, 0x0c, 0xfb, 0xbd, 0x22, 0xa6, 0x98, 0x4e, 0xb8, 0xef, 0xd9,
+	0x6f, 0x1d, 0x36, 0x3d, 0x32, 0xca, 0x7d, 0x23, 0x23, 0xfe, 0x3e, 0x54, 0x12, 0xef, 0x83, 0x1c,
+	0x67, 0xf9, 0x48, 0x24, 0xe2, 0x5f, 0x14, 0x71, 0x6b, 0x74, 0x62, 0x38, 0x97, 0xc4, 0x7b, 0x82,
+	0xfd, 0xf6, 0x90, 0x27, 0x15, 0x6f, 0xba, 0x52, 0x92, 0xc3, 0x6c, 0xc2, 0xec, 0x65, 0xe0, 0x28,
+	0x05, 0x7c, 0x46, 0x08, 0x63, 0xd3, 0x2e, 0x7a, 0x69, 0xba, 0x4d, 0x11, 0x09, 0x12, 0x89, 0x8d,
+	0x27, 0x13, 0xbb, 0x0b, 0x8b, 0x7d, 0x80, 0x65, 0x53, 0x77, 0x5f, 0x01, 0xa5, 0xf7, 0x0a, 0x68,
+	0xbf, 0x2b, 0x70, 0xa3, 0xce, 0xac, 0x33, 0x6c, 0x3d, 0x3e, 0xfb, 0xe2, 0xd1, 0x5b, 0xe7, 0xd6,
+	0x0d, 0x51, 0x4e, 0x3e, 0x34, 0x97, 0x8e, 0x1f, 0x66, 0x51, 0xd1, 0xc5, 0x37, 0xd2, 0x60, 0x86,
+	0xda, 0xe1, 0x38, 0xa3, 0x8e, 0x1d, 0x76, 0xf6, 0xac, 0x9e, 0x90, 0xa1, 0x7b, 0x30, 0xee, 0xf9,
+	0x36, 0x61, 0xa2, 0xbd, 0x33, 0x08, 0xb5, 0xee, 0xdb, 0xe4, 0x10, 0xb7, 0xb1, 0x6d, 0x10, 0x3d,
+	0xd4, 0xd4, 0xe6, 0x01, 0xc5, 0x93, 0x09, 0xf3, 0xde, 0xff, 0x69, 0x0e, 0xca, 0x75, 0x66, 0x21,
+	0x17, 0x50, 0x3f, 0x93, 0x46, 0x1f, 0xa6, 0xfd, 0x16, 0xfc, 0x08, 0x50, 0xef, 0x0e, 0xa3, 0x1c,
+	0xb5, 0x3a, 0xfa, 0x56, 0x81, 0x5a, 0x1e, 0x0d, 0x45, 0xfb, 0xb9, 0xbe, 0x72, 0x79, 0xbc, 0x7a,
+	0x30, 0x92, 0x8d, 0x44, 0xf1, 0x9d, 0x02, 0x4b, 0xb9, 0xcc, 0x10, 0xe5, 0xbb, 0xcc, 0xa7, 0xc4,
+	0xea, 0xfd, 0xd1, 0x8c, 0x24, 0x90, 0x6f, 0x14, 0x58, 0xcc, 0x61, 0x88, 0xe8, 0x5e, 0x86, 0xc7,
+	0x62, 0x9a, 0xaa, 0xee, 0x8f, 0x62, 0x22, 0x21, 0xb4, 0xe0, 0x9d, 0x14, 0xc5, 0x42, 0x1f, 0x64,
+	0xb8, 0xc9, 0x26, 0xa8, 0xea, 0x9d, 0x61, 0x54, 0xfb, 0xce, 0xbe, 0x9f, 0x2e, 0x15, 0x9c, 0x7d,
+	0x2e, 0xb9, 0x53, 0x0f, 0x46, 0xb2, 0x91, 0x28, 0x9e, 0xc3, 0x7c, 0xd6, 0xaf, 0x5e, 0xb4, 0x3b,
+	0xd8, 0x59, 0xfc, 0xe7, 0xbb, 0xba, 0x37, 0xb4, 0xbe, 0x0c, 0xfc, 0x0c, 0x50, 0x3f, 0xe5, 0x42,
+	0x1f, 0x65, 0xb8, 0xc9, 0xe5, 0x8a, 0xea, 0xdd, 0x21, 0xb5, 0x65, 0xc8, 0x2f, 0x01, 0x7a, 0x54,
+	0x06, 0x6d, 0x65, 0x18, 0xf7, 0x51, 0x3c, 0x75, 0x7b, 0x80, 0x96, 0x74, 0xfd, 0x04, 0xaa, 0x11,
+	0xa3, 0x40, 0x9b, 0x59, 0x36, 0x29, 0x66, 0xa4, 0x6e, 0x15, 0x2b, 0x49, 0xbf, 0x0d, 0x98, 0x89,
+	0x73, 0x04, 0x74, 0x2b, 0xf7, 0x4a, 0x27, 0x28, 0x8b, 0x7a, 0x7b, 0xa0, 0x5e, 0xef, 0xbe, 0xa7,
+	0x48, 0x40, 0xe6, 0x7d, 0xcf, 0x66, 0x23, 0xea, 0x9d, 0x61, 0x54, 0x63, 0x53, 0x26, 0x97, 0x02,
+	0x64, 0x4e, 0x99, 0x41, 0xfc, 0x43, 0xbd, 0x3f, 0x9a, 0x51, 0xac, 0xf1, 0xf2, 0x1e, 0xf6, 0xcc,
+	0xc6, 0x1b, 0xc0, 0x47, 0xd4, 0x83, 0x91, 0x6c, 0x7a, 0x27, 0x1b, 0x7f, 0x84, 0x33, 0x4f, 0x36,
+	0x83, 0x56, 0xa8, 0xb7, 0x07, 0xea, 0xc9, 0x00, 0x27, 0x30, 0x29, 0x1f, 0x3a, 0xb4, 0x91, 0x61,
+	0x93, 0x7c, 0xd1, 0x55, 0xad, 0x48, 0x25, 0xf4, 0x78, 0x78, 0xf4, 0xf2, 0xf5, 0xaa, 0xf2, 0xea,
+	0xf5, 0xaa, 0xf2, 0xd7, 0xeb, 0x55, 0xe5, 0xc5, 0x9b, 0xd5, 0xb1, 0x57, 0x6f, 0x56, 0xc7, 0xfe,
+	0x78, 0xb3, 0x3a, 0xf6, 0xd5, 0xae, 0x45, 0x79, 0xcb, 0x6f, 0xee, 0x1a, 0x4e, 0x67, 0x2f, 0xf0,
+	0x23, 0xfe, 0xf5, 0x33, 0x9c, 0xb6, 0x58, 0xec, 0x5d, 0xc5, 0xff, 0x73, 0xbc, 0x76, 0x09, 0x6b,
+	0x4e, 0x08, 0x85, 0x83, 0x7f, 0x06, 0x00, 0x65, 0xf2, 0x4c, 0xeb, 0x92, 0x14, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// SubmitBlockHeaders submits bitcoin block headers to the side chain.
+	SubmitBlockHeaders(ctx context.Context, in *MsgSubmitBlockHeaderRequest, opts ...grpc.CallOption) (*MsgSubmitBlockHeadersResponse, error)
+	// SubmitDepositTransaction submits bitcoin transaction to the side chain.
+	SubmitDepositTransaction(ctx context.Context, in *MsgSubmitDepositTransactionRequest, opts ...grpc.CallOption) (*MsgSubmitDepositTransactionResponse, error)
+	// SubmitWithdrawalTransaction submits bitcoin transaction to the side chain.
+	SubmitWithdrawTransaction(ctx context.Context, in *MsgSubmitWithdrawTransactionRequest, opts ...grpc.CallOption) (*MsgSubmitWithdrawTransactionResponse, error)
+	// UpdateSenders updates the senders of the side chain.
+	UpdateQualifiedRelayers(ctx context.Context, in *MsgUpdateQualifiedRelayersRequest, opts ...grpc.CallOption) (*MsgUpdateQualifiedRelayersResponse, error)
+	// WithdrawBitcoin withdraws the bitcoin from the side chain.
+	WithdrawBitcoin(ctx context.Context, in *MsgWithdrawBitcoinRequest, opts ...grpc.CallOption) (*MsgWithdrawBitcoinResponse, error)
+	// SubmitWithdrawSignatures submits the signatures of the withdraw transaction.
+	SubmitWithdrawSignatures(ctx context.Context, in *MsgSubmitWithdrawSignaturesRequest, opts ...grpc.CallOption) (*MsgSubmitWithdrawSignaturesResponse, error)
+	// SubmitWithdrawStatus submits the status of the withdraw transaction.
+	SubmitWithdrawStatus(ctx context.Context, in *MsgSubmitWithdrawStatusRequest, opts ...grpc.CallOption) (*MsgSubmitWithdrawStatusResponse, error)
+	// LinkBitcoinAddress links the bitcoin address to the sender by proving the ownership of the address.
+	LinkBitcoinAddress(ctx context.Context, in *MsgLinkBitcoinAddressRequest, opts ...grpc.CallOption) (*MsgLinkBitcoinAddressResponse, error)
+	// CreateHTLC locks the tokens of the maker in a hashed timelock contract against a bitcoin payment.
+	CreateHTLC(ctx context.Context, in *MsgCreateHTLCRequest, opts ...grpc.CallOption) (*MsgCreateHTLCResponse, error)
+	// ClaimHTLC claims the tokens of the HTLC for the taker by the preimage and the proof of the bitcoin payment.
+	ClaimHTLC(ctx context.Context, in *MsgClaimHTLCRequest, opts ...grpc.CallOption) (*MsgClaimHTLCResponse, error)
+	// UpdateParams updates the module params by the governance.
+	UpdateParams(ctx context.Context, in *MsgUpdateParamsRequest, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// UpdateBlocklist adds or removes the blocked bitcoin addresses and side accounts by the governance.
+	UpdateBlocklist(ctx context.Context, in *MsgUpdateBlocklistRequest, opts ...grpc.CallOption) (*MsgUpdateBlocklistResponse, error)
+	// ReleaseQuarantinedDeposit credits the quarantined deposit to the recipient.
+	ReleaseQuarantinedDeposit(ctx context.Context, in *MsgReleaseQuarantinedDepositRequest, opts ...grpc.CallOption) (*MsgReleaseQuarantinedDepositResponse, error)
+	// ReturnQuarantinedDeposit sends the quarantined deposit back on the bridged chain.
+	ReturnQuarantinedDeposit(ctx context.Context, in *MsgReturnQuarantinedDepositRequest, opts ...grpc.CallOption) (*MsgReturnQuarantinedDepositResponse, error)
+	// RecoverVault sweeps the utxos of a taproot vault to another vault by the recovery script path by the governance.
+	RecoverVault(ctx context.Context, in *MsgRecoverVaultRequest, opts ...grpc.CallOption) (*MsgRecoverVaultResponse, error)
+	// TagUTXO sets the inscriptions and runes carried by a vault utxo by the governance, which are not detected by the deposit tx.
+	TagUTXO(ctx context.Context, in *MsgTagUTXORequest, opts ...grpc.CallOption) (*MsgTagUTXOResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SubmitBlockHeaders(ctx context.Context, in *MsgSubmitBlockHeaderRequest, opts ...grpc.CallOption) (*MsgSubmitBlockHeadersResponse, error) {
+	out := new(MsgSubmitBlockHeadersResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/SubmitBlockHeaders", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) SubmitDepositTransaction(ctx context.Context, in *MsgSubmitDepositTransactionRequest, opts ...grpc.CallOption) (*MsgSubmitDepositTransactionResponse, error) {
+	out := new(MsgSubmitDepositTransactionResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/SubmitDepositTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) SubmitWithdrawTransaction(ctx context.Context, in *MsgSubmitWithdrawTransactionRequest, opts ...grpc.CallOption) (*MsgSubmitWithdrawTransactionResponse, error) {
+	out := new(MsgSubmitWithdrawTransactionResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/SubmitWithdrawTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.FeeRate != 0 {
-		n += 1 + sovTx(uint64(m.FeeRate))
+	return out, nil
+}
+
+func (c *msgClient) UpdateQualifiedRelayers(ctx context.Context, in *MsgUpdateQualifiedRelayersRequest, opts ...grpc.CallOption) (*MsgUpdateQualifiedRelayersResponse, error) {
+	out := new(MsgUpdateQualifiedRelayersResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/UpdateQualifiedRelayers", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgWithdrawBitcoinResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) WithdrawBitcoin(ctx context.Context, in *MsgWithdrawBitcoinRequest, opts ...grpc.CallOption) (*MsgWithdrawBitcoinResponse, error) {
+	out := new(MsgWithdrawBitcoinResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/WithdrawBitcoin", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgSubmitWithdrawSignaturesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) SubmitWithdrawSignatures(ctx context.Context, in *MsgSubmitWithdrawSignaturesRequest, opts ...grpc.CallOption) (*MsgSubmitWithdrawSignaturesResponse, error) {
+	out := new(MsgSubmitWithdrawSignaturesResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/SubmitWithdrawSignatures", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) SubmitWithdrawStatus(ctx context.Context, in *MsgSubmitWithdrawStatusRequest, opts ...grpc.CallOption) (*MsgSubmitWithdrawStatusResponse, error) {
+	out := new(MsgSubmitWithdrawStatusResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/SubmitWithdrawStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Txid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) LinkBitcoinAddress(ctx context.Context, in *MsgLinkBitcoinAddressRequest, opts ...grpc.CallOption) (*MsgLinkBitcoinAddressResponse, error) {
+	out := new(MsgLinkBitcoinAddressResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/LinkBitcoinAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Psbt)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) CreateHTLC(ctx context.Context, in *MsgCreateHTLCRequest, opts ...grpc.CallOption) (*MsgCreateHTLCResponse, error) {
+	out := new(MsgCreateHTLCResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/CreateHTLC", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgSubmitWithdrawSignaturesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) ClaimHTLC(ctx context.Context, in *MsgClaimHTLCRequest, opts ...grpc.CallOption) (*MsgClaimHTLCResponse, error) {
+	out := new(MsgClaimHTLCResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/ClaimHTLC", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParamsRequest, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (c *msgClient) UpdateBlocklist(ctx context.Context, in *MsgUpdateBlocklistRequest, opts ...grpc.CallOption) (*MsgUpdateBlocklistResponse, error) {
+	out := new(MsgUpdateBlocklistResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/UpdateBlocklist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (m *MsgSubmitWithdrawStatusRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+
+func (c *msgClient) ReleaseQuarantinedDeposit(ctx context.Context, in *MsgReleaseQuarantinedDepositRequest, opts ...grpc.CallOption) (*MsgReleaseQuarantinedDepositResponse, error) {
+	out := new(MsgReleaseQuarantinedDepositResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/ReleaseQuarantinedDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReturnQuarantinedDeposit(ctx context.Context, in *MsgReturnQuarantinedDepositRequest, opts ...grpc.CallOption) (*MsgReturnQuarantinedDepositResponse, error) {
+	out := new(MsgReturnQuarantinedDepositResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/ReturnQuarantinedDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RecoverVault(ctx context.Context, in *MsgRecoverVaultRequest, opts ...grpc.CallOption) (*MsgRecoverVaultResponse, error) {
+	out := new(MsgRecoverVaultResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/RecoverVault", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TagUTXO(ctx context.Context, in *MsgTagUTXORequest, opts ...grpc.CallOption) (*MsgTagUTXOResponse, error) {
+	out := new(MsgTagUTXOResponse)
+	err := c.cc.Invoke(ctx, "/side.btcbridge.Msg/TagUTXO", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// SubmitBlockHeaders submits bitcoin block headers to the side chain.
+	SubmitBlockHeaders(context.Context, *MsgSubmitBlockHeaderRequest) (*MsgSubmitBlockHeadersResponse, error)
+	// SubmitDepositTransaction submits bitcoin transaction to the side chain.
+	SubmitDepositTransaction(context.Context, *MsgSubmitDepositTransactionRequest) (*MsgSubmitDepositTransactionResponse, error)
+	// SubmitWithdrawalTransaction submits bitcoin transaction to the side chain.
+	SubmitWithdrawTransaction(context.Context, *MsgSubmitWithdrawTransactionRequest) (*MsgSubmitWithdrawTransactionResponse, error)
+	// UpdateSenders updates the senders of the side chain.
+	UpdateQualifiedRelayers(context.Context, *MsgUpdateQualifiedRelayersRequest) (*MsgUpdateQualifiedRelayersResponse, error)
+	// WithdrawBitcoin withdraws the bitcoin from the side chain.
+	WithdrawBitcoin(context.Context, *MsgWithdrawBitcoinRequest) (*MsgWithdrawBitcoinResponse, error)
+	// SubmitWithdrawSignatures submits the signatures of the withdraw transaction.
+	SubmitWithdrawSignatures(context.Context, *MsgSubmitWithdrawSignaturesRequest) (*MsgSubmitWithdrawSignaturesResponse, error)
+	// SubmitWithdrawStatus submits the status of the withdraw transaction.
+	SubmitWithdrawStatus(context.Context, *MsgSubmitWithdrawStatusRequest) (*MsgSubmitWithdrawStatusResponse, error)
+	// LinkBitcoinAddress links the bitcoin address to the sender by proving the ownership of the address.
+	LinkBitcoinAddress(context.Context, *MsgLinkBitcoinAddressRequest) (*MsgLinkBitcoinAddressResponse, error)
+	// CreateHTLC locks the tokens of the maker in a hashed timelock contract against a bitcoin payment.
+	CreateHTLC(context.Context, *MsgCreateHTLCRequest) (*MsgCreateHTLCResponse, error)
+	// ClaimHTLC claims the tokens of the HTLC for the taker by the preimage and the proof of the bitcoin payment.
+	ClaimHTLC(context.Context, *MsgClaimHTLCRequest) (*MsgClaimHTLCResponse, error)
+	// UpdateParams updates the module params by the governance.
+	UpdateParams(context.Context, *MsgUpdateParamsRequest) (*MsgUpdateParamsResponse, error)
+	// UpdateBlocklist adds or removes the blocked bitcoin addresses and side accounts by the governance.
+	UpdateBlocklist(context.Context, *MsgUpdateBlocklistRequest) (*MsgUpdateBlocklistResponse, error)
+	// ReleaseQuarantinedDeposit credits the quarantined deposit to the recipient.
+	ReleaseQuarantinedDeposit(context.Context, *MsgReleaseQuarantinedDepositRequest) (*MsgReleaseQuarantinedDepositResponse, error)
+	// ReturnQuarantinedDeposit sends the quarantined deposit back on the bridged chain.
+	ReturnQuarantinedDeposit(context.Context, *MsgReturnQuarantinedDepositRequest) (*MsgReturnQuarantinedDepositResponse, error)
+	// RecoverVault sweeps the utxos of a taproot vault to another vault by the recovery script path by the governance.
+	RecoverVault(context.Context, *MsgRecoverVaultRequest) (*MsgRecoverVaultResponse, error)
+	// TagUTXO sets the inscriptions and runes carried by a vault utxo by the governance, which are not detected by the deposit tx.
+	TagUTXO(context.Context, *MsgTagUTXORequest) (*MsgTagUTXOResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) SubmitBlockHeaders(ctx context.Context, req *MsgSubmitBlockHeaderRequest) (*MsgSubmitBlockHeadersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitBlockHeaders not implemented")
+}
+func (*UnimplementedMsgServer) SubmitDepositTransaction(ctx context.Context, req *MsgSubmitDepositTransactionRequest) (*MsgSubmitDepositTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitDepositTransaction not implemented")
+}
+func (*UnimplementedMsgServer) SubmitWithdrawTransaction(ctx context.Context, req *MsgSubmitWithdrawTransactionRequest) (*MsgSubmitWithdrawTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitWithdrawTransaction not implemented")
+}
+func (*UnimplementedMsgServer) UpdateQualifiedRelayers(ctx context.Context, req *MsgUpdateQualifiedRelayersRequest) (*MsgUpdateQualifiedRelayersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateQualifiedRelayers not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawBitcoin(ctx context.Context, req *MsgWithdrawBitcoinRequest) (*MsgWithdrawBitcoinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawBitcoin not implemented")
+}
+func (*UnimplementedMsgServer) SubmitWithdrawSignatures(ctx context.Context, req *MsgSubmitWithdrawSignaturesRequest) (*MsgSubmitWithdrawSignaturesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitWithdrawSignatures not implemented")
+}
+func (*UnimplementedMsgServer) SubmitWithdrawStatus(ctx context.Context, req *MsgSubmitWithdrawStatusRequest) (*MsgSubmitWithdrawStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitWithdrawStatus not implemented")
+}
+func (*UnimplementedMsgServer) LinkBitcoinAddress(ctx context.Context, req *MsgLinkBitcoinAddressRequest) (*MsgLinkBitcoinAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkBitcoinAddress not implemented")
+}
+func (*UnimplementedMsgServer) CreateHTLC(ctx context.Context, req *MsgCreateHTLCRequest) (*MsgCreateHTLCResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateHTLC not implemented")
+}
+func (*UnimplementedMsgServer) ClaimHTLC(ctx context.Context, req *MsgClaimHTLCRequest) (*MsgClaimHTLCResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimHTLC not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParamsRequest) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) UpdateBlocklist(ctx context.Context, req *MsgUpdateBlocklistRequest) (*MsgUpdateBlocklistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBlocklist not implemented")
+}
+func (*UnimplementedMsgServer) ReleaseQuarantinedDeposit(ctx context.Context, req *MsgReleaseQuarantinedDepositRequest) (*MsgReleaseQuarantinedDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseQuarantinedDeposit not implemented")
+}
+func (*UnimplementedMsgServer) ReturnQuarantinedDeposit(ctx context.Context, req *MsgReturnQuarantinedDepositRequest) (*MsgReturnQuarantinedDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReturnQuarantinedDeposit not implemented")
+}
+func (*UnimplementedMsgServer) RecoverVault(ctx context.Context, req *MsgRecoverVaultRequest) (*MsgRecoverVaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverVault not implemented")
+}
+func (*UnimplementedMsgServer) TagUTXO(ctx context.Context, req *MsgTagUTXORequest) (*MsgTagUTXOResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TagUTXO not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_SubmitBlockHeaders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitBlockHeaderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitBlockHeaders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Msg/SubmitBlockHeaders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitBlockHeaders(ctx, req.(*MsgSubmitBlockHeaderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitDepositTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitDepositTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitDepositTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Msg/SubmitDepositTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitDepositTransaction(ctx, req.(*MsgSubmitDepositTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitWithdrawTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitWithdrawTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitWithdrawTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Msg/SubmitWithdrawTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitWithdrawTransaction(ctx, req.(*MsgSubmitWithdrawTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateQualifiedRelayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateQualifiedRelayersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateQualifiedRelayers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Msg/UpdateQualifiedRelayers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateQualifiedRelayers(ctx, req.(*MsgUpdateQualifiedRelayersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawBitcoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawBitcoinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawBitcoin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Msg/WithdrawBitcoin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawBitcoin(ctx, req.(*MsgWithdrawBitcoinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitWithdrawSignatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitWithdrawSignaturesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitWithdrawSignatures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Msg/SubmitWithdrawSignatures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitWithdrawSignatures(ctx, req.(*MsgSubmitWithdrawSignaturesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitWithdrawStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitWithdrawStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitWithdrawStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Msg/SubmitWithdrawStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitWithdrawStatus(ctx, req.(*MsgSubmitWithdrawStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_LinkBitcoinAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLinkBitcoinAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LinkBitcoinAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Msg/LinkBitcoinAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LinkBitcoinAddress(ctx, req.(*MsgLinkBitcoinAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateHTLC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateHTLCRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateHTLC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Msg/CreateHTLC",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateHTLC(ctx, req.(*MsgCreateHTLCRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimHTLC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimHTLCRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimHTLC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Msg/ClaimHTLC",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimHTLC(ctx, req.(*MsgClaimHTLCRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateBlocklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateBlocklistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateBlocklist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Msg/UpdateBlocklist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateBlocklist(ctx, req.(*MsgUpdateBlocklistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReleaseQuarantinedDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReleaseQuarantinedDepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReleaseQuarantinedDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Msg/ReleaseQuarantinedDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReleaseQuarantinedDeposit(ctx, req.(*MsgReleaseQuarantinedDepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReturnQuarantinedDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReturnQuarantinedDepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReturnQuarantinedDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Msg/ReturnQuarantinedDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReturnQuarantinedDeposit(ctx, req.(*MsgReturnQuarantinedDepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RecoverVault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRecoverVaultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RecoverVault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Msg/RecoverVault",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RecoverVault(ctx, req.(*MsgRecoverVaultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TagUTXO_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTagUTXORequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TagUTXO(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/side.btcbridge.Msg/TagUTXO",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TagUTXO(ctx, req.(*MsgTagUTXORequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "side.btcbridge.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SubmitBlockHeaders",
+			Handler:    _Msg_SubmitBlockHeaders_Handler,
+		},
+		{
+			MethodName: "SubmitDepositTransaction",
+			Handler:    _Msg_SubmitDepositTransaction_Handler,
+		},
+		{
+			MethodName: "SubmitWithdrawTransaction",
+			Handler:    _Msg_SubmitWithdrawTransaction_Handler,
+		},
+		{
+			MethodName: "UpdateQualifiedRelayers",
+			Handler:    _Msg_UpdateQualifiedRelayers_Handler,
+		},
+		{
+			MethodName: "WithdrawBitcoin",
+			Handler:    _Msg_WithdrawBitcoin_Handler,
+		},
+		{
+			MethodName: "SubmitWithdrawSignatures",
+			Handler:    _Msg_SubmitWithdrawSignatures_Handler,
+		},
+		{
+			MethodName: "SubmitWithdrawStatus",
+			Handler:    _Msg_SubmitWithdrawStatus_Handler,
+		},
+		{
+			MethodName: "LinkBitcoinAddress",
+			Handler:    _Msg_LinkBitcoinAddress_Handler,
+		},
+		{
+			MethodName: "CreateHTLC",
+			Handler:    _Msg_CreateHTLC_Handler,
+		},
+		{
+			MethodName: "ClaimHTLC",
+			Handler:    _Msg_ClaimHTLC_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "UpdateBlocklist",
+			Handler:    _Msg_UpdateBlocklist_Handler,
+		},
+		{
+			MethodName: "ReleaseQuarantinedDeposit",
+			Handler:    _Msg_ReleaseQuarantinedDeposit_Handler,
+		},
+		{
+			MethodName: "ReturnQuarantinedDeposit",
+			Handler:    _Msg_ReturnQuarantinedDeposit_Handler,
+		},
+		{
+			MethodName: "RecoverVault",
+			Handler:    _Msg_RecoverVault_Handler,
+		},
+		{
+			MethodName: "TagUTXO",
+			Handler:    _Msg_TagUTXO_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "side/btcbridge/tx.proto",
+}
+
+func (m *MsgSubmitWithdrawStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitWithdrawStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitWithdrawStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Txid) > 0 {
+		i -= len(m.Txid)
+		copy(dAtA[i:], m.Txid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Txid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitWithdrawStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitWithdrawStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitWithdrawStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitBlockHeaderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitBlockHeaderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitBlockHeaderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BlockHeaders) > 0 {
+		for iNdEx := len(m.BlockHeaders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockHeaders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitBlockHeadersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitBlockHeadersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitBlockHeadersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitDepositTransactionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitDepositTransactionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitDepositTransactionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InputPrevTxs) > 0 {
+		for iNdEx := len(m.InputPrevTxs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.InputPrevTxs[iNdEx])
+			copy(dAtA[i:], m.InputPrevTxs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.InputPrevTxs[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.TxOutProof) > 0 {
+		i -= len(m.TxOutProof)
+		copy(dAtA[i:], m.TxOutProof)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxOutProof)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PrevTxBytes) > 0 {
+		i -= len(m.PrevTxBytes)
+		copy(dAtA[i:], m.PrevTxBytes)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PrevTxBytes)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Blockhash) > 0 {
+		i -= len(m.Blockhash)
+		copy(dAtA[i:], m.Blockhash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Blockhash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitDepositTransactionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitDepositTransactionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitDepositTransactionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitWithdrawTransactionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitWithdrawTransactionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitWithdrawTransactionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.TxOutProof) > 0 {
+		i -= len(m.TxOutProof)
+		copy(dAtA[i:], m.TxOutProof)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxOutProof)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Blockhash) > 0 {
+		i -= len(m.Blockhash)
+		copy(dAtA[i:], m.Blockhash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Blockhash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitWithdrawTransactionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitWithdrawTransactionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitWithdrawTransactionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateQualifiedRelayersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateQualifiedRelayersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateQualifiedRelayersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Relayers) > 0 {
+		for iNdEx := len(m.Relayers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Relayers[iNdEx])
+			copy(dAtA[i:], m.Relayers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Relayers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateQualifiedRelayersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateQualifiedRelayersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateQualifiedRelayersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawBitcoinRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawBitcoinRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawBitcoinRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.BtcAddress) > 0 {
+		i -= len(m.BtcAddress)
+		copy(dAtA[i:], m.BtcAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BtcAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.FeeRate != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FeeRate))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WithdrawRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WithdrawRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WithdrawRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BtcAddress) > 0 {
+		i -= len(m.BtcAddress)
+		copy(dAtA[i:], m.BtcAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BtcAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawBitcoinResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawBitcoinResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawBitcoinResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitWithdrawSignaturesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitWithdrawSignaturesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitWithdrawSignaturesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Psbt) > 0 {
+		i -= len(m.Psbt)
+		copy(dAtA[i:], m.Psbt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Psbt)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Txid) > 0 {
+		i -= len(m.Txid)
+		copy(dAtA[i:], m.Txid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Txid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitWithdrawSignaturesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitWithdrawSignaturesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitWithdrawSignaturesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLinkBitcoinAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLinkBitcoinAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLinkBitcoinAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BtcAddress) > 0 {
+		i -= len(m.BtcAddress)
+		copy(dAtA[i:], m.BtcAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BtcAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLinkBitcoinAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLinkBitcoinAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLinkBitcoinAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateHTLCRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateHTLCRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateHTLCRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.BtcAmount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BtcAmount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.PkScript) > 0 {
+		i -= len(m.PkScript)
+		copy(dAtA[i:], m.PkScript)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PkScript)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.TimeoutHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.HashLock) > 0 {
+		i -= len(m.HashLock)
+		copy(dAtA[i:], m.HashLock)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HashLock)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Taker) > 0 {
+		i -= len(m.Taker)
+		copy(dAtA[i:], m.Taker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Taker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateHTLCResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateHTLCResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateHTLCResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimHTLCRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimHTLCRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimHTLCRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Blockhash) > 0 {
+		i -= len(m.Blockhash)
+		copy(dAtA[i:], m.Blockhash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Blockhash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Preimage) > 0 {
+		i -= len(m.Preimage)
+		copy(dAtA[i:], m.Preimage)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Preimage)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimHTLCResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimHTLCResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimHTLCResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateBlocklistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateBlocklistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateBlocklistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Remove) > 0 {
+		for iNdEx := len(m.Remove) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Remove[iNdEx])
+			copy(dAtA[i:], m.Remove[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Remove[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Add) > 0 {
+		for iNdEx := len(m.Add) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Add[iNdEx])
+			copy(dAtA[i:], m.Add[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Add[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateBlocklistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateBlocklistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateBlocklistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReleaseQuarantinedDepositRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReleaseQuarantinedDepositRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReleaseQuarantinedDepositRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReleaseQuarantinedDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReleaseQuarantinedDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReleaseQuarantinedDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReturnQuarantinedDepositRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReturnQuarantinedDepositRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReturnQuarantinedDepositRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FeeRate != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FeeRate))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.BtcAddress) > 0 {
+		i -= len(m.BtcAddress)
+		copy(dAtA[i:], m.BtcAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BtcAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReturnQuarantinedDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReturnQuarantinedDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReturnQuarantinedDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRecoverVaultRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRecoverVaultRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecoverVaultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FeeRate != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FeeRate))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRecoverVaultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRecoverVaultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecoverVaultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Txid) > 0 {
+		i -= len(m.Txid)
+		copy(dAtA[i:], m.Txid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Txid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTagUTXORequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTagUTXORequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTagUTXORequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Runes) > 0 {
+		for iNdEx := len(m.Runes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Runes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Inscriptions != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Inscriptions))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Vout != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Vout))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Txid) > 0 {
+		i -= len(m.Txid)
+		copy(dAtA[i:], m.Txid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Txid)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTagUTXOResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTagUTXOResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTagUTXOResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSubmitWithdrawStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Txid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitWithdrawStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitBlockHeaderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.BlockHeaders) > 0 {
+		for _, e := range m.BlockHeaders {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitBlockHeadersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitDepositTransactionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Blockhash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PrevTxBytes)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Proof) > 0 {
+		for _, s := range m.Proof {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.TxOutProof)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.InputPrevTxs) > 0 {
+		for _, s := range m.InputPrevTxs {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSubmitDepositTransactionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitWithdrawTransactionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Blockhash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Proof) > 0 {
+		for _, s := range m.Proof {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.TxOutProof)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitWithdrawTransactionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateQualifiedRelayersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Relayers) > 0 {
+		for _, s := range m.Relayers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateQualifiedRelayersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawBitcoinRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FeeRate != 0 {
+		n += 1 + sovTx(uint64(m.FeeRate))
+	}
+	l = len(m.BtcAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WithdrawRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BtcAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawBitcoinResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitWithdrawSignaturesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Txid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Psbt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitWithdrawSignaturesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgLinkBitcoinAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BtcAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgLinkBitcoinAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateHTLCRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Taker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.HashLock)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TimeoutHeight != 0 {
+		n += 1 + sovTx(uint64(m.TimeoutHeight))
+	}
+	l = len(m.PkScript)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BtcAmount != 0 {
+		n += 1 + sovTx(uint64(m.BtcAmount))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateHTLCResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgClaimHTLCRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.Preimage)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Blockhash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Proof) > 0 {
+		for _, s := range m.Proof {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgClaimHTLCResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateBlocklistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Add) > 0 {
+		for _, s := range m.Add {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Remove) > 0 {
+		for _, s := range m.Remove {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateBlocklistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReleaseQuarantinedDepositRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgReleaseQuarantinedDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReturnQuarantinedDepositRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.BtcAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FeeRate != 0 {
+		n += 1 + sovTx(uint64(m.FeeRate))
+	}
+	return n
+}
+
+func (m *MsgReturnQuarantinedDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRecoverVaultRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FeeRate != 0 {
+		n += 1 + sovTx(uint64(m.FeeRate))
+	}
+	return n
+}
+
+func (m *MsgRecoverVaultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Txid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTagUTXORequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Txid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Vout != 0 {
+		n += 1 + sovTx(uint64(m.Vout))
+	}
+	if m.Inscriptions != 0 {
+		n += 1 + sovTx(uint64(m.Inscriptions))
+	}
+	if len(m.Runes) > 0 {
+		for _, e := range m.Runes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgTagUTXOResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSubmitWithdrawStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitWithdrawStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitWithdrawStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SigningStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitWithdrawStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitWithdrawStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitWithdrawStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitBlockHeaderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitBlockHeaderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitBlockHeaderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeaders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHeaders = append(m.BlockHeaders, &BlockHeader{})
+			if err := m.BlockHeaders[len(m.BlockHeaders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitBlockHeadersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitBlockHeadersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitBlockHeadersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitDepositTransactionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitDepositTransactionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitDepositTransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blockhash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blockhash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevTxBytes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrevTxBytes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxOutProof", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxOutProof = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InputPrevTxs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InputPrevTxs = append(m.InputPrevTxs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitDepositTransactionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitDepositTransactionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitDepositTransactionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitWithdrawTransactionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitWithdrawTransactionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitWithdrawTransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blockhash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blockhash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxOutProof", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxOutProof = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitWithdrawTransactionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitWithdrawTransactionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitWithdrawTransactionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateQualifiedRelayersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateQualifiedRelayersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateQualifiedRelayersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayers = append(m.Relayers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateQualifiedRelayersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateQualifiedRelayersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateQualifiedRelayersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawBitcoinRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawBitcoinRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawBitcoinRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRate", wireType)
+			}
+			m.FeeRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeRate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipients = append(m.Recipients, &WithdrawRecipient{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WithdrawRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawBitcoinResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawBitcoinResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawBitcoinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitWithdrawSignaturesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitWithdrawSignaturesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitWithdrawSignaturesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Psbt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Psbt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitWithdrawSignaturesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitWithdrawSignaturesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitWithdrawSignaturesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLinkBitcoinAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLinkBitcoinAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLinkBitcoinAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLinkBitcoinAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLinkBitcoinAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLinkBitcoinAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateHTLCRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateHTLCRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateHTLCRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Taker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Taker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashLock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HashLock = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+			}
+			m.TimeoutHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PkScript", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PkScript = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcAmount", wireType)
+			}
+			m.BtcAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateHTLCResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateHTLCResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateHTLCResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimHTLCRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimHTLCRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimHTLCRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Preimage", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitWithdrawStatusRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitWithdrawStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Preimage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Blockhash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1889,11 +7165,11 @@ func (m *MsgSubmitWithdrawStatusRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Blockhash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1921,13 +7197,13 @@ func (m *MsgSubmitWithdrawStatusRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Txid = string(dAtA[iNdEx:postIndex])
+			m.TxBytes = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
-			m.Status = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1937,11 +7213,24 @@ func (m *MsgSubmitWithdrawStatusRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= SigningStatus(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1963,7 +7252,7 @@ func (m *MsgSubmitWithdrawStatusRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitWithdrawStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimHTLCResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1986,10 +7275,10 @@ func (m *MsgSubmitWithdrawStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitWithdrawStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimHTLCResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitWithdrawStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimHTLCResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2013,7 +7302,7 @@ func (m *MsgSubmitWithdrawStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitBlockHeaderRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2036,15 +7325,15 @@ func (m *MsgSubmitBlockHeaderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitBlockHeaderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitBlockHeaderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2072,11 +7361,11 @@ func (m *MsgSubmitBlockHeaderRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeaders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2103,8 +7392,7 @@ func (m *MsgSubmitBlockHeaderRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockHeaders = append(m.BlockHeaders, &BlockHeader{})
-			if err := m.BlockHeaders[len(m.BlockHeaders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2129,7 +7417,7 @@ func (m *MsgSubmitBlockHeaderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitBlockHeadersResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2152,10 +7440,10 @@ func (m *MsgSubmitBlockHeadersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitBlockHeadersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitBlockHeadersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2179,7 +7467,7 @@ func (m *MsgSubmitBlockHeadersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitDepositTransactionRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateBlocklistRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2202,15 +7490,15 @@ func (m *MsgSubmitDepositTransactionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitDepositTransactionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateBlocklistRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitDepositTransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateBlocklistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2238,11 +7526,11 @@ func (m *MsgSubmitDepositTransactionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Blockhash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Add", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2270,75 +7558,11 @@ func (m *MsgSubmitDepositTransactionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Blockhash = string(dAtA[iNdEx:postIndex])
+			m.Add = append(m.Add, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevTxBytes", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PrevTxBytes = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TxBytes = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2366,7 +7590,7 @@ func (m *MsgSubmitDepositTransactionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proof = append(m.Proof, string(dAtA[iNdEx:postIndex]))
+			m.Remove = append(m.Remove, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2389,7 +7613,7 @@ func (m *MsgSubmitDepositTransactionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitDepositTransactionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateBlocklistResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2412,10 +7636,10 @@ func (m *MsgSubmitDepositTransactionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitDepositTransactionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateBlocklistResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitDepositTransactionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateBlocklistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2439,7 +7663,7 @@ func (m *MsgSubmitDepositTransactionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitWithdrawTransactionRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgReleaseQuarantinedDepositRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2459,82 +7683,18 @@ func (m *MsgSubmitWithdrawTransactionRequest) Unmarshal(dAtA []byte) error {
 				break
 			}
 		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitWithdrawTransactionRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitWithdrawTransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Blockhash", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Blockhash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReleaseQuarantinedDepositRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReleaseQuarantinedDepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2562,13 +7722,13 @@ func (m *MsgSubmitWithdrawTransactionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxBytes = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2578,24 +7738,11 @@ func (m *MsgSubmitWithdrawTransactionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Proof = append(m.Proof, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2617,7 +7764,7 @@ func (m *MsgSubmitWithdrawTransactionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitWithdrawTransactionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgReleaseQuarantinedDepositResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2640,10 +7787,10 @@ func (m *MsgSubmitWithdrawTransactionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitWithdrawTransactionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReleaseQuarantinedDepositResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitWithdrawTransactionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReleaseQuarantinedDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2667,7 +7814,7 @@ func (m *MsgSubmitWithdrawTransactionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateQualifiedRelayersRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgReturnQuarantinedDepositRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2690,10 +7837,10 @@ func (m *MsgUpdateQualifiedRelayersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateQualifiedRelayersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReturnQuarantinedDepositRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateQualifiedRelayersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReturnQuarantinedDepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2729,8 +7876,27 @@ func (m *MsgUpdateQualifiedRelayersRequest) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2758,8 +7924,27 @@ func (m *MsgUpdateQualifiedRelayersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Relayers = append(m.Relayers, string(dAtA[iNdEx:postIndex]))
+			m.BtcAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRate", wireType)
+			}
+			m.FeeRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeRate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2781,7 +7966,7 @@ func (m *MsgUpdateQualifiedRelayersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateQualifiedRelayersResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgReturnQuarantinedDepositResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2804,10 +7989,10 @@ func (m *MsgUpdateQualifiedRelayersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateQualifiedRelayersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReturnQuarantinedDepositResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateQualifiedRelayersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReturnQuarantinedDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2831,7 +8016,7 @@ func (m *MsgUpdateQualifiedRelayersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawBitcoinRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgRecoverVaultRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2854,15 +8039,15 @@ func (m *MsgWithdrawBitcoinRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawBitcoinRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRecoverVaultRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawBitcoinRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRecoverVaultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2890,11 +8075,11 @@ func (m *MsgWithdrawBitcoinRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2922,9 +8107,73 @@ func (m *MsgWithdrawBitcoinRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field FeeRate", wireType)
 			}
@@ -2964,7 +8213,7 @@ func (m *MsgWithdrawBitcoinRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawBitcoinResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRecoverVaultResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2987,12 +8236,44 @@ func (m *MsgWithdrawBitcoinResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawBitcoinResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRecoverVaultResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawBitcoinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRecoverVaultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3014,7 +8295,7 @@ func (m *MsgWithdrawBitcoinResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitWithdrawSignaturesRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgTagUTXORequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3037,15 +8318,15 @@ func (m *MsgSubmitWithdrawSignaturesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitWithdrawSignaturesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTagUTXORequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitWithdrawSignaturesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTagUTXORequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3073,11 +8354,11 @@ func (m *MsgSubmitWithdrawSignaturesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3105,11 +8386,11 @@ func (m *MsgSubmitWithdrawSignaturesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Txid = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Psbt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3137,7 +8418,79 @@ func (m *MsgSubmitWithdrawSignaturesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Psbt = string(dAtA[iNdEx:postIndex])
+			m.Txid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vout", wireType)
+			}
+			m.Vout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Vout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inscriptions", wireType)
+			}
+			m.Inscriptions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Inscriptions |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Runes = append(m.Runes, &RuneBalance{})
+			if err := m.Runes[len(m.Runes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3160,7 +8513,7 @@ func (m *MsgSubmitWithdrawSignaturesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitWithdrawSignaturesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTagUTXOResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3183,10 +8536,10 @@ func (m *MsgSubmitWithdrawSignaturesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitWithdrawSignaturesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTagUTXOResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitWithdrawSignaturesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTagUTXOResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
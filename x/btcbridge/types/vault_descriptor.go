@@ -0,0 +1,120 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+)
+
+const (
+	// the maximum recovery delay, i.e. the maximum block based relative lock time of BIP-68
+	MaxRecoveryDelay = 0xffff
+)
+
+// Validate validates the vault descriptor
+func (d *VaultDescriptor) Validate() error {
+	if _, err := parseXOnlyPubKey(d.InternalKey); err != nil {
+		return fmt.Errorf("invalid internal key: %v", err)
+	}
+
+	if _, err := parseXOnlyPubKey(d.RecoveryKey); err != nil {
+		return fmt.Errorf("invalid recovery key: %v", err)
+	}
+
+	if d.RecoveryDelay == 0 || d.RecoveryDelay > MaxRecoveryDelay {
+		return fmt.Errorf("recovery delay must be between 1 and %d", MaxRecoveryDelay)
+	}
+
+	return nil
+}
+
+// RecoveryScript returns the tap leaf script of the recovery path:
+// <recovery delay> OP_CHECKSEQUENCEVERIFY OP_DROP <recovery key> OP_CHECKSIG
+func (d *VaultDescriptor) RecoveryScript() ([]byte, error) {
+	recoveryKey, err := parseXOnlyPubKey(d.RecoveryKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return txscript.NewScriptBuilder().
+		AddInt64(int64(d.RecoveryDelay)).
+		AddOp(txscript.OP_CHECKSEQUENCEVERIFY).
+		AddOp(txscript.OP_DROP).
+		AddData(schnorr.SerializePubKey(recoveryKey)).
+		AddOp(txscript.OP_CHECKSIG).
+		Script()
+}
+
+// TapScriptTree returns the tap script tree of the vault, which consists of the recovery leaf only
+func (d *VaultDescriptor) TapScriptTree() (*txscript.IndexedTapScriptTree, error) {
+	script, err := d.RecoveryScript()
+	if err != nil {
+		return nil, err
+	}
+
+	return txscript.AssembleTaprootScriptTree(txscript.NewBaseTapLeaf(script)), nil
+}
+
+// OutputKey returns the taproot output key, i.e. the internal key tweaked by the tap script tree
+func (d *VaultDescriptor) OutputKey() (*btcec.PublicKey, error) {
+	internalKey, err := parseXOnlyPubKey(d.InternalKey)
+	if err != nil {
+		return nil, err
+	}
+
+	tree, err := d.TapScriptTree()
+	if err != nil {
+		return nil, err
+	}
+
+	rootHash := tree.RootNode.TapHash()
+
+	return txscript.ComputeTaprootOutputKey(internalKey, rootHash[:]), nil
+}
+
+// Address returns the taproot address of the vault on the given network
+func (d *VaultDescriptor) Address(chainCfg *chaincfg.Params) (string, error) {
+	outputKey, err := d.OutputKey()
+	if err != nil {
+		return "", err
+	}
+
+	addr, err := btcutil.NewAddressTaproot(schnorr.SerializePubKey(outputKey), chainCfg)
+	if err != nil {
+		return "", err
+	}
+
+	return addr.EncodeAddress(), nil
+}
+
+// RecoveryControlBlock returns the serialized control block to spend by the recovery leaf
+func (d *VaultDescriptor) RecoveryControlBlock() ([]byte, error) {
+	internalKey, err := parseXOnlyPubKey(d.InternalKey)
+	if err != nil {
+		return nil, err
+	}
+
+	tree, err := d.TapScriptTree()
+	if err != nil {
+		return nil, err
+	}
+
+	controlBlock := tree.LeafMerkleProofs[0].ToControlBlock(internalKey)
+
+	return controlBlock.ToBytes()
+}
+
+// parseXOnlyPubKey parses the given hex encoded x-only public key
+func parseXOnlyPubKey(pubKey string) (*btcec.PublicKey, error) {
+	b, err := hex.DecodeString(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return schnorr.ParsePubKey(b)
+}
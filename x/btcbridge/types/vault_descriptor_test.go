@@ -0,0 +1,150 @@
+package types_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sideprotocol/side/x/btcbridge/types"
+)
+
+func newTestDescriptor(t *testing.T) (*types.VaultDescriptor, *btcec.PrivateKey, *btcec.PrivateKey) {
+	committeeKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	recoveryKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	return &types.VaultDescriptor{
+		InternalKey:   hex.EncodeToString(schnorr.SerializePubKey(committeeKey.PubKey())),
+		RecoveryKey:   hex.EncodeToString(schnorr.SerializePubKey(recoveryKey.PubKey())),
+		RecoveryDelay: 144,
+	}, committeeKey, recoveryKey
+}
+
+// signRecovery signs all inputs of the given recovery psbt by the recovery script path
+func signRecovery(t *testing.T, p *psbt.Packet, recoveryKey *btcec.PrivateKey) {
+	prevOuts := txscript.NewMultiPrevOutFetcher(nil)
+	for i, in := range p.UnsignedTx.TxIn {
+		prevOuts.AddPrevOut(in.PreviousOutPoint, p.Inputs[i].WitnessUtxo)
+	}
+
+	sigHashes := txscript.NewTxSigHashes(p.UnsignedTx, prevOuts)
+	for i, input := range p.Inputs {
+		leaf := txscript.NewBaseTapLeaf(input.TaprootLeafScript[0].Script)
+		sig, err := txscript.RawTxInTapscriptSignature(p.UnsignedTx, sigHashes, i, input.WitnessUtxo.Value, input.WitnessUtxo.PkScript, leaf, txscript.SigHashDefault, recoveryKey)
+		require.NoError(t, err)
+
+		leafHash := leaf.TapHash()
+		p.Inputs[i].TaprootScriptSpendSig = []*psbt.TaprootScriptSpendSig{{
+			XOnlyPubKey: schnorr.SerializePubKey(recoveryKey.PubKey()),
+			LeafHash:    leafHash[:],
+			Signature:   sig,
+			SigHash:     txscript.SigHashDefault,
+		}}
+	}
+
+	require.NoError(t, psbt.MaybeFinalizeAll(p))
+}
+
+func TestVaultDescriptor(t *testing.T) {
+	descriptor, committeeKey, _ := newTestDescriptor(t)
+	require.NoError(t, descriptor.Validate())
+
+	address, err := descriptor.Address(&chaincfg.RegressionNetParams)
+	require.NoError(t, err)
+
+	// the output key commits to the recovery leaf
+	script, err := descriptor.RecoveryScript()
+	require.NoError(t, err)
+	rootHash := txscript.NewBaseTapLeaf(script).TapHash()
+
+	outputKey := txscript.ComputeTaprootOutputKey(committeeKey.PubKey(), rootHash[:])
+	expected, err := btcutil.NewAddressTaproot(schnorr.SerializePubKey(outputKey), &chaincfg.RegressionNetParams)
+	require.NoError(t, err)
+	require.Equal(t, expected.EncodeAddress(), address)
+
+	// the vault address must match the descriptor
+	params := types.DefaultParams()
+	params.Network = chaincfg.RegressionNetParams.Name
+	params.Vaults = []*types.Vault{{Address: address, TapDescriptor: descriptor, AssetType: types.AssetType_ASSET_TYPE_BTC}}
+	require.NoError(t, params.Validate())
+
+	other, _, _ := newTestDescriptor(t)
+	params.Vaults[0].TapDescriptor = other
+	require.Error(t, params.Validate())
+
+	for _, invalid := range []*types.VaultDescriptor{
+		{InternalKey: descriptor.InternalKey, RecoveryKey: descriptor.RecoveryKey},
+		{InternalKey: descriptor.InternalKey, RecoveryKey: descriptor.RecoveryKey, RecoveryDelay: types.MaxRecoveryDelay + 1},
+		{InternalKey: "00", RecoveryKey: descriptor.RecoveryKey, RecoveryDelay: 1},
+		{InternalKey: descriptor.InternalKey, RecoveryKey: "invalid", RecoveryDelay: 1},
+	} {
+		require.Error(t, invalid.Validate())
+	}
+}
+
+func TestBuildRecoveryPsbt(t *testing.T) {
+	descriptor, _, recoveryKey := newTestDescriptor(t)
+
+	address, err := descriptor.Address(&chaincfg.RegressionNetParams)
+	require.NoError(t, err)
+	addr, err := btcutil.DecodeAddress(address, &chaincfg.RegressionNetParams)
+	require.NoError(t, err)
+	pkScript, err := txscript.PayToAddrScript(addr)
+	require.NoError(t, err)
+
+	utxos := []*types.UTXO{
+		{Txid: "3a4b7a57d8ed8ba5a2a1d8e5a3d2a8d1d0cd0ec8a1d4b6c1e5f6a7b8c9d0e1f2", Vout: 0, Address: address, Amount: 100000, PubKeyScript: pkScript},
+		{Txid: "3a4b7a57d8ed8ba5a2a1d8e5a3d2a8d1d0cd0ec8a1d4b6c1e5f6a7b8c9d0e1f2", Vout: 1, Address: address, Amount: 50000, PubKeyScript: pkScript},
+	}
+
+	recipientKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	recipient, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(recipientKey.PubKey().SerializeCompressed()), &chaincfg.RegressionNetParams)
+	require.NoError(t, err)
+
+	p, err := types.BuildRecoveryPsbt(utxos, recipient.EncodeAddress(), 10, descriptor, &chaincfg.RegressionNetParams)
+	require.NoError(t, err)
+
+	// all utxos are swept to the recipient
+	require.Len(t, p.UnsignedTx.TxIn, 2)
+	require.Len(t, p.UnsignedTx.TxOut, 1)
+	require.Less(t, p.UnsignedTx.TxOut[0].Value, int64(150000))
+	for _, txIn := range p.UnsignedTx.TxIn {
+		require.Equal(t, descriptor.RecoveryDelay, txIn.Sequence)
+	}
+
+	signRecovery(t, p, recoveryKey)
+	require.True(t, types.VerifyPsbtSignatures(p))
+
+	// the recovery path is not available before the delay
+	early, err := types.BuildRecoveryPsbt(utxos, recipient.EncodeAddress(), 10, descriptor, &chaincfg.RegressionNetParams)
+	require.NoError(t, err)
+	for _, txIn := range early.UnsignedTx.TxIn {
+		txIn.Sequence = descriptor.RecoveryDelay - 1
+	}
+
+	signRecovery(t, early, recoveryKey)
+	require.False(t, types.VerifyPsbtSignatures(early))
+
+	// only the recovery key can spend by the script path
+	otherKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	forged, err := types.BuildRecoveryPsbt(utxos, recipient.EncodeAddress(), 10, descriptor, &chaincfg.RegressionNetParams)
+	require.NoError(t, err)
+
+	signRecovery(t, forged, otherKey)
+	require.False(t, types.VerifyPsbtSignatures(forged))
+
+	// the fee can not be covered
+	_, err = types.BuildRecoveryPsbt(utxos[1:], recipient.EncodeAddress(), 1000, descriptor, &chaincfg.RegressionNetParams)
+	require.Error(t, err)
+}